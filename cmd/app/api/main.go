@@ -1,10 +1,19 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
 	"github.com/morning-night-guild/platform-app/internal/adapter/handler"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
 	"github.com/morning-night-guild/platform-app/internal/application/interactor"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
 	"github.com/morning-night-guild/platform-app/internal/driver/config"
 	"github.com/morning-night-guild/platform-app/internal/driver/connect"
 	"github.com/morning-night-guild/platform-app/internal/driver/cookie"
@@ -12,10 +21,20 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/driver/env"
 	"github.com/morning-night-guild/platform-app/internal/driver/firebase"
 	"github.com/morning-night-guild/platform-app/internal/driver/http"
+	"github.com/morning-night-guild/platform-app/internal/driver/keyring"
+	"github.com/morning-night-guild/platform-app/internal/driver/memory"
+	"github.com/morning-night-guild/platform-app/internal/driver/metrics"
 	"github.com/morning-night-guild/platform-app/internal/driver/middleware"
+	"github.com/morning-night-guild/platform-app/internal/driver/oidc"
+	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
+	"github.com/morning-night-guild/platform-app/internal/driver/probe"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
 	"github.com/morning-night-guild/platform-app/internal/driver/redis"
 	"github.com/morning-night-guild/platform-app/internal/driver/resend"
 	"github.com/morning-night-guild/platform-app/internal/driver/server"
+	"github.com/morning-night-guild/platform-app/internal/driver/tracer"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	goredis "github.com/redis/go-redis/v9"
 )
 
 //nolint:funlen,cyclop
@@ -24,6 +43,11 @@ func main() {
 
 	cfg := config.NewAPI()
 
+	tr, err := tracer.New(context.Background(), cfg.TraceExporter, "platform-app-api")
+	if err != nil {
+		panic(err)
+	}
+
 	con := connect.New()
 
 	origins, err := cors.ConvertAllowOrigins(cfg.CORSAllowOrigins)
@@ -49,7 +73,20 @@ func main() {
 		panic(err)
 	}
 
-	authRPC, err := firebase.New().Of(cfg.FirebaseSecret, cfg.FirebaseAPIEndpoint, cfg.FirebaseAPIKey)
+	reg := metrics.NewRegistry()
+
+	rdb, err := newRDB(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	if rdb != nil {
+		if err := metrics.RegisterDB(reg, rdb.DB, "postgres"); err != nil {
+			panic(err)
+		}
+	}
+
+	authRPC, err := newAuthRPC(cfg, rdb)
 	if err != nil {
 		panic(err)
 	}
@@ -64,32 +101,73 @@ func main() {
 		panic(err)
 	}
 
-	rds, err := redis.NewRedis(cfg.RedisURL)
+	oidcRPC, err := oidc.New().Of(cfg.OIDCProviders, cfg.OIDCCallbackBaseURL)
+	if err != nil {
+		panic(err)
+	}
+
+	backend, err := newCacheBackend(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	if backend.rds != nil {
+		if err := metrics.RegisterRedis(reg, backend.rds); err != nil {
+			panic(err)
+		}
+	}
+
+	invitationCache, err := newCache[model.Invitation](backend, "invitation")
+	if err != nil {
+		panic(err)
+	}
+
+	userCache, err := newCache[model.User](backend, "user")
+	if err != nil {
+		panic(err)
+	}
+
+	authCache, err := newCache[model.Auth](backend, "auth")
+	if err != nil {
+		panic(err)
+	}
+
+	sessionCache, err := newCache[model.Session](backend, "session")
+	if err != nil {
+		panic(err)
+	}
+
+	sessionIndex, err := newIndex(backend, "session_index")
+	if err != nil {
+		panic(err)
+	}
+
+	codeCache, err := newCache[model.Code](backend, "code")
 	if err != nil {
 		panic(err)
 	}
 
-	invitationCache, err := redis.New[model.Invitation]().KVS("invitation", rds)
+	verificationCache, err := newCache[model.Verification](backend, "verification")
 	if err != nil {
 		panic(err)
 	}
 
-	userCache, err := redis.New[model.User]().KVS("user", rds)
+	oidcAuthorizationCache, err := newCache[model.OIDCAuthorization](backend, "oidc")
 	if err != nil {
 		panic(err)
 	}
 
-	authCache, err := redis.New[model.Auth]().KVS("auth", rds)
+	kr, err := newKeyring(cfg, backend)
 	if err != nil {
 		panic(err)
 	}
 
-	sessionCache, err := redis.New[model.Session]().KVS("session", rds)
+	verificationPolicy, err := auth.NewVerificationPolicy(cfg.EmailVerificationPolicy)
 	if err != nil {
 		panic(err)
 	}
 
-	codeCache, err := redis.New[model.Code]().KVS("code", rds)
+	auditRepository, err := metrics.NewAudit(reg, newAuditRepository(rdb))
 	if err != nil {
 		panic(err)
 	}
@@ -99,35 +177,322 @@ func main() {
 		authRPC,
 		userRPC,
 		invitationCache,
+		verificationCache,
 		userCache,
 		authCache,
 		codeCache,
 		sessionCache,
+		sessionIndex,
+		auditRepository,
+		verificationPolicy,
+		cfg.EmailVerificationURL,
 	)
 
+	articleListCache, err := newCache[[]model.Article](backend, "article_list")
+	if err != nil {
+		panic(err)
+	}
+
+	articleListVersionCache, err := newCache[string](backend, "article_list_version")
+	if err != nil {
+		panic(err)
+	}
+
+	cacheMetrics, err := metrics.NewCache(reg)
+	if err != nil {
+		panic(err)
+	}
+
 	articleUsecase := interactor.NewAPIArticle(
 		authCache,
 		articleRPC,
+		articleListCache,
+		articleListVersionCache,
+		cacheMetrics,
 	)
 
 	healthUsecase := interactor.NewAPIHealth(healthRPC)
 
+	oidcUsecase := interactor.NewAPIOIDC(
+		oidcRPC,
+		authRPC,
+		userRPC,
+		invitationCache,
+		oidcAuthorizationCache,
+		userCache,
+		authCache,
+		sessionCache,
+		sessionIndex,
+		auditRepository,
+		cfg.OIDCRedirectURL,
+	)
+
+	auditUsecase := interactor.NewAPIAudit(auditRepository)
+
+	userUsecase := interactor.NewAPIUser(userRPC, articleListVersionCache)
+
 	si := handler.New(
 		cfg.APIKey,
 		auth.Secret(cfg.JWTSecret),
+		kr,
 		cookie.New(cfg.CookieDomain),
 		authUsecase,
 		articleUsecase,
 		healthUsecase,
+		oidcUsecase,
+		auditUsecase,
+		userUsecase,
+	)
+
+	limiter, err := newLimiter(backend, "rate_limit")
+	if err != nil {
+		panic(err)
+	}
+
+	policies, err := ratelimit.Parse(cfg.RateLimitPolicies, ratelimit.DefaultAPIPolicies())
+	if err != nil {
+		panic(err)
+	}
+
+	hops, err := ratelimit.ParseTrustedProxyHops(cfg.TrustedProxyHops)
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyCache, err := newCache[middleware.IdempotencyRecord](backend, "idempotency")
+	if err != nil {
+		panic(err)
+	}
+
+	idempotencyLock, err := newLock(backend, "idempotency_lock")
+	if err != nil {
+		panic(err)
+	}
+
+	idempotency := middleware.NewIdempotency(
+		idempotencyCache,
+		idempotencyLock,
+		[]string{
+			"POST /api/v1/articles",
+			"POST /api/v1/auth/invite",
+			"POST /api/v1/auth/join",
+		},
+		cfg.APIKey,
+		si.ExtractUserID,
+		hops,
 	)
 
+	httpMetrics, err := metrics.NewHTTP(reg)
+	if err != nil {
+		panic(err)
+	}
+
+	checker, err := newChecker(cfg, rdb, backend, healthRPC)
+	if err != nil {
+		panic(err)
+	}
+
 	hd := http.NewOpenAPI(
 		si,
 		cs,
-		middleware.New(),
+		middleware.New(httpMetrics, hops),
+		middleware.NewRateLimit(limiter, policies, cfg.APIKey, si.ExtractUserID, hops),
+		idempotency,
+		checker,
 	)
 
 	srv := server.NewServer(cfg.Port, hd)
 
-	srv.Run()
+	metricsSrv := server.NewServer(cfg.MetricsPort, http.NewMetrics(metrics.Handler(reg)))
+
+	server.Run(srv, metricsSrv)
+
+	if err := tr.Shutdown(context.Background()); err != nil {
+		log.Log().Sugar().Warnf("failed to shutdown tracer: %s", err)
+	}
+}
+
+// newRDB 設定に応じてデータベースに接続する.
+// Firebaseで認証する場合、データベースは監査ログにのみ用いるため、DSNが未指定であれば接続しない.
+func newRDB(cfg config.APIConfig) (*gateway.RDB, error) {
+	provider, err := auth.NewProvider(cfg.AuthProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if provider == auth.ProviderFirebase && cfg.DSN == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	return postgres.New().Of(cfg.DSN)
+}
+
+// newAuditRepository データベースに接続しない場合、監査ログはアプリケーションログにのみ出力する.
+func newAuditRepository(rdb *gateway.RDB) repository.Audit { //nolint:ireturn
+	if rdb == nil {
+		log.Log().Warn("audit logs are not persisted because DATABASE_URL is not set")
+
+		return gateway.NewLogAudit()
+	}
+
+	return gateway.NewAudit(rdb)
+}
+
+// newAuthRPC 設定に応じて認証基盤を作成する.
+// localかつfallbackが有効な場合、未移行のユーザーはFirebaseで認証して移行する.
+func newAuthRPC(cfg config.APIConfig, rdb *gateway.RDB) (rpc.Auth, error) {
+	provider, err := auth.NewProvider(cfg.AuthProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if provider == auth.ProviderFirebase {
+		return firebase.New().Of(cfg.FirebaseSecret, cfg.FirebaseAPIEndpoint, cfg.FirebaseAPIKey)
+	}
+
+	if cfg.AuthFallbackEnable != "true" {
+		return gateway.NewAuth(rdb, nil), nil
+	}
+
+	fallback, err := firebase.New().Of(cfg.FirebaseSecret, cfg.FirebaseAPIEndpoint, cfg.FirebaseAPIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return gateway.NewAuth(rdb, fallback), nil
+}
+
+// newChecker 準備完了の判定に用いる依存先を設定に応じて作成する.
+func newChecker(
+	cfg config.APIConfig,
+	rdb *gateway.RDB,
+	backend cacheBackend,
+	healthRPC rpc.Health,
+) (*probe.Checker, error) {
+	checks := []probe.Check{}
+
+	if rdb != nil {
+		checks = append(checks, probe.Postgres(rdb.DB))
+	}
+
+	checks = append(checks, probe.Core(healthRPC))
+
+	if backend.rds != nil {
+		checks = append(checks, probe.Redis(backend.rds))
+	}
+
+	provider, err := auth.NewProvider(cfg.AuthProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case provider == auth.ProviderFirebase:
+		checks = append(checks, probe.Firebase(cfg.FirebaseAPIEndpoint, false))
+	case cfg.AuthFallbackEnable == "true":
+		checks = append(checks, probe.Firebase(cfg.FirebaseAPIEndpoint, true))
+	}
+
+	return probe.New(checks...), nil
+}
+
+// newKeyring 設定に応じて署名鍵の集合を作成する.
+// memoryの場合、rotateコマンドが発行した鍵はRedisにのみ保存されるため読み込まれることはない.
+// そのため鍵を読み込まず、認証トークンは常に共通鍵(JWT_SECRET)で署名する.
+func newKeyring(cfg config.APIConfig, backend cacheBackend) (handler.Keyring, error) { //nolint:ireturn
+	if backend.store != nil {
+		log.Log().Warn("signing keys are never loaded because CACHE_BACKEND is memory. tokens are signed with JWT_SECRET")
+
+		return keyring.NewStatic(auth.KeySet{}), nil
+	}
+
+	keySetCache, err := newCache[auth.KeySet](backend, "jwks")
+	if err != nil {
+		return nil, err
+	}
+
+	kr, err := keyring.New(keySetCache, cfg.SigningKeyEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := kr.Load(context.Background()); err != nil {
+		return nil, err
+	}
+
+	go kr.Run(context.Background(), keyring.DefaultInterval)
+
+	return kr, nil
+}
+
+// cacheBackend キャッシュの保存先.
+// memoryの場合はRedisを用いず、プロセス内のメモリに保存する.
+type cacheBackend struct {
+	rds   goredis.UniversalClient
+	store *kvs.Store
+	codec kvs.Codec
+}
+
+// newCacheBackend 設定に応じてキャッシュの保存先を作成する.
+func newCacheBackend(cfg config.APIConfig) (cacheBackend, error) {
+	codec, err := kvs.NewCodec(cfg.CacheCodec)
+	if err != nil {
+		return cacheBackend{}, err
+	}
+
+	switch cfg.CacheBackend {
+	case "memory":
+		store := kvs.NewStore()
+
+		go store.Run(context.Background(), time.Minute)
+
+		return cacheBackend{
+			store: store,
+			codec: codec,
+		}, nil
+	case "", "redis":
+		rds, err := redis.NewRedis(cfg.RedisURL)
+		if err != nil {
+			return cacheBackend{}, err
+		}
+
+		return cacheBackend{
+			rds:   rds,
+			codec: codec,
+		}, nil
+	default:
+		return cacheBackend{}, fmt.Errorf("unknown cache backend: %s", cfg.CacheBackend)
+	}
+}
+
+func newCache[T any](backend cacheBackend, prefix string) (cache.Cache[T], error) {
+	if backend.store != nil {
+		return memory.New[T]().WithCodec(backend.codec).Memory(prefix, backend.store)
+	}
+
+	return redis.New[T]().WithCodec(backend.codec).KVS(prefix, backend.rds)
+}
+
+func newIndex(backend cacheBackend, prefix string) (cache.Index, error) {
+	if backend.store != nil {
+		return memory.NewIndex(prefix, backend.store)
+	}
+
+	return redis.NewIndex(prefix, backend.rds)
+}
+
+func newLimiter(backend cacheBackend, prefix string) (cache.Limiter, error) {
+	if backend.store != nil {
+		return memory.NewLimiter(prefix, backend.store)
+	}
+
+	return redis.NewLimiter(prefix, backend.rds)
+}
+
+func newLock(backend cacheBackend, prefix string) (cache.Lock, error) {
+	if backend.store != nil {
+		return memory.NewLock(prefix, backend.store)
+	}
+
+	return redis.NewLock(prefix, backend.rds)
 }
@@ -1,16 +1,34 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/morning-night-guild/platform-app/internal/adapter/controller"
 	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
 	"github.com/morning-night-guild/platform-app/internal/application/interactor"
 	"github.com/morning-night-guild/platform-app/internal/driver/config"
+	"github.com/morning-night-guild/platform-app/internal/driver/database"
 	"github.com/morning-night-guild/platform-app/internal/driver/env"
 	"github.com/morning-night-guild/platform-app/internal/driver/http"
 	"github.com/morning-night-guild/platform-app/internal/driver/interceptor"
+	"github.com/morning-night-guild/platform-app/internal/driver/memory"
+	"github.com/morning-night-guild/platform-app/internal/driver/metrics"
 	"github.com/morning-night-guild/platform-app/internal/driver/newrelic"
-	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
+	"github.com/morning-night-guild/platform-app/internal/driver/probe"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
 	"github.com/morning-night-guild/platform-app/internal/driver/server"
+	"github.com/morning-night-guild/platform-app/internal/driver/tracer"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+const (
+	// replicaCheckInterval レプリカの死活を確認する間隔.
+	replicaCheckInterval = 5 * time.Second
+	// replicaCheckTimeout レプリカのPingのタイムアウト.
+	replicaCheckTimeout = time.Second
 )
 
 func main() {
@@ -18,18 +36,56 @@ func main() {
 
 	cfg := config.NewCore()
 
-	rdb, err := postgres.New().Of(cfg.DSN)
+	tr, err := tracer.New(context.Background(), cfg.TraceExporter, "platform-app-core")
+	if err != nil {
+		panic(err)
+	}
+
+	reg := metrics.NewRegistry()
+
+	// DSNのスキームがsqliteの場合は外部のデータベースなしに起動できる
+	rdb, err := database.New().Of(cfg.DSN)
 	if err != nil {
 		panic(err)
 	}
 
-	articleRepo := gateway.NewArticle(rdb)
+	if err := metrics.RegisterDB(reg, rdb.DB, rdb.Dialect); err != nil {
+		panic(err)
+	}
+
+	replicas := make([]*gateway.Replica, len(cfg.ReplicaDSNs))
+
+	for i, dsn := range cfg.ReplicaDSNs {
+		rep, err := database.New().Of(dsn)
+		if err != nil {
+			panic(err)
+		}
+
+		name := fmt.Sprintf("replica-%d", i)
+
+		if err := metrics.RegisterDB(reg, rep.DB, rep.Dialect+"-"+name); err != nil {
+			panic(err)
+		}
+
+		replicas[i] = gateway.NewReplica(name, rep)
+	}
+
+	if len(replicas) > 0 {
+		rdb.UseReplicas(replicas...)
+
+		go rdb.MonitorReplicas(context.Background(), replicaCheckInterval, replicaCheckTimeout)
+	}
+
+	articleRepo, err := metrics.NewArticle(reg, gateway.NewArticle(rdb))
+	if err != nil {
+		panic(err)
+	}
 
 	userRepo := gateway.NewUser(rdb)
 
-	articleUsecase := interactor.NewCoreArticle(articleRepo, userRepo)
+	articleUsecase := interactor.NewCoreArticle(articleRepo, userRepo, gateway.NewTransactor(rdb))
 
-	userUsecase := interactor.NewCoreUser(userRepo)
+	userUsecase := interactor.NewCoreUser(userRepo, articleRepo, gateway.NewTransactor(rdb))
 
 	ctl := controller.New()
 
@@ -48,11 +104,47 @@ func main() {
 		}
 	}
 
-	ic := interceptor.New()
+	rpcMetrics, err := metrics.NewRPC(reg)
+	if err != nil {
+		panic(err)
+	}
+
+	ic := interceptor.New(rpcMetrics)
 
-	h := http.NewConnect(ic, nr, articleCtr, userCtr, healthCtr)
+	policies, err := ratelimit.Parse(cfg.RateLimitPolicies, ratelimit.DefaultCorePolicies())
+	if err != nil {
+		panic(err)
+	}
+
+	// コアサーバーはRedisを用いないため、上限はインスタンスごとに管理する
+	store := kvs.NewStore()
+
+	go store.Run(context.Background(), time.Minute)
+
+	limiter, err := memory.NewLimiter("rate_limit", store)
+	if err != nil {
+		panic(err)
+	}
+
+	rl := interceptor.NewRateLimit(limiter, policies)
+
+	h := http.NewConnect(
+		ic,
+		rl,
+		nr,
+		articleCtr,
+		userCtr,
+		healthCtr,
+		probe.New(probe.RDB(rdb.Dialect, rdb.DB)),
+	)
 
 	srv := server.NewServer(cfg.Port, h)
 
-	srv.Run()
+	metricsSrv := server.NewServer(cfg.MetricsPort, http.NewMetrics(metrics.Handler(reg)))
+
+	server.Run(srv, metricsSrv)
+
+	if err := tr.Shutdown(context.Background()); err != nil {
+		log.Log().Sugar().Warnf("failed to shutdown tracer: %s", err)
+	}
 }
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/driver/env"
+	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+// main 保持期間を過ぎたゴミ箱の記事を完全に削除する.
+// 定期実行を想定しており、保持期間はARTICLE_TRASH_RETENTION(例: 720h)で指定する.
+// 記事のタグやユーザーとの紐付けも合わせて削除される.
+func main() {
+	env.Init()
+
+	ctx := context.Background()
+
+	retention := model.DefaultArticleTrashRetention
+
+	if v := os.Getenv("ARTICLE_TRASH_RETENTION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.GetLogCtx(ctx).Panic("failed to parse retention", zap.String("retention", v), log.ErrorField(err))
+		}
+
+		if d <= 0 {
+			log.GetLogCtx(ctx).Panic("retention must be positive", zap.String("retention", v))
+		}
+
+		retention = d
+	}
+
+	rdb, err := postgres.New().Of(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to connect to database", log.ErrorField(err))
+	}
+
+	defer rdb.Close()
+
+	before := time.Now().UTC().Add(-retention)
+
+	n, err := gateway.NewArticle(rdb).Purge(ctx, before)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to purge articles", log.ErrorField(err))
+	}
+
+	log.GetLogCtx(ctx).Info("success purge articles", zap.Int("count", n), zap.Time("before", before))
+}
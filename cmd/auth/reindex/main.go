@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/driver/env"
+	"github.com/morning-night-guild/platform-app/internal/driver/redis"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+// batchSize 1回のトランザクションで登録するセッション数.
+const batchSize = 100
+
+// main ユーザーごとのセッションIDの集合を既存のセッションから作成する.
+// 集合の導入前に発行されたセッションを全セッションのサインアウトの対象に含めるため、導入時に一度だけ実行する.
+// 何度実行しても結果は変わらない.
+func main() {
+	env.Init()
+
+	ctx := context.Background()
+
+	rds, err := redis.NewRedis(os.Getenv("REDIS_URL"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to connect to redis", log.ErrorField(err))
+	}
+
+	defer rds.Close()
+
+	sessionCache, err := redis.New[model.Session]().KVS("session", rds)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to create session cache", log.ErrorField(err))
+	}
+
+	sessionIndex, err := redis.NewIndex("session_index", rds)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to create session index", log.ErrorField(err))
+	}
+
+	// ${user_id}:${session_id}形式のキーをSCANで走査する
+	keys, err := sessionCache.Keys(ctx, "", cache.WithoutPrefix)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to scan session keys", log.ErrorField(err))
+	}
+
+	cmds := make([]cache.TxSetCmd, 0, batchSize)
+
+	count := 0
+
+	for _, key := range keys {
+		uid, sid, ok := strings.Cut(key, ":")
+		if !ok {
+			log.GetLogCtx(ctx).Sugar().Warnf("skip invalid session key: %s", key)
+
+			continue
+		}
+
+		// 集合の有効期限は追加のたびに更新されるため、個々のセッションの残り期限ではなく最大の期限を指定する
+		cmd, err := sessionIndex.CreateTxAddCmd(ctx, uid, sid, model.DefaultSessionExpiresIn)
+		if err != nil {
+			log.GetLogCtx(ctx).Panic("failed to create add command", log.ErrorField(err))
+		}
+
+		cmds = append(cmds, cmd)
+
+		if len(cmds) < batchSize {
+			continue
+		}
+
+		if err := sessionCache.Tx(ctx, cmds, []cache.TxDelCmd{}); err != nil {
+			log.GetLogCtx(ctx).Panic("failed to index sessions", log.ErrorField(err))
+		}
+
+		count += len(cmds)
+
+		cmds = cmds[:0]
+	}
+
+	if len(cmds) != 0 {
+		if err := sessionCache.Tx(ctx, cmds, []cache.TxDelCmd{}); err != nil {
+			log.GetLogCtx(ctx).Panic("failed to index sessions", log.ErrorField(err))
+		}
+
+		count += len(cmds)
+	}
+
+	log.GetLogCtx(ctx).Sugar().Infof("success reindex sessions. count: %d", count)
+}
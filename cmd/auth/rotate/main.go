@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/driver/env"
+	"github.com/morning-night-guild/platform-app/internal/driver/keyring"
+	"github.com/morning-night-guild/platform-app/internal/driver/redis"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+// main トークンの署名鍵をローテーションする.
+// 定期実行を想定しており、初回実行時は最初の鍵を発行する.
+// 退役した鍵はセッションの有効期限まで検証用に残す.
+func main() {
+	env.Init()
+
+	ctx := context.Background()
+
+	alg, err := auth.NewSigningAlgorithm(os.Getenv("TOKEN_SIGNING_ALGORITHM"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to parse signing algorithm", log.ErrorField(err))
+	}
+
+	rds, err := redis.NewRedis(os.Getenv("REDIS_URL"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to connect to redis", log.ErrorField(err))
+	}
+
+	defer rds.Close()
+
+	keySetCache, err := redis.New[auth.KeySet]().KVS("jwks", rds)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to create key set cache", log.ErrorField(err))
+	}
+
+	kr, err := keyring.New(keySetCache, os.Getenv("SIGNING_KEY_ENCRYPTION_KEY"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to create keyring", log.ErrorField(err))
+	}
+
+	keys, err := kr.Rotate(ctx, alg, model.DefaultSessionExpiresIn)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to rotate signing key", log.ErrorField(err))
+	}
+
+	current := keys.Keys[len(keys.Keys)-1]
+
+	log.GetLogCtx(ctx).Sugar().Infof("success rotate signing key. kid: %s, activated at: %s", current.KID, current.ActivatedAt)
+}
@@ -1,209 +1,253 @@
 package main
 
 import (
+	"archive/zip"
 	"context"
+	"database/sql"
 	"fmt"
+	"io"
 	"os"
-
-	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
-	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
-	"github.com/morning-night-guild/platform-app/pkg/ent"
-	"github.com/morning-night-guild/platform-app/pkg/ent/article"
-	"github.com/morning-night-guild/platform-app/pkg/ent/articletag"
-	"github.com/morning-night-guild/platform-app/pkg/ent/user"
-	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect"
+	// postgres driver.
+	_ "github.com/lib/pq"
+	"github.com/morning-night-guild/platform-app/internal/driver/backup"
 	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
 )
 
-//nolint:cyclop
+const usage = `usage: backup <command> [args]
+
+commands:
+  dump [FILE]                 write all rows to an archive (BACKUP_DIR/backup-<timestamp>.zip if omitted)
+  incremental [BASE [FILE]]   write rows changed since BASE (latest archive in BACKUP_DIR if omitted)
+  restore FULL [INCR...]      load a full archive into an empty database and replay incrementals in order`
+
+// main データベースの論理バックアップを取得・復元する.
+// 引数を省略した場合はdumpとしてBACKUP_DIRにアーカイブを書き出す. BACKUP_DIRが未設定の場合は失敗する.
+// 差分はBACKUP_DIR内の最新のアーカイブを起点に取得し、復元時は全体のアーカイブに続けて取得順に指定する.
 func main() {
 	ctx := context.Background()
 
-	primaryDSN := os.Getenv("PRIMARY_DATABASE_URL")
+	args := os.Args[1:]
+	if len(args) == 0 {
+		args = []string{"dump"}
+	}
 
-	secondaryDSN := os.Getenv("SECONDARY_DATABASE_URL")
+	if err := run(ctx, args[0], args[1:]); err != nil {
+		log.GetLogCtx(ctx).Panic("failed to backup", zap.String("command", args[0]), log.ErrorField(err))
+	}
+}
 
-	primary, err := postgres.New().Of(primaryDSN)
+func run(ctx context.Context, cmd string, args []string) error {
+	db, err := sql.Open(dialect.Postgres, os.Getenv("DATABASE_URL"))
 	if err != nil {
-		log.GetLogCtx(ctx).Panic("failed to connect to primary database", log.ErrorField(err))
+		return err
 	}
 
-	defer primary.Close()
+	defer db.Close()
 
-	entity, err := Export(ctx, primary)
-	if err != nil {
-		log.GetLogCtx(ctx).Panic("failed to export", log.ErrorField(err))
-	}
+	switch cmd {
+	case "dump":
+		var path string
 
-	secondary, err := postgres.New().Of(secondaryDSN)
-	if err != nil {
-		log.GetLogCtx(ctx).Panic("failed to connect to secondary database", log.ErrorField(err))
+		if len(args) > 0 {
+			path = args[0]
+		} else {
+			path, err = archivePath("")
+			if err != nil {
+				return err
+			}
+		}
+
+		return dump(ctx, path, func(w io.Writer) (*backup.Manifest, error) {
+			return backup.Dump(ctx, db, w)
+		})
+	case "incremental":
+		return incremental(ctx, db, args)
+	case "restore":
+		if len(args) == 0 {
+			fmt.Fprintln(os.Stderr, usage)
+
+			return fmt.Errorf("archive file is required") //nolint:goerr113
+		}
+
+		return restore(ctx, db, args)
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+
+		return fmt.Errorf("unknown command: %s", cmd) //nolint:goerr113
 	}
+}
 
-	defer secondary.Close()
+// backupDir アーカイブを保存するディレクトリ.
+// コンテナの作業ディレクトリは再起動で失われるため、既定値は設けず永続化した保存先の指定を必須とする.
+func backupDir() (string, error) {
+	dir := os.Getenv("BACKUP_DIR")
+	if dir == "" {
+		return "", fmt.Errorf("BACKUP_DIR is required, set it to a persistent directory") //nolint:goerr113
+	}
 
-	log.GetLogCtx(ctx).Info("start transaction")
+	return dir, nil
+}
 
-	tx, err := secondary.Tx(ctx)
+// archivePath BACKUP_DIRに作成するアーカイブのパス. ファイル名の辞書順が取得順になる.
+func archivePath(suffix string) (string, error) {
+	dir, err := backupDir()
 	if err != nil {
-		log.GetLogCtx(ctx).Panic("failed to begin transaction", log.ErrorField(err))
+		return "", err
 	}
 
-	if err := ResetTable(ctx, tx); err != nil {
-		if err := tx.Rollback(); err != nil {
-			log.GetLogCtx(ctx).Panic("failed to rollback transaction", log.ErrorField(err))
-		}
+	return filepath.Join(dir, fmt.Sprintf("backup-%s%s.zip", time.Now().UTC().Format("20060102150405"), suffix)), nil
+}
 
-		log.GetLogCtx(ctx).Panic("failed to drop table", log.ErrorField(err))
-	}
+func incremental(ctx context.Context, db *sql.DB, args []string) error {
+	var base string
 
-	if err := Import(ctx, tx, entity); err != nil {
-		if err := tx.Rollback(); err != nil {
-			log.GetLogCtx(ctx).Panic("failed to rollback transaction", log.ErrorField(err))
+	if len(args) > 0 {
+		base = args[0]
+	} else {
+		dir, err := backupDir()
+		if err != nil {
+			return err
 		}
 
-		log.GetLogCtx(ctx).Panic("failed to import", log.ErrorField(err))
-	}
-
-	if err := tx.Commit(); err != nil {
-		if err := tx.Rollback(); err != nil {
-			log.GetLogCtx(ctx).Panic("failed to rollback transaction", log.ErrorField(err))
+		latest, err := latestArchive(dir)
+		if err != nil {
+			return err
 		}
 
-		log.GetLogCtx(ctx).Panic("failed to commit transaction", log.ErrorField(err))
+		base = latest
 	}
 
-	log.GetLogCtx(ctx).Info("end transaction")
+	var path string
 
-	log.GetLogCtx(ctx).Info("success backup")
-}
+	if len(args) > 1 {
+		path = args[1]
+	} else {
+		var err error
 
-type Entity struct {
-	Users       []*ent.User
-	Articles    []*ent.Article
-	ArticleTags []*ent.ArticleTag
-	UserArticle []*ent.UserArticle
-}
-
-func Export(ctx context.Context, client *gateway.RDB) (Entity, error) {
-	log.GetLogCtx(ctx).Info("start export")
-
-	users, err := client.User.Query().All(ctx)
-	if err != nil {
-		return Entity{}, fmt.Errorf("failed to query users: %w", err)
+		path, err = archivePath("-incremental")
+		if err != nil {
+			return err
+		}
 	}
 
-	articleTags, err := client.ArticleTag.Query().All(ctx)
+	manifest, err := readManifest(base)
 	if err != nil {
-		return Entity{}, fmt.Errorf("failed to query article tags: %w", err)
+		return err
 	}
 
-	articles, err := client.Article.Query().All(ctx)
+	log.GetLogCtx(ctx).Info("start incremental", zap.String("base", base), zap.Time("watermark", manifest.Watermark))
+
+	return dump(ctx, path, func(w io.Writer) (*backup.Manifest, error) {
+		return backup.DumpIncremental(ctx, db, w, manifest)
+	})
+}
+
+// latestArchive ディレクトリ内で最後に作成されたアーカイブ.
+func latestArchive(dir string) (string, error) {
+	matches, err := filepath.Glob(filepath.Join(dir, "backup-*.zip"))
 	if err != nil {
-		return Entity{}, fmt.Errorf("failed to query articles: %w", err)
+		return "", err
 	}
 
-	userArticles, err := client.UserArticle.Query().All(ctx)
-	if err != nil {
-		return Entity{}, fmt.Errorf("failed to query user articles: %w", err)
+	if len(matches) == 0 {
+		return "", fmt.Errorf("no archive found in %q, take a full dump first", dir) //nolint:goerr113
 	}
 
-	return Entity{
-		Users:       users,
-		Articles:    articles,
-		ArticleTags: articleTags,
-		UserArticle: userArticles,
-	}, nil
+	sort.Strings(matches)
+
+	return matches[len(matches)-1], nil
 }
 
-const dropTableQuery = "DROP TABLE IF EXISTS %s CASCADE"
+func readManifest(path string) (*backup.Manifest, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer zr.Close()
 
-func ResetTable(ctx context.Context, tx *ent.Tx) error {
-	log.GetLogCtx(ctx).Info("start drop table")
+	return backup.ReadManifest(&zr.Reader)
+}
 
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf(dropTableQuery, user.Table)); err != nil {
-		return fmt.Errorf("failed to drop user table: %w", err)
+func dump(ctx context.Context, path string, fn func(w io.Writer) (*backup.Manifest, error)) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil { //nolint:gomnd
+		return err
 	}
 
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf(dropTableQuery, article.Table)); err != nil {
-		return fmt.Errorf("failed to drop article table: %w", err)
+	// 書き込み途中のファイルを残さないよう一時ファイルに書き出してから置き換える
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".backup-*.zip")
+	if err != nil {
+		return err
 	}
 
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf(dropTableQuery, articletag.Table)); err != nil {
-		return fmt.Errorf("failed to drop article tag table: %w", err)
+	defer os.Remove(tmp.Name())
+
+	manifest, err := fn(tmp)
+	if err != nil {
+		tmp.Close()
+
+		return err
 	}
 
-	if _, err := tx.ExecContext(ctx, fmt.Sprintf(dropTableQuery, userarticle.Table)); err != nil {
-		return fmt.Errorf("failed to drop user article table: %w", err)
+	if err := tmp.Close(); err != nil {
+		return err
 	}
 
-	if err := tx.Client().Debug().Schema.Create(ctx); err != nil {
-		return fmt.Errorf("failed to create primary schema: %w", err)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
 	}
 
-	log.GetLogCtx(ctx).Info("end drop table")
+	log.GetLogCtx(ctx).Info(
+		"success dump",
+		zap.String("file", path),
+		zap.String("kind", string(manifest.Kind)),
+		zap.String("schema_version", manifest.SchemaVersion),
+		zap.Int("tables", len(manifest.Tables)),
+	)
 
 	return nil
 }
 
-func Import(ctx context.Context, tx *ent.Tx, entity Entity) error {
-	log.GetLogCtx(ctx).Info("start import data")
+func restore(ctx context.Context, db *sql.DB, paths []string) error {
+	archives := make([]backup.Archive, len(paths))
 
-	userBulk := make([]*ent.UserCreate, len(entity.Users))
-	for i, user := range entity.Users {
-		userBulk[i] = tx.User.Create().
-			SetID(user.ID).
-			SetCreatedAt(user.CreatedAt).
-			SetUpdatedAt(user.UpdatedAt)
-	}
-
-	if _, err := tx.User.CreateBulk(userBulk...).Save(ctx); err != nil {
-		return fmt.Errorf("failed to bulk create users: %w", err)
-	}
-
-	articleBulk := make([]*ent.ArticleCreate, len(entity.Articles))
-	for i, article := range entity.Articles {
-		articleBulk[i] = tx.Article.Create().
-			SetID(article.ID).
-			SetTitle(article.Title).
-			SetURL(article.URL).
-			SetDescription(article.Description).
-			SetThumbnail(article.Thumbnail).
-			SetCreatedAt(article.CreatedAt).
-			SetUpdatedAt(article.UpdatedAt)
-	}
-
-	if _, err := tx.Article.CreateBulk(articleBulk...).Save(ctx); err != nil {
-		return fmt.Errorf("failed to bulk create articles: %w", err)
-	}
+	for i, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
 
-	articleTagBulk := make([]*ent.ArticleTagCreate, len(entity.ArticleTags))
-	for i, articleTag := range entity.ArticleTags {
-		articleTagBulk[i] = tx.ArticleTag.Create().
-			SetID(articleTag.ID).
-			SetTag(articleTag.Tag).
-			SetArticleID(articleTag.ArticleID)
-	}
+		defer f.Close()
 
-	if _, err := tx.ArticleTag.CreateBulk(articleTagBulk...).Save(ctx); err != nil {
-		return fmt.Errorf("failed to bulk create article tags: %w", err)
-	}
+		info, err := f.Stat()
+		if err != nil {
+			return err
+		}
 
-	userArticleBulk := make([]*ent.UserArticleCreate, len(entity.UserArticle))
-	for i, userArticle := range entity.UserArticle {
-		userArticleBulk[i] = tx.UserArticle.Create().
-			SetID(userArticle.ID).
-			SetUserID(userArticle.UserID).
-			SetArticleID(userArticle.ArticleID).
-			SetCreatedAt(userArticle.CreatedAt).
-			SetUpdatedAt(userArticle.UpdatedAt)
+		archives[i] = backup.Archive{
+			Reader: f,
+			Size:   info.Size(),
+		}
 	}
 
-	if _, err := tx.UserArticle.CreateBulk(userArticleBulk...).Save(ctx); err != nil {
-		return fmt.Errorf("failed to bulk create user articles: %w", err)
+	manifest, err := backup.Restore(ctx, db, archives[0], archives[1:]...)
+	if err != nil {
+		return err
 	}
 
-	log.GetLogCtx(ctx).Info("end import data")
+	log.GetLogCtx(ctx).Info(
+		"success restore",
+		zap.Int("archives", len(paths)),
+		zap.String("id", manifest.ID),
+		zap.String("schema_version", manifest.SchemaVersion),
+		zap.Int("tables", len(manifest.Tables)),
+	)
 
 	return nil
 }
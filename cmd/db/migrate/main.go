@@ -2,23 +2,175 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"os"
+	"strconv"
+	"text/tabwriter"
 
-	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
+	"entgo.io/ent/dialect"
+	// postgres driver.
+	_ "github.com/lib/pq"
+	"github.com/morning-night-guild/platform-app/internal/driver/migration"
+	"github.com/morning-night-guild/platform-app/pkg/ent/migrate/migrations"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
 )
 
+// defaultDir diffで生成したファイルを書き込むディレクトリ. リポジトリのルートから実行する想定.
+const defaultDir = "pkg/ent/migrate/migrations"
+
+const usage = `usage: migrate <command> [args]
+
+commands:
+  up [N]            apply N pending migrations (all if omitted)
+  down N            revert the last N applied migrations
+  status            show the state of each migration
+  baseline VERSION  mark migrations up to VERSION as applied without executing them
+  diff NAME         generate a migration from the difference between the ent schema and the migrations`
+
+// main バージョン付きのマイグレーションを実行する.
+// 引数を省略した場合はupとして全ての未適用のマイグレーションを適用する.
 func main() {
-	dsn := os.Getenv("DATABASE_URL")
+	ctx := context.Background()
 
-	rdb, err := postgres.New().Of(dsn)
+	args := os.Args[1:]
+	if len(args) == 0 {
+		args = []string{"up"}
+	}
+
+	if err := run(ctx, args[0], args[1:]); err != nil {
+		log.GetLogCtx(ctx).Panic("failed to migrate", zap.String("command", args[0]), log.ErrorField(err))
+	}
+}
+
+//nolint:cyclop
+func run(ctx context.Context, cmd string, args []string) error {
+	if cmd == "diff" {
+		return diff(ctx, args)
+	}
+
+	db, err := sql.Open(dialect.Postgres, os.Getenv("DATABASE_URL"))
 	if err != nil {
-		panic(err)
+		return err
 	}
-	defer rdb.Close()
 
-	ctx := context.Background()
+	defer db.Close()
+
+	migrator := migration.New(db, migration.NewDir(migrations.FS))
+
+	switch cmd {
+	case "up":
+		n := 0
+
+		if len(args) > 0 {
+			if n, err = strconv.Atoi(args[0]); err != nil {
+				return fmt.Errorf("invalid count %q: %w", args[0], err)
+			}
+		}
+
+		count, err := migrator.Up(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		log.GetLogCtx(ctx).Info("applied migrations", zap.Int("count", count))
+	case "down":
+		if len(args) == 0 {
+			return fmt.Errorf("%w\n%s", migration.ErrInvalidCount, usage)
+		}
+
+		n, err := strconv.Atoi(args[0])
+		if err != nil {
+			return fmt.Errorf("invalid count %q: %w", args[0], err)
+		}
+
+		count, err := migrator.Down(ctx, n)
+		if err != nil {
+			return err
+		}
+
+		log.GetLogCtx(ctx).Info("reverted migrations", zap.Int("count", count))
+	case "status":
+		sts, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		return printStatus(sts)
+	case "baseline":
+		if len(args) == 0 {
+			return fmt.Errorf("%w\n%s", migration.ErrBaselineNotFound, usage)
+		}
+
+		if err := migrator.Baseline(ctx, args[0]); err != nil {
+			return err
+		}
+
+		log.GetLogCtx(ctx).Info("set baseline", zap.String("version", args[0]))
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+
+		return fmt.Errorf("unknown command: %s", cmd) //nolint:goerr113
+	}
+
+	return nil
+}
+
+// diff 検証用データベースを使ってentのスキーマとの差分からマイグレーションファイルを生成する.
+func diff(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, usage)
+
+		return fmt.Errorf("migration name is required") //nolint:goerr113
+	}
+
+	path := os.Getenv("MIGRATION_DIR")
+	if path == "" {
+		path = defaultDir
+	}
+
+	dir, err := migration.OpenDir(path)
+	if err != nil {
+		return err
+	}
+
+	dev, err := sql.Open(dialect.Postgres, os.Getenv("DEV_DATABASE_URL"))
+	if err != nil {
+		return err
+	}
+
+	defer dev.Close()
 
-	if err := rdb.Debug().Schema.Create(ctx); err != nil {
-		panic(err)
+	changed, err := migration.Diff(ctx, dev, dir, args[0])
+	if err != nil {
+		return err
+	}
+
+	if !changed {
+		log.GetLogCtx(ctx).Info("no schema changes")
+
+		return nil
 	}
+
+	log.GetLogCtx(ctx).Info("generated migration", zap.String("name", args[0]), zap.String("dir", path))
+
+	return nil
+}
+
+func printStatus(sts []migration.Status) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "VERSION\tDESCRIPTION\tSTATE\tEXECUTED AT")
+
+	for _, st := range sts {
+		executed := "-"
+		if !st.ExecutedAt.IsZero() {
+			executed = st.ExecutedAt.Format("2006-01-02 15:04:05")
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", st.Version, st.Description, st.State, executed)
+	}
+
+	return w.Flush()
 }
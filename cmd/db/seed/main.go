@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"os"
+	"strconv"
+
+	"github.com/morning-night-guild/platform-app/internal/driver/database"
+	"github.com/morning-night-guild/platform-app/internal/driver/env"
+	"github.com/morning-night-guild/platform-app/internal/driver/fixture"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultUsers    = 10
+	defaultArticles = 100
+	defaultSeed     = 1
+)
+
+// main ローカル開発やデモ用のデータを登録する.
+// 引数にYAMLのフィクスチャを指定した場合はその内容を登録する.
+// 省略した場合はSEED_USERS人のユーザーとSEED_ARTICLES件の記事、ランダムな紐付けをSEED_RANDOMから決定的に生成して登録する.
+// 登録済みの行は読み飛ばすため、繰り返し実行できる.
+func main() {
+	env.Init()
+
+	ctx := context.Background()
+
+	var f *fixture.Fixture
+
+	if paths := os.Args[1:]; len(paths) > 0 {
+		loaded, err := fixture.ReadFiles(paths...)
+		if err != nil {
+			log.GetLogCtx(ctx).Panic("failed to read fixtures", zap.Strings("files", paths), log.ErrorField(err))
+		}
+
+		f = loaded
+	} else {
+		seed := getInt(ctx, "SEED_RANDOM", defaultSeed)
+
+		f = fixture.Generate(int64(seed), getInt(ctx, "SEED_USERS", defaultUsers), getInt(ctx, "SEED_ARTICLES", defaultArticles))
+	}
+
+	rdb, err := database.New().Of(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to connect to database", log.ErrorField(err))
+	}
+
+	defer rdb.Close()
+
+	if err := fixture.Load(ctx, rdb.Client, f); err != nil {
+		log.GetLogCtx(ctx).Panic("failed to load fixture", log.ErrorField(err))
+	}
+
+	log.GetLogCtx(ctx).Info(
+		"success seed",
+		zap.Int("users", len(f.Users)),
+		zap.Int("articles", len(f.Articles)),
+		zap.Int("user_articles", len(f.UserArticles)),
+	)
+}
+
+// getInt 環境変数を0以上の整数として読み込む. 指定がない場合は既定値を返す.
+func getInt(ctx context.Context, key string, def int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		log.GetLogCtx(ctx).Panic("invalid value", zap.String("key", key), zap.String("value", v))
+	}
+
+	return n
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/application/interactor"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/internal/driver/database"
+	"github.com/morning-night-guild/platform-app/internal/driver/env"
+	"github.com/morning-night-guild/platform-app/internal/driver/firebase"
+	"github.com/morning-night-guild/platform-app/internal/driver/redis"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+// main 削除を受け付けてから猶予期間を過ぎたユーザーを完全に削除する.
+// 定期実行を想定している. 猶予期間は削除時に利用者へ伝えた削除日時と一致させるため変更できない.
+// 猶予期間中は復旧できるよう認証基盤のユーザーも残しているため、先に認証基盤から削除する.
+// ユーザーと記事の紐付けも合わせて削除され、監査ログのユーザーを特定できる項目は匿名化される.
+// 記事の共有者の表示が変わるため、削除したユーザーがいる場合は記事一覧のキャッシュを無効化する.
+func main() {
+	env.Init()
+
+	ctx := context.Background()
+
+	rdb, err := database.New().Of(os.Getenv("DATABASE_URL"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to connect to database", log.ErrorField(err))
+	}
+
+	defer rdb.Close()
+
+	authRPC, err := newAuthRPC(rdb)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to create auth rpc", log.ErrorField(err))
+	}
+
+	rds, err := redis.NewRedis(os.Getenv("REDIS_URL"))
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to connect to redis", log.ErrorField(err))
+	}
+
+	defer rds.Close()
+
+	articleListVersionCache, err := redis.New[string]().KVS("article_list_version", rds)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to create article list version cache", log.ErrorField(err))
+	}
+
+	before := time.Now().UTC().Add(-model.UserDeletionGracePeriod)
+
+	userGateway := gateway.NewUser(rdb)
+
+	uids, err := userGateway.FindPurgeable(ctx, before)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to find users to purge", log.ErrorField(err))
+	}
+
+	// 認証基盤からの削除に失敗した場合は、コアのデータを残して次回の実行で再度削除する
+	for _, uid := range uids {
+		if err := authRPC.Delete(ctx, uid); err != nil {
+			log.GetLogCtx(ctx).Panic("failed to delete auth user", log.ErrorField(err), zap.String("uid", uid.String()))
+		}
+	}
+
+	n, err := userGateway.Purge(ctx, before)
+	if err != nil {
+		log.GetLogCtx(ctx).Panic("failed to purge users", log.ErrorField(err))
+	}
+
+	if n > 0 {
+		if err := interactor.InvalidateArticleLists(ctx, articleListVersionCache); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to invalidate article list cache", log.ErrorField(err))
+		}
+	}
+
+	log.GetLogCtx(ctx).Info("success purge users", zap.Int("count", n), zap.Time("before", before))
+}
+
+// newAuthRPC 認証基盤のユーザーを削除するための認証基盤を作成する.
+// パスワードハッシュを削除し、Firebaseを設定した場合はFirebaseのユーザーも削除する.
+func newAuthRPC(rdb *gateway.RDB) (rpc.Auth, error) {
+	secret := os.Getenv("FIREBASE_SECRET")
+	if secret == "" {
+		return gateway.NewAuth(rdb, nil), nil
+	}
+
+	fallback, err := firebase.New().Of(secret, os.Getenv("FIREBASE_API_ENDPOINT"), os.Getenv("FIREBASE_API_KEY"))
+	if err != nil {
+		return nil, err
+	}
+
+	return gateway.NewAuth(rdb, fallback), nil
+}
@@ -23,6 +23,9 @@ func TestAppAPIE2EInternalArticleDelete(t *testing.T) {
 
 		db.BulkInsertArticles([]uuid.UUID{id})
 
+		// ゴミ箱に移動した記事を完全に削除する
+		defer db.BulkDeleteArticles([]uuid.UUID{id})
+
 		defer db.Close()
 
 		client := helper.NewOpenAPIClientWithAPIKey(t, url, helper.GetAPIKey(t))
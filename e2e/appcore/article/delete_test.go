@@ -27,6 +27,9 @@ func TestAppCoreE2EArticleDelete(t *testing.T) {
 
 		db.BulkInsertArticles([]uuid.UUID{id})
 
+		// ゴミ箱に移動した記事を完全に削除する
+		defer db.BulkDeleteArticles([]uuid.UUID{id})
+
 		client := helper.NewConnectClient(t, &http.Client{}, url)
 
 		req := &articlev1.DeleteRequest{
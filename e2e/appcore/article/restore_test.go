@@ -0,0 +1,90 @@
+package article_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/e2e/helper"
+	articlev1 "github.com/morning-night-guild/platform-app/pkg/connect/article/v1"
+)
+
+func TestAppCoreE2EArticleRestore(t *testing.T) {
+	t.Parallel()
+
+	url := helper.GetAppCoreEndpoint(t)
+
+	t.Run("ゴミ箱に移動した記事が復元できる", func(t *testing.T) {
+		t.Parallel()
+
+		db := helper.NewDatabase(t, helper.GetDSN(t))
+
+		id := uuid.New()
+
+		defer db.Close()
+
+		db.BulkInsertArticles([]uuid.UUID{id})
+
+		defer db.BulkDeleteArticles([]uuid.UUID{id})
+
+		client := helper.NewConnectClient(t, &http.Client{}, url)
+
+		ctx := context.Background()
+
+		if _, err := client.Article.Delete(ctx, connect.NewRequest(&articlev1.DeleteRequest{
+			ArticleId: id.String(),
+		})); err != nil {
+			t.Fatalf("failed to delete article: %s", err)
+		}
+
+		res, err := client.Article.ListTrash(ctx, connect.NewRequest(&articlev1.ListTrashRequest{
+			MaxPageSize: 100,
+		}))
+		if err != nil {
+			t.Fatalf("failed to list trash: %s", err)
+		}
+
+		found := false
+
+		for _, trashed := range res.Msg.Articles {
+			if trashed.Article.ArticleId == id.String() {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf("trash does not contain %s", id)
+		}
+
+		if _, err := client.Article.Restore(ctx, connect.NewRequest(&articlev1.RestoreRequest{
+			ArticleId: id.String(),
+		})); err != nil {
+			t.Fatalf("failed to restore article: %s", err)
+		}
+
+		// 復元した記事は再度削除できる
+		if _, err := client.Article.Delete(ctx, connect.NewRequest(&articlev1.DeleteRequest{
+			ArticleId: id.String(),
+		})); err != nil {
+			t.Errorf("failed to delete restored article: %s", err)
+		}
+	})
+
+	t.Run("ゴミ箱にない記事は復元できない", func(t *testing.T) {
+		t.Parallel()
+
+		client := helper.NewConnectClient(t, &http.Client{}, url)
+
+		req := &articlev1.RestoreRequest{
+			ArticleId: uuid.NewString(),
+		}
+
+		if _, err := client.Article.Restore(context.Background(), connect.NewRequest(req)); err == nil {
+			t.Error("succeeded to restore article")
+		} else if connect.CodeOf(err) != connect.CodeNotFound {
+			t.Errorf("err = %v, want %v", connect.CodeOf(err), connect.CodeNotFound)
+		}
+	})
+}
@@ -0,0 +1,97 @@
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/e2e/helper"
+	userv1 "github.com/morning-night-guild/platform-app/pkg/connect/user/v1"
+)
+
+func TestAppCoreE2EUserDelete(t *testing.T) {
+	t.Parallel()
+
+	url := helper.GetAppCoreEndpoint(t)
+
+	t.Run("ユーザーが削除できる", func(t *testing.T) {
+		t.Parallel()
+
+		db := helper.NewDatabase(t, helper.GetDSN(t))
+
+		defer db.Close()
+
+		client := helper.NewConnectClient(t, http.DefaultClient, url)
+
+		res1, err := client.User.Create(context.Background(), connect.NewRequest(&userv1.CreateRequest{}))
+		if err != nil {
+			t.Fatalf("failed to create user: %s", err)
+		}
+
+		uid := res1.Msg.User.UserId
+
+		defer db.DeleteUser(uuid.MustParse(uid))
+
+		req := &userv1.DeleteRequest{
+			UserId: uid,
+		}
+
+		res2, err := client.User.Delete(context.Background(), connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("failed to delete user: %s", err)
+		}
+
+		if res2.Msg.Export.User.UserId != uid {
+			t.Errorf("export user id = %s, want %s", res2.Msg.Export.User.UserId, uid)
+		}
+
+		if !res2.Msg.PurgeAt.AsTime().After(res2.Msg.Export.ExportedAt.AsTime()) {
+			t.Errorf("purge at = %v, want after %v", res2.Msg.PurgeAt.AsTime(), res2.Msg.Export.ExportedAt.AsTime())
+		}
+
+		// 削除済みのユーザーは更新できない
+		if _, err := client.User.Update(context.Background(), connect.NewRequest(&userv1.UpdateRequest{UserId: uid})); err == nil {
+			t.Errorf("success to update deleted user")
+		}
+
+		// 再度削除しても同じ削除予定日時が返る
+		res3, err := client.User.Delete(context.Background(), connect.NewRequest(req))
+		if err != nil {
+			t.Fatalf("failed to delete user again: %s", err)
+		}
+
+		if !res3.Msg.PurgeAt.AsTime().Equal(res2.Msg.PurgeAt.AsTime()) {
+			t.Errorf("purge at = %v, want %v", res3.Msg.PurgeAt.AsTime(), res2.Msg.PurgeAt.AsTime())
+		}
+	})
+
+	t.Run("存在しないユーザーであるため削除に失敗する", func(t *testing.T) {
+		t.Parallel()
+
+		client := helper.NewConnectClient(t, http.DefaultClient, url)
+
+		req := &userv1.DeleteRequest{
+			UserId: uuid.New().String(),
+		}
+
+		if _, err := client.User.Delete(context.Background(), connect.NewRequest(req)); err == nil {
+			t.Errorf("success to delete user: %s", err)
+		}
+	})
+
+	t.Run("不正なIDであるため削除に失敗する", func(t *testing.T) {
+		t.Parallel()
+
+		client := helper.NewConnectClient(t, http.DefaultClient, url)
+
+		req := &userv1.DeleteRequest{
+			UserId: "uid",
+		}
+
+		if _, err := client.User.Delete(context.Background(), connect.NewRequest(req)); err == nil {
+			t.Errorf("success to delete user: %s", err)
+		}
+	})
+}
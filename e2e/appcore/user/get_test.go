@@ -0,0 +1,60 @@
+package user_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/e2e/helper"
+	userv1 "github.com/morning-night-guild/platform-app/pkg/connect/user/v1"
+)
+
+func TestAppCoreE2EUserGet(t *testing.T) {
+	t.Parallel()
+
+	url := helper.GetAppCoreEndpoint(t)
+
+	t.Run("ユーザーが取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		db := helper.NewDatabase(t, helper.GetDSN(t))
+
+		defer db.Close()
+
+		client := helper.NewConnectClient(t, http.DefaultClient, url)
+
+		res1, err := client.User.Create(context.Background(), connect.NewRequest(&userv1.CreateRequest{}))
+		if err != nil {
+			t.Fatalf("failed to create user: %s", err)
+		}
+
+		uid := res1.Msg.User.UserId
+
+		defer db.DeleteUser(uuid.MustParse(uid))
+
+		res2, err := client.User.Get(context.Background(), connect.NewRequest(&userv1.GetRequest{UserId: uid}))
+		if err != nil {
+			t.Fatalf("failed to get user: %s", err)
+		}
+
+		if res2.Msg.User.UserId != uid {
+			t.Errorf("user id = %s, want %s", res2.Msg.User.UserId, uid)
+		}
+	})
+
+	t.Run("存在しないユーザーであるため取得に失敗する", func(t *testing.T) {
+		t.Parallel()
+
+		client := helper.NewConnectClient(t, http.DefaultClient, url)
+
+		req := &userv1.GetRequest{
+			UserId: uuid.New().String(),
+		}
+
+		if _, err := client.User.Get(context.Background(), connect.NewRequest(req)); err == nil {
+			t.Errorf("success to get user: %s", err)
+		}
+	})
+}
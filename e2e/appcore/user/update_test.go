@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/e2e/helper"
 	userv1 "github.com/morning-night-guild/platform-app/pkg/connect/user/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestAppCoreE2EUserUpdate(t *testing.T) {
@@ -44,6 +45,64 @@ func TestAppCoreE2EUserUpdate(t *testing.T) {
 		db.DeleteUser(uuid.MustParse(res2.Msg.User.UserId))
 	})
 
+	t.Run("フィールドマスクで指定した項目のみ更新できる", func(t *testing.T) {
+		t.Parallel()
+
+		db := helper.NewDatabase(t, helper.GetDSN(t))
+
+		defer db.Close()
+
+		client := helper.NewConnectClient(t, http.DefaultClient, url)
+
+		res1, err := client.User.Create(context.Background(), connect.NewRequest(&userv1.CreateRequest{}))
+		if err != nil {
+			t.Fatalf("failed to create user: %s", err)
+		}
+
+		defer db.DeleteUser(uuid.MustParse(res1.Msg.User.UserId))
+
+		req2 := &userv1.UpdateRequest{
+			UserId: res1.Msg.User.UserId,
+			Profile: &userv1.Profile{
+				DisplayName: "name",
+				Bio:         "bio",
+			},
+			UpdateMask: &fieldmaskpb.FieldMask{
+				Paths: []string{"display_name"},
+			},
+		}
+
+		res2, err := client.User.Update(context.Background(), connect.NewRequest(req2))
+		if err != nil {
+			t.Fatalf("failed to update user: %s", err)
+		}
+
+		if res2.Msg.User.Profile.DisplayName != "name" {
+			t.Errorf("display name = %s, want %s", res2.Msg.User.Profile.DisplayName, "name")
+		}
+
+		if res2.Msg.User.Profile.Bio != "" {
+			t.Errorf("bio = %s, want empty", res2.Msg.User.Profile.Bio)
+		}
+	})
+
+	t.Run("不正なフィールドマスクであるため更新に失敗する", func(t *testing.T) {
+		t.Parallel()
+
+		client := helper.NewConnectClient(t, http.DefaultClient, url)
+
+		req := &userv1.UpdateRequest{
+			UserId: uuid.New().String(),
+			UpdateMask: &fieldmaskpb.FieldMask{
+				Paths: []string{"email"},
+			},
+		}
+
+		if _, err := client.User.Update(context.Background(), connect.NewRequest(req)); err == nil {
+			t.Errorf("success to update user: %s", err)
+		}
+	})
+
 	t.Run("存在しないユーザーであるため更新に失敗する", func(t *testing.T) {
 		t.Parallel()
 
@@ -3,13 +3,15 @@ package helper
 import (
 	"context"
 	"testing"
-	"time"
 
 	"github.com/google/uuid"
 	// postgres driver.
 	_ "github.com/lib/pq"
+	"github.com/morning-night-guild/platform-app/internal/driver/fixture"
 	"github.com/morning-night-guild/platform-app/pkg/ent"
 	"github.com/morning-night-guild/platform-app/pkg/ent/article"
+	// 既定値やインターセプターを登録する.
+	_ "github.com/morning-night-guild/platform-app/pkg/ent/runtime"
 	"github.com/morning-night-guild/platform-app/pkg/ent/user"
 	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
 )
@@ -45,28 +47,51 @@ func (db *Database) Close() {
 func (db *Database) BulkInsertArticles(ids []uuid.UUID) {
 	db.T.Helper()
 
-	count := len(ids)
-
-	bulk := make([]*ent.ArticleCreate, count)
+	articles := make([]fixture.Article, len(ids))
 
 	for i, id := range ids {
-		bulk[i] = db.client.Article.Create().
-			SetID(id).
-			SetTitle("title-" + id.String()).
-			SetURL("https://example.com/" + id.String()).
-			SetDescription("description").
-			SetThumbnail("https://example.com/" + id.String()).
-			SetCreatedAt(time.Now()).
-			SetUpdatedAt(time.Now())
+		articles[i] = fixture.Article{
+			ID:          id,
+			Title:       "title-" + id.String(),
+			URL:         "https://example.com/" + id.String(),
+			Description: "description",
+			Thumbnail:   "https://example.com/" + id.String(),
+		}
 	}
 
-	if err := db.client.Article.CreateBulk(bulk...).
-		OnConflict().
-		UpdateNewValues().
-		DoNothing().
-		Exec(context.Background()); err != nil {
+	db.Load(&fixture.Fixture{Articles: articles})
+}
+
+// Load フィクスチャを登録する. 削除はテストごとに行う.
+func (db *Database) Load(f *fixture.Fixture) {
+	db.T.Helper()
+
+	if err := fixture.Load(context.Background(), db.client, f); err != nil {
+		db.T.Fatal(err)
+	}
+}
+
+// LoadFixture YAMLのフィクスチャを登録する. 削除はUnloadで行う.
+func (db *Database) LoadFixture(paths ...string) *fixture.Fixture {
+	db.T.Helper()
+
+	f, err := fixture.ReadFiles(paths...)
+	if err != nil {
 		db.T.Fatal(err)
 	}
+
+	db.Load(f)
+
+	return f
+}
+
+// Unload フィクスチャで登録したユーザーと記事を削除する.
+func (db *Database) Unload(f *fixture.Fixture) {
+	db.T.Helper()
+
+	if err := fixture.Unload(context.Background(), db.client, f); err != nil {
+		db.T.Error(err)
+	}
 }
 
 func (db *Database) SelectArticleByTitle(title string) *ent.Article {
@@ -114,9 +139,7 @@ func (db *Database) DeleteUserArticle(
 func (db *Database) InsertUser(uid uuid.UUID) {
 	db.T.Helper()
 
-	if err := db.client.User.Create().SetID(uid).Exec(context.Background()); err != nil {
-		db.T.Fatal(err)
-	}
+	db.Load(&fixture.Fixture{Users: []fixture.User{{ID: uid}}})
 }
 
 func (db *Database) DeleteUser(uid uuid.UUID) {
@@ -0,0 +1,47 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/integration/helper"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
+)
+
+func TestArticle(t *testing.T) {
+	t.Parallel()
+
+	dsn := helper.GetDSN(t)
+
+	t.Run("フィクスチャで登録したユーザーの記事を取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := postgres.New().Of(dsn)
+		if err != nil {
+			t.Fatalf("failed to connect to postgres: %v", err)
+		}
+
+		t.Cleanup(func() { rdb.Close() })
+
+		f := helper.LoadFixture(t, rdb.Client, "testdata/article.yaml")
+
+		ctx := context.Background()
+
+		index, _ := value.NewIndex(0)
+
+		size, _ := value.NewSize(10)
+
+		got, err := gateway.NewArticle(rdb).ListByUser(ctx, user.ID(f.Users[0].ID), index, size)
+		if err != nil {
+			t.Fatalf("failed to list by user: %v", err)
+		}
+
+		if len(got) != 1 || got[0].ArticleID != article.ID(f.Articles[0].ID) || len(got[0].TagList) != 1 {
+			t.Errorf("ListByUser() = %+v, want %+v", got, f.Articles[0])
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package gateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/integration/helper"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
+)
+
+func TestAuth(t *testing.T) {
+	t.Parallel()
+
+	dsn := helper.GetDSN(t)
+
+	t.Run("Firebaseを利用せずにサインアップとサインインができる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := postgres.New().Of(dsn)
+		if err != nil {
+			t.Fatalf("failed to connect to postgres: %v", err)
+		}
+
+		defer rdb.Close()
+
+		ctx := context.Background()
+
+		if err := rdb.Schema.Create(ctx); err != nil {
+			t.Fatalf("failed to create schema: %v", err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		uid := user.GenerateID()
+
+		email := auth.Email(fmt.Sprintf("%s@example.com", uuid.NewString()))
+
+		if err := authGateway.SignUp(ctx, uid, email, auth.Password("password")); err != nil {
+			t.Fatalf("failed to sign up: %v", err)
+		}
+
+		defer rdb.Credential.DeleteOneID(uid.Value()).Exec(ctx) //nolint:errcheck
+
+		got, err := authGateway.SignIn(ctx, email, auth.Password("password"))
+		if err != nil {
+			t.Fatalf("failed to sign in: %v", err)
+		}
+
+		if got.UserID != uid {
+			t.Errorf("SignIn() = %v, want %v", got.UserID, uid)
+		}
+
+		if _, err := authGateway.SignIn(ctx, email, auth.Password("invalid")); err == nil {
+			t.Error("SignIn() with invalid password error = nil, want error")
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/driver/fixture"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+)
+
+// LoadFixture YAMLのフィクスチャを登録し、テストの終了時に削除する.
+// 削除にクライアントを用いるため、クライアントはdeferではなくt.Cleanupで閉じる.
+func LoadFixture(t *testing.T, client *ent.Client, paths ...string) *fixture.Fixture {
+	t.Helper()
+
+	f, err := fixture.ReadFiles(paths...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if err := fixture.Load(ctx, client, f); err != nil {
+		t.Fatalf("failed to load fixture: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := fixture.Unload(ctx, client, f); err != nil {
+			t.Errorf("failed to unload fixture: %v", err)
+		}
+	})
+
+	return f
+}
@@ -27,6 +27,20 @@ func GetRedisURL(t *testing.T) string {
 	return url
 }
 
+// GetRedisClusterURL Redis Clusterの接続先を取得する.
+// Clusterを用意していない環境もあるため、未設定の場合はテストをスキップする.
+func GetRedisClusterURL(t *testing.T) string {
+	t.Helper()
+
+	url := os.Getenv("TEST_REDIS_CLUSTER_URL")
+
+	if url == "" {
+		t.Skip("TEST_REDIS_CLUSTER_URL is not set")
+	}
+
+	return url
+}
+
 func GetPostgresURL(t *testing.T) string {
 	t.Helper()
 
@@ -0,0 +1,129 @@
+package kvs_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/morning-night-guild/platform-app/integration/helper"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/application/interactor"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/internal/driver/redis"
+)
+
+func TestAPIAuthCluster(t *testing.T) {
+	t.Parallel()
+
+	rds, err := redis.NewRedis(helper.GetRedisClusterURL(t))
+	if err != nil {
+		t.Fatalf("failed to connect to redis cluster: %v", err)
+	}
+
+	userCache, err := redis.New[model.User]().KVS("user", rds)
+	if err != nil {
+		t.Fatal("failed to create kvs")
+	}
+
+	authCache, err := redis.New[model.Auth]().KVS("auth", rds)
+	if err != nil {
+		t.Fatal("failed to create kvs")
+	}
+
+	codeCache, err := redis.New[model.Code]().KVS("code", rds)
+	if err != nil {
+		t.Fatal("failed to create kvs")
+	}
+
+	sessionCache, err := redis.New[model.Session]().KVS("session", rds)
+	if err != nil {
+		t.Fatal("failed to create kvs")
+	}
+
+	sessionIndex, err := redis.NewIndex("session_index", rds)
+	if err != nil {
+		t.Fatal("failed to create index")
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	uid := user.GenerateID()
+
+	email := auth.Email("cluster@example.com")
+
+	ctrl := gomock.NewController(t)
+	authRPC := rpc.NewMockAuth(ctrl)
+	authRPC.EXPECT().SignIn(gomock.Any(), email, auth.Password("password")).Return(model.User{
+		UserID: uid,
+	}, nil).Times(2)
+
+	itr := interactor.NewAPIAuth(
+		nil,
+		authRPC,
+		nil,
+		nil,
+		nil,
+		userCache,
+		authCache,
+		codeCache,
+		sessionCache,
+		sessionIndex,
+		gateway.NewLogAudit(),
+		auth.VerificationPolicyNone,
+		"",
+	)
+
+	sids := make([]auth.SessionID, 0, 2)
+
+	// 異なるスロットに割り当てられるセッションIDでも、ユーザーIDで始まるキーにより同じトランザクションで更新できる
+	for i := 0; i < 2; i++ {
+		out, err := itr.SignIn(ctx, usecase.APIAuthSignInInput{
+			Secret:    auth.Secret("secret"),
+			KeySet:    auth.KeySet{},
+			Email:     email,
+			Password:  auth.Password("password"),
+			PublicKey: key.PublicKey,
+			ExpiresIn: auth.DefaultExpiresIn,
+		})
+		if err != nil {
+			t.Fatalf("failed to sign in: %v", err)
+		}
+
+		sids = append(sids, out.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}))
+	}
+
+	for _, sid := range sids {
+		if _, err := itr.Verify(ctx, usecase.APIAuthVerifyInput{
+			UserID:    uid,
+			SessionID: sid,
+		}); err != nil {
+			t.Errorf("failed to verify: %v", err)
+		}
+	}
+
+	if _, err := itr.SignOutAll(ctx, usecase.APIAuthSignOutAllInput{
+		UserID: uid,
+	}); err != nil {
+		t.Fatalf("failed to sign out all: %v", err)
+	}
+
+	for _, sid := range sids {
+		if _, err := itr.Verify(ctx, usecase.APIAuthVerifyInput{
+			UserID:    uid,
+			SessionID: sid,
+		}); err == nil {
+			t.Errorf("session %s is still valid after sign out all", sid)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/integration/helper"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs/kvstest"
 	"github.com/morning-night-guild/platform-app/internal/domain/cache"
 	"github.com/morning-night-guild/platform-app/internal/driver/redis"
 )
@@ -299,3 +300,72 @@ func TestKVS(t *testing.T) {
 		}
 	})
 }
+
+func TestKVSConformance(t *testing.T) {
+	t.Parallel()
+
+	rds, err := redis.NewRedis(helper.GetRedisURL(t))
+	if err != nil {
+		t.Fatal("failed to connect to redis")
+	}
+
+	kvstest.Run(t, func(t *testing.T, prefix string) cache.Cache[kvstest.KV] {
+		t.Helper()
+
+		kvs, err := redis.New[kvstest.KV]().KVS(prefix, rds)
+		if err != nil {
+			t.Fatal("failed to create kvs")
+		}
+
+		return kvs
+	}, func(t *testing.T, prefix string) cache.Index {
+		t.Helper()
+
+		idx, err := redis.NewIndex(prefix, rds)
+		if err != nil {
+			t.Fatal("failed to create index")
+		}
+
+		return idx
+	})
+}
+
+func TestLimiterConformance(t *testing.T) {
+	t.Parallel()
+
+	rds, err := redis.NewRedis(helper.GetRedisURL(t))
+	if err != nil {
+		t.Fatal("failed to connect to redis")
+	}
+
+	kvstest.RunLimiter(t, func(t *testing.T, prefix string) cache.Limiter {
+		t.Helper()
+
+		lmt, err := redis.NewLimiter(prefix, rds)
+		if err != nil {
+			t.Fatal("failed to create limiter")
+		}
+
+		return lmt
+	})
+}
+
+func TestLockConformance(t *testing.T) {
+	t.Parallel()
+
+	rds, err := redis.NewRedis(helper.GetRedisURL(t))
+	if err != nil {
+		t.Fatal("failed to connect to redis")
+	}
+
+	kvstest.RunLock(t, func(t *testing.T, prefix string) cache.Lock {
+		t.Helper()
+
+		lck, err := redis.NewLock(prefix, rds)
+		if err != nil {
+			t.Fatal("failed to create lock")
+		}
+
+		return lck
+	})
+}
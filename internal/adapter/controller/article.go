@@ -5,11 +5,13 @@ import (
 
 	"github.com/bufbuild/connect-go"
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/value"
 	articlev1 "github.com/morning-night-guild/platform-app/pkg/connect/article/v1"
 	"github.com/morning-night-guild/platform-app/pkg/connect/article/v1/articlev1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ articlev1connect.ArticleServiceHandler = (*Article)(nil)
@@ -63,20 +65,22 @@ func (ctrl *Article) Share(
 		Thumbnail:   thumbnail,
 	}
 
+	if req.Msg.UserId != nil {
+		uid, err := user.NewID(*req.Msg.UserId)
+		if err != nil {
+			return nil, ctrl.controller.HandleConnectError(ctx, err)
+		}
+
+		input.SharedBy = &uid
+	}
+
 	output, err := ctrl.usecase.Share(ctx, input)
 	if err != nil {
 		return nil, ctrl.controller.HandleConnectError(ctx, err)
 	}
 
 	return connect.NewResponse(&articlev1.ShareResponse{
-		Article: &articlev1.Article{
-			ArticleId:   output.Article.ArticleID.String(),
-			Title:       output.Article.Title.String(),
-			Url:         output.Article.URL.String(),
-			Description: output.Article.Description.String(),
-			Thumbnail:   output.Article.Thumbnail.String(),
-			Tags:        output.Article.TagList.StringSlice(),
-		},
+		Article: toArticleMessage(output.Article),
 	}), nil
 }
 
@@ -113,14 +117,7 @@ func (ctrl *Article) List(
 	result := make([]*articlev1.Article, len(output.Articles))
 
 	for i, article := range output.Articles {
-		result[i] = &articlev1.Article{
-			ArticleId:   article.ArticleID.String(),
-			Title:       article.Title.String(),
-			Url:         article.URL.String(),
-			Description: article.Description.String(),
-			Thumbnail:   article.Thumbnail.String(),
-			Tags:        article.TagList.StringSlice(),
-		}
+		result[i] = toArticleMessage(article)
 	}
 
 	next := token.CreateNextToken(size).String()
@@ -173,14 +170,7 @@ func (ctrl *Article) ListByUser(
 	result := make([]*articlev1.Article, len(output.Articles))
 
 	for i, article := range output.Articles {
-		result[i] = &articlev1.Article{
-			ArticleId:   article.ArticleID.String(),
-			Title:       article.Title.String(),
-			Url:         article.URL.String(),
-			Description: article.Description.String(),
-			Thumbnail:   article.Thumbnail.String(),
-			Tags:        article.TagList.StringSlice(),
-		}
+		result[i] = toArticleMessage(article)
 	}
 
 	next := token.CreateNextToken(size).String()
@@ -215,6 +205,71 @@ func (ctrl *Article) Delete(
 	return connect.NewResponse(&articlev1.DeleteResponse{}), nil
 }
 
+// ListTrash ゴミ箱の記事を取得するコントローラメソッド.
+func (ctrl *Article) ListTrash(
+	ctx context.Context,
+	req *connect.Request[articlev1.ListTrashRequest],
+) (*connect.Response[articlev1.ListTrashResponse], error) {
+	token := value.NewNextToken(req.Msg.PageToken)
+
+	index := token.ToIndex()
+
+	size, err := value.NewSize(int(req.Msg.MaxPageSize))
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	input := usecase.CoreArticleListTrashInput{
+		Index: index,
+		Size:  size,
+	}
+
+	output, err := ctrl.usecase.ListTrash(ctx, input)
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	result := make([]*articlev1.TrashedArticle, len(output.Articles))
+
+	for i, article := range output.Articles {
+		result[i] = &articlev1.TrashedArticle{
+			Article:   toArticleMessage(article.Article),
+			DeletedAt: timestamppb.New(article.DeletedAt),
+		}
+	}
+
+	next := token.CreateNextToken(size).String()
+	if len(output.Articles) < size.Int() {
+		next = ""
+	}
+
+	return connect.NewResponse(&articlev1.ListTrashResponse{
+		Articles:      result,
+		NextPageToken: next,
+	}), nil
+}
+
+// Restore ゴミ箱の記事を復元するコントローラメソッド.
+func (ctrl *Article) Restore(
+	ctx context.Context,
+	req *connect.Request[articlev1.RestoreRequest],
+) (*connect.Response[articlev1.RestoreResponse], error) {
+	articleID, err := article.NewID(req.Msg.ArticleId)
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	input := usecase.CoreArticleRestoreInput{
+		ArticleID: articleID,
+	}
+
+	if _, err = ctrl.usecase.Restore(ctx, input); err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	return connect.NewResponse(&articlev1.RestoreResponse{}), nil
+}
+
 func (ctrl *Article) AddToUser(
 	ctx context.Context,
 	req *connect.Request[articlev1.AddToUserRequest],
@@ -266,3 +321,25 @@ func (ctrl *Article) RemoveFromUser(
 
 	return connect.NewResponse(&articlev1.RemoveFromUserResponse{}), nil
 }
+
+// toArticleMessage 記事モデルをメッセージに変換する.
+func toArticleMessage(item model.Article) *articlev1.Article {
+	msg := &articlev1.Article{
+		ArticleId:   item.ArticleID.String(),
+		Title:       item.Title.String(),
+		Url:         item.URL.String(),
+		Description: item.Description.String(),
+		Thumbnail:   item.Thumbnail.String(),
+		Tags:        item.TagList.StringSlice(),
+	}
+
+	if item.SharedBy != nil {
+		msg.SharedBy = &articlev1.Sharer{
+			UserId:      item.SharedBy.UserID.String(),
+			DisplayName: item.SharedBy.DisplayName.String(),
+			AvatarUrl:   item.SharedBy.AvatarURL.String(),
+		}
+	}
+
+	return msg
+}
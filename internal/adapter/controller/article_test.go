@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/golang/mock/gomock"
@@ -17,6 +18,8 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/value"
 	articlev1 "github.com/morning-night-guild/platform-app/pkg/connect/article/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const aid = "01234567-0123-0123-0123-0123456789ab"
@@ -87,6 +90,64 @@ func TestArticleShare(t *testing.T) {
 			}),
 			wantErr: nil,
 		},
+		{
+			name: "共有したユーザーを指定して記事の共有ができる",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreArticle(ctrl)
+					sharer := user.ID(uuid.MustParse(uid))
+					mock.EXPECT().Share(gomock.Any(), usecase.CoreArticleShareInput{
+						URL:         article.URL("https://example.com"),
+						Title:       article.Title("title"),
+						Description: article.Description("description"),
+						Thumbnail:   article.Thumbnail("https://example.com"),
+						SharedBy:    &sharer,
+					}).Return(usecase.CoreArticleShareOutput{
+						Article: model.Article{
+							ArticleID:   article.ID(uuid.MustParse(aid)),
+							URL:         article.URL("https://example.com"),
+							Title:       article.Title("title"),
+							Description: article.Description("description"),
+							Thumbnail:   article.Thumbnail("https://example.com"),
+							TagList:     []article.Tag{},
+							SharedBy: &model.ArticleSharer{
+								UserID:      sharer,
+								DisplayName: user.DisplayName("name"),
+							},
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &connect.Request[articlev1.ShareRequest]{
+					Msg: &articlev1.ShareRequest{
+						Url:         "https://example.com",
+						Title:       "title",
+						Description: "description",
+						Thumbnail:   "https://example.com",
+						UserId:      proto.String(uid),
+					},
+				},
+			},
+			want: connect.NewResponse(&articlev1.ShareResponse{
+				Article: &articlev1.Article{
+					ArticleId:   aid,
+					Url:         "https://example.com",
+					Title:       "title",
+					Description: "description",
+					Thumbnail:   "https://example.com",
+					SharedBy: &articlev1.Sharer{
+						UserId:      uid,
+						DisplayName: "name",
+					},
+				},
+			}),
+			wantErr: nil,
+		},
 		{
 			name: "URLが不正の時、バッドリクエストエラーになる",
 			fields: fields{
@@ -230,6 +291,9 @@ func TestArticleShare(t *testing.T) {
 			if !reflect.DeepEqual(got.Msg.Article.Thumbnail, tt.want.Msg.Article.Thumbnail) {
 				t.Errorf("Article.Share() Msg Article Thumbnail = %v, want %v", got.Msg.Article.Thumbnail, tt.want.Msg.Article.Thumbnail)
 			}
+			if !reflect.DeepEqual(got.Msg.Article.SharedBy, tt.want.Msg.Article.SharedBy) {
+				t.Errorf("Article.Share() Msg Article SharedBy = %v, want %v", got.Msg.Article.SharedBy, tt.want.Msg.Article.SharedBy)
+			}
 		})
 	}
 }
@@ -787,6 +851,209 @@ func TestArticleDelete(t *testing.T) {
 	}
 }
 
+func TestArticleListTrash(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		usecase func(*testing.T) usecase.CoreArticle
+	}
+
+	type args struct {
+		ctx context.Context
+		req *connect.Request[articlev1.ListTrashRequest]
+	}
+
+	deletedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *connect.Response[articlev1.ListTrashResponse]
+		wantErr bool
+	}{
+		{
+			name: "ゴミ箱の記事が一覧できる",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreArticle(ctrl)
+					mock.EXPECT().ListTrash(gomock.Any(), usecase.CoreArticleListTrashInput{
+						Index: value.Index(0),
+						Size:  value.Size(1),
+					}).Return(usecase.CoreArticleListTrashOutput{
+						Articles: []model.TrashedArticle{
+							{
+								Article: model.Article{
+									ArticleID:   article.ID(uuid.MustParse(aid)),
+									Title:       article.Title("title"),
+									URL:         article.URL("https://example.com"),
+									Description: article.Description("description"),
+									Thumbnail:   article.Thumbnail("https://example.com"),
+									TagList:     article.TagList{article.Tag("tag")},
+								},
+								DeletedAt: deletedAt,
+							},
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &connect.Request[articlev1.ListTrashRequest]{
+					Msg: &articlev1.ListTrashRequest{
+						PageToken:   "",
+						MaxPageSize: 1,
+					},
+				},
+			},
+			want: connect.NewResponse(&articlev1.ListTrashResponse{
+				Articles: []*articlev1.TrashedArticle{
+					{
+						Article: &articlev1.Article{
+							ArticleId:   aid,
+							Title:       "title",
+							Url:         "https://example.com",
+							Description: "description",
+							Thumbnail:   "https://example.com",
+							Tags:        []string{"tag"},
+						},
+						DeletedAt: timestamppb.New(deletedAt),
+					},
+				},
+				NextPageToken: value.NewNextToken("").CreateNextToken(value.Size(1)).String(),
+			}),
+			wantErr: false,
+		},
+		{
+			name: "ページサイズが不正な場合は一覧できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreArticle(ctrl)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &connect.Request[articlev1.ListTrashRequest]{
+					Msg: &articlev1.ListTrashRequest{
+						PageToken:   "",
+						MaxPageSize: 0,
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := controller.NewArticle(controller.New(), tt.fields.usecase(t))
+			got, err := ctrl.ListTrash(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Article.ListTrash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Article.ListTrash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArticleRestore(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		usecase func(*testing.T) usecase.CoreArticle
+	}
+
+	type args struct {
+		ctx context.Context
+		req *connect.Request[articlev1.RestoreRequest]
+	}
+
+	id := uuid.New()
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *connect.Response[articlev1.RestoreResponse]
+		wantErr bool
+	}{
+		{
+			name: "記事が復元できる",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreArticle(ctrl)
+					mock.EXPECT().Restore(gomock.Any(), usecase.CoreArticleRestoreInput{
+						ArticleID: article.ID(id),
+					}).Return(usecase.CoreArticleRestoreOutput{}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &connect.Request[articlev1.RestoreRequest]{
+					Msg: &articlev1.RestoreRequest{
+						ArticleId: id.String(),
+					},
+				},
+			},
+			want:    connect.NewResponse(&articlev1.RestoreResponse{}),
+			wantErr: false,
+		},
+		{
+			name: "ゴミ箱にない記事は復元できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreArticle(ctrl)
+					mock.EXPECT().Restore(gomock.Any(), usecase.CoreArticleRestoreInput{
+						ArticleID: article.ID(id),
+					}).Return(usecase.CoreArticleRestoreOutput{}, errors.NewNotFoundError("article not found in trash"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: &connect.Request[articlev1.RestoreRequest]{
+					Msg: &articlev1.RestoreRequest{
+						ArticleId: id.String(),
+					},
+				},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := controller.NewArticle(controller.New(), tt.fields.usecase(t))
+			got, err := ctrl.Restore(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Article.Restore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Article.Restore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestArticleAddToUser(t *testing.T) {
 	t.Parallel()
 
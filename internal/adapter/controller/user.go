@@ -5,9 +5,12 @@ import (
 
 	"github.com/bufbuild/connect-go"
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	articlev1 "github.com/morning-night-guild/platform-app/pkg/connect/article/v1"
 	userv1 "github.com/morning-night-guild/platform-app/pkg/connect/user/v1"
 	"github.com/morning-night-guild/platform-app/pkg/connect/user/v1/userv1connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ userv1connect.UserServiceHandler = (*User)(nil)
@@ -41,14 +44,38 @@ func (ctrl *User) Create(
 	}
 
 	res := &userv1.CreateResponse{
-		User: &userv1.User{
-			UserId: output.User.UserID.String(),
-		},
+		User: toUserMessage(output.User),
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (ctrl *User) Get(
+	ctx context.Context,
+	req *connect.Request[userv1.GetRequest],
+) (*connect.Response[userv1.GetResponse], error) {
+	uid, err := user.NewID(req.Msg.UserId)
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	input := usecase.CoreUserGetInput{
+		UserID: uid,
+	}
+
+	output, err := ctrl.usecase.Get(ctx, input)
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	res := &userv1.GetResponse{
+		User: toUserMessage(output.User),
 	}
 
 	return connect.NewResponse(res), nil
 }
 
+// Update 更新マスクで指定したプロフィールの項目を更新するコントローラメソッド.
 func (ctrl *User) Update(
 	ctx context.Context,
 	req *connect.Request[userv1.UpdateRequest],
@@ -58,8 +85,20 @@ func (ctrl *User) Update(
 		return nil, ctrl.controller.HandleConnectError(ctx, err)
 	}
 
+	fields, err := user.NewProfileFields(req.Msg.UpdateMask.GetPaths())
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	profile, err := toProfile(req.Msg.Profile, fields)
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
 	input := usecase.CoreUserUpdateInput{
-		UserID: uid,
+		UserID:  uid,
+		Profile: profile,
+		Fields:  fields,
 	}
 
 	output, err := ctrl.usecase.Update(ctx, input)
@@ -68,10 +107,94 @@ func (ctrl *User) Update(
 	}
 
 	res := &userv1.UpdateResponse{
-		User: &userv1.User{
-			UserId: output.User.UserID.String(),
+		User: toUserMessage(output.User),
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// Delete ユーザーの削除を受け付け、削除前に取得したデータの写しを返すコントローラメソッド.
+func (ctrl *User) Delete(
+	ctx context.Context,
+	req *connect.Request[userv1.DeleteRequest],
+) (*connect.Response[userv1.DeleteResponse], error) {
+	uid, err := user.NewID(req.Msg.UserId)
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	input := usecase.CoreUserDeleteInput{
+		UserID: uid,
+	}
+
+	output, err := ctrl.usecase.Delete(ctx, input)
+	if err != nil {
+		return nil, ctrl.controller.HandleConnectError(ctx, err)
+	}
+
+	export := output.Deletion.Export
+
+	articles := make([]*articlev1.Article, len(export.Articles))
+
+	for i, article := range export.Articles {
+		articles[i] = toArticleMessage(article)
+	}
+
+	res := &userv1.DeleteResponse{
+		Export: &userv1.UserExport{
+			User:       toUserMessage(export.User),
+			Articles:   articles,
+			ExportedAt: timestamppb.New(export.ExportedAt),
 		},
+		PurgeAt: timestamppb.New(output.Deletion.PurgeAt),
 	}
 
 	return connect.NewResponse(res), nil
 }
+
+// toUserMessage ユーザーモデルをメッセージに変換する.
+func toUserMessage(item model.User) *userv1.User {
+	return &userv1.User{
+		UserId: item.UserID.String(),
+		Profile: &userv1.Profile{
+			DisplayName: item.Profile.DisplayName.String(),
+			AvatarUrl:   item.Profile.AvatarURL.String(),
+			Bio:         item.Profile.Bio.String(),
+			Locale:      item.Profile.Locale.String(),
+			Timezone:    item.Profile.Timezone.String(),
+		},
+	}
+}
+
+// toProfile 更新するプロフィールの項目のみ検証してプロフィールに変換する.
+// 指定していない項目の値は使わないため検証しない.
+func toProfile(
+	msg *userv1.Profile,
+	fields []user.ProfileField,
+) (model.UserProfile, error) {
+	var (
+		profile model.UserProfile
+		err     error
+	)
+
+	for _, field := range fields {
+		switch field {
+		case user.FieldDisplayName:
+			profile.DisplayName, err = user.NewDisplayName(msg.GetDisplayName())
+		case user.FieldAvatarURL:
+			profile.AvatarURL, err = user.NewAvatarURL(msg.GetAvatarUrl())
+		case user.FieldBio:
+			profile.Bio, err = user.NewBio(msg.GetBio())
+		case user.FieldLocale:
+			profile.Locale, err = user.NewLocale(msg.GetLocale())
+		case user.FieldTimezone:
+			profile.Timezone, err = user.NewTimezone(msg.GetTimezone())
+		}
+
+		if err != nil {
+			return model.UserProfile{}, err
+		}
+	}
+
+	return profile, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/bufbuild/connect-go"
 	"github.com/golang/mock/gomock"
@@ -12,8 +13,12 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/adapter/controller"
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	articlev1 "github.com/morning-night-guild/platform-app/pkg/connect/article/v1"
 	userv1 "github.com/morning-night-guild/platform-app/pkg/connect/user/v1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const uid = "01234567-0123-0123-0123-0123456789ab"
@@ -58,7 +63,8 @@ func TestUserCreate(t *testing.T) {
 			},
 			want: connect.NewResponse(&userv1.CreateResponse{
 				User: &userv1.User{
-					UserId: uid,
+					UserId:  uid,
+					Profile: &userv1.Profile{},
 				},
 			}),
 			wantErr: false,
@@ -103,6 +109,129 @@ func TestUserCreate(t *testing.T) {
 	}
 }
 
+func TestUserGet(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		usecase func(*testing.T) usecase.CoreUser
+	}
+
+	type args struct {
+		ctx context.Context
+		req *connect.Request[userv1.GetRequest]
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *connect.Response[userv1.GetResponse]
+		wantErr bool
+	}{
+		{
+			name: "ユーザーが取得できる",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreUser(ctrl)
+					mock.EXPECT().Get(gomock.Any(), usecase.CoreUserGetInput{
+						UserID: user.ID(uuid.MustParse(uid)),
+					}).Return(usecase.CoreUserGetOutput{
+						User: model.User{
+							UserID: user.ID(uuid.MustParse(uid)),
+							Profile: model.UserProfile{
+								DisplayName: user.DisplayName("name"),
+								AvatarURL:   user.AvatarURL("https://example.com/avatar.png"),
+								Bio:         user.Bio("bio"),
+								Locale:      user.Locale("ja-JP"),
+								Timezone:    user.Timezone("Asia/Tokyo"),
+							},
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.GetRequest{
+					UserId: uid,
+				}),
+			},
+			want: connect.NewResponse(&userv1.GetResponse{
+				User: &userv1.User{
+					UserId: uid,
+					Profile: &userv1.Profile{
+						DisplayName: "name",
+						AvatarUrl:   "https://example.com/avatar.png",
+						Bio:         "bio",
+						Locale:      "ja-JP",
+						Timezone:    "Asia/Tokyo",
+					},
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "不正なユーザーIDの場合は取得できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockCoreUser(ctrl)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.GetRequest{
+					UserId: "uid",
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ユーザーが取得できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreUser(ctrl)
+					mock.EXPECT().Get(gomock.Any(), gomock.Any()).Return(usecase.CoreUserGetOutput{}, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.GetRequest{
+					UserId: uid,
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := controller.NewUser(
+				controller.New(),
+				tt.fields.usecase(t),
+			)
+			got, err := ctrl.Get(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("User.Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("User.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestUserUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -131,9 +260,51 @@ func TestUserUpdate(t *testing.T) {
 					mock := usecase.NewMockCoreUser(ctrl)
 					mock.EXPECT().Update(gomock.Any(), usecase.CoreUserUpdateInput{
 						UserID: user.ID(uuid.MustParse(uid)),
+						Fields: []user.ProfileField{},
+					}).Return(usecase.CoreUserUpdateOutput{
+						User: model.User{
+							UserID: user.ID(uuid.MustParse(uid)),
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.UpdateRequest{
+					UserId: uid,
+				}),
+			},
+			want: connect.NewResponse(&userv1.UpdateResponse{
+				User: &userv1.User{
+					UserId:  uid,
+					Profile: &userv1.Profile{},
+				},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "更新マスクで指定したプロフィールの項目のみ更新できる",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreUser(ctrl)
+					mock.EXPECT().Update(gomock.Any(), usecase.CoreUserUpdateInput{
+						UserID: user.ID(uuid.MustParse(uid)),
+						Profile: model.UserProfile{
+							DisplayName: user.DisplayName("name"),
+							Locale:      user.Locale("en-US"),
+						},
+						Fields: []user.ProfileField{user.FieldDisplayName, user.FieldLocale},
 					}).Return(usecase.CoreUserUpdateOutput{
 						User: model.User{
 							UserID: user.ID(uuid.MustParse(uid)),
+							Profile: model.UserProfile{
+								DisplayName: user.DisplayName("name"),
+								Bio:         user.Bio("bio"),
+								Locale:      user.Locale("en-US"),
+							},
 						},
 					}, nil)
 					return mock
@@ -143,15 +314,75 @@ func TestUserUpdate(t *testing.T) {
 				ctx: context.Background(),
 				req: connect.NewRequest(&userv1.UpdateRequest{
 					UserId: uid,
+					Profile: &userv1.Profile{
+						DisplayName: "name",
+						// 更新マスクに含まれない項目は検証せずに無視する
+						AvatarUrl: "invalid",
+						Locale:    "en-us",
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{
+						Paths: []string{"display_name", "locale"},
+					},
 				}),
 			},
 			want: connect.NewResponse(&userv1.UpdateResponse{
 				User: &userv1.User{
 					UserId: uid,
+					Profile: &userv1.Profile{
+						DisplayName: "name",
+						Bio:         "bio",
+						Locale:      "en-US",
+					},
 				},
 			}),
 			wantErr: false,
 		},
+		{
+			name: "更新マスクに存在しない項目を指定した場合は更新できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockCoreUser(ctrl)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.UpdateRequest{
+					UserId:  uid,
+					Profile: &userv1.Profile{},
+					UpdateMask: &fieldmaskpb.FieldMask{
+						Paths: []string{"user_id"},
+					},
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "更新マスクで指定した項目の値が不正な場合は更新できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockCoreUser(ctrl)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.UpdateRequest{
+					UserId: uid,
+					Profile: &userv1.Profile{
+						Timezone: "Asia/Nowhere",
+					},
+					UpdateMask: &fieldmaskpb.FieldMask{
+						Paths: []string{"timezone"},
+					},
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
 		{
 			name: "ユーザーが更新できない",
 			fields: fields{
@@ -161,6 +392,7 @@ func TestUserUpdate(t *testing.T) {
 					mock := usecase.NewMockCoreUser(ctrl)
 					mock.EXPECT().Update(gomock.Any(), usecase.CoreUserUpdateInput{
 						UserID: user.ID(uuid.MustParse(uid)),
+						Fields: []user.ProfileField{},
 					}).Return(usecase.CoreUserUpdateOutput{}, fmt.Errorf("error"))
 					return mock
 				},
@@ -195,3 +427,147 @@ func TestUserUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestUserDelete(t *testing.T) {
+	t.Parallel()
+
+	at := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	aid := "01234567-0123-0123-0123-0123456789ac"
+
+	type fields struct {
+		usecase func(*testing.T) usecase.CoreUser
+	}
+
+	type args struct {
+		ctx context.Context
+		req *connect.Request[userv1.DeleteRequest]
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *connect.Response[userv1.DeleteResponse]
+		wantErr bool
+	}{
+		{
+			name: "ユーザーの削除を受け付け、データの写しを返す",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreUser(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), usecase.CoreUserDeleteInput{
+						UserID: user.ID(uuid.MustParse(uid)),
+					}).Return(usecase.CoreUserDeleteOutput{
+						Deletion: model.UserDeletion{
+							Export: model.UserExport{
+								User: model.User{
+									UserID: user.ID(uuid.MustParse(uid)),
+								},
+								Articles: []model.Article{
+									{
+										ArticleID:   article.ID(uuid.MustParse(aid)),
+										URL:         article.URL("https://example.com"),
+										Title:       article.Title("title"),
+										Description: article.Description("description"),
+										Thumbnail:   article.Thumbnail("https://example.com"),
+										TagList:     article.TagList{article.Tag("tag")},
+									},
+								},
+								ExportedAt: at,
+							},
+							PurgeAt: at.Add(model.UserDeletionGracePeriod),
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.DeleteRequest{
+					UserId: uid,
+				}),
+			},
+			want: connect.NewResponse(&userv1.DeleteResponse{
+				Export: &userv1.UserExport{
+					User: &userv1.User{
+						UserId:  uid,
+						Profile: &userv1.Profile{},
+					},
+					Articles: []*articlev1.Article{
+						{
+							ArticleId:   aid,
+							Url:         "https://example.com",
+							Title:       "title",
+							Description: "description",
+							Thumbnail:   "https://example.com",
+							Tags:        []string{"tag"},
+						},
+					},
+					ExportedAt: timestamppb.New(at),
+				},
+				PurgeAt: timestamppb.New(at.Add(model.UserDeletionGracePeriod)),
+			}),
+			wantErr: false,
+		},
+		{
+			name: "ユーザーIDが不正な場合は削除できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockCoreUser(ctrl)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.DeleteRequest{
+					UserId: "invalid",
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "ユーザーが削除できない",
+			fields: fields{
+				usecase: func(t *testing.T) usecase.CoreUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockCoreUser(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(usecase.CoreUserDeleteOutput{}, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				req: connect.NewRequest(&userv1.DeleteRequest{
+					UserId: uid,
+				}),
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := controller.NewUser(
+				controller.New(),
+				tt.fields.usecase(t),
+			)
+			got, err := ctrl.Delete(tt.args.ctx, tt.args.req)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("User.Delete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("User.Delete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
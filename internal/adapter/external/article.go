@@ -41,6 +41,7 @@ func (ext *Article) Share(
 	title article.Title,
 	description article.Description,
 	thumbnail article.Thumbnail,
+	sharedBy *user.ID,
 ) (model.Article, error) {
 	req := NewRequest(ctx, &articlev1.ShareRequest{
 		Url:         url.String(),
@@ -49,6 +50,11 @@ func (ext *Article) Share(
 		Thumbnail:   thumbnail.String(),
 	})
 
+	if sharedBy != nil {
+		uid := sharedBy.String()
+		req.Msg.UserId = &uid
+	}
+
 	res, err := ext.connect.Share(ctx, req)
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to share article", log.ErrorField(err))
@@ -56,16 +62,7 @@ func (ext *Article) Share(
 		return model.Article{}, ext.external.HandleError(ctx, err)
 	}
 
-	article := model.ReconstructArticle(
-		uuid.MustParse(res.Msg.Article.ArticleId),
-		res.Msg.Article.Url,
-		res.Msg.Article.Title,
-		res.Msg.Article.Description,
-		res.Msg.Article.Thumbnail,
-		[]string{},
-	)
-
-	return article, nil
+	return ext.toModel(res.Msg.Article), nil
 }
 
 func (ext *Article) List(
@@ -147,6 +144,52 @@ func (ext *Article) Delete(
 	return nil
 }
 
+func (ext *Article) ListTrash(
+	ctx context.Context,
+	index value.Index,
+	size value.Size,
+) ([]model.TrashedArticle, error) {
+	req := NewRequest(ctx, &articlev1.ListTrashRequest{
+		PageToken:   string(value.CreateNextTokenFromIndex(index)),
+		MaxPageSize: uint32(size),
+	})
+
+	res, err := ext.connect.ListTrash(ctx, req)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to list trashed articles", log.ErrorField(err))
+
+		return nil, ext.external.HandleError(ctx, err)
+	}
+
+	articles := make([]model.TrashedArticle, len(res.Msg.Articles))
+
+	for i, article := range res.Msg.Articles {
+		articles[i] = model.TrashedArticle{
+			Article:   ext.toModel(article.Article),
+			DeletedAt: article.DeletedAt.AsTime(),
+		}
+	}
+
+	return articles, nil
+}
+
+func (ext *Article) Restore(
+	ctx context.Context,
+	articleID article.ID,
+) error {
+	req := NewRequest(ctx, &articlev1.RestoreRequest{
+		ArticleId: articleID.String(),
+	})
+
+	if _, err := ext.connect.Restore(ctx, req); err != nil {
+		log.GetLogCtx(ctx).Warn(fmt.Sprintf("failed to restore article. articleID=%s", articleID.String()), log.ErrorField(err))
+
+		return ext.external.HandleError(ctx, err)
+	}
+
+	return nil
+}
+
 func (ext *Article) AddToUser(
 	ctx context.Context,
 	articleID article.ID,
@@ -189,21 +232,44 @@ func (ext *Article) RemoveFromUser(
 	return nil
 }
 
+func (ext *Article) toModel(
+	article *articlev1.Article,
+) model.Article {
+	return toArticleModel(article)
+}
+
 func (ext *Article) toModels(
 	articles []*articlev1.Article,
 ) []model.Article {
 	res := make([]model.Article, len(articles))
 
 	for i, article := range articles {
-		res[i] = model.ReconstructArticle(
-			uuid.MustParse(article.ArticleId),
-			article.Url,
-			article.Title,
-			article.Description,
-			article.Thumbnail,
-			article.Tags,
-		)
+		res[i] = ext.toModel(article)
 	}
 
 	return res
 }
+
+// toArticleModel コアから受け取った記事をモデルに変換する.
+func toArticleModel(
+	article *articlev1.Article,
+) model.Article {
+	item := model.ReconstructArticle(
+		uuid.MustParse(article.ArticleId),
+		article.Url,
+		article.Title,
+		article.Description,
+		article.Thumbnail,
+		article.Tags,
+	)
+
+	if article.SharedBy != nil {
+		item.SharedBy = &model.ArticleSharer{
+			UserID:      user.ID(uuid.MustParse(article.SharedBy.UserId)),
+			DisplayName: user.DisplayName(article.SharedBy.DisplayName),
+			AvatarURL:   user.AvatarURL(article.SharedBy.AvatarUrl),
+		}
+	}
+
+	return item
+}
@@ -187,3 +187,96 @@ func (ext *Auth) GetEmail(
 
 	return auth.Email(user.Email), nil
 }
+
+// FindByEmail メールアドレスからユーザーを取得する.
+func (ext *Auth) FindByEmail(
+	ctx context.Context,
+	email auth.Email,
+) (model.User, error) {
+	record, err := ext.firebaseAuth.GetUserByEmail(ctx, email.String())
+	if firebase.IsUserNotFound(err) {
+		return model.User{}, errors.NewNotFoundError("user not found", err)
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get user by email", log.ErrorField(err))
+
+		return model.User{}, fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	uid, err := user.NewID(record.UID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	return model.User{
+		UserID: uid,
+	}, nil
+}
+
+// UpdateEmail メールアドレスを変更する.
+// 変更後のメールアドレスは未検証として扱う.
+func (ext *Auth) UpdateEmail(
+	ctx context.Context,
+	userID user.ID,
+	email auth.Email,
+) error {
+	params := (&firebase.UserToUpdate{}).
+		Email(email.String()).
+		EmailVerified(false)
+
+	if _, err := ext.firebaseAuth.UpdateUser(ctx, userID.String(), params); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to update email", log.ErrorField(err))
+
+		return fmt.Errorf("failed to update email: %w", err)
+	}
+
+	return nil
+}
+
+// MarkVerified メールアドレスを検証済みにする.
+func (ext *Auth) MarkVerified(
+	ctx context.Context,
+	userID user.ID,
+) error {
+	params := (&firebase.UserToUpdate{}).
+		EmailVerified(true)
+
+	if _, err := ext.firebaseAuth.UpdateUser(ctx, userID.String(), params); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to mark verified", log.ErrorField(err))
+
+		return fmt.Errorf("failed to mark verified: %w", err)
+	}
+
+	return nil
+}
+
+// IsVerified メールアドレスが検証済みかどうかを取得する.
+func (ext *Auth) IsVerified(
+	ctx context.Context,
+	userID user.ID,
+) (bool, error) {
+	user, err := ext.firebaseAuth.GetUser(ctx, userID.String())
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get user", log.ErrorField(err))
+
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	return user.EmailVerified, nil
+}
+
+// Delete 認証基盤からユーザーを削除する.
+// 既に削除済みの場合も成功とする.
+func (ext *Auth) Delete(
+	ctx context.Context,
+	userID user.ID,
+) error {
+	if err := ext.firebaseAuth.DeleteUser(ctx, userID.String()); err != nil && !firebase.IsUserNotFound(err) {
+		log.GetLogCtx(ctx).Warn("failed to delete user", log.ErrorField(err))
+
+		return fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	return nil
+}
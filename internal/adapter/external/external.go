@@ -6,6 +6,7 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
 	"github.com/morning-night-guild/platform-app/pkg/trace"
 )
 
@@ -14,6 +15,10 @@ const (
 	HeaderTID = "tid"
 	// HeaderUID ユーザーIDのヘッダー名.
 	HeaderUID = "uid"
+	// HeaderReadFrom 読み取り先を指定するヘッダー名.
+	HeaderReadFrom = "read-from"
+	// ReadFromPrimary 読み取りをライターで行う場合のHeaderReadFromの値.
+	ReadFromPrimary = "primary"
 )
 
 func NewRequest[T any](ctx context.Context, msg *T) *connect.Request[T] {
@@ -23,6 +28,10 @@ func NewRequest[T any](ctx context.Context, msg *T) *connect.Request[T] {
 
 	req.Header().Set(HeaderUID, user.GetUIDCtx(ctx).String())
 
+	if rpc.IsPrimaryReadCtx(ctx) {
+		req.Header().Set(HeaderReadFrom, ReadFromPrimary)
+	}
+
 	return req
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/bufbuild/connect-go"
 	"github.com/morning-night-guild/platform-app/internal/adapter/external"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
 )
 
 func TestExternalHandleError(t *testing.T) {
@@ -106,3 +107,35 @@ func TestExternalHandleError(t *testing.T) {
 		})
 	}
 }
+
+func TestNewRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{
+			name: "ライターでの読み取りが指定された場合は読み取り先のヘッダーを付与する",
+			ctx:  rpc.SetPrimaryReadCtx(context.Background()),
+			want: external.ReadFromPrimary,
+		},
+		{
+			name: "指定がない場合は読み取り先のヘッダーを付与しない",
+			ctx:  context.Background(),
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := external.NewRequest(tt.ctx, &struct{}{})
+			if got := req.Header().Get(external.HeaderReadFrom); got != tt.want {
+				t.Errorf("NewRequest() header = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"golang.org/x/oauth2"
+)
+
+var _ rpc.OIDC = (*OIDC)(nil)
+
+// OIDCKind 認証プロバイダの方式.
+type OIDCKind string
+
+const (
+	// OIDCKindOIDC IDトークンを発行するOpenID Connectプロバイダ(Google, 社内IdPなど).
+	OIDCKindOIDC OIDCKind = "oidc"
+	// OIDCKindGitHub IDトークンを発行せず、APIからメールアドレスを取得するGitHub.
+	OIDCKindGitHub OIDCKind = "github"
+)
+
+// OIDCProvider 認証プロバイダの設定.
+type OIDCProvider struct {
+	Kind   OIDCKind
+	Config oauth2.Config
+	// Issuer IDトークンのissクレームとして期待する値(OIDCKindOIDCのみ).
+	Issuer string
+	// Keyfunc IDトークンの署名検証に利用するJWKS(OIDCKindOIDCのみ).
+	Keyfunc jwt.Keyfunc
+	// Algorithms IDトークンの署名アルゴリズムとして受け付ける値(OIDCKindOIDCのみ).
+	Algorithms []string
+	// APIURL ユーザー情報を取得するAPIのURL(OIDCKindGitHubのみ).
+	APIURL string
+}
+
+type OIDC struct {
+	providers  map[auth.OIDCProvider]OIDCProvider
+	httpClient *http.Client
+}
+
+func NewOIDC(
+	providers map[auth.OIDCProvider]OIDCProvider,
+	httpClient *http.Client,
+) *OIDC {
+	return &OIDC{
+		providers:  providers,
+		httpClient: httpClient,
+	}
+}
+
+func (ext *OIDC) Supports(provider auth.OIDCProvider) bool {
+	_, ok := ext.providers[provider]
+
+	return ok
+}
+
+func (ext *OIDC) AuthorizationURL(
+	ctx context.Context,
+	authz model.OIDCAuthorization,
+) (string, error) {
+	prv, ok := ext.providers[authz.Provider]
+	if !ok {
+		return "", errors.NewNotFoundError(fmt.Sprintf("oidc provider: %s", authz.Provider))
+	}
+
+	opts := []oauth2.AuthCodeOption{
+		oauth2.SetAuthURLParam("code_challenge", authz.CodeVerifier.Challenge()),
+		oauth2.SetAuthURLParam("code_challenge_method", auth.CodeChallengeMethod),
+	}
+
+	if prv.Kind == OIDCKindOIDC {
+		opts = append(opts, oauth2.SetAuthURLParam("nonce", authz.Nonce.String()))
+	}
+
+	return prv.Config.AuthCodeURL(authz.State.String(), opts...), nil
+}
+
+func (ext *OIDC) Exchange(
+	ctx context.Context,
+	authz model.OIDCAuthorization,
+	code string,
+) (model.OIDCIdentity, error) {
+	prv, ok := ext.providers[authz.Provider]
+	if !ok {
+		return model.OIDCIdentity{}, errors.NewNotFoundError(fmt.Sprintf("oidc provider: %s", authz.Provider))
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, ext.httpClient)
+
+	token, err := prv.Config.Exchange(ctx, code, oauth2.SetAuthURLParam("code_verifier", authz.CodeVerifier.String()))
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to exchange authorization code", log.ErrorField(err))
+
+		return model.OIDCIdentity{}, errors.NewUnauthorizedError("failed to exchange authorization code", err)
+	}
+
+	switch prv.Kind {
+	case OIDCKindOIDC:
+		return ext.verifyIDToken(ctx, prv, authz, token)
+	case OIDCKindGitHub:
+		return ext.fetchGitHubIdentity(ctx, prv, authz, token)
+	}
+
+	return model.OIDCIdentity{}, errors.NewValidationError(fmt.Sprintf("unknown oidc kind: %s", prv.Kind))
+}
+
+// emailVerified email_verifiedクレーム.
+// プロバイダによっては文字列("true")で返却されるため両方を受け付ける.
+type emailVerified bool
+
+func (ev *emailVerified) UnmarshalJSON(data []byte) error {
+	var b bool
+	if err := json.Unmarshal(data, &b); err == nil {
+		*ev = emailVerified(b)
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+
+	*ev = emailVerified(b)
+
+	return nil
+}
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string        `json:"nonce"`
+	Email         string        `json:"email"`
+	EmailVerified emailVerified `json:"email_verified"` //nolint:tagliatelle
+}
+
+func (ext *OIDC) verifyIDToken(
+	ctx context.Context,
+	prv OIDCProvider,
+	authz model.OIDCAuthorization,
+	token *oauth2.Token,
+) (model.OIDCIdentity, error) {
+	raw, ok := token.Extra("id_token").(string)
+	if !ok || raw == "" {
+		return model.OIDCIdentity{}, errors.NewUnauthorizedError("id token is missing")
+	}
+
+	var claims idTokenClaims
+
+	// 署名アルゴリズムをプロバイダのものに限定し、noneや共通鍵での署名を受け付けない
+	if _, err := jwt.ParseWithClaims(
+		raw,
+		&claims,
+		prv.Keyfunc,
+		jwt.WithValidMethods(prv.Algorithms),
+		jwt.WithIssuer(prv.Issuer),
+		jwt.WithAudience(prv.Config.ClientID),
+	); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to verify id token", log.ErrorField(err))
+
+		return model.OIDCIdentity{}, errors.NewUnauthorizedError("invalid id token", err)
+	}
+
+	if !authz.Nonce.Equal(claims.Nonce) {
+		return model.OIDCIdentity{}, errors.NewUnauthorizedError("invalid id token nonce")
+	}
+
+	email, err := auth.NewEmail(claims.Email)
+	if err != nil {
+		return model.OIDCIdentity{}, errors.NewUnauthorizedError("invalid id token email", err)
+	}
+
+	return model.OIDCIdentity{
+		Provider:      authz.Provider,
+		Subject:       claims.Subject,
+		Email:         email,
+		EmailVerified: bool(claims.EmailVerified),
+	}, nil
+}
+
+type gitHubUser struct {
+	ID int64 `json:"id"`
+}
+
+type gitHubEmail struct {
+	Email    string `json:"email"`
+	Primary  bool   `json:"primary"`
+	Verified bool   `json:"verified"`
+}
+
+func (ext *OIDC) fetchGitHubIdentity(
+	ctx context.Context,
+	prv OIDCProvider,
+	authz model.OIDCAuthorization,
+	token *oauth2.Token,
+) (model.OIDCIdentity, error) {
+	client := prv.Config.Client(ctx, token)
+
+	var usr gitHubUser
+
+	if err := ext.getJSON(ctx, client, prv.APIURL+"/user", &usr); err != nil {
+		return model.OIDCIdentity{}, err
+	}
+
+	var emails []gitHubEmail
+
+	if err := ext.getJSON(ctx, client, prv.APIURL+"/user/emails", &emails); err != nil {
+		return model.OIDCIdentity{}, err
+	}
+
+	for _, em := range emails {
+		if !em.Primary {
+			continue
+		}
+
+		email, err := auth.NewEmail(em.Email)
+		if err != nil {
+			return model.OIDCIdentity{}, errors.NewUnauthorizedError("invalid github email", err)
+		}
+
+		return model.OIDCIdentity{
+			Provider:      authz.Provider,
+			Subject:       strconv.FormatInt(usr.ID, 10),
+			Email:         email,
+			EmailVerified: em.Verified,
+		}, nil
+	}
+
+	return model.OIDCIdentity{}, errors.NewUnauthorizedError("primary email is not found")
+}
+
+func (ext *OIDC) getJSON(
+	ctx context.Context,
+	client *http.Client,
+	url string,
+	value any,
+) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get "+url, log.ErrorField(err))
+
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.NewUnauthorizedError(fmt.Sprintf("failed to get %s. status code is %d", url, res.StatusCode))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(value); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to decode json", log.ErrorField(err))
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,319 @@
+package external_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/morning-night-guild/platform-app/internal/adapter/external"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oidcClientID = "client-id"
+	oidcIssuer   = "https://issuer.example.com"
+)
+
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"` //nolint:tagliatelle
+}
+
+func newOIDCServer(t *testing.T, key *rsa.PrivateKey, claims idTokenClaims) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+
+		if r.PostForm.Get("code_verifier") == "" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		idToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"id_token":     idToken,
+		})
+	})
+
+	mux.HandleFunc("/user", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 1234})
+	})
+
+	mux.HandleFunc("/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]any{
+			{"email": "secondary@example.com", "primary": false, "verified": true},
+			{"email": "test@example.com", "primary": true, "verified": true},
+		})
+	})
+
+	srv := httptest.NewServer(mux)
+
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newOIDCProvider(srv *httptest.Server, kind external.OIDCKind, key *rsa.PrivateKey) external.OIDCProvider {
+	return external.OIDCProvider{
+		Kind: kind,
+		Config: oauth2.Config{
+			ClientID:     oidcClientID,
+			ClientSecret: "client-secret",
+			Endpoint: oauth2.Endpoint{
+				AuthURL:   srv.URL + "/authorize",
+				TokenURL:  srv.URL + "/token",
+				AuthStyle: oauth2.AuthStyleInParams,
+			},
+			RedirectURL: "http://localhost:8080/api/v1/auth/oidc/test/callback",
+			Scopes:      []string{"openid", "email"},
+		},
+		Issuer: oidcIssuer,
+		Keyfunc: func(*jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		},
+		Algorithms: []string{"RS256"},
+		APIURL:     srv.URL,
+	}
+}
+
+func TestOIDCAuthorizationURL(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := newOIDCServer(t, key, idTokenClaims{})
+
+	ext := external.NewOIDC(map[auth.OIDCProvider]external.OIDCProvider{
+		"google": newOIDCProvider(srv, external.OIDCKindOIDC, key),
+	}, srv.Client())
+
+	authz := model.GenerateOIDCAuthorization("google", "", rsa.PublicKey{}, auth.DefaultExpiresIn)
+
+	t.Run("PKCEとnonceを含む認可URLを生成できる", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := ext.AuthorizationURL(context.Background(), authz)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		u, err := url.Parse(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		query := u.Query()
+
+		if query.Get("state") != authz.State.String() {
+			t.Errorf("state = %v, want %v", query.Get("state"), authz.State)
+		}
+
+		if query.Get("nonce") != authz.Nonce.String() {
+			t.Errorf("nonce = %v, want %v", query.Get("nonce"), authz.Nonce)
+		}
+
+		if query.Get("code_challenge") != authz.CodeVerifier.Challenge() {
+			t.Errorf("code_challenge = %v, want %v", query.Get("code_challenge"), authz.CodeVerifier.Challenge())
+		}
+
+		if query.Get("code_challenge_method") != "S256" {
+			t.Errorf("code_challenge_method = %v, want S256", query.Get("code_challenge_method"))
+		}
+	})
+
+	t.Run("未設定の認証プロバイダでは認可URLを生成できない", func(t *testing.T) {
+		t.Parallel()
+
+		other := authz
+		other.Provider = "unknown"
+
+		if _, err := ext.AuthorizationURL(context.Background(), other); err == nil {
+			t.Error("AuthorizationURL() error = nil, want error")
+		}
+	})
+}
+
+//nolint:funlen
+func TestOIDCExchange(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authz := model.GenerateOIDCAuthorization("test", "", rsa.PublicKey{}, auth.DefaultExpiresIn)
+
+	valid := func() idTokenClaims {
+		return idTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    oidcIssuer,
+				Subject:   "subject",
+				Audience:  jwt.ClaimStrings{oidcClientID},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+			},
+			Nonce:         authz.Nonce.String(),
+			Email:         "test@example.com",
+			EmailVerified: true,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		kind       external.OIDCKind
+		claims     func() idTokenClaims
+		algorithms []string
+		want       model.OIDCIdentity
+		wantErr    bool
+	}{
+		{
+			name:   "IDトークンを検証してユーザー情報を取得できる",
+			kind:   external.OIDCKindOIDC,
+			claims: valid,
+			want: model.OIDCIdentity{
+				Provider:      "test",
+				Subject:       "subject",
+				Email:         "test@example.com",
+				EmailVerified: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "文字列のemail_verifiedを解釈できる",
+			kind: external.OIDCKindOIDC,
+			claims: func() idTokenClaims {
+				c := valid()
+				c.EmailVerified = "false"
+
+				return c
+			},
+			want: model.OIDCIdentity{
+				Provider:      "test",
+				Subject:       "subject",
+				Email:         "test@example.com",
+				EmailVerified: false,
+			},
+			wantErr: false,
+		},
+		{
+			name: "nonceが一致しないIDトークンは拒否される",
+			kind: external.OIDCKindOIDC,
+			claims: func() idTokenClaims {
+				c := valid()
+				c.Nonce = "other"
+
+				return c
+			},
+			want:    model.OIDCIdentity{},
+			wantErr: true,
+		},
+		{
+			name: "audienceが一致しないIDトークンは拒否される",
+			kind: external.OIDCKindOIDC,
+			claims: func() idTokenClaims {
+				c := valid()
+				c.Audience = jwt.ClaimStrings{"other"}
+
+				return c
+			},
+			want:    model.OIDCIdentity{},
+			wantErr: true,
+		},
+		{
+			name: "issuerが一致しないIDトークンは拒否される",
+			kind: external.OIDCKindOIDC,
+			claims: func() idTokenClaims {
+				c := valid()
+				c.Issuer = "https://evil.example.com"
+
+				return c
+			},
+			want:    model.OIDCIdentity{},
+			wantErr: true,
+		},
+		{
+			name: "有効期限切れのIDトークンは拒否される",
+			kind: external.OIDCKindOIDC,
+			claims: func() idTokenClaims {
+				c := valid()
+				c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+				return c
+			},
+			want:    model.OIDCIdentity{},
+			wantErr: true,
+		},
+		{
+			name:       "プロバイダの署名アルゴリズム以外で署名されたIDトークンは拒否される",
+			kind:       external.OIDCKindOIDC,
+			claims:     valid,
+			algorithms: []string{"ES256"},
+			want:       model.OIDCIdentity{},
+			wantErr:    true,
+		},
+		{
+			name:   "GitHubのAPIからプライマリのメールアドレスを取得できる",
+			kind:   external.OIDCKindGitHub,
+			claims: valid,
+			want: model.OIDCIdentity{
+				Provider:      "test",
+				Subject:       "1234",
+				Email:         "test@example.com",
+				EmailVerified: true,
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := newOIDCServer(t, key, tt.claims())
+			prv := newOIDCProvider(srv, tt.kind, key)
+			if tt.algorithms != nil {
+				prv.Algorithms = tt.algorithms
+			}
+			ext := external.NewOIDC(map[auth.OIDCProvider]external.OIDCProvider{
+				"test": prv,
+			}, srv.Client())
+			got, err := ext.Exchange(context.Background(), authz, "code")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OIDC.Exchange() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("OIDC.Exchange() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	articlev1 "github.com/morning-night-guild/platform-app/pkg/connect/article/v1"
 	userv1 "github.com/morning-night-guild/platform-app/pkg/connect/user/v1"
 	"github.com/morning-night-guild/platform-app/pkg/connect/user/v1/userv1connect"
 	"github.com/morning-night-guild/platform-app/pkg/log"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type UserFactory interface {
@@ -42,14 +44,50 @@ func (ext *User) Create(ctx context.Context) (model.User, error) {
 		return model.User{}, ext.external.HandleError(ctx, err)
 	}
 
-	return model.User{
-		UserID: user.ID(uuid.MustParse(res.Msg.User.UserId)),
-	}, nil
+	return ext.toModel(res.Msg.User), nil
+}
+
+// Get ユーザーをプロフィールとともに取得する.
+func (ext *User) Get(ctx context.Context, uid user.ID) (model.User, error) {
+	req := NewRequest(ctx, &userv1.GetRequest{
+		UserId: uid.String(),
+	})
+
+	res, err := ext.connect.Get(ctx, req)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get user core", log.ErrorField(err))
+
+		return model.User{}, ext.external.HandleError(ctx, err)
+	}
+
+	return ext.toModel(res.Msg.User), nil
 }
 
-func (ext *User) Update(ctx context.Context, uid user.ID) (model.User, error) {
+// Update フィールドマスクで指定した項目のみプロフィールを更新する.
+func (ext *User) Update(
+	ctx context.Context,
+	uid user.ID,
+	profile model.UserProfile,
+	fields []user.ProfileField,
+) (model.User, error) {
+	paths := make([]string, len(fields))
+
+	for i, field := range fields {
+		paths[i] = field.String()
+	}
+
 	req := NewRequest(ctx, &userv1.UpdateRequest{
 		UserId: uid.String(),
+		Profile: &userv1.Profile{
+			DisplayName: profile.DisplayName.String(),
+			AvatarUrl:   profile.AvatarURL.String(),
+			Bio:         profile.Bio.String(),
+			Locale:      profile.Locale.String(),
+			Timezone:    profile.Timezone.String(),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{
+			Paths: paths,
+		},
 	})
 
 	res, err := ext.connect.Update(ctx, req)
@@ -59,7 +97,57 @@ func (ext *User) Update(ctx context.Context, uid user.ID) (model.User, error) {
 		return model.User{}, ext.external.HandleError(ctx, err)
 	}
 
-	return model.User{
-		UserID: user.ID(uuid.MustParse(res.Msg.User.UserId)),
+	return ext.toModel(res.Msg.User), nil
+}
+
+// Delete ユーザーの削除を依頼し、削除前に取得したデータの写しを受け取る.
+func (ext *User) Delete(ctx context.Context, uid user.ID) (model.UserDeletion, error) {
+	req := NewRequest(ctx, &userv1.DeleteRequest{
+		UserId: uid.String(),
+	})
+
+	res, err := ext.connect.Delete(ctx, req)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to delete user core", log.ErrorField(err))
+
+		return model.UserDeletion{}, ext.external.HandleError(ctx, err)
+	}
+
+	export := res.Msg.Export
+
+	return model.UserDeletion{
+		Export: model.UserExport{
+			User:       ext.toModel(export.User),
+			Articles:   ext.toArticles(export.Articles),
+			ExportedAt: export.ExportedAt.AsTime(),
+		},
+		PurgeAt: res.Msg.PurgeAt.AsTime(),
 	}, nil
 }
+
+func (ext *User) toModel(
+	usr *userv1.User,
+) model.User {
+	profile := usr.GetProfile()
+
+	return model.ReconstructUser(
+		uuid.MustParse(usr.UserId),
+		profile.GetDisplayName(),
+		profile.GetAvatarUrl(),
+		profile.GetBio(),
+		profile.GetLocale(),
+		profile.GetTimezone(),
+	)
+}
+
+func (ext *User) toArticles(
+	articles []*articlev1.Article,
+) []model.Article {
+	res := make([]model.Article, len(articles))
+
+	for i, article := range articles {
+		res[i] = toArticleModel(article)
+	}
+
+	return res
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/domain/value"
 	"github.com/morning-night-guild/platform-app/pkg/ent"
 	entarticle "github.com/morning-night-guild/platform-app/pkg/ent/article"
+	entschema "github.com/morning-night-guild/platform-app/pkg/ent/schema"
+	entuser "github.com/morning-night-guild/platform-app/pkg/ent/user"
 	entuserarticle "github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
 	"github.com/morning-night-guild/platform-app/pkg/log"
 	"github.com/pkg/errors"
@@ -32,32 +34,56 @@ func NewArticle(rdb *RDB) *Article {
 }
 
 // Save 記事を保存するメソッド.
+// タグの登録に失敗した場合にタグのない記事が残らないよう、記事とタグを同じトランザクションで登録する.
 func (gtw *Article) Save(
 	ctx context.Context,
 	item model.Article,
+) error {
+	return gtw.rdb.RunInTx(ctx, func(ctx context.Context) error {
+		return gtw.save(ctx, item)
+	})
+}
+
+func (gtw *Article) save(
+	ctx context.Context,
+	item model.Article,
 ) error {
 	id := item.ArticleID.Value()
 
 	now := time.Now().UTC()
 
-	err := gtw.rdb.Article.Create().
+	create := gtw.rdb.Writer(ctx).Article.Create().
 		SetID(id).
 		SetTitle(item.Title.String()).
 		SetURL(item.URL.String()).
 		SetDescription(item.Description.String()).
 		SetThumbnail(item.Thumbnail.String()).
 		SetCreatedAt(now).
-		SetUpdatedAt(now).
+		SetUpdatedAt(now)
+
+	// 同じURLの記事が共有済みの場合は最初に共有したユーザーを残す
+	if item.SharedBy != nil {
+		create = create.SetSharedBy(item.SharedBy.UserID.Value())
+	}
+
+	err := create.
 		OnConflict().
 		DoNothing().
 		Exec(ctx)
 
 	if err != nil && gtw.rdb.IsDuplicatedError(ctx, err) {
-		if ea, err := gtw.rdb.Article.Query().Where(entarticle.URLEQ(item.URL.String())).First(ctx); err == nil {
-			id = ea.ID
-		} else {
+		// ゴミ箱に移動した記事も同じURLで登録されているため、ゴミ箱を含めて検索する
+		ea, err := gtw.rdb.Writer(ctx).Article.Query().Where(entarticle.URLEQ(item.URL.String())).First(entschema.SkipSoftDelete(ctx))
+		if err != nil {
 			return errors.Wrap(err, "failed to save")
 		}
+
+		// ゴミ箱に移動した記事は管理者の判断で削除されたため、共有により復元せずに拒否する
+		if ea.DeletedAt != nil {
+			return domainerrors.NewValidationError("article is in the trash")
+		}
+
+		id = ea.ID
 	} else if err != nil {
 		return errors.Wrap(err, "failed to save")
 	}
@@ -68,12 +94,14 @@ func (gtw *Article) Save(
 
 	bulk := make([]*ent.ArticleTagCreate, item.TagList.Len())
 	for i, tag := range item.TagList {
-		bulk[i] = gtw.rdb.ArticleTag.Create().
+		bulk[i] = gtw.rdb.Writer(ctx).ArticleTag.Create().
 			SetTag(tag.String()).
-			SetArticleID(id)
+			SetArticleID(id).
+			SetCreatedAt(now).
+			SetUpdatedAt(now)
 	}
 
-	if err = gtw.rdb.ArticleTag.CreateBulk(bulk...).
+	if err = gtw.rdb.Writer(ctx).ArticleTag.CreateBulk(bulk...).
 		OnConflict().
 		DoNothing().
 		Exec(ctx); err == nil {
@@ -94,8 +122,9 @@ func (gtw *Article) List(
 	size value.Size,
 	filter ...value.Filter,
 ) ([]model.Article, error) {
-	query := gtw.rdb.Article.Query().
+	query := gtw.rdb.Reader(ctx).Article.Query().
 		WithTags().
+		WithSharer(withSharer).
 		Order(ent.Desc(entarticle.FieldCreatedAt)).
 		Offset(index.Int()).
 		Limit(size.Int())
@@ -113,24 +142,6 @@ func (gtw *Article) List(
 		return nil, errors.Wrap(err, "failed to article query")
 	}
 
-	articles := make([]model.Article, len(eas))
-
-	for i, ea := range eas {
-		tags := make([]string, len(ea.Edges.Tags))
-		for i, tag := range ea.Edges.Tags {
-			tags[i] = tag.Tag
-		}
-
-		articles[i] = model.ReconstructArticle(
-			ea.ID,
-			ea.URL,
-			ea.Title,
-			ea.Description,
-			ea.Thumbnail,
-			tags,
-		)
-	}
-
 	return gtw.toModels(eas), nil
 }
 
@@ -142,10 +153,32 @@ func (gtw *Article) ListByUser(
 	size value.Size,
 	filter ...value.Filter,
 ) ([]model.Article, error) {
-	query := gtw.rdb.UserArticle.Query().
+	return gtw.listByUser(ctx, userID, index, size, filter...)
+}
+
+// ListAllByUser ゴミ箱に移動した記事も含めてユーザーに紐づく記事を取得するメソッド.
+// ユーザーのデータの写しの作成に用いる.
+func (gtw *Article) ListAllByUser(
+	ctx context.Context,
+	userID user.ID,
+	index value.Index,
+	size value.Size,
+) ([]model.Article, error) {
+	return gtw.listByUser(entschema.SkipSoftDelete(ctx), userID, index, size)
+}
+
+func (gtw *Article) listByUser(
+	ctx context.Context,
+	userID user.ID,
+	index value.Index,
+	size value.Size,
+	filter ...value.Filter,
+) ([]model.Article, error) {
+	query := gtw.rdb.Reader(ctx).UserArticle.Query().
 		Where(entuserarticle.UserID(userID.Value())).
 		QueryArticle().
 		WithTags().
+		WithSharer(withSharer).
 		Order(ent.Desc(entarticle.FieldCreatedAt)).
 		Offset(index.Int()).
 		Limit(size.Int())
@@ -171,9 +204,10 @@ func (gtw *Article) Find(
 	ctx context.Context,
 	id article.ID,
 ) (model.Article, error) {
-	ea, err := gtw.rdb.Article.Query().
+	ea, err := gtw.rdb.Reader(ctx).Article.Query().
 		Where(entarticle.IDEQ(id.Value())).
 		WithTags().
+		WithSharer(withSharer).
 		First(ctx)
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to find article", log.ErrorField(err))
@@ -188,8 +222,13 @@ func (gtw *Article) Find(
 	return gtw.toModel(ea), nil
 }
 
+// Delete 記事をゴミ箱に移動するメソッド.
+// 記事のタグやユーザーとの紐付けは復元に備えて残し、Purgeで完全に削除する.
 func (gtw *Article) Delete(ctx context.Context, id article.ID) error {
-	if err := gtw.rdb.Article.DeleteOneID(id.Value()).Exec(ctx); err != nil {
+	if err := gtw.rdb.Writer(ctx).Article.UpdateOneID(id.Value()).
+		Where(entarticle.DeletedAtIsNil()).
+		SetDeletedAt(time.Now().UTC()).
+		Exec(ctx); err != nil {
 		if ent.IsNotFound(err) {
 			return nil
 		}
@@ -200,17 +239,76 @@ func (gtw *Article) Delete(ctx context.Context, id article.ID) error {
 	return nil
 }
 
+// ListTrash ゴミ箱に移動した記事を移動した日時の新しい順に取得するメソッド.
+func (gtw *Article) ListTrash(
+	ctx context.Context,
+	index value.Index,
+	size value.Size,
+) ([]model.TrashedArticle, error) {
+	eas, err := gtw.rdb.Writer(ctx).Article.Query().
+		Where(entarticle.DeletedAtNotNil()).
+		WithTags().
+		WithSharer(withSharer).
+		Order(ent.Desc(entarticle.FieldDeletedAt), ent.Asc(entarticle.FieldID)).
+		Offset(index.Int()).
+		Limit(size.Int()).
+		All(entschema.SkipSoftDelete(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to trash query")
+	}
+
+	articles := make([]model.TrashedArticle, len(eas))
+
+	for i, ea := range eas {
+		articles[i] = model.TrashedArticle{
+			Article:   gtw.toModel(ea),
+			DeletedAt: *ea.DeletedAt,
+		}
+	}
+
+	return articles, nil
+}
+
+// Restore ゴミ箱に移動した記事を復元するメソッド.
+func (gtw *Article) Restore(ctx context.Context, id article.ID) error {
+	if err := gtw.rdb.Writer(ctx).Article.UpdateOneID(id.Value()).
+		Where(entarticle.DeletedAtNotNil()).
+		ClearDeletedAt().
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return domainerrors.NewNotFoundError("article not found in trash")
+		}
+
+		return errors.Wrap(err, "failed to restore article")
+	}
+
+	return nil
+}
+
+// Purge 指定日時より前にゴミ箱に移動した記事を完全に削除するメソッド.
+// 記事のタグやユーザーとの紐付けも外部キーの制約により削除される.
+func (gtw *Article) Purge(ctx context.Context, before time.Time) (int, error) {
+	n, err := gtw.rdb.Writer(ctx).Article.Delete().
+		Where(entarticle.DeletedAtLT(before)).
+		Exec(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to purge articles")
+	}
+
+	return n, nil
+}
+
 func (gtw *Article) AddToUser(
 	ctx context.Context,
 	articleID article.ID,
 	userID user.ID,
 ) error {
-	if err := gtw.rdb.UserArticle.Create().
+	if err := gtw.rdb.Writer(ctx).UserArticle.Create().
 		SetArticleID(articleID.Value()).
 		SetUserID(userID.Value()).
 		OnConflict().
 		DoNothing().
-		Exec(ctx); err != nil {
+		Exec(ctx); err != nil && !gtw.rdb.IsDuplicatedError(ctx, err) {
 		return errors.Wrap(err, "failed to add to user")
 	}
 
@@ -222,7 +320,7 @@ func (gtw *Article) ExistsByUser(
 	articleID article.ID,
 	userID user.ID,
 ) (bool, error) {
-	return gtw.rdb.UserArticle.Query().
+	return gtw.rdb.Writer(ctx).UserArticle.Query().
 		Where(
 			entuserarticle.ArticleIDEQ(articleID.Value()),
 			entuserarticle.UserID(userID.Value()),
@@ -235,7 +333,7 @@ func (gtw *Article) RemoveFromUser(
 	articleID article.ID,
 	userID user.ID,
 ) error {
-	if _, err := gtw.rdb.UserArticle.Delete().
+	if _, err := gtw.rdb.Writer(ctx).UserArticle.Delete().
 		Where(
 			entuserarticle.ArticleIDEQ(articleID.Value()),
 			entuserarticle.UserID(userID.Value()),
@@ -247,6 +345,11 @@ func (gtw *Article) RemoveFromUser(
 	return nil
 }
 
+// withSharer 記事を共有したユーザーを取得する. 削除を受け付けたユーザーは共有者として表示しない.
+func withSharer(query *ent.UserQuery) {
+	query.Where(entuser.DeletedAtIsNil())
+}
+
 func (gtw *Article) toModel(
 	ea *ent.Article,
 ) model.Article {
@@ -255,7 +358,7 @@ func (gtw *Article) toModel(
 		tags[i] = tag.Tag
 	}
 
-	return model.ReconstructArticle(
+	article := model.ReconstructArticle(
 		ea.ID,
 		ea.URL,
 		ea.Title,
@@ -263,6 +366,16 @@ func (gtw *Article) toModel(
 		ea.Thumbnail,
 		tags,
 	)
+
+	if sharer := ea.Edges.Sharer; sharer != nil {
+		article.SharedBy = &model.ArticleSharer{
+			UserID:      user.ID(sharer.ID),
+			DisplayName: user.DisplayName(sharer.DisplayName),
+			AvatarURL:   user.AvatarURL(sharer.AvatarURL),
+		}
+	}
+
+	return article
 }
 
 func (gtw *Article) toModels(
@@ -271,19 +384,7 @@ func (gtw *Article) toModels(
 	articles := make([]model.Article, len(eas))
 
 	for i, ea := range eas {
-		tags := make([]string, len(ea.Edges.Tags))
-		for i, tag := range ea.Edges.Tags {
-			tags[i] = tag.Tag
-		}
-
-		articles[i] = model.ReconstructArticle(
-			ea.ID,
-			ea.URL,
-			ea.Title,
-			ea.Description,
-			ea.Thumbnail,
-			tags,
-		)
+		articles[i] = gtw.toModel(ea)
 	}
 
 	return articles
@@ -2,8 +2,10 @@ package gateway_test
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
@@ -16,6 +18,7 @@ import (
 	"github.com/morning-night-guild/platform-app/pkg/ent"
 	entarticle "github.com/morning-night-guild/platform-app/pkg/ent/article"
 	"github.com/morning-night-guild/platform-app/pkg/ent/articletag"
+	entschema "github.com/morning-night-guild/platform-app/pkg/ent/schema"
 	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
 )
 
@@ -574,6 +577,19 @@ func TestArticleDelete(t *testing.T) {
 		if article != nil {
 			t.Error("Delete target still exists")
 		}
+
+		// 復元に備えて記事とタグはゴミ箱に残る
+		trashed, err := rdb.Article.Query().
+			Where(entarticle.IDEQ(item.ArticleID.Value())).
+			WithTags().
+			First(entschema.SkipSoftDelete(ctx))
+		if err != nil {
+			t.Fatalf("unexpected error while find trashed. got %v", err)
+		}
+
+		if trashed.DeletedAt == nil || len(trashed.Edges.Tags) != 2 {
+			t.Errorf("trashed article = %v, want deleted_at and 2 tags", trashed)
+		}
 	})
 
 	t.Run("存在しない記事を削除しようとしてもエラーにならない", func(t *testing.T) {
@@ -594,6 +610,243 @@ func TestArticleDelete(t *testing.T) {
 	})
 }
 
+func TestArticleTrash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ゴミ箱に移動した記事は一覧に含まれず、ゴミ箱の一覧で取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		usr := model.User{
+			UserID: user.GenerateID(),
+		}
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		items := make([]model.Article, 2)
+
+		for i := range items {
+			items[i] = model.CreateArticle(
+				article.URL(fmt.Sprintf("https://example.com/%d", i)),
+				article.Title(fmt.Sprintf("title%d", i)),
+				article.Description("description"),
+				article.Thumbnail("https://example.com"),
+				article.TagList([]article.Tag{article.Tag("tag")}),
+			)
+
+			if err := articleGateway.Save(ctx, items[i]); err != nil {
+				t.Fatalf("failed to save. got %v", err)
+			}
+
+			if err := articleGateway.AddToUser(ctx, items[i].ArticleID, usr.UserID); err != nil {
+				t.Fatalf("failed to add to user. got %v", err)
+			}
+		}
+
+		if err := articleGateway.Delete(ctx, items[0].ArticleID); err != nil {
+			t.Fatalf("failed to delete. got %v", err)
+		}
+
+		want := []model.Article{items[1]}
+
+		if got, err := articleGateway.List(ctx, value.Index(0), value.Size(10)); err != nil {
+			t.Fatalf("failed to list. got %v", err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("List() = %v, want %v", got, want)
+		}
+
+		if got, err := articleGateway.ListByUser(ctx, usr.UserID, value.Index(0), value.Size(10)); err != nil {
+			t.Fatalf("failed to list by user. got %v", err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("ListByUser() = %v, want %v", got, want)
+		}
+
+		if _, err := articleGateway.Find(ctx, items[0].ArticleID); !errors.AsNotFoundError(err) {
+			t.Errorf("Find() error = %v, want NotFoundError", err)
+		}
+
+		// ユーザーとの紐付けは残る
+		if got, err := articleGateway.ExistsByUser(ctx, items[0].ArticleID, usr.UserID); err != nil {
+			t.Fatalf("failed to exists by user. got %v", err)
+		} else if !got {
+			t.Error("user article is removed")
+		}
+
+		trash, err := articleGateway.ListTrash(ctx, value.Index(0), value.Size(10))
+		if err != nil {
+			t.Fatalf("failed to list trash. got %v", err)
+		}
+
+		if len(trash) != 1 || !reflect.DeepEqual(trash[0].Article, items[0]) || trash[0].DeletedAt.IsZero() {
+			t.Errorf("ListTrash() = %v, want %v", trash, items[0])
+		}
+	})
+
+	t.Run("ゴミ箱に移動した記事を復元できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		item := model.CreateArticle(
+			article.URL("https://example.com"),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList([]article.Tag{article.Tag("tag")}),
+		)
+
+		if err := articleGateway.Save(ctx, item); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		// ゴミ箱にない記事は復元できない
+		if err := articleGateway.Restore(ctx, item.ArticleID); !errors.AsNotFoundError(err) {
+			t.Errorf("Restore() error = %v, want NotFoundError", err)
+		}
+
+		if err := articleGateway.Delete(ctx, item.ArticleID); err != nil {
+			t.Fatalf("failed to delete. got %v", err)
+		}
+
+		if err := articleGateway.Restore(ctx, item.ArticleID); err != nil {
+			t.Fatalf("failed to restore. got %v", err)
+		}
+
+		if got, err := articleGateway.Find(ctx, item.ArticleID); err != nil {
+			t.Fatalf("failed to find. got %v", err)
+		} else if !reflect.DeepEqual(got, item) {
+			t.Errorf("Find() = %v, want %v", got, item)
+		}
+	})
+
+	t.Run("ゴミ箱に移動した記事は再度共有しても復元されない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		item := model.CreateArticle(
+			article.URL("https://example.com"),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList{},
+		)
+
+		if err := articleGateway.Save(ctx, item); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		if err := articleGateway.Delete(ctx, item.ArticleID); err != nil {
+			t.Fatalf("failed to delete. got %v", err)
+		}
+
+		if err := articleGateway.Save(ctx, model.CreateArticle(
+			article.URL("https://example.com"),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList{},
+		)); !errors.AsValidationError(err) {
+			t.Fatalf("Save() error = %v, want validation error", err)
+		}
+
+		if _, err := articleGateway.Find(ctx, item.ArticleID); !errors.AsNotFoundError(err) {
+			t.Errorf("Find() error = %v, want not found error", err)
+		}
+
+		trash, err := articleGateway.ListTrash(ctx, value.Index(0), value.Size(10))
+		if err != nil {
+			t.Fatalf("failed to list trash. got %v", err)
+		}
+
+		if len(trash) != 1 || trash[0].ArticleID != item.ArticleID {
+			t.Errorf("ListTrash() = %v, want %v", trash, item)
+		}
+	})
+
+	t.Run("保持期間を過ぎた記事を完全に削除できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		items := make([]model.Article, 3)
+
+		for i := range items {
+			items[i] = model.CreateArticle(
+				article.URL(fmt.Sprintf("https://example.com/%d", i)),
+				article.Title(fmt.Sprintf("title%d", i)),
+				article.Description("description"),
+				article.Thumbnail("https://example.com"),
+				article.TagList([]article.Tag{article.Tag("tag")}),
+			)
+
+			if err := articleGateway.Save(ctx, items[i]); err != nil {
+				t.Fatalf("failed to save. got %v", err)
+			}
+		}
+
+		now := time.Now().UTC()
+
+		// 1件目は保持期間を過ぎ、2件目は保持期間内、3件目はゴミ箱にない
+		rdb.Article.UpdateOneID(items[0].ArticleID.Value()).SetDeletedAt(now.Add(-2 * time.Hour)).ExecX(ctx)
+		rdb.Article.UpdateOneID(items[1].ArticleID.Value()).SetDeletedAt(now).ExecX(ctx)
+
+		n, err := articleGateway.Purge(ctx, now.Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("failed to purge. got %v", err)
+		}
+
+		if n != 1 {
+			t.Errorf("Purge() = %d, want 1", n)
+		}
+
+		ids := rdb.Article.Query().Order(ent.Asc(entarticle.FieldTitle)).IDsX(entschema.SkipSoftDelete(ctx))
+
+		want := []uuid.UUID{items[1].ArticleID.Value(), items[2].ArticleID.Value()}
+
+		if !reflect.DeepEqual(ids, want) {
+			t.Errorf("articles = %v, want %v", ids, want)
+		}
+
+		if n := rdb.ArticleTag.Query().Where(articletag.ArticleIDEQ(items[0].ArticleID.Value())).CountX(ctx); n != 0 {
+			t.Errorf("tags of purged article = %d, want 0", n)
+		}
+	})
+}
+
 func TestArticleAddToUser(t *testing.T) {
 	t.Parallel()
 
@@ -652,6 +905,56 @@ func TestArticleAddToUser(t *testing.T) {
 		}
 	})
 
+	t.Run("ユーザーに追加済みの記事を重複して追加してもエラーにならない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		usr := model.User{
+			UserID: user.GenerateID(),
+		}
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		atc := model.CreateArticle(
+			article.URL("https://example.com"),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList([]article.Tag{}),
+		)
+
+		if err := articleGateway.Save(ctx, atc); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		for i := 0; i < 2; i++ {
+			if err := articleGateway.AddToUser(ctx, atc.ArticleID, usr.UserID); err != nil {
+				t.Fatalf("unexpected error while add to user. got %v", err)
+			}
+		}
+
+		got, err := rdb.UserArticle.Query().Where(userarticle.UserIDEQ(usr.UserID.Value())).Count(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error while count. got %v", err)
+		}
+
+		if got != 1 {
+			t.Errorf("UserArticle count = %d, want 1", got)
+		}
+	})
+
 	t.Run("存在しない記事をユーザーに追加しようとするとエラーになる", func(t *testing.T) {
 		t.Parallel()
 
@@ -784,6 +1087,83 @@ func TestArticleListByUser(t *testing.T) {
 	})
 }
 
+func TestArticleListAllByUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ゴミ箱に移動した記事も含めてユーザーに紐づく記事を取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		usr := model.User{
+			UserID: user.GenerateID(),
+		}
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		items := []model.Article{
+			model.CreateArticle(
+				article.URL("https://example.com/1"),
+				article.Title("title1"),
+				article.Description("description"),
+				article.Thumbnail("https://example.com/1"),
+				article.TagList([]article.Tag{}),
+			),
+			model.CreateArticle(
+				article.URL("https://example.com/2"),
+				article.Title("title2"),
+				article.Description("description"),
+				article.Thumbnail("https://example.com/2"),
+				article.TagList([]article.Tag{}),
+			),
+		}
+
+		for _, item := range items {
+			if err := articleGateway.Save(ctx, item); err != nil {
+				t.Fatalf("failed to save. got %v", err)
+			}
+
+			if err := articleGateway.AddToUser(ctx, item.ArticleID, usr.UserID); err != nil {
+				t.Fatalf("failed to add to user. got %v", err)
+			}
+		}
+
+		if err := articleGateway.Delete(ctx, items[1].ArticleID); err != nil {
+			t.Fatalf("failed to delete. got %v", err)
+		}
+
+		// ListByUserではゴミ箱に移動した記事を取得しない
+		if got, err := articleGateway.ListByUser(ctx, usr.UserID, value.Index(0), value.Size(10)); err != nil || len(got) != 1 {
+			t.Fatalf("ListByUser() = %v, %v, want 1 article", got, err)
+		}
+
+		got, err := articleGateway.ListAllByUser(ctx, usr.UserID, value.Index(0), value.Size(10))
+		if err != nil {
+			t.Fatalf("unexpected error while list. got %v", err)
+		}
+
+		ids := map[article.ID]bool{}
+		for _, item := range got {
+			ids[item.ArticleID] = true
+		}
+
+		if len(got) != len(items) || !ids[items[0].ArticleID] || !ids[items[1].ArticleID] {
+			t.Errorf("ListAllByUser() = %v, want %v", got, items)
+		}
+	})
+}
+
 func TestArticleRemoveFromUser(t *testing.T) {
 	t.Parallel()
 
@@ -845,3 +1225,138 @@ func TestArticleRemoveFromUser(t *testing.T) {
 		}
 	})
 }
+
+func TestArticleSharedBy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("共有したユーザーを記録して取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		usr := model.User{
+			UserID: user.GenerateID(),
+			Profile: model.UserProfile{
+				DisplayName: user.DisplayName("name"),
+				AvatarURL:   user.AvatarURL("https://example.com/avatar.png"),
+			},
+		}
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		item := model.CreateArticle(
+			article.URL("https://example.com"),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList([]article.Tag{}),
+		)
+
+		item.SharedBy = model.NewArticleSharer(usr)
+
+		if err := articleGateway.Save(ctx, item); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		// 同じURLを別のユーザーが共有しても最初に共有したユーザーが残る
+		other := model.CreateArticle(
+			item.URL,
+			item.Title,
+			item.Description,
+			item.Thumbnail,
+			item.TagList,
+		)
+
+		other.SharedBy = &model.ArticleSharer{
+			UserID: user.GenerateID(),
+		}
+
+		if err := articleGateway.Save(ctx, other); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		if got, err := articleGateway.Find(ctx, item.ArticleID); err != nil {
+			t.Fatalf("failed to find. got %v", err)
+		} else if !reflect.DeepEqual(got, item) {
+			t.Errorf("Find() = %v, want %v", got, item)
+		}
+
+		if got, err := articleGateway.List(ctx, value.Index(0), value.Size(10)); err != nil {
+			t.Fatalf("failed to list. got %v", err)
+		} else if !reflect.DeepEqual(got, []model.Article{item}) {
+			t.Errorf("List() = %v, want %v", got, []model.Article{item})
+		}
+	})
+
+	t.Run("削除したユーザーは共有者として表示されず、完全に削除しても記事は残る", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatalf("failed to create rdb client. got %v", err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		item := model.CreateArticle(
+			article.URL("https://example.com"),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList([]article.Tag{}),
+		)
+
+		item.SharedBy = model.NewArticleSharer(usr)
+
+		if err := articleGateway.Save(ctx, item); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		if _, err := userGateway.Delete(ctx, usr.UserID); err != nil {
+			t.Fatalf("failed to delete user. got %v", err)
+		}
+
+		want := item
+		want.SharedBy = nil
+
+		if got, err := articleGateway.Find(ctx, item.ArticleID); err != nil {
+			t.Fatalf("failed to find. got %v", err)
+		} else if !reflect.DeepEqual(got, want) {
+			t.Errorf("Find() = %v, want %v", got, want)
+		}
+
+		if _, err := userGateway.Purge(ctx, time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("failed to purge users. got %v", err)
+		}
+
+		ea, err := rdb.Article.Get(ctx, item.ArticleID.Value())
+		if err != nil {
+			t.Fatalf("failed to get article. got %v", err)
+		}
+
+		if ea.SharedBy != nil {
+			t.Errorf("Article() shared_by = %v, want nil", *ea.SharedBy)
+		}
+	})
+}
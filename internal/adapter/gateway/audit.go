@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	domainerrors "github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	entauditlog "github.com/morning-night-guild/platform-app/pkg/ent/auditlog"
+	"github.com/morning-night-guild/platform-app/pkg/ent/predicate"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+var (
+	_ repository.Audit = (*Audit)(nil)
+	_ repository.Audit = (*LogAudit)(nil)
+)
+
+// Audit 監査ログのゲートウェイ.
+type Audit struct {
+	rdb *RDB
+}
+
+// NewAudit 監査ログのゲートウェイを生成するファクトリー関数.
+func NewAudit(rdb *RDB) *Audit {
+	return &Audit{
+		rdb: rdb,
+	}
+}
+
+// Save 監査ログを追記するメソッド.
+func (gtw *Audit) Save(
+	ctx context.Context,
+	item model.AuditLog,
+) error {
+	create := gtw.rdb.Writer(ctx).AuditLog.Create().
+		SetID(item.ID.Value()).
+		SetEmail(item.Email.String()).
+		SetEventType(item.EventType.String()).
+		SetOutcome(item.Outcome.String()).
+		SetIP(item.Client.IP).
+		SetForwardedFor(item.Client.ForwardedFor).
+		SetUserAgent(item.Client.UserAgent).
+		SetTraceID(item.TraceID).
+		SetOccurredAt(item.OccurredAt.UTC())
+
+	if item.HasActor() {
+		create = create.SetActorID(item.ActorID.Value())
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return errors.Wrap(err, "failed to save audit log")
+	}
+
+	return nil
+}
+
+// List 監査ログを新しい順に取得するメソッド.
+// カーソルが指す監査ログより古いものを(occurred_at, id)の降順で取得する.
+// フィルターにはactor_id, email, event_type, outcome, ip, since, until(RFC3339)を指定できる.
+func (gtw *Audit) List(
+	ctx context.Context,
+	cursor audit.Cursor,
+	size value.Size,
+	filter ...value.Filter,
+) ([]model.AuditLog, error) {
+	predicates, err := gtw.predicates(filter...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cursor.IsZero() {
+		at := cursor.OccurredAt.UTC()
+
+		predicates = append(predicates, entauditlog.Or(
+			entauditlog.OccurredAtLT(at),
+			entauditlog.And(entauditlog.OccurredAtEQ(at), entauditlog.IDLT(cursor.ID.Value())),
+		))
+	}
+
+	eals, err := gtw.rdb.Writer(ctx).AuditLog.Query().
+		Where(predicates...).
+		Order(ent.Desc(entauditlog.FieldOccurredAt), ent.Desc(entauditlog.FieldID)).
+		Limit(size.Int()).
+		All(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to audit log query")
+	}
+
+	logs := make([]model.AuditLog, len(eals))
+
+	for i, eal := range eals {
+		actorID := user.GenerateZeroID()
+		if eal.ActorID != nil {
+			actorID = user.ID(*eal.ActorID)
+		}
+
+		logs[i] = model.NewAuditLog(
+			audit.ID(eal.ID),
+			actorID,
+			auth.Email(eal.Email),
+			audit.EventType(eal.EventType),
+			audit.Outcome(eal.Outcome),
+			audit.Client{
+				IP:           eal.IP,
+				ForwardedFor: eal.ForwardedFor,
+				UserAgent:    eal.UserAgent,
+			},
+			eal.TraceID,
+			eal.OccurredAt,
+		)
+	}
+
+	return logs, nil
+}
+
+//nolint:cyclop
+func (gtw *Audit) predicates(filter ...value.Filter) ([]predicate.AuditLog, error) {
+	predicates := make([]predicate.AuditLog, 0, len(filter))
+
+	for _, f := range filter {
+		switch f.Name {
+		case "actor_id":
+			id, err := uuid.Parse(f.Value)
+			if err != nil {
+				return nil, domainerrors.NewValidationError(fmt.Sprintf("invalid actor_id: %s", f.Value), err)
+			}
+
+			predicates = append(predicates, entauditlog.ActorIDEQ(id))
+		case "email":
+			predicates = append(predicates, entauditlog.EmailEQ(f.Value))
+		case "event_type":
+			predicates = append(predicates, entauditlog.EventTypeEQ(f.Value))
+		case "outcome":
+			predicates = append(predicates, entauditlog.OutcomeEQ(f.Value))
+		case "ip":
+			predicates = append(predicates, entauditlog.IPEQ(f.Value))
+		case "since", "until":
+			at, err := time.Parse(time.RFC3339, f.Value)
+			if err != nil {
+				return nil, domainerrors.NewValidationError(fmt.Sprintf("invalid %s: %s", f.Name, f.Value), err)
+			}
+
+			if f.Name == "since" {
+				predicates = append(predicates, entauditlog.OccurredAtGTE(at.UTC()))
+			} else {
+				predicates = append(predicates, entauditlog.OccurredAtLT(at.UTC()))
+			}
+		}
+	}
+
+	return predicates, nil
+}
+
+// LogAudit データベースを用いない場合の監査ログ.
+// 監査ログはアプリケーションログにのみ出力し、保持しない.
+type LogAudit struct{}
+
+// NewLogAudit データベースを用いない監査ログを生成するファクトリー関数.
+func NewLogAudit() *LogAudit {
+	return &LogAudit{}
+}
+
+// Save 監査ログをアプリケーションログに出力するメソッド.
+// メールアドレスやIPアドレスなどの個人情報は出力しない.
+func (gtw *LogAudit) Save(
+	ctx context.Context,
+	item model.AuditLog,
+) error {
+	log.GetLogCtx(ctx).Info(
+		"audit log",
+		zap.String("id", item.ID.String()),
+		zap.String("actor_id", item.ActorID.String()),
+		zap.String("event_type", item.EventType.String()),
+		zap.String("outcome", item.Outcome.String()),
+		zap.String("trace_id", item.TraceID),
+	)
+
+	return nil
+}
+
+// List 監査ログを保持しないため、常に空の一覧を返すメソッド.
+func (gtw *LogAudit) List(
+	_ context.Context,
+	_ audit.Cursor,
+	_ value.Size,
+	_ ...value.Filter,
+) ([]model.AuditLog, error) {
+	return []model.AuditLog{}, nil
+}
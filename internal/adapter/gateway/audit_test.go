@@ -0,0 +1,248 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+)
+
+func TestAuditList(t *testing.T) {
+	t.Parallel()
+
+	uid := user.GenerateID()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	logs := []model.AuditLog{
+		model.NewAuditLog(
+			audit.GenerateID(),
+			uid,
+			auth.Email("test@example.com"),
+			audit.EventSignIn,
+			audit.OutcomeSuccess,
+			audit.Client{IP: "192.0.2.1", UserAgent: "agent"},
+			"tid1",
+			now.Add(-2*time.Hour),
+		),
+		model.NewAuditLog(
+			audit.GenerateID(),
+			user.GenerateZeroID(),
+			auth.Email("unknown@example.com"),
+			audit.EventSignIn,
+			audit.OutcomeFailure,
+			audit.Client{IP: "198.51.100.1", UserAgent: "agent"},
+			"tid2",
+			now.Add(-time.Hour),
+		),
+		model.NewAuditLog(
+			audit.GenerateID(),
+			uid,
+			auth.Email("test@example.com"),
+			audit.EventSignOut,
+			audit.OutcomeSuccess,
+			audit.Client{IP: "192.0.2.1", UserAgent: "agent"},
+			"tid3",
+			now,
+		),
+	}
+
+	tests := []struct {
+		name     string
+		filter   []value.Filter
+		wantTIDs []string
+		wantErr  bool
+	}{
+		{
+			name:     "監査ログを新しい順に取得できる",
+			filter:   []value.Filter{},
+			wantTIDs: []string{"tid3", "tid2", "tid1"},
+			wantErr:  false,
+		},
+		{
+			name:     "主体で絞り込める",
+			filter:   []value.Filter{value.NewFilter("actor_id", uid.String())},
+			wantTIDs: []string{"tid3", "tid1"},
+			wantErr:  false,
+		},
+		{
+			name: "イベント種別と結果で絞り込める",
+			filter: []value.Filter{
+				value.NewFilter("event_type", audit.EventSignIn.String()),
+				value.NewFilter("outcome", audit.OutcomeFailure.String()),
+			},
+			wantTIDs: []string{"tid2"},
+			wantErr:  false,
+		},
+		{
+			name:     "IPで絞り込める",
+			filter:   []value.Filter{value.NewFilter("ip", "192.0.2.1")},
+			wantTIDs: []string{"tid3", "tid1"},
+			wantErr:  false,
+		},
+		{
+			name: "期間で絞り込める",
+			filter: []value.Filter{
+				value.NewFilter("since", now.Add(-90*time.Minute).Format(time.RFC3339)),
+				value.NewFilter("until", now.Format(time.RFC3339)),
+			},
+			wantTIDs: []string{"tid2"},
+			wantErr:  false,
+		},
+		{
+			name:     "不正な期間では取得できない",
+			filter:   []value.Filter{value.NewFilter("since", "yesterday")},
+			wantTIDs: nil,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			auditGateway := gateway.NewAudit(rdb)
+
+			ctx := context.Background()
+
+			for _, log := range logs {
+				if err := auditGateway.Save(ctx, log); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := auditGateway.List(ctx, audit.Cursor{}, value.Size(10), tt.filter...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(got) != len(tt.wantTIDs) {
+				t.Fatalf("List() len = %v, want %v", len(got), len(tt.wantTIDs))
+			}
+
+			for i, log := range got {
+				if log.TraceID != tt.wantTIDs[i] {
+					t.Errorf("List()[%d].TraceID = %v, want %v", i, log.TraceID, tt.wantTIDs[i])
+				}
+			}
+
+			if len(got) > 1 && got[len(got)-1].ActorID != uid {
+				t.Errorf("List() actor = %v, want %v", got[len(got)-1].ActorID, uid)
+			}
+		})
+	}
+}
+
+func TestAuditListCursor(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	auditGateway := gateway.NewAudit(rdb)
+
+	ctx := context.Background()
+
+	// 同じ発生日時の監査ログがページをまたいでも重複や欠落が生じないことを確認する
+	occurredAts := []time.Time{now, now, now, now.Add(-time.Hour), now.Add(-2 * time.Hour)}
+
+	for _, at := range occurredAts {
+		al := model.NewAuditLog(
+			audit.GenerateID(),
+			user.GenerateZeroID(),
+			auth.Email("test@example.com"),
+			audit.EventSignIn,
+			audit.OutcomeSuccess,
+			audit.Client{},
+			"",
+			at,
+		)
+
+		if err := auditGateway.Save(ctx, al); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, err := auditGateway.List(ctx, audit.Cursor{}, value.Size(len(occurredAts)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := make([]model.AuditLog, 0, len(occurredAts))
+
+	cursor := audit.Cursor{}
+
+	for {
+		page, err := auditGateway.List(ctx, cursor, value.Size(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got = append(got, page...)
+
+		if len(page) < 2 {
+			break
+		}
+
+		cursor = page[len(page)-1].Cursor()
+	}
+
+	if len(got) != len(all) {
+		t.Fatalf("List() len = %v, want %v", len(got), len(all))
+	}
+
+	for i := range got {
+		if got[i].ID != all[i].ID {
+			t.Errorf("List()[%d].ID = %v, want %v", i, got[i].ID, all[i].ID)
+		}
+	}
+}
+
+func TestLogAudit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("監査ログを保持せずに保存できる", func(t *testing.T) {
+		t.Parallel()
+
+		gtw := gateway.NewLogAudit()
+
+		item := model.IssueAuditLog(
+			user.GenerateID(),
+			auth.Email("test@example.com"),
+			audit.EventSignIn,
+			audit.OutcomeSuccess,
+			audit.Client{IP: "192.0.2.1"},
+			"tid",
+		)
+
+		if err := gtw.Save(context.Background(), item); err != nil {
+			t.Fatalf("failed to save audit log: %s", err)
+		}
+
+		got, err := gtw.List(context.Background(), audit.Cursor{}, value.Size(10))
+		if err != nil {
+			t.Fatalf("failed to list audit logs: %s", err)
+		}
+
+		if len(got) != 0 {
+			t.Errorf("List() len = %d, want 0", len(got))
+		}
+	})
+}
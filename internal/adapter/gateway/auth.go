@@ -0,0 +1,321 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	"github.com/morning-night-guild/platform-app/pkg/ent/credential"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+var _ rpc.Auth = (*Auth)(nil)
+
+// Auth PostgreSQLにパスワードハッシュを保存する認証基盤.
+// fallbackを指定した場合、未移行のユーザーはfallbackで認証し、
+// 認証に成功した時点でパスワードハッシュを保存して移行する.
+type Auth struct {
+	rdb      *RDB
+	fallback rpc.Auth
+}
+
+func NewAuth(
+	rdb *RDB,
+	fallback rpc.Auth,
+) *Auth {
+	return &Auth{
+		rdb:      rdb,
+		fallback: fallback,
+	}
+}
+
+func (gtw *Auth) SignUp(
+	ctx context.Context,
+	uid user.ID,
+	email auth.Email,
+	password auth.Password,
+) error {
+	hash, err := auth.GeneratePasswordHash(password)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to generate password hash", log.ErrorField(err))
+
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	if err := gtw.rdb.Writer(ctx).Credential.Create().
+		SetID(uid.Value()).
+		SetEmail(email.String()).
+		SetPasswordHash(hash.String()).
+		SetEmailVerified(false).
+		SetCreatedAt(now).
+		SetUpdatedAt(now).
+		Exec(ctx); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to sign up user", log.ErrorField(err))
+
+		if ent.IsConstraintError(err) {
+			return errors.NewValidationError("email already exists", err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (gtw *Auth) SignIn(
+	ctx context.Context,
+	email auth.Email,
+	password auth.Password,
+) (model.User, error) {
+	item, err := gtw.rdb.Writer(ctx).Credential.Query().
+		Where(credential.EmailEQ(email.String())).
+		Only(ctx)
+	if ent.IsNotFound(err) && gtw.fallback != nil {
+		return gtw.migrate(ctx, email, password)
+	}
+
+	if ent.IsNotFound(err) {
+		// 存在しないメールアドレスでも同じだけハッシュを計算し、応答時間からユーザーの有無を判別できないようにする
+		auth.DummyPasswordHash.Verify(password)
+
+		return model.User{}, errors.NewUnauthorizedError("invalid email or password")
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to find credential", log.ErrorField(err))
+
+		return model.User{}, errors.NewUnauthorizedError("failed to sign in", err)
+	}
+
+	hash := auth.PasswordHash(item.PasswordHash)
+
+	if !hash.Verify(password) {
+		return model.User{}, errors.NewUnauthorizedError("invalid email or password")
+	}
+
+	uid := user.ID(item.ID)
+
+	// bcryptや古いパラメータのハッシュは、平文のパスワードが手元にあるサインイン成功時に再ハッシュする
+	if hash.NeedsRehash() {
+		if err := gtw.ChangePassword(ctx, uid, password); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to rehash password", log.ErrorField(err))
+		}
+	}
+
+	return model.User{
+		UserID: uid,
+	}, nil
+}
+
+// migrate fallbackで認証したユーザーのパスワードハッシュを保存する.
+// 移行済みのユーザーがメールアドレスを変更した場合、fallbackには旧メールアドレスと旧パスワードが残るため、
+// 移行済みのユーザーや保存に失敗したユーザーはfallbackで認証できても認証しない.
+func (gtw *Auth) migrate(
+	ctx context.Context,
+	email auth.Email,
+	password auth.Password,
+) (model.User, error) {
+	usr, err := gtw.fallback.SignIn(ctx, email, password)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	migrated, err := gtw.rdb.Writer(ctx).Credential.Query().
+		Where(credential.IDEQ(usr.UserID.Value())).
+		Exist(ctx)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to find credential", log.ErrorField(err))
+
+		return model.User{}, errors.NewUnauthorizedError("failed to sign in", err)
+	}
+
+	if migrated {
+		log.GetLogCtx(ctx).Warn("fallback accepted credentials of a migrated user")
+
+		return model.User{}, errors.NewUnauthorizedError("invalid email or password")
+	}
+
+	verified, err := gtw.fallback.IsVerified(ctx, usr.UserID)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get verification status from fallback", log.ErrorField(err))
+
+		verified = false
+	}
+
+	if err := gtw.SignUp(ctx, usr.UserID, email, password); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to migrate credential", log.ErrorField(err))
+
+		return model.User{}, errors.NewUnauthorizedError("failed to migrate credential", err)
+	}
+
+	if verified {
+		if err := gtw.MarkVerified(ctx, usr.UserID); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to migrate verification status", log.ErrorField(err))
+		}
+	}
+
+	return usr, nil
+}
+
+func (gtw *Auth) ChangePassword(
+	ctx context.Context,
+	uid user.ID,
+	password auth.Password,
+) error {
+	hash, err := auth.GeneratePasswordHash(password)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to generate password hash", log.ErrorField(err))
+
+		return err
+	}
+
+	err = gtw.rdb.Writer(ctx).Credential.UpdateOneID(uid.Value()).
+		SetPasswordHash(hash.String()).
+		SetUpdatedAt(time.Now().UTC()).
+		Exec(ctx)
+	if ent.IsNotFound(err) && gtw.fallback != nil {
+		return gtw.fallback.ChangePassword(ctx, uid, password)
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to change password", log.ErrorField(err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (gtw *Auth) GetEmail(
+	ctx context.Context,
+	uid user.ID,
+) (auth.Email, error) {
+	item, err := gtw.rdb.Writer(ctx).Credential.Get(ctx, uid.Value())
+	if ent.IsNotFound(err) && gtw.fallback != nil {
+		return gtw.fallback.GetEmail(ctx, uid)
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get credential", log.ErrorField(err))
+
+		return auth.Email(""), errors.NewNotFoundError("failed to get email", err)
+	}
+
+	return auth.Email(item.Email), nil
+}
+
+func (gtw *Auth) FindByEmail(
+	ctx context.Context,
+	email auth.Email,
+) (model.User, error) {
+	item, err := gtw.rdb.Writer(ctx).Credential.Query().
+		Where(credential.EmailEQ(email.String())).
+		Only(ctx)
+	if ent.IsNotFound(err) && gtw.fallback != nil {
+		return gtw.fallback.FindByEmail(ctx, email)
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to find credential", log.ErrorField(err))
+
+		return model.User{}, errors.NewNotFoundError("failed to find user by email", err)
+	}
+
+	return model.User{
+		UserID: user.ID(item.ID),
+	}, nil
+}
+
+func (gtw *Auth) UpdateEmail(
+	ctx context.Context,
+	uid user.ID,
+	email auth.Email,
+) error {
+	err := gtw.rdb.Writer(ctx).Credential.UpdateOneID(uid.Value()).
+		SetEmail(email.String()).
+		SetEmailVerified(false).
+		SetUpdatedAt(time.Now().UTC()).
+		Exec(ctx)
+	if ent.IsNotFound(err) && gtw.fallback != nil {
+		return gtw.fallback.UpdateEmail(ctx, uid, email)
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to update email", log.ErrorField(err))
+
+		if ent.IsConstraintError(err) {
+			return errors.NewValidationError("email already exists", err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (gtw *Auth) MarkVerified(
+	ctx context.Context,
+	uid user.ID,
+) error {
+	err := gtw.rdb.Writer(ctx).Credential.UpdateOneID(uid.Value()).
+		SetEmailVerified(true).
+		SetUpdatedAt(time.Now().UTC()).
+		Exec(ctx)
+	if ent.IsNotFound(err) && gtw.fallback != nil {
+		return gtw.fallback.MarkVerified(ctx, uid)
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to mark verified", log.ErrorField(err))
+
+		return err
+	}
+
+	return nil
+}
+
+func (gtw *Auth) IsVerified(
+	ctx context.Context,
+	uid user.ID,
+) (bool, error) {
+	item, err := gtw.rdb.Writer(ctx).Credential.Get(ctx, uid.Value())
+	if ent.IsNotFound(err) && gtw.fallback != nil {
+		return gtw.fallback.IsVerified(ctx, uid)
+	}
+
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get credential", log.ErrorField(err))
+
+		return false, errors.NewNotFoundError("failed to get verification status", err)
+	}
+
+	return item.EmailVerified, nil
+}
+
+// Delete パスワードハッシュを削除する.
+// fallbackを指定した場合、移行済みかどうかに関わらずfallbackからも削除する.
+// 既に削除済みの場合も成功とする.
+func (gtw *Auth) Delete(
+	ctx context.Context,
+	uid user.ID,
+) error {
+	if err := gtw.rdb.Writer(ctx).Credential.DeleteOneID(uid.Value()).
+		Exec(ctx); err != nil && !ent.IsNotFound(err) {
+		log.GetLogCtx(ctx).Warn("failed to delete credential", log.ErrorField(err))
+
+		return err
+	}
+
+	if gtw.fallback != nil {
+		return gtw.fallback.Delete(ctx, uid)
+	}
+
+	return nil
+}
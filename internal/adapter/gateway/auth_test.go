@@ -0,0 +1,402 @@
+package gateway_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthSignIn(t *testing.T) {
+	t.Parallel()
+
+	t.Run("サインアップしたユーザーでサインインできる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		ctx := context.Background()
+
+		uid := user.GenerateID()
+
+		if err := authGateway.SignUp(ctx, uid, auth.Email("test@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := authGateway.SignIn(ctx, auth.Email("test@example.com"), auth.Password("password"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.UserID != uid {
+			t.Errorf("SignIn() = %v, want %v", got.UserID, uid)
+		}
+	})
+
+	t.Run("パスワードが誤っている場合はサインインできない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		ctx := context.Background()
+
+		if err := authGateway.SignUp(ctx, user.GenerateID(), auth.Email("test@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := authGateway.SignIn(ctx, auth.Email("test@example.com"), auth.Password("invalid")); err == nil {
+			t.Error("SignIn() error = nil, want error")
+		}
+	})
+
+	t.Run("同じメールアドレスで複数回サインアップできない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		ctx := context.Background()
+
+		if err := authGateway.SignUp(ctx, user.GenerateID(), auth.Email("test@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := authGateway.SignUp(ctx, user.GenerateID(), auth.Email("test@example.com"), auth.Password("password")); err == nil {
+			t.Error("SignUp() error = nil, want error")
+		}
+	})
+
+	t.Run("bcryptのハッシュはサインイン時にargon2idで再ハッシュされる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		ctx := context.Background()
+
+		uid := user.GenerateID()
+
+		hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := rdb.Credential.Create().
+			SetID(uid.Value()).
+			SetEmail("test@example.com").
+			SetPasswordHash(string(hash)).
+			Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := authGateway.SignIn(ctx, auth.Email("test@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		item, err := rdb.Credential.Get(ctx, uid.Value())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if auth.PasswordHash(item.PasswordHash).NeedsRehash() {
+			t.Errorf("password hash = %v, want argon2id", item.PasswordHash)
+		}
+	})
+
+	t.Run("未移行のユーザーはfallbackで認証して移行される", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		uid := user.GenerateID()
+
+		ctrl := gomock.NewController(t)
+		fallback := rpc.NewMockAuth(ctrl)
+		fallback.EXPECT().SignIn(
+			gomock.Any(),
+			auth.Email("test@example.com"),
+			auth.Password("password"),
+		).Return(model.User{UserID: uid}, nil).Times(1)
+		fallback.EXPECT().IsVerified(gomock.Any(), uid).Return(true, nil).Times(1)
+
+		authGateway := gateway.NewAuth(rdb, fallback)
+
+		ctx := context.Background()
+
+		for i := 0; i < 2; i++ {
+			got, err := authGateway.SignIn(ctx, auth.Email("test@example.com"), auth.Password("password"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got.UserID != uid {
+				t.Errorf("SignIn() = %v, want %v", got.UserID, uid)
+			}
+		}
+
+		verified, err := authGateway.IsVerified(ctx, uid)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !verified {
+			t.Error("IsVerified() = false, want true")
+		}
+	})
+
+	t.Run("メールアドレスを変更した移行済みのユーザーは旧メールアドレスでサインインできない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		uid := user.GenerateID()
+
+		// fallbackには変更前のメールアドレスとパスワードが残っている
+		ctrl := gomock.NewController(t)
+		fallback := rpc.NewMockAuth(ctrl)
+		fallback.EXPECT().SignIn(
+			gomock.Any(),
+			auth.Email("old@example.com"),
+			auth.Password("password"),
+		).Return(model.User{UserID: uid}, nil)
+
+		authGateway := gateway.NewAuth(rdb, fallback)
+
+		ctx := context.Background()
+
+		if err := authGateway.SignUp(ctx, uid, auth.Email("old@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := authGateway.UpdateEmail(ctx, uid, auth.Email("new@example.com")); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := authGateway.SignIn(ctx, auth.Email("old@example.com"), auth.Password("password")); !errors.AsUnauthorizedError(err) {
+			t.Errorf("SignIn() error = %v, want unauthorized error", err)
+		}
+	})
+
+	t.Run("存在しないメールアドレスではサインインできない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		if _, err := authGateway.SignIn(context.Background(), auth.Email("test@example.com"), auth.Password("password")); !errors.AsUnauthorizedError(err) {
+			t.Errorf("SignIn() error = %v, want unauthorized error", err)
+		}
+	})
+
+	t.Run("fallbackで認証に失敗した場合はサインインできない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctrl := gomock.NewController(t)
+		fallback := rpc.NewMockAuth(ctrl)
+		fallback.EXPECT().SignIn(gomock.Any(), gomock.Any(), gomock.Any()).Return(model.User{}, fmt.Errorf("error"))
+
+		authGateway := gateway.NewAuth(rdb, fallback)
+
+		if _, err := authGateway.SignIn(context.Background(), auth.Email("test@example.com"), auth.Password("password")); err == nil {
+			t.Error("SignIn() error = nil, want error")
+		}
+	})
+}
+
+func TestAuthChangePassword(t *testing.T) {
+	t.Parallel()
+
+	t.Run("パスワードを変更できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		ctx := context.Background()
+
+		uid := user.GenerateID()
+
+		if err := authGateway.SignUp(ctx, uid, auth.Email("test@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := authGateway.ChangePassword(ctx, uid, auth.Password("new-password")); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := authGateway.SignIn(ctx, auth.Email("test@example.com"), auth.Password("password")); err == nil {
+			t.Error("SignIn() with old password error = nil, want error")
+		}
+
+		if _, err := authGateway.SignIn(ctx, auth.Email("test@example.com"), auth.Password("new-password")); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestAuthUpdateEmail(t *testing.T) {
+	t.Parallel()
+
+	t.Run("メールアドレスを変更すると未検証に戻る", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		ctx := context.Background()
+
+		uid := user.GenerateID()
+
+		if err := authGateway.SignUp(ctx, uid, auth.Email("old@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := authGateway.MarkVerified(ctx, uid); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := authGateway.UpdateEmail(ctx, uid, auth.Email("new@example.com")); err != nil {
+			t.Fatal(err)
+		}
+
+		email, err := authGateway.GetEmail(ctx, uid)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if email != auth.Email("new@example.com") {
+			t.Errorf("GetEmail() = %v, want %v", email, "new@example.com")
+		}
+
+		verified, err := authGateway.IsVerified(ctx, uid)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if verified {
+			t.Error("IsVerified() = true, want false")
+		}
+	})
+
+	t.Run("存在しないユーザーのメールアドレスは取得できない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		if _, err := authGateway.GetEmail(context.Background(), user.GenerateID()); err == nil {
+			t.Error("GetEmail() error = nil, want error")
+		}
+	})
+}
+
+func TestAuthDelete(t *testing.T) {
+	t.Parallel()
+
+	t.Run("削除したユーザーではサインインできない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		authGateway := gateway.NewAuth(rdb, nil)
+
+		ctx := context.Background()
+
+		uid := user.GenerateID()
+
+		if err := authGateway.SignUp(ctx, uid, auth.Email("test@example.com"), auth.Password("password")); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := authGateway.Delete(ctx, uid); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := authGateway.SignIn(ctx, auth.Email("test@example.com"), auth.Password("password")); err == nil {
+			t.Error("SignIn() error = nil, want error")
+		}
+
+		// 削除済みのユーザーを再度削除してもエラーにならない
+		if err := authGateway.Delete(ctx, uid); err != nil {
+			t.Errorf("Delete() error = %v, want nil", err)
+		}
+	})
+
+	t.Run("fallbackからも削除する", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		uid := user.GenerateID()
+
+		ctrl := gomock.NewController(t)
+		fallback := rpc.NewMockAuth(ctrl)
+		fallback.EXPECT().Delete(gomock.Any(), uid).Return(nil).Times(1)
+
+		authGateway := gateway.NewAuth(rdb, fallback)
+
+		if err := authGateway.Delete(context.Background(), uid); err != nil {
+			t.Errorf("Delete() error = %v, want nil", err)
+		}
+	})
+}
@@ -3,15 +3,24 @@ package gateway
 import (
 	"context"
 	"database/sql"
+	"sync/atomic"
 
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/morning-night-guild/platform-app/pkg/ent"
 	"github.com/morning-night-guild/platform-app/pkg/log"
 	"github.com/pkg/errors"
 )
 
 // RDB RDBクライアント.
+// 埋め込んだクライアントは更新に用いるライターで、読み取りはReaderでレプリカに振り分ける.
 type RDB struct {
 	*ent.Client
+	// DB entクライアントが用いるコネクションプール. メトリクスの収集に用いる.
+	DB *sql.DB
+	// Dialect 接続先のデータベースの種類. entのdialect.Postgresまたはdialect.SQLite.
+	Dialect  string
+	replicas []*Replica
+	next     atomic.Uint32
 }
 
 // RDBFactory RDBクライアントのファクトリ.
@@ -31,5 +40,14 @@ func (r *RDB) IsDuplicatedError(ctx context.Context, err error) bool {
 		return true
 	}
 
+	// 一意制約違反のエラーメッセージはデータベースごとに異なるため、entの判定に委ねる
+	if sqlgraph.IsUniqueConstraintError(err) {
+		log := log.GetLogCtx(ctx)
+
+		log.Debug(err.Error())
+
+		return true
+	}
+
 	return false
 }
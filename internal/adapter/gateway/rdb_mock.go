@@ -1,13 +1,25 @@
 package gateway
 
 import (
+	"context"
+	"database/sql"
 	"fmt"
+	"net/url"
+	"os"
+	"strings"
 	"testing"
 
+	"entgo.io/ent/dialect"
+	// postgres driver.
+	_ "github.com/lib/pq"
 	"github.com/morning-night-guild/platform-app/pkg/ent"
 	"github.com/morning-night-guild/platform-app/pkg/ent/enttest"
 )
 
+// TestDSNKey ゲートウェイのテストをPostgreSQLに対して実行する場合に接続先を指定する環境変数.
+// 指定しない場合はインメモリのSQLiteに対して実行する.
+const TestDSNKey = "GATEWAY_TEST_DATABASE_URL"
+
 var _ RDBFactory = (*RDBClientMock)(nil)
 
 type RDBClientMock struct {
@@ -30,11 +42,59 @@ func (rm *RDBClientMock) Of(dsn string) (*RDB, error) {
 		enttest.WithOptions(ent.Debug()),
 	}
 
+	if url := os.Getenv(TestDSNKey); url != "" {
+		return rm.ofPostgres(url, dsn, opts...)
+	}
+
 	dataSourceName := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", dsn)
 
-	db := enttest.Open(rm.t, "sqlite3", dataSourceName, opts...)
+	db := enttest.Open(rm.t, dialect.SQLite, dataSourceName, opts...)
+
+	return &RDB{
+		Client:  db,
+		Dialect: dialect.SQLite,
+	}, nil
+}
+
+// ofPostgres テストごとに作成したスキーマを検索パスとして接続する.
+// スキーマはテストの終了時に削除する.
+func (rm *RDBClientMock) ofPostgres(dsn string, name string, opts ...enttest.Option) (*RDB, error) {
+	rm.t.Helper()
+
+	schema := "test_" + strings.ReplaceAll(name, "-", "_")
+
+	admin, err := sql.Open(dialect.Postgres, dsn)
+	if err != nil {
+		return &RDB{}, err
+	}
+
+	if _, err := admin.ExecContext(context.Background(), fmt.Sprintf("CREATE SCHEMA %q", schema)); err != nil {
+		admin.Close()
+
+		return &RDB{}, err
+	}
+
+	rm.t.Cleanup(func() {
+		defer admin.Close()
+
+		if _, err := admin.ExecContext(context.Background(), fmt.Sprintf("DROP SCHEMA %q CASCADE", schema)); err != nil {
+			rm.t.Errorf("failed to drop schema %s: %v", schema, err)
+		}
+	})
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return &RDB{}, err
+	}
+
+	query := u.Query()
+	query.Set("search_path", schema)
+	u.RawQuery = query.Encode()
+
+	db := enttest.Open(rm.t, dialect.Postgres, u.String(), opts...)
 
 	return &RDB{
-		Client: db,
+		Client:  db,
+		Dialect: dialect.Postgres,
 	}, nil
 }
@@ -0,0 +1,155 @@
+package gateway
+
+import (
+	"context"
+	"database/sql"
+	"sync/atomic"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+// Replica 読み取り専用のレプリカ.
+type Replica struct {
+	*ent.Client
+	// DB entクライアントが用いるコネクションプール. 死活監視に用いる.
+	DB *sql.DB
+	// Name ログに出力するレプリカの名前.
+	Name    string
+	healthy atomic.Bool
+}
+
+// NewReplica ファクトリで接続したRDBをレプリカとして扱う.
+// 死活監視で応答がないと判定されるまでは正常とみなす.
+func NewReplica(name string, rdb *RDB) *Replica {
+	rep := &Replica{
+		Client: rdb.Client,
+		DB:     rdb.DB,
+		Name:   name,
+	}
+
+	rep.healthy.Store(true)
+
+	return rep
+}
+
+// Healthy 読み取りの対象とするかを判定する.
+func (rep *Replica) Healthy() bool {
+	return rep.healthy.Load()
+}
+
+type writtenKey struct{}
+
+// SetReadYourWritesCtx リクエスト内の更新の有無を記録するコンテキストを作成する.
+// このコンテキストで更新した後の読み取りは、レプリカの遅延によらず更新を反映するためライターで行う.
+func SetReadYourWritesCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writtenKey{}, new(atomic.Bool))
+}
+
+type writerKey struct{}
+
+// SetReadFromWriterCtx 読み取りを常にライターで行うコンテキストを作成する.
+// 呼び出し元がレプリカの遅延を許容できない読み取りに用いる.
+func SetReadFromWriterCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, writerKey{}, true)
+}
+
+// readFromWriter コンテキストで読み取りをライターで行うよう指定されているかを判定する.
+func readFromWriter(ctx context.Context) bool {
+	writer, ok := ctx.Value(writerKey{}).(bool)
+
+	return ok && writer
+}
+
+// hasWritten コンテキスト内でライターを更新したかを判定する.
+func hasWritten(ctx context.Context) bool {
+	written, ok := ctx.Value(writtenKey{}).(*atomic.Bool)
+
+	return ok && written.Load()
+}
+
+// trackWrites ライターでの更新をコンテキストに記録するフック.
+func trackWrites(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if written, ok := ctx.Value(writtenKey{}).(*atomic.Bool); ok {
+			written.Store(true)
+		}
+
+		return next.Mutate(ctx, m)
+	})
+}
+
+// UseReplicas 読み取りに用いるレプリカを設定する.
+// ライターに更新を記録するフックを登録するため、起動時に1度だけ呼び出す.
+func (r *RDB) UseReplicas(replicas ...*Replica) {
+	r.replicas = replicas
+
+	r.Client.Use(trackWrites)
+}
+
+// Reader 読み取りに用いるクライアント.
+// 正常なレプリカを順番に選び、レプリカがない場合や同じリクエスト内で更新した後、ライターでの読み取りを指定された場合はライターを返す.
+// トランザクション内では更新を読み取れるようトランザクションのクライアントを返す.
+func (r *RDB) Reader(ctx context.Context) *ent.Client {
+	if len(r.replicas) == 0 || hasWritten(ctx) || readFromWriter(ctx) {
+		return r.Writer(ctx)
+	}
+
+	if tx, ok := txFromCtx(ctx); ok {
+		return tx.Client()
+	}
+
+	start := int(r.next.Add(1))
+
+	for i := range r.replicas {
+		rep := r.replicas[(start+i)%len(r.replicas)]
+
+		if rep.Healthy() {
+			return rep.Client
+		}
+	}
+
+	return r.Client
+}
+
+// MonitorReplicas 一定間隔でレプリカの死活を確認する. コンテキストが終了するまで処理を続ける.
+func (r *RDB) MonitorReplicas(ctx context.Context, interval time.Duration, timeout time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		r.CheckReplicas(ctx, timeout)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckReplicas レプリカにPingし、応答しないレプリカを読み取りの対象から外す.
+// 応答が戻ったレプリカは再び読み取りの対象にする.
+func (r *RDB) CheckReplicas(ctx context.Context, timeout time.Duration) {
+	for _, rep := range r.replicas {
+		pctx, cancel := context.WithTimeout(ctx, timeout)
+
+		err := rep.DB.PingContext(pctx)
+
+		cancel()
+
+		healthy := err == nil
+
+		if rep.healthy.Swap(healthy) == healthy {
+			continue
+		}
+
+		if healthy {
+			log.GetLogCtx(ctx).Info("replica recovered", zap.String("replica", rep.Name))
+		} else {
+			log.GetLogCtx(ctx).Warn("replica is unhealthy", zap.String("replica", rep.Name), log.ErrorField(err))
+		}
+	}
+}
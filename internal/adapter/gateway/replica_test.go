@@ -0,0 +1,155 @@
+package gateway_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
+	"github.com/morning-night-guild/platform-app/internal/driver/sqlite"
+)
+
+func openSQLite(t *testing.T) *gateway.RDB {
+	t.Helper()
+
+	rdb, err := sqlite.New().Of("sqlite://" + uuid.NewString() + "?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatalf("failed to open sqlite. got %v", err)
+	}
+
+	t.Cleanup(func() { rdb.Close() })
+
+	return rdb
+}
+
+func newArticle() model.Article {
+	return model.CreateArticle(
+		article.URL("https://example.com/"+uuid.NewString()),
+		article.Title("title"),
+		article.Description("description"),
+		article.Thumbnail("https://example.com"),
+		article.TagList([]article.Tag{}),
+	)
+}
+
+func TestRDBReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("レプリカがない場合はライターから読み取る", func(t *testing.T) {
+		t.Parallel()
+
+		writer := openSQLite(t)
+
+		ctx := context.Background()
+
+		if writer.Reader(ctx) != writer.Client {
+			t.Error("Reader() is not writer")
+		}
+	})
+
+	t.Run("レプリカにのみ存在する記事を取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		writer := openSQLite(t)
+
+		replica := openSQLite(t)
+
+		writer.UseReplicas(gateway.NewReplica("replica", replica))
+
+		ctx := context.Background()
+
+		atc := newArticle()
+
+		if err := gateway.NewArticle(replica).Save(ctx, atc); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		if _, err := gateway.NewArticle(writer).Find(ctx, atc.ArticleID); err != nil {
+			t.Errorf("failed to find from replica. got %v", err)
+		}
+	})
+
+	t.Run("同じリクエスト内で更新した後はライターから読み取る", func(t *testing.T) {
+		t.Parallel()
+
+		writer := openSQLite(t)
+
+		replica := openSQLite(t)
+
+		writer.UseReplicas(gateway.NewReplica("replica", replica))
+
+		gtw := gateway.NewArticle(writer)
+
+		ctx := gateway.SetReadYourWritesCtx(context.Background())
+
+		if writer.Reader(ctx) != replica.Client {
+			t.Fatal("Reader() is not replica before write")
+		}
+
+		atc := newArticle()
+
+		if err := gtw.Save(ctx, atc); err != nil {
+			t.Fatalf("failed to save. got %v", err)
+		}
+
+		if _, err := gtw.Find(ctx, atc.ArticleID); err != nil {
+			t.Errorf("failed to find written article. got %v", err)
+		}
+
+		// 別のリクエストでは更新が伝播するまでレプリカから読み取る
+		other := gateway.SetReadYourWritesCtx(context.Background())
+
+		if _, err := gtw.Find(other, atc.ArticleID); err == nil {
+			t.Error("found article in replica")
+		}
+	})
+
+	t.Run("ライターでの読み取りを指定された場合はライターから読み取る", func(t *testing.T) {
+		t.Parallel()
+
+		writer := openSQLite(t)
+
+		replica := openSQLite(t)
+
+		writer.UseReplicas(gateway.NewReplica("replica", replica))
+
+		ctx := gateway.SetReadFromWriterCtx(gateway.SetReadYourWritesCtx(context.Background()))
+
+		if writer.Reader(ctx) != writer.Client {
+			t.Error("Reader() is not writer")
+		}
+	})
+
+	t.Run("応答しないレプリカを読み取りの対象から外す", func(t *testing.T) {
+		t.Parallel()
+
+		writer := openSQLite(t)
+
+		healthy := gateway.NewReplica("healthy", openSQLite(t))
+
+		broken := openSQLite(t)
+
+		unhealthy := gateway.NewReplica("unhealthy", broken)
+
+		writer.UseReplicas(unhealthy, healthy)
+
+		broken.DB.Close()
+
+		ctx := context.Background()
+
+		writer.CheckReplicas(ctx, time.Second)
+
+		if unhealthy.Healthy() || !healthy.Healthy() {
+			t.Fatalf("Healthy() = %v, %v, want false, true", unhealthy.Healthy(), healthy.Healthy())
+		}
+
+		for i := 0; i < 3; i++ {
+			if got := writer.Reader(ctx); got != healthy.Client {
+				t.Errorf("Reader() is not healthy replica")
+			}
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/pkg/errors"
+)
+
+var _ repository.Transactor = (*Transactor)(nil)
+
+type Transactor struct {
+	rdb *RDB
+}
+
+func NewTransactor(rdb *RDB) *Transactor {
+	return &Transactor{
+		rdb: rdb,
+	}
+}
+
+// RunInTx トランザクション内でfnを実行する.
+func (gtw *Transactor) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return gtw.rdb.RunInTx(ctx, fn)
+}
+
+type txKey struct{}
+
+// txFromCtx コンテキストに紐づくトランザクション.
+func txFromCtx(ctx context.Context) (*ent.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*ent.Tx)
+
+	return tx, ok
+}
+
+// Writer 書き込みに用いるクライアント.
+// コンテキストにトランザクションがある場合はトランザクションのクライアントを返す.
+func (r *RDB) Writer(ctx context.Context) *ent.Client {
+	if tx, ok := txFromCtx(ctx); ok {
+		return tx.Client()
+	}
+
+	return r.Client
+}
+
+// RunInTx ライターのトランザクション内でfnを実行する.
+// fnに渡すコンテキストを用いたゲートウェイの操作はトランザクションに参加する.
+// 既にトランザクション内のコンテキストの場合は新たに開始せず、外側のトランザクションでコミットする.
+func (r *RDB) RunInTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := txFromCtx(ctx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := r.Client.Tx(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if v := recover(); v != nil {
+			rollback(ctx, tx)
+
+			panic(v)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		rollback(ctx, tx)
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+func rollback(ctx context.Context, tx *ent.Tx) {
+	if err := tx.Rollback(); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to rollback transaction", log.ErrorField(err))
+	}
+}
@@ -0,0 +1,199 @@
+package gateway_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	"github.com/morning-night-guild/platform-app/pkg/ent/hook"
+)
+
+func TestTransactorRunInTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("コミットした書き込みを取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		if err := gateway.NewTransactor(rdb).RunInTx(ctx, func(ctx context.Context) error {
+			return userGateway.Save(ctx, usr)
+		}); err != nil {
+			t.Fatalf("failed to run in tx. got %v", err)
+		}
+
+		if _, err := userGateway.Find(ctx, usr.UserID); err != nil {
+			t.Errorf("failed to find committed user. got %v", err)
+		}
+	})
+
+	t.Run("エラーを返した場合は全ての書き込みをロールバックする", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		atc := newArticle()
+
+		want := fmt.Errorf("error")
+
+		if err := gateway.NewTransactor(rdb).RunInTx(ctx, func(ctx context.Context) error {
+			if err := userGateway.Save(ctx, usr); err != nil {
+				return err
+			}
+
+			if err := articleGateway.Save(ctx, atc); err != nil {
+				return err
+			}
+
+			// トランザクション内では書き込んだ記事を取得できる
+			if _, err := articleGateway.Find(ctx, atc.ArticleID); err != nil {
+				return err
+			}
+
+			return want
+		}); !errors.Is(err, want) {
+			t.Fatalf("RunInTx() error = %v, want %v", err, want)
+		}
+
+		if _, err := userGateway.Find(ctx, usr.UserID); err == nil {
+			t.Error("found rolled back user")
+		}
+
+		if _, err := articleGateway.Find(ctx, atc.ArticleID); err == nil {
+			t.Error("found rolled back article")
+		}
+	})
+
+	t.Run("入れ子の場合は外側のトランザクションに参加する", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		transactor := gateway.NewTransactor(rdb)
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		if err := transactor.RunInTx(ctx, func(ctx context.Context) error {
+			if err := transactor.RunInTx(ctx, func(ctx context.Context) error {
+				return userGateway.Save(ctx, usr)
+			}); err != nil {
+				return err
+			}
+
+			return fmt.Errorf("error")
+		}); err == nil {
+			t.Fatal("error is nil")
+		}
+
+		if _, err := userGateway.Find(ctx, usr.UserID); err == nil {
+			t.Error("found user saved in rolled back inner transaction")
+		}
+	})
+
+	t.Run("パニックした場合はロールバックしてパニックを伝える", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("panic is not propagated")
+				}
+			}()
+
+			_ = gateway.NewTransactor(rdb).RunInTx(ctx, func(ctx context.Context) error {
+				if err := userGateway.Save(ctx, usr); err != nil {
+					return err
+				}
+
+				panic("panic")
+			})
+		}()
+
+		if _, err := userGateway.Find(ctx, usr.UserID); err == nil {
+			t.Error("found rolled back user")
+		}
+	})
+}
+
+func TestArticleSaveInTx(t *testing.T) {
+	t.Parallel()
+
+	t.Run("タグの登録に失敗した場合は記事も登録しない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rdb.ArticleTag.Use(func(next ent.Mutator) ent.Mutator {
+			return hook.ArticleTagFunc(func(ctx context.Context, m *ent.ArticleTagMutation) (ent.Value, error) {
+				return nil, fmt.Errorf("error")
+			})
+		})
+
+		articleGateway := gateway.NewArticle(rdb)
+
+		ctx := context.Background()
+
+		atc := model.CreateArticle(
+			article.URL("https://example.com"),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList([]article.Tag{article.Tag("tag")}),
+		)
+
+		if err := articleGateway.Save(ctx, atc); err == nil {
+			t.Fatal("error is nil")
+		}
+
+		if _, err := articleGateway.Find(ctx, atc.ArticleID); err == nil {
+			t.Error("found article without tags")
+		}
+	})
+}
@@ -6,11 +6,14 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
-	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	domainerrors "github.com/morning-night-guild/platform-app/internal/domain/model/errors"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	entauditlog "github.com/morning-night-guild/platform-app/pkg/ent/auditlog"
 	entuser "github.com/morning-night-guild/platform-app/pkg/ent/user"
 	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/pkg/errors"
 )
 
 var _ repository.User = (*User)(nil)
@@ -25,6 +28,8 @@ func NewUser(rdb *RDB) *User {
 	}
 }
 
+// Save ユーザーを作成するメソッド.
+// プロフィールの更新には同時の更新を上書きしないUpdateProfileを用いる.
 func (gtw *User) Save(
 	ctx context.Context,
 	item model.User,
@@ -33,14 +38,25 @@ func (gtw *User) Save(
 
 	now := time.Now().UTC()
 
-	if err := gtw.rdb.User.Create().
+	profile := item.Profile
+
+	if err := gtw.rdb.Writer(ctx).User.Create().
 		SetID(id).
+		SetDisplayName(profile.DisplayName.String()).
+		SetAvatarURL(profile.AvatarURL.String()).
+		SetBio(profile.Bio.String()).
+		SetLocale(profile.Locale.String()).
+		SetTimezone(profile.Timezone.String()).
 		SetCreatedAt(now).
 		SetUpdatedAt(now).
 		OnConflict(
 			sql.ConflictColumns(entuser.FieldID),
 		).
-		UpdateUpdatedAt().
+		UpdateDisplayName().
+		UpdateAvatarURL().
+		UpdateBio().
+		UpdateLocale().
+		UpdateTimezone().
 		SetUpdatedAt(now).
 		Exec(ctx); err != nil {
 		log.GetLogCtx(ctx).Warn("failed to save user", log.ErrorField(err))
@@ -51,16 +67,196 @@ func (gtw *User) Save(
 	return nil
 }
 
+// UpdateProfile 指定した項目のみユーザーのプロフィールを1回の更新で書き換えるメソッド.
+// 指定していない項目は読み書きしないため、異なる項目の同時の更新が互いを上書きしない.
+// 削除を受け付けたユーザーは更新できない.
+func (gtw *User) UpdateProfile(
+	ctx context.Context,
+	id user.ID,
+	profile model.UserProfile,
+	fields []user.ProfileField,
+) (model.User, error) {
+	update := gtw.rdb.Writer(ctx).User.UpdateOneID(id.Value()).
+		Where(entuser.DeletedAtIsNil())
+
+	for _, field := range fields {
+		switch field {
+		case user.FieldDisplayName:
+			update = update.SetDisplayName(profile.DisplayName.String())
+		case user.FieldAvatarURL:
+			update = update.SetAvatarURL(profile.AvatarURL.String())
+		case user.FieldBio:
+			update = update.SetBio(profile.Bio.String())
+		case user.FieldLocale:
+			update = update.SetLocale(profile.Locale.String())
+		case user.FieldTimezone:
+			update = update.SetTimezone(profile.Timezone.String())
+		}
+	}
+
+	item, err := update.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return model.User{}, domainerrors.NewNotFoundError("user not found", err)
+		}
+
+		log.GetLogCtx(ctx).Warn("failed to update user profile", log.ErrorField(err))
+
+		return model.User{}, errors.Wrap(err, "failed to update user profile")
+	}
+
+	return model.ReconstructUser(
+		item.ID,
+		item.DisplayName,
+		item.AvatarURL,
+		item.Bio,
+		item.Locale,
+		item.Timezone,
+	), nil
+}
+
 func (gtw *User) Find(
 	ctx context.Context,
 	id user.ID,
 ) (model.User, error) {
-	item, err := gtw.rdb.User.Get(ctx, id.Value())
+	// 削除を受け付けたユーザーは猶予期間中もユーザーとして扱わない
+	item, err := gtw.rdb.Writer(ctx).User.Query().
+		Where(
+			entuser.IDEQ(id.Value()),
+			entuser.DeletedAtIsNil(),
+		).
+		Only(ctx)
 	if err != nil {
-		return model.User{}, errors.NewNotFoundError("failed to find user", err)
+		return model.User{}, domainerrors.NewNotFoundError("failed to find user", err)
+	}
+
+	return model.ReconstructUser(
+		item.ID,
+		item.DisplayName,
+		item.AvatarURL,
+		item.Bio,
+		item.Locale,
+		item.Timezone,
+	), nil
+}
+
+// Delete ユーザーの削除を受け付けるメソッド.
+// 猶予期間中は復旧に備えてデータを残し、Purgeで完全に削除する.
+// 受け付け済みの場合は最初に受け付けた日時を返す.
+func (gtw *User) Delete(
+	ctx context.Context,
+	id user.ID,
+) (model.DeletedUser, error) {
+	item, err := gtw.rdb.Writer(ctx).User.Get(ctx, id.Value())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return model.DeletedUser{}, domainerrors.NewNotFoundError("user not found", err)
+		}
+
+		return model.DeletedUser{}, errors.Wrap(err, "failed to get user")
+	}
+
+	deleted := model.DeletedUser{
+		User: model.ReconstructUser(
+			item.ID,
+			item.DisplayName,
+			item.AvatarURL,
+			item.Bio,
+			item.Locale,
+			item.Timezone,
+		),
+	}
+
+	if item.DeletedAt != nil {
+		deleted.DeletedAt = *item.DeletedAt
+
+		return deleted, nil
+	}
+
+	now := time.Now().UTC()
+
+	if err := gtw.rdb.Writer(ctx).User.UpdateOneID(id.Value()).
+		SetDeletedAt(now).
+		Exec(ctx); err != nil {
+		return model.DeletedUser{}, errors.Wrap(err, "failed to delete user")
+	}
+
+	deleted.DeletedAt = now
+
+	return deleted, nil
+}
+
+// FindPurgeable 猶予期間を過ぎて完全に削除する対象のユーザーを取得するメソッド.
+// 認証基盤のユーザーはPurgeの前に削除するため、Purgeと同じ条件で取得する.
+func (gtw *User) FindPurgeable(ctx context.Context, before time.Time) ([]user.ID, error) {
+	ids, err := gtw.rdb.Writer(ctx).User.Query().
+		Where(entuser.DeletedAtLT(before)).
+		IDs(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query users to purge")
+	}
+
+	uids := make([]user.ID, len(ids))
+
+	for i, id := range ids {
+		uids[i] = user.ID(id)
+	}
+
+	return uids, nil
+}
+
+// Purge 猶予期間を過ぎたユーザーを完全に削除するメソッド.
+// ユーザーと記事の紐付けも合わせて削除される.
+// 監査ログは記録として残し、ユーザーを特定できる項目を同じトランザクション内で匿名化する.
+// 匿名化した監査ログは更新日時が更新されるため、差分バックアップにも反映される.
+func (gtw *User) Purge(ctx context.Context, before time.Time) (int, error) {
+	var n int
+
+	if err := gtw.rdb.RunInTx(ctx, func(ctx context.Context) error {
+		client := gtw.rdb.Writer(ctx)
+
+		ids, err := client.User.Query().
+			Where(entuser.DeletedAtLT(before)).
+			IDs(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to query users to purge")
+		}
+
+		if len(ids) == 0 {
+			return nil
+		}
+
+		// 認証前の操作の監査ログはメールアドレスのみが記録されているため、メールアドレスでも照合する
+		emails, err := client.AuditLog.Query().
+			Where(entauditlog.ActorIDIn(ids...), entauditlog.EmailNEQ("")).
+			Unique(true).
+			Select(entauditlog.FieldEmail).
+			Strings(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to query audit log emails")
+		}
+
+		if err := client.AuditLog.Update().
+			Where(entauditlog.Or(entauditlog.ActorIDIn(ids...), entauditlog.EmailIn(emails...))).
+			ClearActorID().
+			SetEmail("").
+			SetIP("").
+			SetForwardedFor("").
+			SetUserAgent("").
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to anonymize audit logs")
+		}
+
+		if n, err = client.User.Delete().
+			Where(entuser.IDIn(ids...)).
+			Exec(ctx); err != nil {
+			return errors.Wrap(err, "failed to purge users")
+		}
+
+		return nil
+	}); err != nil {
+		return 0, err
 	}
 
-	return model.User{
-		UserID: user.ID(item.ID),
-	}, nil
+	return n, nil
 }
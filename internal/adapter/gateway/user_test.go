@@ -3,13 +3,19 @@ package gateway_test
 import (
 	"context"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	entarticle "github.com/morning-night-guild/platform-app/pkg/ent/article"
+	entauditlog "github.com/morning-night-guild/platform-app/pkg/ent/auditlog"
+	entuser "github.com/morning-night-guild/platform-app/pkg/ent/user"
 )
 
 func TestUserSave(t *testing.T) {
@@ -90,6 +96,46 @@ func TestUserSave(t *testing.T) {
 			t.Errorf("User() updated_at found1 = %v, found2 = %v", found1.UpdatedAt, found2.UpdatedAt)
 		}
 	})
+
+	t.Run("保存済みのユーザーのプロフィールを更新できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatal(err)
+		}
+
+		usr.Profile = model.UserProfile{
+			DisplayName: user.DisplayName("name"),
+			AvatarURL:   user.AvatarURL("https://example.com/avatar.png"),
+			Bio:         user.Bio("bio"),
+			Locale:      user.Locale("ja-JP"),
+			Timezone:    user.Timezone("Asia/Tokyo"),
+		}
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatal(err)
+		}
+
+		found, err := userGateway.Find(ctx, usr.UserID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(found, usr) {
+			t.Errorf("User() = %v, want %v", found, usr)
+		}
+	})
 }
 
 func TestUserFind(t *testing.T) {
@@ -142,3 +188,371 @@ func TestUserFind(t *testing.T) {
 		}
 	})
 }
+
+func TestUserUpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("異なる項目を同時に更新しても互いの更新を上書きしない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatal(err)
+		}
+
+		updates := []struct {
+			profile model.UserProfile
+			fields  []user.ProfileField
+		}{
+			{
+				profile: model.UserProfile{DisplayName: user.DisplayName("name")},
+				fields:  []user.ProfileField{user.FieldDisplayName},
+			},
+			{
+				profile: model.UserProfile{Bio: user.Bio("bio")},
+				fields:  []user.ProfileField{user.FieldBio},
+			},
+			{
+				profile: model.UserProfile{Locale: user.Locale("ja"), Timezone: user.Timezone("Asia/Tokyo")},
+				fields:  []user.ProfileField{user.FieldLocale, user.FieldTimezone},
+			},
+		}
+
+		var wg sync.WaitGroup
+
+		for _, update := range updates {
+			update := update
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if _, err := userGateway.UpdateProfile(ctx, usr.UserID, update.profile, update.fields); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		got, err := userGateway.Find(ctx, usr.UserID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := model.User{
+			UserID: usr.UserID,
+			Profile: model.UserProfile{
+				DisplayName: user.DisplayName("name"),
+				Bio:         user.Bio("bio"),
+				Locale:      user.Locale("ja"),
+				Timezone:    user.Timezone("Asia/Tokyo"),
+			},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Find() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("指定した項目のみ更新して更新後のユーザーを返す", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+		usr.Profile.Bio = user.Bio("before")
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := userGateway.UpdateProfile(
+			ctx,
+			usr.UserID,
+			model.UserProfile{DisplayName: user.DisplayName("name"), Bio: user.Bio("ignored")},
+			[]user.ProfileField{user.FieldDisplayName},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := model.User{
+			UserID: usr.UserID,
+			Profile: model.UserProfile{
+				DisplayName: user.DisplayName("name"),
+				Bio:         user.Bio("before"),
+			},
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("UpdateProfile() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("削除を受け付けたユーザーと存在しないユーザーは更新できない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := userGateway.Delete(ctx, usr.UserID); err != nil {
+			t.Fatal(err)
+		}
+
+		fields := []user.ProfileField{user.FieldBio}
+
+		for _, id := range []user.ID{usr.UserID, user.GenerateID()} {
+			if _, err := userGateway.UpdateProfile(ctx, id, model.UserProfile{}, fields); !errors.AsNotFoundError(err) {
+				t.Errorf("UpdateProfile() error = %v, want not found error", err)
+			}
+		}
+	})
+}
+
+func TestUserDelete(t *testing.T) {
+	t.Parallel()
+
+	t.Run("削除を受け付けたユーザーは取得できない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		usr := model.CreateUser()
+
+		if err := userGateway.Save(ctx, usr); err != nil {
+			t.Fatal(err)
+		}
+
+		deleted, err := userGateway.Delete(ctx, usr.UserID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(deleted.User, usr) {
+			t.Errorf("Delete() user = %v, want %v", deleted.User, usr)
+		}
+
+		if _, err := userGateway.Find(ctx, usr.UserID); !errors.AsNotFoundError(err) {
+			t.Errorf("Find() error = %v, want not found error", err)
+		}
+
+		// 猶予期間中はデータを残す
+		if !rdb.User.Query().Where(entuser.IDEQ(usr.UserID.Value())).ExistX(ctx) {
+			t.Error("user is purged before grace period")
+		}
+
+		// 2回目の削除は最初に受け付けた日時を返す
+		again, err := userGateway.Delete(ctx, usr.UserID)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !again.DeletedAt.Equal(deleted.DeletedAt) {
+			t.Errorf("Delete() deleted at = %v, want %v", again.DeletedAt, deleted.DeletedAt)
+		}
+	})
+
+	t.Run("存在しないユーザーは削除できない", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		if _, err := userGateway.Delete(context.Background(), user.GenerateID()); !errors.AsNotFoundError(err) {
+			t.Errorf("Delete() error = %v, want not found error", err)
+		}
+	})
+
+	t.Run("猶予期間を過ぎたユーザーを記事との紐付けとともに完全に削除できる", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		users := []model.User{model.CreateUser(), model.CreateUser(), model.CreateUser()}
+
+		for _, usr := range users {
+			if err := userGateway.Save(ctx, usr); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		art := rdb.Article.Create().
+			SetTitle("title").
+			SetURL("https://example.com").
+			SetDescription("description").
+			SetThumbnail("https://example.com").
+			SaveX(ctx)
+
+		rdb.UserArticle.Create().SetUserID(users[0].UserID.Value()).SetArticleID(art.ID).ExecX(ctx)
+
+		now := time.Now().UTC()
+
+		// 1件目は猶予期間を過ぎ、2件目は猶予期間内、3件目は削除を受け付けていない
+		rdb.User.UpdateOneID(users[0].UserID.Value()).SetDeletedAt(now.Add(-2 * time.Hour)).ExecX(ctx)
+		rdb.User.UpdateOneID(users[1].UserID.Value()).SetDeletedAt(now).ExecX(ctx)
+
+		ids, err := userGateway.FindPurgeable(ctx, now.Add(-time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(ids, []user.ID{users[0].UserID}) {
+			t.Errorf("FindPurgeable() = %v, want %v", ids, []user.ID{users[0].UserID})
+		}
+
+		n, err := userGateway.Purge(ctx, now.Add(-time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if n != 1 {
+			t.Errorf("Purge() = %d, want 1", n)
+		}
+
+		if rdb.User.Query().Where(entuser.IDEQ(users[0].UserID.Value())).ExistX(ctx) {
+			t.Error("user is not purged")
+		}
+
+		if n := rdb.User.Query().CountX(ctx); n != 2 {
+			t.Errorf("users = %d, want 2", n)
+		}
+
+		if n := rdb.UserArticle.Query().CountX(ctx); n != 0 {
+			t.Errorf("user articles = %d, want 0", n)
+		}
+
+		// 記事そのものは残る
+		if !rdb.Article.Query().Where(entarticle.IDEQ(art.ID)).ExistX(ctx) {
+			t.Error("article is purged")
+		}
+	})
+
+	t.Run("完全に削除したユーザーの監査ログを匿名化し、差分バックアップの対象とする", func(t *testing.T) {
+		t.Parallel()
+
+		rdb, err := gateway.NewRDBClientMock(t).Of(uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		userGateway := gateway.NewUser(rdb)
+
+		ctx := context.Background()
+
+		purged, kept := model.CreateUser(), model.CreateUser()
+
+		for _, usr := range []model.User{purged, kept} {
+			if err := userGateway.Save(ctx, usr); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// 差分バックアップの対象となったことを確認するため、以前に記録した監査ログとする
+		past := time.Now().UTC().Add(-24 * time.Hour)
+
+		audit := func(email string) *ent.AuditLogCreate {
+			return rdb.AuditLog.Create().
+				SetOccurredAt(past).
+				SetUpdatedAt(past).
+				SetEmail(email).
+				SetEventType("sign_in").
+				SetOutcome("success").
+				SetIP("192.0.2.1").
+				SetForwardedFor("192.0.2.2").
+				SetUserAgent("agent")
+		}
+
+		audit("purged@example.com").SetActorID(purged.UserID.Value()).ExecX(ctx)
+		// 認証前の操作はメールアドレスのみが記録される
+		audit("purged@example.com").SetOutcome("failure").ExecX(ctx)
+		audit("kept@example.com").SetActorID(kept.UserID.Value()).ExecX(ctx)
+
+		now := time.Now().UTC()
+
+		rdb.User.UpdateOneID(purged.UserID.Value()).SetDeletedAt(now.Add(-2 * time.Hour)).ExecX(ctx)
+
+		if _, err := userGateway.Purge(ctx, now.Add(-time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+
+		anonymized := rdb.AuditLog.Query().
+			Where(
+				entauditlog.ActorIDIsNil(),
+				entauditlog.Email(""),
+				entauditlog.IP(""),
+				entauditlog.ForwardedFor(""),
+				entauditlog.UserAgent(""),
+				entauditlog.UpdatedAtGT(past.Add(time.Hour)),
+			).
+			CountX(ctx)
+		if anonymized != 2 {
+			t.Errorf("anonymized audit logs = %d, want 2", anonymized)
+		}
+
+		// 他のユーザーの監査ログはそのまま残る
+		if !rdb.AuditLog.Query().
+			Where(
+				entauditlog.ActorID(kept.UserID.Value()),
+				entauditlog.Email("kept@example.com"),
+				entauditlog.IP("192.0.2.1"),
+				entauditlog.UpdatedAtLT(past.Add(time.Hour)),
+			).
+			ExistX(ctx) {
+			t.Error("audit log of other user is anonymized")
+		}
+
+		// 監査ログの件数は変わらない
+		if n := rdb.AuditLog.Query().CountX(ctx); n != 3 {
+			t.Errorf("audit logs = %d, want 3", n)
+		}
+	})
+}
@@ -0,0 +1,411 @@
+package handler
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/deepmap/oapi-codegen/pkg/types"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+// auditCSVHeader 監査ログのCSVエクスポートのヘッダー.
+var auditCSVHeader = []string{ //nolint:gochecknoglobals
+	"id",
+	"occurred_at",
+	"event_type",
+	"outcome",
+	"actor_id",
+	"email",
+	"ip",
+	"forwarded_for",
+	"user_agent",
+	"trace_id",
+}
+
+// csvFormulaPrefixes 表計算ソフトが数式として解釈するセルの先頭文字.
+const csvFormulaPrefixes = "=+-@\t\r"
+
+// escapeCSVFormula 数式として解釈されるセルの先頭にシングルクォートを付与する.
+// メールアドレスやUser-Agentなど利用者が指定できる値を含むため、CSVインジェクションを防ぐ.
+func escapeCSVFormula(record []string) []string {
+	escaped := make([]string, len(record))
+
+	for i, cell := range record {
+		if cell != "" && strings.ContainsRune(csvFormulaPrefixes, rune(cell[0])) {
+			cell = "'" + cell
+		}
+
+		escaped[i] = cell
+	}
+
+	return escaped
+}
+
+// 監査ログ一覧
+// (GET /v1/admin/audit).
+func (hdl *Handler) V1AdminAuditList(
+	w http.ResponseWriter,
+	r *http.Request,
+	params openapi.V1AdminAuditListParams,
+) {
+	ctx := r.Context()
+
+	key := r.Header.Get("Api-Key")
+	if key != hdl.key {
+		log.GetLogCtx(ctx).Warn(fmt.Sprintf("invalid api key. api key = %s", key))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	cursor, err := audit.ParseCursor(pageToken)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to list audit logs", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	size := value.Size(model.DefaultAuditLogSize)
+
+	if params.MaxPageSize != nil {
+		sz, err := value.NewSize(*params.MaxPageSize)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to list audit logs", log.ErrorField(err))
+
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		size = sz
+	}
+
+	output, err := hdl.audit.List(ctx, usecase.APIAuditListInput{
+		Cursor: cursor,
+		Size:   size,
+		Filter: auditFilter(params),
+	})
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to list audit logs", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+
+	logs := make([]openapi.AuditLogSchema, len(output.Logs))
+
+	for i, al := range output.Logs {
+		logs[i] = hdl.auditLogSchema(al)
+	}
+
+	res := openapi.V1AdminAuditListResponseSchema{
+		Logs: &logs,
+	}
+
+	// ページが埋まらなかった場合は最後のページとみなし、次のページトークンを返さない
+	if len(output.Logs) == size.Int() {
+		next := output.Logs[len(output.Logs)-1].Cursor().Token()
+
+		res.NextPageToken = &next
+	}
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to encode response", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+}
+
+// 監査ログエクスポート
+// (GET /v1/admin/audit/export).
+func (hdl *Handler) V1AdminAuditExport(
+	w http.ResponseWriter,
+	r *http.Request,
+	params openapi.V1AdminAuditExportParams,
+) {
+	ctx := r.Context()
+
+	key := r.Header.Get("Api-Key")
+	if key != hdl.key {
+		log.GetLogCtx(ctx).Warn(fmt.Sprintf("invalid api key. api key = %s", key))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	output, err := hdl.audit.Export(ctx, usecase.APIAuditExportInput{
+		Filter: auditFilter(openapi.V1AdminAuditListParams{
+			ActorId:   params.ActorId,
+			Email:     params.Email,
+			EventType: params.EventType,
+			Outcome:   params.Outcome,
+			Ip:        params.Ip,
+			Since:     params.Since,
+			Until:     params.Until,
+		}),
+	})
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to export audit logs", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="audit.csv"`)
+	w.Header().Set("X-Audit-Truncated", strconv.FormatBool(output.Truncated))
+
+	records := make([][]string, 0, len(output.Logs)+1)
+
+	records = append(records, auditCSVHeader)
+
+	for _, al := range output.Logs {
+		actorID := ""
+		if al.HasActor() {
+			actorID = al.ActorID.String()
+		}
+
+		records = append(records, escapeCSVFormula([]string{
+			al.ID.String(),
+			al.OccurredAt.UTC().Format(time.RFC3339Nano),
+			al.EventType.String(),
+			al.Outcome.String(),
+			actorID,
+			al.Email.String(),
+			al.Client.IP,
+			al.Client.ForwardedFor,
+			al.Client.UserAgent,
+			al.TraceID,
+		}))
+	}
+
+	if err := csv.NewWriter(w).WriteAll(records); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to write csv", log.ErrorField(err))
+
+		return
+	}
+}
+
+// ゴミ箱一覧
+// (GET /v1/admin/articles/trash).
+func (hdl *Handler) V1AdminArticleTrashList(
+	w http.ResponseWriter,
+	r *http.Request,
+	params openapi.V1AdminArticleTrashListParams,
+) {
+	ctx := r.Context()
+
+	key := r.Header.Get("Api-Key")
+	if key != hdl.key {
+		log.GetLogCtx(ctx).Warn(fmt.Sprintf("invalid api key. api key = %s", key))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+
+	token := value.NewNextToken(pageToken)
+
+	size := model.DefaultArticleSize
+
+	if params.MaxPageSize != nil {
+		sz, err := value.NewSize(*params.MaxPageSize)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to list trash", log.ErrorField(err))
+
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		size = sz
+	}
+
+	output, err := hdl.article.ListTrash(ctx, usecase.APIArticleListTrashInput{
+		Index: token.ToIndex(),
+		Size:  size,
+	})
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to list trash", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+
+	articles := make([]openapi.TrashedArticleSchema, len(output.Articles))
+
+	for i, trashed := range output.Articles {
+		id := trashed.ArticleID.Value()
+		tags := trashed.TagList.StringSlice()
+		articles[i] = openapi.TrashedArticleSchema{
+			Article: openapi.ArticleSchema{
+				Id:          &id,
+				Title:       hdl.StringToPointer(trashed.Title.String()),
+				Url:         hdl.StringToPointer(trashed.URL.String()),
+				Description: hdl.StringToPointer(trashed.Description.String()),
+				Thumbnail:   hdl.StringToPointer(trashed.Thumbnail.String()),
+				Tags:        &tags,
+				SharedBy:    hdl.articleSharerSchema(trashed.SharedBy),
+			},
+			DeletedAt: trashed.DeletedAt,
+		}
+	}
+
+	next := token.CreateNextToken(size).String()
+	if len(output.Articles) < size.Int() {
+		next = ""
+	}
+
+	res := openapi.V1AdminArticleTrashListResponseSchema{
+		Articles:      &articles,
+		NextPageToken: &next,
+	}
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to encode response", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+}
+
+// 記事復元
+// (POST /v1/admin/articles/trash/{articleId}/restore).
+func (hdl *Handler) V1AdminArticleRestore(
+	w http.ResponseWriter,
+	r *http.Request,
+	articleID types.UUID,
+) {
+	ctx := r.Context()
+
+	key := r.Header.Get("Api-Key")
+	if key != hdl.key {
+		log.GetLogCtx(ctx).Warn(fmt.Sprintf("invalid api key. api key = %s", key))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	input := usecase.APIArticleRestoreInput{
+		ArticleID: article.ID(articleID),
+	}
+
+	if _, err := hdl.article.Restore(ctx, input); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to restore article", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+}
+
+// アカウント削除
+// (DELETE /v1/admin/users/{userId}).
+func (hdl *Handler) V1AdminUserDelete(
+	w http.ResponseWriter,
+	r *http.Request,
+	userID types.UUID,
+) {
+	ctx := r.Context()
+
+	key := r.Header.Get("Api-Key")
+	if key != hdl.key {
+		log.GetLogCtx(ctx).Warn(fmt.Sprintf("invalid api key. api key = %s", key))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	hdl.deleteUser(ctx, w, user.ID(userID))
+}
+
+// auditFilter クエリパラメータを監査ログの絞り込み条件に変換する.
+func auditFilter(params openapi.V1AdminAuditListParams) []value.Filter {
+	filter := make([]value.Filter, 0)
+
+	if params.ActorId != nil {
+		filter = append(filter, value.NewFilter("actor_id", params.ActorId.String()))
+	}
+
+	if params.Email != nil {
+		filter = append(filter, value.NewFilter("email", *params.Email))
+	}
+
+	if params.EventType != nil {
+		filter = append(filter, value.NewFilter("event_type", string(*params.EventType)))
+	}
+
+	if params.Outcome != nil {
+		filter = append(filter, value.NewFilter("outcome", string(*params.Outcome)))
+	}
+
+	if params.Ip != nil {
+		filter = append(filter, value.NewFilter("ip", *params.Ip))
+	}
+
+	if params.Since != nil {
+		filter = append(filter, value.NewFilter("since", params.Since.Format(time.RFC3339Nano)))
+	}
+
+	if params.Until != nil {
+		filter = append(filter, value.NewFilter("until", params.Until.Format(time.RFC3339Nano)))
+	}
+
+	return filter
+}
+
+func (hdl *Handler) auditLogSchema(al model.AuditLog) openapi.AuditLogSchema {
+	schema := openapi.AuditLogSchema{
+		Id:           uuid.UUID(al.ID.Value()),
+		Email:        hdl.StringToPointer(al.Email.String()),
+		EventType:    openapi.AuditEventTypeSchema(al.EventType.String()),
+		Outcome:      openapi.AuditOutcomeSchema(al.Outcome.String()),
+		Ip:           hdl.StringToPointer(al.Client.IP),
+		ForwardedFor: hdl.StringToPointer(al.Client.ForwardedFor),
+		UserAgent:    hdl.StringToPointer(al.Client.UserAgent),
+		TraceId:      hdl.StringToPointer(al.TraceID),
+		OccurredAt:   al.OccurredAt,
+	}
+
+	if al.HasActor() {
+		actorID := al.ActorID.Value()
+		schema.ActorId = &actorID
+	}
+
+	return schema
+}
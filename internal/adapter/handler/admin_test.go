@@ -0,0 +1,811 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/adapter/handler"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+func TestHandlerV1AdminAuditList(t *testing.T) {
+	t.Parallel()
+
+	actorID := uuid.New()
+
+	eventType := openapi.AuditEventTypeSchema("signin")
+
+	size := 10
+
+	invalidSize := 101
+
+	one := 1
+
+	al := model.IssueAuditLog(user.ID(actorID), auth.Email("test@example.com"), audit.EventSignIn, audit.OutcomeSuccess, audit.Client{}, "")
+
+	cursor := audit.NewCursor(time.Now().UTC(), audit.GenerateID())
+
+	pageToken := cursor.Token()
+
+	invalidPageToken := "invalid"
+
+	type fields struct {
+		audit func(*testing.T) usecase.APIAudit
+	}
+
+	type args struct {
+		r      *http.Request
+		params openapi.V1AdminAuditListParams
+	}
+
+	tests := []struct {
+		name     string
+		fields   fields
+		args     args
+		status   int
+		wantNext string
+	}{
+		{
+			name: "監査ログの一覧が取得できる",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), usecase.APIAuditListInput{
+						Cursor: audit.Cursor{},
+						Size:   value.Size(size),
+						Filter: []value.Filter{
+							value.NewFilter("actor_id", actorID.String()),
+							value.NewFilter("event_type", "signin"),
+						},
+					}).Return(usecase.APIAuditListOutput{
+						Logs: []model.AuditLog{al},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditListParams{
+					ActorId:     &actorID,
+					EventType:   &eventType,
+					MaxPageSize: &size,
+				},
+			},
+			status:   http.StatusOK,
+			wantNext: "",
+		},
+		{
+			name: "ページが埋まった場合は最後の監査ログを指すページトークンを返す",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, input usecase.APIAuditListInput) (usecase.APIAuditListOutput, error) {
+							if !input.Cursor.OccurredAt.Equal(cursor.OccurredAt) || input.Cursor.ID != cursor.ID {
+								t.Errorf("Cursor = %v, want %v", input.Cursor, cursor)
+							}
+							return usecase.APIAuditListOutput{
+								Logs: []model.AuditLog{al},
+							}, nil
+						},
+					)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditListParams{
+					MaxPageSize: &one,
+					PageToken:   &pageToken,
+				},
+			},
+			status:   http.StatusOK,
+			wantNext: al.Cursor().Token(),
+		},
+		{
+			name: "ページトークンが不正で監査ログの一覧が取得できない",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIAudit(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditListParams{
+					PageToken: &invalidPageToken,
+				},
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "Api-Keyがなくて監査ログの一覧が取得できない",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIAudit(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{""},
+					},
+				},
+				params: openapi.V1AdminAuditListParams{},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "ページサイズが不正で監査ログの一覧が取得できない",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIAudit(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditListParams{
+					MaxPageSize: &invalidSize,
+				},
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "絞り込み条件が不正で監査ログの一覧が取得できない",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), gomock.Any()).Return(usecase.APIAuditListOutput{}, errors.NewValidationError("invalid filter"))
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditListParams{},
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "監査ログの取得に失敗する",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), gomock.Any()).Return(usecase.APIAuditListOutput{}, errors.NewUnknownError("unknown"))
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditListParams{},
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret(""),
+				Keyring(t),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.fields.audit(t),
+				nil,
+			)
+			got := httptest.NewRecorder()
+			hdl.V1AdminAuditList(got, tt.args.r, tt.args.params)
+			if got.Code != tt.status {
+				t.Errorf("V1AdminAuditList() = %v, want %v", got.Code, tt.status)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var res openapi.V1AdminAuditListResponseSchema
+			if err := json.NewDecoder(got.Body).Decode(&res); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			next := ""
+			if res.NextPageToken != nil {
+				next = *res.NextPageToken
+			}
+			if next != tt.wantNext {
+				t.Errorf("NextPageToken = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestHandlerV1AdminAuditExport(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	logs := []model.AuditLog{
+		model.IssueAuditLog(user.GenerateID(), auth.Email("test@example.com"), audit.EventSignIn, audit.OutcomeSuccess, audit.Client{IP: "192.0.2.1"}, "tid"),
+		model.IssueAuditLog(user.GenerateZeroID(), auth.Email("test@example.com"), audit.EventSignIn, audit.OutcomeFailure, audit.Client{IP: "192.0.2.1"}, "tid"),
+	}
+
+	injected := []model.AuditLog{
+		model.IssueAuditLog(user.GenerateZeroID(), auth.Email("test@example.com"), audit.EventSignIn, audit.OutcomeFailure, audit.Client{IP: "192.0.2.1", UserAgent: "=HYPERLINK(\"https://example.com\")"}, "tid"),
+	}
+
+	type fields struct {
+		audit func(*testing.T) usecase.APIAudit
+	}
+
+	type args struct {
+		r      *http.Request
+		params openapi.V1AdminAuditExportParams
+	}
+
+	tests := []struct {
+		name      string
+		fields    fields
+		args      args
+		status    int
+		rows      int
+		truncated string
+		userAgent string
+	}{
+		{
+			name: "監査ログがCSVでエクスポートできる",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().Export(gomock.Any(), usecase.APIAuditExportInput{
+						Filter: []value.Filter{
+							value.NewFilter("since", "2023-01-01T00:00:00Z"),
+						},
+					}).Return(usecase.APIAuditExportOutput{
+						Logs: logs,
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditExportParams{
+					Since: &since,
+				},
+			},
+			status:    http.StatusOK,
+			rows:      len(logs) + 1,
+			truncated: "false",
+		},
+		{
+			name: "上限件数に達した場合はヘッダーで通知される",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().Export(gomock.Any(), gomock.Any()).Return(usecase.APIAuditExportOutput{
+						Logs:      logs,
+						Truncated: true,
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditExportParams{},
+			},
+			status:    http.StatusOK,
+			rows:      len(logs) + 1,
+			truncated: "true",
+		},
+		{
+			name: "数式として解釈される値はエスケープしてエクスポートされる",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().Export(gomock.Any(), gomock.Any()).Return(usecase.APIAuditExportOutput{
+						Logs: injected,
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditExportParams{},
+			},
+			status:    http.StatusOK,
+			rows:      len(injected) + 1,
+			truncated: "false",
+			userAgent: "'=HYPERLINK(\"https://example.com\")",
+		},
+		{
+			name: "Api-Keyがなくて監査ログがエクスポートできない",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIAudit(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{""},
+					},
+				},
+				params: openapi.V1AdminAuditExportParams{},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "監査ログの取得に失敗する",
+			fields: fields{
+				audit: func(t *testing.T) usecase.APIAudit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAudit(ctrl)
+					mock.EXPECT().Export(gomock.Any(), gomock.Any()).Return(usecase.APIAuditExportOutput{}, errors.NewUnknownError("unknown"))
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminAuditExportParams{},
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret(""),
+				Keyring(t),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.fields.audit(t),
+				nil,
+			)
+			got := httptest.NewRecorder()
+			hdl.V1AdminAuditExport(got, tt.args.r, tt.args.params)
+			if got.Code != tt.status {
+				t.Errorf("V1AdminAuditExport() = %v, want %v", got.Code, tt.status)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			if h := got.Header().Get("X-Audit-Truncated"); h != tt.truncated {
+				t.Errorf("X-Audit-Truncated = %v, want %v", h, tt.truncated)
+			}
+			records, err := csv.NewReader(got.Body).ReadAll()
+			if err != nil {
+				t.Fatalf("failed to read csv: %v", err)
+			}
+			if len(records) != tt.rows {
+				t.Errorf("rows = %v, want %v", len(records), tt.rows)
+			}
+			if tt.userAgent != "" && records[1][8] != tt.userAgent {
+				t.Errorf("user_agent = %v, want %v", records[1][8], tt.userAgent)
+			}
+		})
+	}
+}
+
+func TestHandlerV1AdminArticleTrashList(t *testing.T) {
+	t.Parallel()
+
+	size := 10
+
+	invalidSize := 0
+
+	type fields struct {
+		article func(*testing.T) usecase.APIArticle
+	}
+
+	type args struct {
+		r      *http.Request
+		params openapi.V1AdminArticleTrashListParams
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "ゴミ箱の記事の一覧が取得できる",
+			fields: fields{
+				article: func(t *testing.T) usecase.APIArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIArticle(ctrl)
+					mock.EXPECT().ListTrash(gomock.Any(), usecase.APIArticleListTrashInput{
+						Index: value.Index(0),
+						Size:  value.Size(size),
+					}).Return(usecase.APIArticleListTrashOutput{
+						Articles: []model.TrashedArticle{
+							{
+								Article: model.Article{
+									ArticleID: article.GenerateID(),
+									URL:       article.URL("https://example.com"),
+									Title:     article.Title("title"),
+									TagList:   article.TagList{},
+								},
+								DeletedAt: time.Now(),
+							},
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminArticleTrashListParams{
+					MaxPageSize: &size,
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "Api-Keyがなくてゴミ箱の記事の一覧が取得できない",
+			fields: fields{
+				article: func(t *testing.T) usecase.APIArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIArticle(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{""},
+					},
+				},
+				params: openapi.V1AdminArticleTrashListParams{},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "ページサイズが不正でゴミ箱の記事の一覧が取得できない",
+			fields: fields{
+				article: func(t *testing.T) usecase.APIArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIArticle(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				params: openapi.V1AdminArticleTrashListParams{
+					MaxPageSize: &invalidSize,
+				},
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret(""),
+				Keyring(t),
+				nil,
+				nil,
+				tt.fields.article(t),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			hdl.V1AdminArticleTrashList(got, tt.args.r, tt.args.params)
+			if got.Code != tt.status {
+				t.Errorf("V1AdminArticleTrashList() = %v, want %v", got.Code, tt.status)
+			}
+		})
+	}
+}
+
+func TestHandlerV1AdminArticleRestore(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	type fields struct {
+		article func(*testing.T) usecase.APIArticle
+	}
+
+	type args struct {
+		r *http.Request
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "ゴミ箱の記事が復元できる",
+			fields: fields{
+				article: func(t *testing.T) usecase.APIArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIArticle(ctrl)
+					mock.EXPECT().Restore(gomock.Any(), usecase.APIArticleRestoreInput{
+						ArticleID: article.ID(id),
+					}).Return(usecase.APIArticleRestoreOutput{}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "Api-Keyがなくて記事が復元できない",
+			fields: fields{
+				article: func(t *testing.T) usecase.APIArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIArticle(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{
+						"Api-Key": []string{""},
+					},
+				},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "ゴミ箱にない記事は復元できない",
+			fields: fields{
+				article: func(t *testing.T) usecase.APIArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIArticle(ctrl)
+					mock.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(usecase.APIArticleRestoreOutput{}, errors.NewNotFoundError("not found"))
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret(""),
+				Keyring(t),
+				nil,
+				nil,
+				tt.fields.article(t),
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			hdl.V1AdminArticleRestore(got, tt.args.r, id)
+			if got.Code != tt.status {
+				t.Errorf("V1AdminArticleRestore() = %v, want %v", got.Code, tt.status)
+			}
+		})
+	}
+}
+
+func TestHandlerV1AdminUserDelete(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+
+	type fields struct {
+		auth func(*testing.T) usecase.APIAuth
+	}
+
+	type args struct {
+		r *http.Request
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "指定したユーザーのアカウントを削除できる",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), usecase.APIAuthDeleteInput{
+						UserID: user.ID(id),
+					}).Return(usecase.APIAuthDeleteOutput{}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodDelete,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "Api-Keyがなくてアカウントを削除できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIAuth(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodDelete,
+					Header: http.Header{
+						"Api-Key": []string{""},
+					},
+				},
+			},
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret(""),
+				Keyring(t),
+				nil,
+				tt.fields.auth(t),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			hdl.V1AdminUserDelete(got, tt.args.r, id)
+			if got.Code != tt.status {
+				t.Errorf("V1AdminUserDelete() = %v, want %v", got.Code, tt.status)
+			}
+		})
+	}
+}
@@ -107,6 +107,7 @@ func (hdl *Handler) V1ArticleList( //nolint:cyclop
 			Description: hdl.StringToPointer(article.Description.String()),
 			Thumbnail:   hdl.StringToPointer(article.Thumbnail.String()),
 			Tags:        &tags,
+			SharedBy:    hdl.articleSharerSchema(article.SharedBy),
 		}
 	}
 
@@ -253,6 +254,11 @@ func (hdl *Handler) V1InternalArticleShare(
 		Thumbnail:   article.Thumbnail(hdl.PointerToString(body.Thumbnail)),
 	}
 
+	if body.UserId != nil {
+		uid := user.ID(*body.UserId)
+		input.SharedBy = &uid
+	}
+
 	if _, err := hdl.article.Share(ctx, input); err != nil {
 		w.WriteHeader(hdl.HandleConnectError(ctx, err))
 
@@ -288,3 +294,16 @@ func (hdl *Handler) V1InternalArticleDelete(
 		return
 	}
 }
+
+// articleSharerSchema 記事を共有したユーザーをレスポンスに変換する.
+func (hdl *Handler) articleSharerSchema(sharer *model.ArticleSharer) *openapi.ArticleSharerSchema {
+	if sharer == nil {
+		return nil
+	}
+
+	return &openapi.ArticleSharerSchema{
+		Id:          sharer.UserID.Value(),
+		DisplayName: hdl.StringToPointer(sharer.DisplayName.String()),
+		AvatarUrl:   hdl.StringToPointer(sharer.AvatarURL.String()),
+	}
+}
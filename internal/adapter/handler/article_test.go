@@ -18,6 +18,7 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/value"
 	"github.com/morning-night-guild/platform-app/pkg/openapi"
 )
@@ -40,6 +41,8 @@ func TestHandlerV1ArticleList(t *testing.T) {
 		auth    usecase.APIAuth
 		article func(*testing.T) usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -344,10 +347,14 @@ func TestHandlerV1ArticleList(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				tt.fields.cookie,
 				tt.fields.auth,
 				tt.fields.article(t),
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			for _, cookie := range tt.args.cookies {
@@ -368,12 +375,16 @@ func TestHandlerV1V1InternalArticleShare(t *testing.T) {
 		return &s
 	}
 
+	sharerID := uuid.New()
+
 	type fields struct {
 		secret  auth.Secret
 		cookie  handler.Cookie
 		auth    usecase.APIAuth
 		article func(*testing.T) usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -468,6 +479,43 @@ func TestHandlerV1V1InternalArticleShare(t *testing.T) {
 			},
 			status: http.StatusOK,
 		},
+		{
+			name: "共有したユーザーを指定して記事が共有できる",
+			fields: fields{
+				article: func(t *testing.T) usecase.APIArticle {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIArticle(ctrl)
+					sharer := user.ID(sharerID)
+					mock.EXPECT().Share(gomock.Any(), usecase.APIArticleShareInput{
+						URL:         article.URL("https://example.com"),
+						Title:       article.Title(""),
+						Description: article.Description(""),
+						Thumbnail:   article.Thumbnail(""),
+						SharedBy:    &sharer,
+					}).Return(usecase.APIArticleShareOutput{
+						Article: model.Article{
+							ArticleID: article.ID(uuid.MustParse(aid)),
+							URL:       article.URL("https://example.com"),
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{
+						"Api-Key": []string{"key"},
+					},
+				},
+				body: openapi.V1ArticleShareRequestSchema{
+					Url:    "https://example.com",
+					UserId: &sharerID,
+				},
+			},
+			status: http.StatusOK,
+		},
 		{
 			name: "Api-Keyがなくて記事が共有できない",
 			fields: fields{
@@ -503,10 +551,14 @@ func TestHandlerV1V1InternalArticleShare(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				tt.fields.secret,
+				Keyring(t),
 				tt.fields.cookie,
 				tt.fields.auth,
 				tt.fields.article(t),
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			buf, _ := json.Marshal(tt.args.body)
@@ -528,6 +580,8 @@ func TestHandlerV1InternalArticleDelete(t *testing.T) {
 		auth    usecase.APIAuth
 		article func(*testing.T) usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -601,10 +655,14 @@ func TestHandlerV1InternalArticleDelete(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				tt.fields.secret,
+				Keyring(t),
 				tt.fields.cookie,
 				tt.fields.auth,
 				tt.fields.article(t),
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			hdl.V1InternalArticleDelete(got, tt.args.r, tt.args.articleID)
@@ -623,6 +681,8 @@ func TestHandlerV1ArticleAddOwn(t *testing.T) {
 		auth    usecase.APIAuth
 		article func(*testing.T) usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -704,10 +764,14 @@ func TestHandlerV1ArticleAddOwn(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				tt.fields.cookie,
 				tt.fields.auth,
 				tt.fields.article(t),
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			for _, cookie := range tt.args.cookies {
@@ -729,6 +793,8 @@ func TestHandlerV1ArticleRemoveOwn(t *testing.T) {
 		auth    usecase.APIAuth
 		article func(*testing.T) usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -810,10 +876,14 @@ func TestHandlerV1ArticleRemoveOwn(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				tt.fields.cookie,
 				tt.fields.auth,
 				tt.fields.article(t),
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			for _, cookie := range tt.args.cookies {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"html/template"
 	"net/http"
 	"time"
 
@@ -152,7 +153,7 @@ func (hdl *Handler) V1AuthRefresh(w http.ResponseWriter, r *http.Request, params
 		return
 	}
 
-	sessionToken, err := auth.ParseSessionToken(sessionTokenCookie.Value, hdl.secret)
+	sessionToken, err := auth.ParseSessionToken(sessionTokenCookie.Value, hdl.secret, hdl.keyring.KeySet())
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to new session token", log.ErrorField(err))
 
@@ -169,8 +170,10 @@ func (hdl *Handler) V1AuthRefresh(w http.ResponseWriter, r *http.Request, params
 	input := usecase.APIAuthRefreshInput{
 		CodeID:    codeID,
 		Signature: signature,
-		SessionID: sessionToken.ID(hdl.secret),
+		UserID:    sessionToken.UserID(hdl.secret, hdl.keyring.KeySet()),
+		SessionID: sessionToken.ID(hdl.secret, hdl.keyring.KeySet()),
 		ExpiresIn: expiresIn,
+		KeySet:    hdl.keyring.KeySet(),
 	}
 
 	output, err := hdl.auth.Refresh(ctx, input)
@@ -255,6 +258,7 @@ func (hdl *Handler) V1AuthSignIn(w http.ResponseWriter, r *http.Request) {
 
 	input := usecase.APIAuthSignInInput{
 		Secret:    hdl.secret,
+		KeySet:    hdl.keyring.KeySet(),
 		PublicKey: key,
 		Email:     email,
 		Password:  password,
@@ -307,7 +311,7 @@ func (hdl *Handler) V1AuthSignOut(_ http.ResponseWriter, r *http.Request) {
 
 	input := usecase.APIAuthSignOutInput{
 		UserID:    tokens.AuthToken.UserID(),
-		SessionID: tokens.SessionToken.ID(hdl.secret),
+		SessionID: tokens.SessionToken.ID(hdl.secret, hdl.keyring.KeySet()),
 	}
 
 	if _, err := hdl.auth.SignOut(ctx, input); err != nil {
@@ -413,7 +417,7 @@ func (hdl *Handler) V1AuthVerify(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sessionToken, err := auth.ParseSessionToken(sessionTokenCookie.Value, hdl.secret)
+	sessionToken, err := auth.ParseSessionToken(sessionTokenCookie.Value, hdl.secret, hdl.keyring.KeySet())
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to new session token", log.ErrorField(err))
 
@@ -431,7 +435,7 @@ func (hdl *Handler) V1AuthVerify(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	authToken, err := auth.ParseAuthToken(authTokenCookie.Value, sessionToken.ToSecret(hdl.secret))
+	authToken, err := auth.ParseAuthToken(authTokenCookie.Value, sessionToken.ToSecret(hdl.secret, hdl.keyring.KeySet()), hdl.keyring.KeySet())
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to new auth token", log.ErrorField(err))
 
@@ -442,7 +446,7 @@ func (hdl *Handler) V1AuthVerify(w http.ResponseWriter, r *http.Request) {
 
 	input := usecase.APIAuthVerifyInput{
 		UserID:    authToken.UserID(),
-		SessionID: sessionToken.ID(hdl.secret),
+		SessionID: sessionToken.ID(hdl.secret, hdl.keyring.KeySet()),
 	}
 
 	if _, err := hdl.auth.Verify(ctx, input); err != nil {
@@ -462,7 +466,8 @@ func (hdl *Handler) unauthorize(
 	sessionToken auth.SessionToken,
 ) {
 	input := usecase.APIAuthGenerateCodeInput{
-		SessionID: sessionToken.ID(hdl.secret),
+		UserID:    sessionToken.UserID(hdl.secret, hdl.keyring.KeySet()),
+		SessionID: sessionToken.ID(hdl.secret, hdl.keyring.KeySet()),
 	}
 
 	output, err := hdl.auth.GenerateCode(ctx, input)
@@ -566,6 +571,7 @@ func (hdl *Handler) V1AuthChangePassword(
 	input := usecase.APIAuthChangePasswordInput{
 		UserID:      tokens.AuthToken.UserID(),
 		Secret:      hdl.secret,
+		KeySet:      hdl.keyring.KeySet(),
 		PublicKey:   key,
 		ExpiresIn:   expiresIn,
 		OldPassword: oldPassword,
@@ -603,3 +609,141 @@ func (hdl *Handler) V1AuthChangePassword(
 		SameSite: hdl.cookie.SameSite(),
 	})
 }
+
+// メールアドレス変更
+// (PUT /v1/auth/email).
+func (hdl *Handler) V1AuthChangeEmail(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+
+	uid, err := hdl.ExtractUserID(ctx, r)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to extract user id", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	var req openapi.V1AuthChangeEmailRequestSchema
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to decode request body", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	email, err := auth.NewEmail(string(req.Email))
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to new email", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	password, err := auth.NewPassword(req.Password)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to new password", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	input := usecase.APIAuthChangeEmailInput{
+		UserID:   uid,
+		Email:    email,
+		Password: password,
+	}
+
+	if _, err := hdl.auth.ChangeEmail(ctx, input); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to change email", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+}
+
+// confirmEmailPage 確認リンクの遷移先として返すページ. 送信したときに初めて検証コードを消費する.
+var confirmEmailPage = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html lang="ja">
+<head><meta charset="utf-8"><meta name="robots" content="noindex"><title>メールアドレスの確認</title></head>
+<body>
+<form method="post">
+<input type="hidden" name="code" value="{{.}}">
+<button type="submit">メールアドレスを確認する</button>
+</form>
+</body>
+</html>
+`))
+
+// メールアドレス検証の確認
+// (GET /v1/auth/email/verify).
+// リンクの先読みやスキャンで検証コードが消費されないよう、確認ページを返すのみで検証は行わない.
+func (hdl *Handler) V1AuthConfirmEmail(
+	w http.ResponseWriter,
+	r *http.Request,
+	params openapi.V1AuthConfirmEmailParams,
+) {
+	ctx := r.Context()
+
+	if _, err := auth.NewVerificationCode(params.Code); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to new verification code", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	if err := confirmEmailPage.Execute(w, params.Code); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to write confirm page", log.ErrorField(err))
+	}
+}
+
+// メールアドレス検証
+// (POST /v1/auth/email/verify).
+func (hdl *Handler) V1AuthVerifyEmail(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+
+	if err := r.ParseForm(); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to parse form", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	code, err := auth.NewVerificationCode(r.PostForm.Get("code"))
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to new verification code", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	input := usecase.APIAuthVerifyEmailInput{
+		Code: code,
+	}
+
+	if _, err := hdl.auth.VerifyEmail(ctx, input); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to verify email", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+}
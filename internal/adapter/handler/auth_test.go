@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
@@ -21,12 +22,15 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/pkg/openapi"
 )
 
 const (
 	sid = "01234567-0123-0123-0123-0123456789ab"
 	cid = "01234567-0123-0123-0123-0123456789ac"
+	uid = "01234567-0123-0123-0123-0123456789ad"
 )
 
 type Public struct {
@@ -86,6 +90,8 @@ func TestHandlerV1AuthRefresh(t *testing.T) {
 		auth    func(*testing.T) usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -111,6 +117,7 @@ func TestHandlerV1AuthRefresh(t *testing.T) {
 						CodeID:    auth.CodeID(uuid.MustParse(cid)),
 						Signature: auth.Signature("signature"),
 						SessionID: auth.SessionID(uuid.MustParse(sid)),
+						UserID:    user.ID(uuid.MustParse(uid)),
 						ExpiresIn: auth.DefaultExpiresIn,
 					}).Return(usecase.APIAuthRefreshOutput{}, nil)
 					return mock
@@ -123,7 +130,7 @@ func TestHandlerV1AuthRefresh(t *testing.T) {
 				},
 				cookie: &http.Cookie{
 					Name:  auth.SessionTokenKey,
-					Value: auth.GenerateSessionToken(auth.SessionID(uuid.MustParse(sid)), "secret").String(),
+					Value: auth.GenerateSessionToken(auth.SessionID(uuid.MustParse(sid)), user.ID(uuid.MustParse(uid)), "secret", auth.KeySet{}).String(),
 				},
 				params: openapi.V1AuthRefreshParams{
 					Signature: "signature",
@@ -149,7 +156,7 @@ func TestHandlerV1AuthRefresh(t *testing.T) {
 				},
 				cookie: &http.Cookie{
 					Name:  auth.SessionTokenKey,
-					Value: auth.GenerateSessionToken(auth.SessionID(uuid.MustParse(sid)), "secret").String(),
+					Value: auth.GenerateSessionToken(auth.SessionID(uuid.MustParse(sid)), user.ID(uuid.MustParse(uid)), "secret", auth.KeySet{}).String(),
 				},
 				params: openapi.V1AuthRefreshParams{
 					Signature: "signature",
@@ -169,6 +176,7 @@ func TestHandlerV1AuthRefresh(t *testing.T) {
 						CodeID:    auth.CodeID(uuid.MustParse(cid)),
 						Signature: auth.Signature("signature"),
 						SessionID: auth.SessionID(uuid.MustParse(sid)),
+						UserID:    user.ID(uuid.MustParse(uid)),
 						ExpiresIn: auth.DefaultExpiresIn,
 					}).Return(usecase.APIAuthRefreshOutput{}, fmt.Errorf("error"))
 					return mock
@@ -181,7 +189,7 @@ func TestHandlerV1AuthRefresh(t *testing.T) {
 				},
 				cookie: &http.Cookie{
 					Name:  auth.SessionTokenKey,
-					Value: auth.GenerateSessionToken(auth.SessionID(uuid.MustParse(sid)), "secret").String(),
+					Value: auth.GenerateSessionToken(auth.SessionID(uuid.MustParse(sid)), user.ID(uuid.MustParse(uid)), "secret", auth.KeySet{}).String(),
 				},
 				params: openapi.V1AuthRefreshParams{
 					Signature: "signature",
@@ -199,10 +207,14 @@ func TestHandlerV1AuthRefresh(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				Cookie(t),
 				tt.fields.auth(t),
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			tt.args.r.AddCookie(tt.args.cookie)
@@ -221,6 +233,8 @@ func TestHandlerV1AuthSignIn(t *testing.T) {
 		auth    func(*testing.T) usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -401,10 +415,14 @@ func TestHandlerV1AuthSignIn(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				Cookie(t),
 				tt.fields.auth(t),
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			buf, _ := json.Marshal(tt.args.body)
@@ -424,6 +442,8 @@ func TestHandlerV1AuthSignOut(t *testing.T) {
 		auth    func(*testing.T) usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -448,7 +468,7 @@ func TestHandlerV1AuthSignOut(t *testing.T) {
 					mock := usecase.NewMockAPIAuth(ctrl)
 					mock.EXPECT().SignOut(gomock.Any(), usecase.APIAuthSignOutInput{
 						UserID:    token.UserID,
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 					}).Return(usecase.APIAuthSignOutOutput{}, nil)
 					return mock
 				},
@@ -584,7 +604,7 @@ func TestHandlerV1AuthSignOut(t *testing.T) {
 					mock := usecase.NewMockAPIAuth(ctrl)
 					mock.EXPECT().SignOut(gomock.Any(), usecase.APIAuthSignOutInput{
 						UserID:    token.UserID,
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 					}).Return(usecase.APIAuthSignOutOutput{}, fmt.Errorf("error"))
 					return mock
 				},
@@ -616,10 +636,14 @@ func TestHandlerV1AuthSignOut(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				Cookie(t),
 				tt.fields.auth(t),
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			for _, cookie := range tt.args.cookies {
@@ -640,6 +664,8 @@ func TestHandlerV1AuthSignOutAll(t *testing.T) {
 		auth    func(*testing.T) usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -830,10 +856,14 @@ func TestHandlerV1AuthSignOutAll(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				Cookie(t),
 				tt.fields.auth(t),
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			for _, cookie := range tt.args.cookies {
@@ -854,6 +884,8 @@ func TestHandlerV1AuthSignUp(t *testing.T) {
 		auth    func(*testing.T) usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -999,10 +1031,14 @@ func TestHandlerV1AuthSignUp(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				Cookie(t),
 				tt.fields.auth(t),
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			buf, _ := json.Marshal(tt.args.body)
@@ -1022,6 +1058,8 @@ func TestHandlerV1AuthVerify(t *testing.T) {
 		auth    func(*testing.T) usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -1046,7 +1084,7 @@ func TestHandlerV1AuthVerify(t *testing.T) {
 					mock := usecase.NewMockAPIAuth(ctrl)
 					mock.EXPECT().Verify(gomock.Any(), usecase.APIAuthVerifyInput{
 						UserID:    token.UserID,
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 					}).Return(usecase.APIAuthVerifyOutput{}, nil)
 					return mock
 				},
@@ -1125,11 +1163,12 @@ func TestHandlerV1AuthVerify(t *testing.T) {
 					ctrl := gomock.NewController(t)
 					mock := usecase.NewMockAPIAuth(ctrl)
 					mock.EXPECT().GenerateCode(gomock.Any(), usecase.APIAuthGenerateCodeInput{
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
+						UserID:    token.UserID,
 					}).Return(usecase.APIAuthGenerateCodeOutput{
 						Code: model.Code{
 							CodeID:    auth.CodeID(uuid.MustParse(cid)),
-							SessionID: token.SessionToken.ID(auth.Secret("secret")),
+							SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 							IssuedAt:  time.Now(),
 							ExpiresAt: time.Now().Add(time.Minute * 10),
 						},
@@ -1159,11 +1198,12 @@ func TestHandlerV1AuthVerify(t *testing.T) {
 					ctrl := gomock.NewController(t)
 					mock := usecase.NewMockAPIAuth(ctrl)
 					mock.EXPECT().GenerateCode(gomock.Any(), usecase.APIAuthGenerateCodeInput{
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
+						UserID:    token.UserID,
 					}).Return(usecase.APIAuthGenerateCodeOutput{
 						Code: model.Code{
 							CodeID:    auth.CodeID(uuid.MustParse(cid)),
-							SessionID: token.SessionToken.ID(auth.Secret("secret")),
+							SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 							IssuedAt:  time.Now(),
 							ExpiresAt: time.Now().Add(time.Minute * 10),
 						},
@@ -1198,14 +1238,15 @@ func TestHandlerV1AuthVerify(t *testing.T) {
 					mock := usecase.NewMockAPIAuth(ctrl)
 					mock.EXPECT().Verify(gomock.Any(), usecase.APIAuthVerifyInput{
 						UserID:    token.UserID,
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 					}).Return(usecase.APIAuthVerifyOutput{}, fmt.Errorf("error"))
 					mock.EXPECT().GenerateCode(gomock.Any(), usecase.APIAuthGenerateCodeInput{
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
+						UserID:    token.UserID,
 					}).Return(usecase.APIAuthGenerateCodeOutput{
 						Code: model.Code{
 							CodeID:    auth.CodeID(uuid.MustParse(cid)),
-							SessionID: token.SessionToken.ID(auth.Secret("secret")),
+							SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 							IssuedAt:  time.Now(),
 							ExpiresAt: time.Now().Add(time.Minute * 10),
 						},
@@ -1240,10 +1281,11 @@ func TestHandlerV1AuthVerify(t *testing.T) {
 					mock := usecase.NewMockAPIAuth(ctrl)
 					mock.EXPECT().Verify(gomock.Any(), usecase.APIAuthVerifyInput{
 						UserID:    token.UserID,
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
 					}).Return(usecase.APIAuthVerifyOutput{}, fmt.Errorf("error"))
 					mock.EXPECT().GenerateCode(gomock.Any(), usecase.APIAuthGenerateCodeInput{
-						SessionID: token.SessionToken.ID(auth.Secret("secret")),
+						SessionID: token.SessionToken.ID(auth.Secret("secret"), auth.KeySet{}),
+						UserID:    token.UserID,
 					}).Return(usecase.APIAuthGenerateCodeOutput{}, fmt.Errorf("error"))
 					return mock
 				},
@@ -1275,10 +1317,14 @@ func TestHandlerV1AuthVerify(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				Cookie(t),
 				tt.fields.auth(t),
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			for _, cookie := range tt.args.cookies {
@@ -1299,6 +1345,8 @@ func TestHandlerV1AuthChangePassword(t *testing.T) {
 		auth    func(*testing.T) usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -1564,10 +1612,14 @@ func TestHandlerV1AuthChangePassword(t *testing.T) {
 			hdl := handler.New(
 				"key",
 				auth.Secret("secret"),
+				Keyring(t),
 				Cookie(t),
 				tt.fields.auth(t),
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			for _, cookie := range tt.args.cookies {
@@ -1582,3 +1634,340 @@ func TestHandlerV1AuthChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerV1AuthChangeEmail(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		auth    func(*testing.T) usecase.APIAuth
+		article usecase.APIArticle
+		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
+	}
+
+	type args struct {
+		r       *http.Request
+		cookies []*http.Cookie
+		body    openapi.V1AuthChangeEmailRequestSchema
+	}
+
+	token := GenerateToken(t)
+
+	cookies := []*http.Cookie{
+		{
+			Name:  auth.AuthTokenKey,
+			Value: token.AuthTokenString,
+		},
+		{
+			Name:  auth.SessionTokenKey,
+			Value: token.SessionTokenString,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "メールアドレスの変更を申請できる",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					mock.EXPECT().ChangeEmail(gomock.Any(), usecase.APIAuthChangeEmailInput{
+						UserID:   token.UserID,
+						Email:    auth.Email("new@example.com"),
+						Password: auth.Password("password"),
+					}).Return(usecase.APIAuthChangeEmailOutput{}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPut,
+					Header: http.Header{},
+				},
+				body: openapi.V1AuthChangeEmailRequestSchema{
+					Email:    "new@example.com",
+					Password: "password",
+				},
+				cookies: cookies,
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "トークンが取得できずにメールアドレスの変更を申請できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPut,
+					Header: http.Header{},
+				},
+				body: openapi.V1AuthChangeEmailRequestSchema{
+					Email:    "new@example.com",
+					Password: "password",
+				},
+				cookies: []*http.Cookie{},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "パスワードが不正な値でメールアドレスの変更を申請できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPut,
+					Header: http.Header{},
+				},
+				body: openapi.V1AuthChangeEmailRequestSchema{
+					Email:    "new@example.com",
+					Password: "",
+				},
+				cookies: cookies,
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "usecaseでエラーが発生してメールアドレスの変更を申請できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					mock.EXPECT().ChangeEmail(gomock.Any(), gomock.Any()).Return(usecase.APIAuthChangeEmailOutput{}, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPut,
+					Header: http.Header{},
+				},
+				body: openapi.V1AuthChangeEmailRequestSchema{
+					Email:    "new@example.com",
+					Password: "password",
+				},
+				cookies: cookies,
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				Cookie(t),
+				tt.fields.auth(t),
+				tt.fields.article,
+				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			for _, cookie := range tt.args.cookies {
+				tt.args.r.AddCookie(cookie)
+			}
+			buf, _ := json.Marshal(tt.args.body)
+			tt.args.r.Body = io.NopCloser(bytes.NewBuffer(buf))
+			hdl.V1AuthChangeEmail(got, tt.args.r)
+			if got.Code != tt.status {
+				t.Errorf("got %v, want %v", got.Code, tt.status)
+			}
+		})
+	}
+}
+
+func TestHandlerV1AuthVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		auth    func(*testing.T) usecase.APIAuth
+		article usecase.APIArticle
+		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
+	}
+
+	type args struct {
+		r *http.Request
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "メールアドレスを検証できる",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					mock.EXPECT().VerifyEmail(gomock.Any(), usecase.APIAuthVerifyEmailInput{
+						Code: auth.VerificationCode("code"),
+					}).Return(usecase.APIAuthVerifyEmailOutput{}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: verifyEmailRequest("code"),
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "検証コードが空文字でメールアドレスを検証できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					return mock
+				},
+			},
+			args: args{
+				r: verifyEmailRequest(""),
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "検証コードが存在せずメールアドレスを検証できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					mock.EXPECT().VerifyEmail(gomock.Any(), gomock.Any()).Return(
+						usecase.APIAuthVerifyEmailOutput{},
+						errors.NewNotFoundError("verification not found"),
+					)
+					return mock
+				},
+			},
+			args: args{
+				r: verifyEmailRequest("code"),
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				Cookie(t),
+				tt.fields.auth(t),
+				tt.fields.article,
+				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			hdl.V1AuthVerifyEmail(got, tt.args.r)
+			if got.Code != tt.status {
+				t.Errorf("got %v, want %v", got.Code, tt.status)
+			}
+		})
+	}
+}
+
+func verifyEmailRequest(code string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/v1/auth/email/verify", strings.NewReader(url.Values{"code": {code}}.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return r
+}
+
+func TestHandlerV1AuthConfirmEmail(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		params openapi.V1AuthConfirmEmailParams
+	}
+
+	tests := []struct {
+		name   string
+		args   args
+		status int
+	}{
+		{
+			name: "検証コードを消費せずに確認ページを返す",
+			args: args{
+				params: openapi.V1AuthConfirmEmailParams{
+					Code: "code",
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "検証コードが空文字の場合は確認ページを返さない",
+			args: args{
+				params: openapi.V1AuthConfirmEmailParams{
+					Code: "",
+				},
+			},
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			// 確認ページの表示では検証を行わない
+			mock := usecase.NewMockAPIAuth(ctrl)
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				Cookie(t),
+				mock,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, "/v1/auth/email/verify?code="+tt.args.params.Code, nil)
+			hdl.V1AuthConfirmEmail(got, r, tt.args.params)
+			if got.Code != tt.status {
+				t.Errorf("got %v, want %v", got.Code, tt.status)
+			}
+			if tt.status == http.StatusOK && !strings.Contains(got.Body.String(), `method="post"`) {
+				t.Errorf("body = %v, want form to post the code", got.Body.String())
+			}
+		})
+	}
+}
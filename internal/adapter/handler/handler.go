@@ -20,27 +20,39 @@ var _ openapi.ServerInterface = (*Handler)(nil)
 type Handler struct {
 	key     string
 	secret  auth.Secret
+	keyring Keyring
 	cookie  Cookie
 	auth    usecase.APIAuth
 	article usecase.APIArticle
 	health  usecase.APIHealth
+	oidc    usecase.APIOIDC
+	audit   usecase.APIAudit
+	user    usecase.APIUser
 }
 
 func New(
 	key string,
 	secret auth.Secret,
+	keyring Keyring,
 	cookie Cookie,
 	auth usecase.APIAuth,
 	article usecase.APIArticle,
 	health usecase.APIHealth,
+	oidc usecase.APIOIDC,
+	audit usecase.APIAudit,
+	user usecase.APIUser,
 ) *Handler {
 	return &Handler{
 		key:     key,
 		secret:  secret,
+		keyring: keyring,
 		cookie:  cookie,
 		auth:    auth,
 		article: article,
 		health:  health,
+		oidc:    oidc,
+		audit:   audit,
+		user:    user,
 	}
 }
 
@@ -156,7 +168,7 @@ func (hdl *Handler) ExtractTokens(
 		return Tokens{}, derr.NewUnauthorizedError("failed to get session token cookie", err)
 	}
 
-	sessionToken, err := auth.ParseSessionToken(sessionTokenCookie.Value, hdl.secret)
+	sessionToken, err := auth.ParseSessionToken(sessionTokenCookie.Value, hdl.secret, hdl.keyring.KeySet())
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to new session token", log.ErrorField(err))
 
@@ -170,7 +182,7 @@ func (hdl *Handler) ExtractTokens(
 		return Tokens{}, derr.NewUnauthorizedError("failed to get auth token cookie", err)
 	}
 
-	authToken, err := auth.ParseAuthToken(authTokenCookie.Value, sessionToken.ToSecret(hdl.secret))
+	authToken, err := auth.ParseAuthToken(authTokenCookie.Value, sessionToken.ToSecret(hdl.secret, hdl.keyring.KeySet()), hdl.keyring.KeySet())
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to new auth token", log.ErrorField(err))
 
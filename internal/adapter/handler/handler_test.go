@@ -27,6 +27,17 @@ func Cookie(t *testing.T) *handler.MockCookie {
 	return cookie
 }
 
+func Keyring(t *testing.T) *handler.MockKeyring {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	keyring := handler.NewMockKeyring(ctrl)
+	keyring.EXPECT().KeySet().Return(auth.KeySet{}).AnyTimes()
+
+	return keyring
+}
+
 func GenerateToken(t *testing.T) struct {
 	UserID             user.ID
 	AuthToken          auth.AuthToken
@@ -38,11 +49,11 @@ func GenerateToken(t *testing.T) struct {
 
 	sid := auth.GenerateSessionID()
 
-	st := auth.GenerateSessionToken(sid, auth.Secret("secret"))
-
 	uid := user.GenerateID()
 
-	at := auth.GenerateAuthToken(uid, sid.ToSecret(), auth.DefaultExpiresIn)
+	st := auth.GenerateSessionToken(sid, uid, auth.Secret("secret"), auth.KeySet{})
+
+	at := auth.GenerateAuthToken(uid, sid.ToSecret(), auth.KeySet{}, auth.DefaultExpiresIn)
 
 	return struct {
 		UserID             user.ID
@@ -93,7 +104,7 @@ func TestHandleConnectError(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			rest := handler.New("", auth.Secret(""), nil, nil, nil, nil)
+			rest := handler.New("", auth.Secret(""), nil, nil, nil, nil, nil, nil, nil, nil)
 			if got := rest.HandleConnectError(tt.args.ctx, tt.args.err); got != tt.want {
 				t.Errorf("API.HandleConnectError() = %v, want %v", got, tt.want)
 			}
@@ -138,6 +149,10 @@ func TestHandlerPointerToString(t *testing.T) {
 			hdl := handler.New(
 				"",
 				auth.Secret(""),
+				Keyring(t),
+				nil,
+				nil,
+				nil,
 				nil,
 				nil,
 				nil,
@@ -269,6 +284,10 @@ func TestHandlerExtractUserID(t *testing.T) {
 			hdl := handler.New(
 				"",
 				auth.Secret("secret"),
+				Keyring(t),
+				nil,
+				nil,
+				nil,
 				nil,
 				nil,
 				nil,
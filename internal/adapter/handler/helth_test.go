@@ -22,6 +22,8 @@ func TestHandlerV1HealthAPI(t *testing.T) {
 		auth    usecase.APIAuth
 		article usecase.APIArticle
 		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -53,10 +55,14 @@ func TestHandlerV1HealthAPI(t *testing.T) {
 			hdl := handler.New(
 				tt.fields.key,
 				tt.fields.secret,
+				Keyring(t),
 				tt.fields.cookie,
 				tt.fields.auth,
 				tt.fields.article,
 				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			hdl.V1HealthAPI(got, tt.args.r)
@@ -77,6 +83,8 @@ func TestHandlerV1HealthCore(t *testing.T) {
 		auth    usecase.APIAuth
 		article usecase.APIArticle
 		health  func(t *testing.T) usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
 	}
 
 	type args struct {
@@ -134,10 +142,14 @@ func TestHandlerV1HealthCore(t *testing.T) {
 			hdl := handler.New(
 				tt.fields.key,
 				tt.fields.secret,
+				Keyring(t),
 				tt.fields.cookie,
 				tt.fields.auth,
 				tt.fields.article,
 				tt.fields.health(t),
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
 			)
 			got := httptest.NewRecorder()
 			hdl.V1HealthCore(got, tt.args.r)
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+// jwksMaxAge JWKSをキャッシュしてよい秒数.
+// 未知のkidを受け取った検証側が再取得する前提で短めにする.
+const jwksMaxAge = "max-age=300"
+
+// JWKS
+// (GET /.well-known/jwks.json).
+func (hdl *Handler) WellKnownJWKS(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	jwks, err := hdl.keyring.KeySet().JWKS()
+	if err != nil {
+		log.GetLogCtx(ctx).Error("failed to create jwks", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	keys := make([]openapi.JWKSchema, len(jwks.Keys))
+
+	for i, key := range jwks.Keys {
+		keys[i] = openapi.JWKSchema{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   hdl.optionalString(key.N),
+			E:   hdl.optionalString(key.E),
+			Crv: hdl.optionalString(key.Crv),
+			X:   hdl.optionalString(key.X),
+		}
+	}
+
+	res := openapi.JWKSResponseSchema{
+		Keys: keys,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", jwksMaxAge)
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to encode response", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+}
+
+func (hdl *Handler) optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return hdl.StringToPointer(s)
+}
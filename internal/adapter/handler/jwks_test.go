@@ -0,0 +1,141 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/morning-night-guild/platform-app/internal/adapter/handler"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+func TestHandlerWellKnownJWKS(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		keyring func(*testing.T) handler.Keyring
+		auth    usecase.APIAuth
+		article usecase.APIArticle
+		health  usecase.APIHealth
+		oidc    usecase.APIOIDC
+		audit   usecase.APIAudit
+	}
+
+	type args struct {
+		r *http.Request
+	}
+
+	key, err := auth.GenerateSigningKey(auth.SigningAlgorithmRS256, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		status  int
+		wantKID []string
+	}{
+		{
+			name: "公開鍵の一覧を取得できる",
+			fields: fields{
+				keyring: func(t *testing.T) handler.Keyring {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := handler.NewMockKeyring(ctrl)
+					mock.EXPECT().KeySet().Return(auth.NewKeySet(key))
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+				},
+			},
+			status:  http.StatusOK,
+			wantKID: []string{key.KID},
+		},
+		{
+			name: "署名鍵が未発行の場合は空の一覧を取得できる",
+			fields: fields{
+				keyring: func(t *testing.T) handler.Keyring {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := handler.NewMockKeyring(ctrl)
+					mock.EXPECT().KeySet().Return(auth.KeySet{})
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+				},
+			},
+			status:  http.StatusOK,
+			wantKID: []string{},
+		},
+		{
+			name: "不正な署名鍵が含まれている場合は公開鍵の一覧を取得できない",
+			fields: fields{
+				keyring: func(t *testing.T) handler.Keyring {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := handler.NewMockKeyring(ctrl)
+					mock.EXPECT().KeySet().Return(auth.NewKeySet(auth.SigningKey{KID: "invalid"}))
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+				},
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				tt.fields.keyring(t),
+				Cookie(t),
+				tt.fields.auth,
+				tt.fields.article,
+				tt.fields.health,
+				tt.fields.oidc,
+				tt.fields.audit,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			hdl.WellKnownJWKS(got, tt.args.r)
+			if got.Code != tt.status {
+				t.Errorf("WellKnownJWKS() = %v, want %v", got.Code, tt.status)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var res openapi.JWKSResponseSchema
+			if err := json.NewDecoder(got.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if len(res.Keys) != len(tt.wantKID) {
+				t.Fatalf("WellKnownJWKS() keys = %v, want %v", res.Keys, tt.wantKID)
+			}
+			for i, key := range res.Keys {
+				if key.Kid != tt.wantKID[i] {
+					t.Errorf("WellKnownJWKS() kid = %v, want %v", key.Kid, tt.wantKID[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package handler
+
+import "github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+
+//go:generate mockgen -source keyring.go -destination keyring_mock.go -package handler
+
+type Keyring interface {
+	KeySet() auth.KeySet
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: keyring.go
+
+// Package handler is a generated GoMock package.
+package handler
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	auth "github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+// MockKeyring is a mock of Keyring interface.
+type MockKeyring struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyringMockRecorder
+}
+
+// MockKeyringMockRecorder is the mock recorder for MockKeyring.
+type MockKeyringMockRecorder struct {
+	mock *MockKeyring
+}
+
+// NewMockKeyring creates a new mock instance.
+func NewMockKeyring(ctrl *gomock.Controller) *MockKeyring {
+	mock := &MockKeyring{ctrl: ctrl}
+	mock.recorder = &MockKeyringMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyring) EXPECT() *MockKeyringMockRecorder {
+	return m.recorder
+}
+
+// KeySet mocks base method.
+func (m *MockKeyring) KeySet() auth.KeySet {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeySet")
+	ret0, _ := ret[0].(auth.KeySet)
+	return ret0
+}
+
+// KeySet indicates an expected call of KeySet.
+func (mr *MockKeyringMockRecorder) KeySet() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeySet", reflect.TypeOf((*MockKeyring)(nil).KeySet))
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+// OIDC認可
+// (POST /v1/auth/oidc/{provider}/authorize).
+func (hdl *Handler) V1AuthOIDCAuthorize(
+	w http.ResponseWriter,
+	r *http.Request,
+	provider string,
+) {
+	ctx := r.Context()
+
+	prv, err := auth.NewOIDCProvider(provider)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to new oidc provider", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	var req openapi.V1AuthOIDCAuthorizeRequestSchema
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to decode request body", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	key, err := auth.DecodePublicKey(req.PublicKey)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to decode public key", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	expiresIn := auth.DefaultExpiresIn
+	if req.ExpiresIn != nil {
+		expiresIn, err = auth.NewExpiresIn(*req.ExpiresIn)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to new expires in", log.ErrorField(err))
+
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	input := usecase.APIOIDCAuthorizeInput{
+		Provider:       prv,
+		InvitationCode: auth.InvitationCode(hdl.PointerToString(req.InvitationCode)),
+		PublicKey:      key,
+		ExpiresIn:      expiresIn,
+	}
+
+	output, err := hdl.oidc.Authorize(ctx, input)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to authorize oidc", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+
+	// コールバックが認可リクエストを行ったブラウザからのものであることを検証するため、stateをクッキーに保持する
+	http.SetCookie(w, &http.Cookie{
+		Name:     auth.OIDCStateKey,
+		Value:    output.State.String(),
+		Path:     path,
+		Domain:   hdl.cookie.Domain(),
+		MaxAge:   int(model.DefaultOIDCAuthorizationExpiresIn.Seconds()),
+		Secure:   hdl.cookie.Secure(),
+		HttpOnly: true,
+		SameSite: hdl.cookie.SameSite(),
+	})
+
+	res := openapi.V1AuthOIDCAuthorizeResponseSchema{
+		Url: output.URL,
+	}
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to encode response", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+}
+
+// OIDCコールバック
+// (GET /v1/auth/oidc/{provider}/callback).
+func (hdl *Handler) V1AuthOIDCCallback(
+	w http.ResponseWriter,
+	r *http.Request,
+	provider string,
+	params openapi.V1AuthOIDCCallbackParams,
+) {
+	ctx := r.Context()
+
+	prv, err := auth.NewOIDCProvider(provider)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to new oidc provider", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	state, err := auth.NewOIDCState(params.State)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to new oidc state", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	cookie, err := r.Cookie(auth.OIDCStateKey)
+	if err != nil || cookie.Value != state.String() {
+		log.GetLogCtx(ctx).Warn("oidc state cookie mismatch")
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	// stateは使い捨てのため、結果によらずクッキーを削除する
+	http.SetCookie(w, &http.Cookie{
+		Name:     auth.OIDCStateKey,
+		Value:    "",
+		Path:     path,
+		Domain:   hdl.cookie.Domain(),
+		MaxAge:   -1,
+		Secure:   hdl.cookie.Secure(),
+		HttpOnly: true,
+		SameSite: hdl.cookie.SameSite(),
+	})
+
+	input := usecase.APIOIDCCallbackInput{
+		Secret:   hdl.secret,
+		KeySet:   hdl.keyring.KeySet(),
+		Provider: prv,
+		State:    state,
+		Code:     params.Code,
+	}
+
+	output, err := hdl.oidc.Callback(ctx, input)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to sign in with oidc", log.ErrorField(err))
+
+		hdl.HandleErrorStatus(w, err)
+
+		return
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     auth.AuthTokenKey,
+		Value:    output.AuthToken.String(),
+		Path:     path,
+		Domain:   hdl.cookie.Domain(),
+		Expires:  output.Auth.ExpiresAt,
+		Secure:   hdl.cookie.Secure(),
+		HttpOnly: true,
+		SameSite: hdl.cookie.SameSite(),
+	})
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     auth.SessionTokenKey,
+		Value:    output.SessionToken.String(),
+		Path:     path,
+		Domain:   hdl.cookie.Domain(),
+		Expires:  output.Auth.IssuedAt.Add(model.DefaultSessionExpiresIn),
+		Secure:   hdl.cookie.Secure(),
+		HttpOnly: true,
+		SameSite: hdl.cookie.SameSite(),
+	})
+
+	http.Redirect(w, r, output.RedirectURL, http.StatusFound)
+}
@@ -0,0 +1,358 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/morning-night-guild/platform-app/internal/adapter/handler"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+func TestHandlerV1AuthOIDCAuthorize(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		auth    usecase.APIAuth
+		article usecase.APIArticle
+		health  usecase.APIHealth
+		oidc    func(*testing.T) usecase.APIOIDC
+		audit   usecase.APIAudit
+	}
+
+	type args struct {
+		r        *http.Request
+		provider string
+		body     openapi.V1AuthOIDCAuthorizeRequestSchema
+	}
+
+	pubkey := NewPublicKey(t)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "認可URLを取得できる",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIOIDC(ctrl)
+					mock.EXPECT().Authorize(gomock.Any(), usecase.APIOIDCAuthorizeInput{
+						Provider:       auth.OIDCProvider("google"),
+						InvitationCode: auth.InvitationCode("invitation"),
+						PublicKey:      pubkey.Key,
+						ExpiresIn:      auth.DefaultExpiresIn,
+					}).Return(usecase.APIOIDCAuthorizeOutput{
+						URL:   "https://accounts.google.com/o/oauth2/v2/auth",
+						State: auth.OIDCState("state"),
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{},
+				},
+				provider: "google",
+				body: openapi.V1AuthOIDCAuthorizeRequestSchema{
+					PublicKey:      pubkey.String(),
+					InvitationCode: func() *string { s := "invitation"; return &s }(),
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "認証プロバイダ名が不正で認可URLを取得できない",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					return usecase.NewMockAPIOIDC(gomock.NewController(t))
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{},
+				},
+				provider: "Google",
+				body: openapi.V1AuthOIDCAuthorizeRequestSchema{
+					PublicKey: pubkey.String(),
+				},
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "公開鍵が不正な値で認可URLを取得できない",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					return usecase.NewMockAPIOIDC(gomock.NewController(t))
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{},
+				},
+				provider: "google",
+				body: openapi.V1AuthOIDCAuthorizeRequestSchema{
+					PublicKey: "",
+				},
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "未設定の認証プロバイダで認可URLを取得できない",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIOIDC(ctrl)
+					mock.EXPECT().Authorize(gomock.Any(), gomock.Any()).Return(
+						usecase.APIOIDCAuthorizeOutput{},
+						errors.NewNotFoundError("oidc provider: unknown"),
+					)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPost,
+					Header: http.Header{},
+				},
+				provider: "unknown",
+				body: openapi.V1AuthOIDCAuthorizeRequestSchema{
+					PublicKey: pubkey.String(),
+				},
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				Cookie(t),
+				tt.fields.auth,
+				tt.fields.article,
+				tt.fields.health,
+				tt.fields.oidc(t),
+				tt.fields.audit,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			buf, _ := json.Marshal(tt.args.body)
+			tt.args.r.Body = io.NopCloser(bytes.NewBuffer(buf))
+			hdl.V1AuthOIDCAuthorize(got, tt.args.r, tt.args.provider)
+			if got.Code != tt.status {
+				t.Errorf("got %v, want %v", got.Code, tt.status)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			found := false
+			for _, cookie := range got.Result().Cookies() {
+				if cookie.Name == auth.OIDCStateKey && cookie.Value == "state" && cookie.HttpOnly {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("state cookie is not set")
+			}
+		})
+	}
+}
+
+func TestHandlerV1AuthOIDCCallback(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		auth    usecase.APIAuth
+		article usecase.APIArticle
+		health  usecase.APIHealth
+		oidc    func(*testing.T) usecase.APIOIDC
+		audit   usecase.APIAudit
+	}
+
+	type args struct {
+		r        *http.Request
+		cookies  []*http.Cookie
+		provider string
+		params   openapi.V1AuthOIDCCallbackParams
+	}
+
+	token := GenerateToken(t)
+
+	stateCookie := []*http.Cookie{
+		{
+			Name:  auth.OIDCStateKey,
+			Value: "state",
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "サインインしてリダイレクトできる",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIOIDC(ctrl)
+					mock.EXPECT().Callback(gomock.Any(), usecase.APIOIDCCallbackInput{
+						Secret:   auth.Secret("secret"),
+						Provider: auth.OIDCProvider("google"),
+						State:    auth.OIDCState("state"),
+						Code:     "code",
+					}).Return(usecase.APIOIDCCallbackOutput{
+						RedirectURL: "http://localhost:5173",
+						Auth: model.Auth{
+							UserID:    token.UserID,
+							IssuedAt:  time.Now(),
+							ExpiresAt: time.Now().Add(time.Hour),
+						},
+						AuthToken:    token.AuthToken,
+						SessionToken: token.SessionToken,
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{},
+				},
+				cookies:  stateCookie,
+				provider: "google",
+				params: openapi.V1AuthOIDCCallbackParams{
+					Code:  "code",
+					State: "state",
+				},
+			},
+			status: http.StatusFound,
+		},
+		{
+			name: "stateのクッキーがなくサインインできない",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					return usecase.NewMockAPIOIDC(gomock.NewController(t))
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{},
+				},
+				cookies:  []*http.Cookie{},
+				provider: "google",
+				params: openapi.V1AuthOIDCCallbackParams{
+					Code:  "code",
+					State: "state",
+				},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "stateがクッキーと一致せずサインインできない",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					return usecase.NewMockAPIOIDC(gomock.NewController(t))
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{},
+				},
+				cookies:  stateCookie,
+				provider: "google",
+				params: openapi.V1AuthOIDCCallbackParams{
+					Code:  "code",
+					State: "other",
+				},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "usecaseでエラーが発生してサインインできない",
+			fields: fields{
+				oidc: func(t *testing.T) usecase.APIOIDC {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIOIDC(ctrl)
+					mock.EXPECT().Callback(gomock.Any(), gomock.Any()).Return(
+						usecase.APIOIDCCallbackOutput{},
+						fmt.Errorf("error"),
+					)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{},
+				},
+				cookies:  stateCookie,
+				provider: "google",
+				params: openapi.V1AuthOIDCCallbackParams{
+					Code:  "code",
+					State: "state",
+				},
+			},
+			status: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				Cookie(t),
+				tt.fields.auth,
+				tt.fields.article,
+				tt.fields.health,
+				tt.fields.oidc(t),
+				tt.fields.audit,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			for _, cookie := range tt.args.cookies {
+				tt.args.r.AddCookie(cookie)
+			}
+			hdl.V1AuthOIDCCallback(got, tt.args.r, tt.args.provider, tt.args.params)
+			if got.Code != tt.status {
+				t.Errorf("got %v, want %v", got.Code, tt.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+// アカウント削除
+// (DELETE /v1/users/me).
+func (hdl *Handler) V1UserDelete(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+
+	uid, err := hdl.ExtractUserID(ctx, r)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to extract user id", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	ctx = user.SetUIDCtx(ctx, uid)
+
+	hdl.deleteUser(ctx, w, uid)
+}
+
+// プロフィール取得
+// (GET /v1/users/me).
+func (hdl *Handler) V1UserGet(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+
+	uid, err := hdl.ExtractUserID(ctx, r)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to extract user id", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	ctx = user.SetUIDCtx(ctx, uid)
+
+	output, err := hdl.user.Get(ctx, usecase.APIUserGetInput{
+		UserID: uid,
+	})
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get user", log.ErrorField(err))
+
+		w.WriteHeader(hdl.HandleConnectError(ctx, err))
+
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(hdl.userSchema(output.User)); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to encode response", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// プロフィール更新
+// (PATCH /v1/users/me).
+func (hdl *Handler) V1UserUpdate(
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	ctx := r.Context()
+
+	uid, err := hdl.ExtractUserID(ctx, r)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to extract user id", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusUnauthorized)
+
+		return
+	}
+
+	ctx = user.SetUIDCtx(ctx, uid)
+
+	var body openapi.V1UserUpdateRequestSchema
+
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to decode request body", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	fields, err := hdl.profileFields(body)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("invalid update mask", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	profile, err := hdl.profile(body, fields)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("invalid profile", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusBadRequest)
+
+		return
+	}
+
+	output, err := hdl.user.Update(ctx, usecase.APIUserUpdateInput{
+		UserID:  uid,
+		Profile: profile,
+		Fields:  fields,
+	})
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to update user", log.ErrorField(err))
+
+		w.WriteHeader(hdl.HandleConnectError(ctx, err))
+
+		return
+	}
+
+	if err := json.NewEncoder(w).Encode(hdl.userSchema(output.User)); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to encode response", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// profileFields 更新する項目を決定する.
+// updateMaskを省略した場合はリクエストボディに含まれる項目を更新する.
+func (hdl *Handler) profileFields(body openapi.V1UserUpdateRequestSchema) ([]user.ProfileField, error) {
+	if body.UpdateMask == nil {
+		fields := make([]user.ProfileField, 0, len(profileMaskFields))
+
+		present := map[user.ProfileField]bool{
+			user.FieldDisplayName: body.DisplayName != nil,
+			user.FieldAvatarURL:   body.AvatarUrl != nil,
+			user.FieldBio:         body.Bio != nil,
+			user.FieldLocale:      body.Locale != nil,
+			user.FieldTimezone:    body.Timezone != nil,
+		}
+
+		for _, field := range profileMaskFields {
+			if present[field] {
+				fields = append(fields, field)
+			}
+		}
+
+		return fields, nil
+	}
+
+	paths := make([]string, len(*body.UpdateMask))
+
+	for i, mask := range *body.UpdateMask {
+		field, ok := profileMasks[mask]
+		if !ok {
+			return nil, errors.NewValidationError(fmt.Sprintf("unknown update mask: %s", mask))
+		}
+
+		paths[i] = field.String()
+	}
+
+	return user.NewProfileFields(paths)
+}
+
+// profile 更新する項目のみ検証してプロフィールを生成する.
+func (hdl *Handler) profile(
+	body openapi.V1UserUpdateRequestSchema,
+	fields []user.ProfileField,
+) (model.UserProfile, error) {
+	var (
+		profile model.UserProfile
+		err     error
+	)
+
+	for _, field := range fields {
+		switch field {
+		case user.FieldDisplayName:
+			profile.DisplayName, err = user.NewDisplayName(hdl.PointerToString(body.DisplayName))
+		case user.FieldAvatarURL:
+			profile.AvatarURL, err = user.NewAvatarURL(hdl.PointerToString(body.AvatarUrl))
+		case user.FieldBio:
+			profile.Bio, err = user.NewBio(hdl.PointerToString(body.Bio))
+		case user.FieldLocale:
+			profile.Locale, err = user.NewLocale(hdl.PointerToString(body.Locale))
+		case user.FieldTimezone:
+			profile.Timezone, err = user.NewTimezone(hdl.PointerToString(body.Timezone))
+		}
+
+		if err != nil {
+			return model.UserProfile{}, err
+		}
+	}
+
+	return profile, nil
+}
+
+// deleteUser アカウントを削除し、削除前に取得したデータの写しを返す.
+func (hdl *Handler) deleteUser(
+	ctx context.Context,
+	w http.ResponseWriter,
+	uid user.ID,
+) {
+	input := usecase.APIAuthDeleteInput{
+		UserID: uid,
+	}
+
+	output, err := hdl.auth.Delete(ctx, input)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to delete user", log.ErrorField(err))
+
+		w.WriteHeader(hdl.HandleConnectError(ctx, err))
+
+		return
+	}
+
+	res := openapi.V1UserDeleteResponseSchema{
+		Export:  hdl.userExportSchema(output.Deletion.Export),
+		PurgeAt: output.Deletion.PurgeAt,
+	}
+
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to encode response", log.ErrorField(err))
+
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// profileMasks updateMaskの値とプロフィールの項目の対応.
+var profileMasks = map[openapi.V1UserUpdateRequestSchemaUpdateMask]user.ProfileField{
+	openapi.DisplayName: user.FieldDisplayName,
+	openapi.AvatarUrl:   user.FieldAvatarURL,
+	openapi.Bio:         user.FieldBio,
+	openapi.Locale:      user.FieldLocale,
+	openapi.Timezone:    user.FieldTimezone,
+}
+
+// profileMaskFields updateMaskを省略した場合に確認する項目の順序.
+var profileMaskFields = []user.ProfileField{
+	user.FieldDisplayName,
+	user.FieldAvatarURL,
+	user.FieldBio,
+	user.FieldLocale,
+	user.FieldTimezone,
+}
+
+func (hdl *Handler) userSchema(usr model.User) openapi.UserSchema {
+	return openapi.UserSchema{
+		Id:          usr.UserID.Value(),
+		DisplayName: hdl.StringToPointer(usr.Profile.DisplayName.String()),
+		AvatarUrl:   hdl.StringToPointer(usr.Profile.AvatarURL.String()),
+		Bio:         hdl.StringToPointer(usr.Profile.Bio.String()),
+		Locale:      hdl.StringToPointer(usr.Profile.Locale.String()),
+		Timezone:    hdl.StringToPointer(usr.Profile.Timezone.String()),
+	}
+}
+
+func (hdl *Handler) userExportSchema(export model.UserExport) openapi.UserExportSchema {
+	articles := make([]openapi.ArticleSchema, len(export.Articles))
+
+	for i, article := range export.Articles {
+		id := article.ArticleID.Value()
+		tags := article.TagList.StringSlice()
+		articles[i] = openapi.ArticleSchema{
+			Id:          &id,
+			Title:       hdl.StringToPointer(article.Title.String()),
+			Url:         hdl.StringToPointer(article.URL.String()),
+			Description: hdl.StringToPointer(article.Description.String()),
+			Thumbnail:   hdl.StringToPointer(article.Thumbnail.String()),
+			Tags:        &tags,
+			SharedBy:    hdl.articleSharerSchema(article.SharedBy),
+		}
+	}
+
+	return openapi.UserExportSchema{
+		User:       hdl.userSchema(export.User),
+		Articles:   articles,
+		ExportedAt: export.ExportedAt,
+	}
+}
@@ -0,0 +1,516 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/golang/mock/gomock"
+	"github.com/morning-night-guild/platform-app/internal/adapter/handler"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/pkg/openapi"
+)
+
+func TestHandlerV1UserDelete(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		auth func(*testing.T) usecase.APIAuth
+	}
+
+	type args struct {
+		r       *http.Request
+		cookies []*http.Cookie
+	}
+
+	token := GenerateToken(t)
+
+	purgeAt := time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "アカウントを削除し、データの写しを返す",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), usecase.APIAuthDeleteInput{
+						UserID: token.UserID,
+					}).Return(usecase.APIAuthDeleteOutput{
+						Deletion: model.UserDeletion{
+							Export: model.UserExport{
+								User:       model.NewUser(token.UserID),
+								Articles:   []model.Article{},
+								ExportedAt: purgeAt.Add(-model.UserDeletionGracePeriod),
+							},
+							PurgeAt: purgeAt,
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodDelete,
+					Header: http.Header{},
+				},
+				cookies: []*http.Cookie{
+					{
+						Name:  auth.AuthTokenKey,
+						Value: token.AuthTokenString,
+					},
+					{
+						Name:  auth.SessionTokenKey,
+						Value: token.SessionTokenString,
+					},
+				},
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "認証に失敗してアカウントを削除できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIAuth(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodDelete,
+					Header: http.Header{},
+				},
+				cookies: []*http.Cookie{},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "ユーザーが存在しない場合はアカウントを削除できない",
+			fields: fields{
+				auth: func(t *testing.T) usecase.APIAuth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIAuth(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(
+						usecase.APIAuthDeleteOutput{},
+						connect.NewError(connect.CodeNotFound, errors.New("not found")),
+					)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodDelete,
+					Header: http.Header{},
+				},
+				cookies: []*http.Cookie{
+					{
+						Name:  auth.AuthTokenKey,
+						Value: token.AuthTokenString,
+					},
+					{
+						Name:  auth.SessionTokenKey,
+						Value: token.SessionTokenString,
+					},
+				},
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				nil,
+				tt.fields.auth(t),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			got := httptest.NewRecorder()
+			for _, cookie := range tt.args.cookies {
+				tt.args.r.AddCookie(cookie)
+			}
+			hdl.V1UserDelete(got, tt.args.r)
+			if got.Code != tt.status {
+				t.Errorf("V1UserDelete() = %v, want %v", got.Code, tt.status)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var res openapi.V1UserDeleteResponseSchema
+			if err := json.NewDecoder(got.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if res.Export.User.Id != token.UserID.Value() || !res.PurgeAt.Equal(purgeAt) {
+				t.Errorf("V1UserDelete() response = %+v", res)
+			}
+		})
+	}
+}
+
+func TestHandlerV1UserGet(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		user func(*testing.T) usecase.APIUser
+	}
+
+	type args struct {
+		r       *http.Request
+		cookies []*http.Cookie
+	}
+
+	token := GenerateToken(t)
+
+	cookies := []*http.Cookie{
+		{
+			Name:  auth.AuthTokenKey,
+			Value: token.AuthTokenString,
+		},
+		{
+			Name:  auth.SessionTokenKey,
+			Value: token.SessionTokenString,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "プロフィールを取得できる",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIUser(ctrl)
+					mock.EXPECT().Get(gomock.Any(), usecase.APIUserGetInput{
+						UserID: token.UserID,
+					}).Return(usecase.APIUserGetOutput{
+						User: model.User{
+							UserID: token.UserID,
+							Profile: model.UserProfile{
+								DisplayName: user.DisplayName("name"),
+							},
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{},
+				},
+				cookies: cookies,
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "認証に失敗してプロフィールを取得できない",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIUser(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{},
+				},
+				cookies: []*http.Cookie{},
+			},
+			status: http.StatusUnauthorized,
+		},
+		{
+			name: "ユーザーが存在しない場合はプロフィールを取得できない",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIUser(ctrl)
+					mock.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+						usecase.APIUserGetOutput{},
+						connect.NewError(connect.CodeNotFound, errors.New("not found")),
+					)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodGet,
+					Header: http.Header{},
+				},
+				cookies: cookies,
+			},
+			status: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.fields.user(t),
+			)
+			got := httptest.NewRecorder()
+			for _, cookie := range tt.args.cookies {
+				tt.args.r.AddCookie(cookie)
+			}
+			hdl.V1UserGet(got, tt.args.r)
+			if got.Code != tt.status {
+				t.Errorf("V1UserGet() = %v, want %v", got.Code, tt.status)
+			}
+			if tt.status != http.StatusOK {
+				return
+			}
+			var res openapi.UserSchema
+			if err := json.NewDecoder(got.Body).Decode(&res); err != nil {
+				t.Fatal(err)
+			}
+			if res.Id != token.UserID.Value() || res.DisplayName == nil || *res.DisplayName != "name" {
+				t.Errorf("V1UserGet() response = %+v", res)
+			}
+		})
+	}
+}
+
+func TestHandlerV1UserUpdate(t *testing.T) {
+	t.Parallel()
+
+	toPointer := func(s string) *string {
+		return &s
+	}
+
+	type fields struct {
+		user func(*testing.T) usecase.APIUser
+	}
+
+	type args struct {
+		r       *http.Request
+		body    any
+		cookies []*http.Cookie
+	}
+
+	token := GenerateToken(t)
+
+	cookies := []*http.Cookie{
+		{
+			Name:  auth.AuthTokenKey,
+			Value: token.AuthTokenString,
+		},
+		{
+			Name:  auth.SessionTokenKey,
+			Value: token.SessionTokenString,
+		},
+	}
+
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		status int
+	}{
+		{
+			name: "updateMaskで指定した項目のみ更新できる",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIUser(ctrl)
+					mock.EXPECT().Update(gomock.Any(), usecase.APIUserUpdateInput{
+						UserID: token.UserID,
+						Profile: model.UserProfile{
+							Timezone: user.Timezone("Asia/Tokyo"),
+						},
+						Fields: []user.ProfileField{user.FieldTimezone},
+					}).Return(usecase.APIUserUpdateOutput{
+						User: model.User{
+							UserID: token.UserID,
+							Profile: model.UserProfile{
+								Timezone: user.Timezone("Asia/Tokyo"),
+							},
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPatch,
+					Header: http.Header{},
+				},
+				body: openapi.V1UserUpdateRequestSchema{
+					DisplayName: toPointer("ignored"),
+					Timezone:    toPointer("Asia/Tokyo"),
+					UpdateMask:  &[]openapi.V1UserUpdateRequestSchemaUpdateMask{openapi.Timezone},
+				},
+				cookies: cookies,
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "updateMaskを省略した場合はリクエストボディに含まれる項目を更新できる",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := usecase.NewMockAPIUser(ctrl)
+					mock.EXPECT().Update(gomock.Any(), usecase.APIUserUpdateInput{
+						UserID: token.UserID,
+						Profile: model.UserProfile{
+							DisplayName: user.DisplayName("name"),
+							Locale:      user.Locale("ja-JP"),
+						},
+						Fields: []user.ProfileField{user.FieldDisplayName, user.FieldLocale},
+					}).Return(usecase.APIUserUpdateOutput{
+						User: model.User{
+							UserID: token.UserID,
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPatch,
+					Header: http.Header{},
+				},
+				body: openapi.V1UserUpdateRequestSchema{
+					DisplayName: toPointer("name"),
+					Locale:      toPointer("ja-JP"),
+				},
+				cookies: cookies,
+			},
+			status: http.StatusOK,
+		},
+		{
+			name: "不正なupdateMaskが指定された場合は更新できない",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIUser(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPatch,
+					Header: http.Header{},
+				},
+				body: map[string]any{
+					"updateMask": []string{"email"},
+				},
+				cookies: cookies,
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "不正なアバター画像のURLが指定された場合は更新できない",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIUser(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPatch,
+					Header: http.Header{},
+				},
+				body: openapi.V1UserUpdateRequestSchema{
+					AvatarUrl: toPointer("http://example.com/avatar.png"),
+				},
+				cookies: cookies,
+			},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "認証に失敗してプロフィールを更新できない",
+			fields: fields{
+				user: func(t *testing.T) usecase.APIUser {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return usecase.NewMockAPIUser(ctrl)
+				},
+			},
+			args: args{
+				r: &http.Request{
+					Method: http.MethodPatch,
+					Header: http.Header{},
+				},
+				body:    openapi.V1UserUpdateRequestSchema{},
+				cookies: []*http.Cookie{},
+			},
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			hdl := handler.New(
+				"key",
+				auth.Secret("secret"),
+				Keyring(t),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				tt.fields.user(t),
+			)
+			got := httptest.NewRecorder()
+			for _, cookie := range tt.args.cookies {
+				tt.args.r.AddCookie(cookie)
+			}
+			buf, _ := json.Marshal(tt.args.body)
+			tt.args.r.Body = io.NopCloser(bytes.NewBuffer(buf))
+			hdl.V1UserUpdate(got, tt.args.r)
+			if got.Code != tt.status {
+				t.Errorf("V1UserUpdate() = %v, want %v", got.Code, tt.status)
+			}
+		})
+	}
+}
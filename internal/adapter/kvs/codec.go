@@ -0,0 +1,219 @@
+package kvs
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Format 値のシリアライズ形式.
+type Format byte
+
+const (
+	FormatJSON        Format = 1
+	FormatMessagePack Format = 2
+)
+
+// Compression 値の圧縮方式.
+type Compression byte
+
+const (
+	CompressionNone   Compression = 0
+	CompressionZstd   Compression = 1
+	CompressionSnappy Compression = 2
+)
+
+const (
+	// compressionShift バージョンバイトにおける圧縮方式の位置.
+	compressionShift = 3
+	formatMask       = 1<<compressionShift - 1
+	// versionLimit バージョンバイトの上限.
+	// base64の文字はすべてこれ以上の値となるため、先頭の1バイトでバージョンバイトの有無を判定できる.
+	versionLimit = 0x20
+)
+
+// Codec キャッシュに保存する値のエンコード方式.
+// 先頭に形式と圧縮方式を表すバージョンバイトを付与するため、異なるCodecで保存された値も復号できる.
+// ゼロ値はバージョンバイト導入前と同じbase64エンコードしたJSONで保存する.
+type Codec struct {
+	Format      Format
+	Compression Compression
+}
+
+// DefaultCodec バージョンバイト導入前のインスタンスとも互換性のあるCodec.
+var DefaultCodec = Codec{} //nolint:gochecknoglobals
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)                                 //nolint:gochecknoglobals
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0)) //nolint:gochecknoglobals
+)
+
+// NewCodec json, msgpack, msgpack+zstdのように形式と圧縮方式を+で連結した文字列からCodecを作成する.
+// 空文字の場合はDefaultCodecとなる.
+func NewCodec(value string) (Codec, error) {
+	if value == "" {
+		return DefaultCodec, nil
+	}
+
+	name, comp, _ := strings.Cut(value, "+")
+
+	codec := Codec{}
+
+	switch name {
+	case "json":
+		codec.Format = FormatJSON
+	case "msgpack":
+		codec.Format = FormatMessagePack
+	default:
+		return Codec{}, fmt.Errorf("unknown codec format: %s", name)
+	}
+
+	switch comp {
+	case "":
+		codec.Compression = CompressionNone
+	case "zstd":
+		codec.Compression = CompressionZstd
+	case "snappy":
+		codec.Compression = CompressionSnappy
+	default:
+		return Codec{}, fmt.Errorf("unknown codec compression: %s", comp)
+	}
+
+	// 圧縮しないJSONは互換性のため従来の形式で保存する
+	if codec == (Codec{Format: FormatJSON}) {
+		return DefaultCodec, nil
+	}
+
+	return codec, nil
+}
+
+func (c Codec) version() byte {
+	return byte(c.Format) | byte(c.Compression)<<compressionShift
+}
+
+// Encode 値をエンコードする.
+func (c Codec) Encode(value any) (string, error) {
+	if c == DefaultCodec {
+		val, err := json.Marshal(value)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal json: %w", err)
+		}
+
+		return base64.StdEncoding.EncodeToString(val), nil
+	}
+
+	val, err := c.marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	switch c.Compression {
+	case CompressionNone:
+	case CompressionZstd:
+		val = zstdEncoder.EncodeAll(val, make([]byte, 0, len(val)))
+	case CompressionSnappy:
+		val = snappy.Encode(nil, val)
+	default:
+		return "", fmt.Errorf("unknown codec compression: %d", c.Compression)
+	}
+
+	buf := make([]byte, 0, len(val)+1)
+	buf = append(buf, c.version())
+	buf = append(buf, val...)
+
+	return string(buf), nil
+}
+
+func (c Codec) marshal(value any) ([]byte, error) {
+	switch c.Format {
+	case FormatJSON:
+		val, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal json: %w", err)
+		}
+
+		return val, nil
+	case FormatMessagePack:
+		var buf bytes.Buffer
+
+		enc := msgpack.NewEncoder(&buf)
+		// フィールド名をJSONと揃える
+		enc.SetCustomStructTag("json")
+
+		if err := enc.Encode(value); err != nil {
+			return nil, fmt.Errorf("failed to marshal msgpack: %w", err)
+		}
+
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unknown codec format: %d", c.Format)
+	}
+}
+
+// Decode エンコードされた値を復号する.
+// 復号の方式は値のバージョンバイトから判定するため、Codecの設定によらない.
+func Decode(str string, value any) error {
+	if len(str) == 0 || str[0] >= versionLimit {
+		dec, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return fmt.Errorf("failed to decode base64: %w", err)
+		}
+
+		if err := json.Unmarshal(dec, value); err != nil {
+			return fmt.Errorf("failed to unmarshal json: %w", err)
+		}
+
+		return nil
+	}
+
+	codec := Codec{
+		Format:      Format(str[0] & formatMask),
+		Compression: Compression(str[0] >> compressionShift),
+	}
+
+	val := []byte(str[1:])
+
+	switch codec.Compression {
+	case CompressionNone:
+	case CompressionZstd:
+		dec, err := zstdDecoder.DecodeAll(val, nil)
+		if err != nil {
+			return fmt.Errorf("failed to decompress zstd: %w", err)
+		}
+
+		val = dec
+	case CompressionSnappy:
+		dec, err := snappy.Decode(nil, val)
+		if err != nil {
+			return fmt.Errorf("failed to decompress snappy: %w", err)
+		}
+
+		val = dec
+	default:
+		return fmt.Errorf("unknown codec compression: %d", codec.Compression)
+	}
+
+	switch codec.Format {
+	case FormatJSON:
+		if err := json.Unmarshal(val, value); err != nil {
+			return fmt.Errorf("failed to unmarshal json: %w", err)
+		}
+	case FormatMessagePack:
+		dec := msgpack.NewDecoder(bytes.NewReader(val))
+		dec.SetCustomStructTag("json")
+
+		if err := dec.Decode(value); err != nil {
+			return fmt.Errorf("failed to unmarshal msgpack: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown codec format: %d", codec.Format)
+	}
+
+	return nil
+}
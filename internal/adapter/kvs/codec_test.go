@@ -0,0 +1,273 @@
+package kvs_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs/kvstest"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func Codecs() []kvs.Codec {
+	return []kvs.Codec{
+		kvs.DefaultCodec,
+		{Format: kvs.FormatJSON, Compression: kvs.CompressionZstd},
+		{Format: kvs.FormatMessagePack, Compression: kvs.CompressionNone},
+		{Format: kvs.FormatMessagePack, Compression: kvs.CompressionZstd},
+		{Format: kvs.FormatMessagePack, Compression: kvs.CompressionSnappy},
+	}
+}
+
+func Session(t testing.TB) model.Session {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().UTC()
+
+	return model.Session{
+		SessionID: auth.GenerateSessionID(),
+		UserID:    user.GenerateID(),
+		PublicKey: key.PublicKey,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(model.DefaultSessionExpiresIn),
+	}
+}
+
+func TestNewCodec(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    kvs.Codec
+		wantErr bool
+	}{
+		{
+			name:    "空文字の場合は従来の形式となる",
+			value:   "",
+			want:    kvs.DefaultCodec,
+			wantErr: false,
+		},
+		{
+			name:    "圧縮しないJSONは従来の形式となる",
+			value:   "json",
+			want:    kvs.DefaultCodec,
+			wantErr: false,
+		},
+		{
+			name:    "圧縮するJSONを作成できる",
+			value:   "json+zstd",
+			want:    kvs.Codec{Format: kvs.FormatJSON, Compression: kvs.CompressionZstd},
+			wantErr: false,
+		},
+		{
+			name:    "MessagePackを作成できる",
+			value:   "msgpack",
+			want:    kvs.Codec{Format: kvs.FormatMessagePack, Compression: kvs.CompressionNone},
+			wantErr: false,
+		},
+		{
+			name:    "snappyで圧縮するMessagePackを作成できる",
+			value:   "msgpack+snappy",
+			want:    kvs.Codec{Format: kvs.FormatMessagePack, Compression: kvs.CompressionSnappy},
+			wantErr: false,
+		},
+		{
+			name:    "未知の形式はエラーとなる",
+			value:   "protobuf",
+			want:    kvs.Codec{},
+			wantErr: true,
+		},
+		{
+			name:    "未知の圧縮方式はエラーとなる",
+			value:   "msgpack+gzip",
+			want:    kvs.Codec{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := kvs.NewCodec(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewCodec() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewCodec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	sss := Session(t)
+
+	for _, codec := range Codecs() {
+		codec := codec
+		t.Run(fmt.Sprintf("format=%d,compression=%d", codec.Format, codec.Compression), func(t *testing.T) {
+			t.Parallel()
+			enc, err := codec.Encode(sss)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got model.Session
+			if err := kvs.Decode(enc, &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.SessionID != sss.SessionID || got.UserID != sss.UserID {
+				t.Errorf("Decode() = %v, want %v", got, sss)
+			}
+			if !got.PublicKey.Equal(&sss.PublicKey) {
+				t.Errorf("Decode() public key = %v, want %v", got.PublicKey, sss.PublicKey)
+			}
+			if !got.IssuedAt.Equal(sss.IssuedAt) || !got.ExpiresAt.Equal(sss.ExpiresAt) {
+				t.Errorf("Decode() = %v, want %v", got, sss)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		str string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    kvstest.KV
+		wantErr bool
+	}{
+		{
+			name: "バージョンバイト導入前の値を復号できる",
+			args: args{
+				str: base64.StdEncoding.EncodeToString([]byte(`{"key":"key","value":"value"}`)),
+			},
+			want:    kvstest.KV{Key: "key", Value: "value"},
+			wantErr: false,
+		},
+		{
+			name: "未知の形式はエラーとなる",
+			args: args{
+				str: string([]byte{0x07, 0x00}),
+			},
+			want:    kvstest.KV{},
+			wantErr: true,
+		},
+		{
+			name: "未知の圧縮方式はエラーとなる",
+			args: args{
+				str: string([]byte{0x19, 0x00}),
+			},
+			want:    kvstest.KV{},
+			wantErr: true,
+		},
+		{
+			name: "壊れた値はエラーとなる",
+			args: args{
+				str: string([]byte{0x0a, 0x00}),
+			},
+			want:    kvstest.KV{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got kvstest.KV
+			err := kvs.Decode(tt.args.str, &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decode() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Decode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMemoryCodec(t *testing.T) {
+	t.Parallel()
+
+	store := kvs.NewStore()
+
+	kvstest.Run(t, func(t *testing.T, prefix string) cache.Cache[kvstest.KV] {
+		t.Helper()
+
+		return &kvs.Memory[kvstest.KV]{
+			Prefix: prefix,
+			Store:  store,
+			Codec:  kvs.Codec{Format: kvs.FormatMessagePack, Compression: kvs.CompressionZstd},
+		}
+	}, func(t *testing.T, prefix string) cache.Index {
+		t.Helper()
+
+		return &kvs.MemoryIndex{
+			Prefix: prefix,
+			Store:  store,
+		}
+	})
+}
+
+func BenchmarkCodec(b *testing.B) {
+	now := time.Now().UTC()
+
+	entries := map[string]any{
+		"session": Session(b),
+		"auth": model.Auth{
+			AuthID:    user.GenerateID(),
+			UserID:    user.GenerateID(),
+			IssuedAt:  now,
+			ExpiresAt: now.Add(model.DefaultSessionExpiresIn),
+		},
+		"code": model.GenerateCode(auth.GenerateSessionID()),
+	}
+
+	for name, entry := range entries {
+		for _, codec := range Codecs() {
+			codec := codec
+			entry := entry
+			b.Run(fmt.Sprintf("%s/format=%d,compression=%d", name, codec.Format, codec.Compression), func(b *testing.B) {
+				b.ReportAllocs()
+				size := 0
+				for i := 0; i < b.N; i++ {
+					enc, err := codec.Encode(entry)
+					if err != nil {
+						b.Fatal(err)
+					}
+					val := reflect.New(reflect.TypeOf(entry)).Interface()
+					if err := kvs.Decode(enc, val); err != nil {
+						b.Fatal(err)
+					}
+					size = len(enc)
+				}
+				b.ReportMetric(float64(size), "bytes/entry")
+			})
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package kvs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// hashKey キャッシュのキーを作成する.
+// Redis Clusterの場合はキーの先頭の要素をハッシュタグで囲み、同じ要素で始まるキーを同じスロットに配置する.
+// セッションに関するキーはすべてユーザーIDで始めるため、例えばsession:{uid}:sid, user:{uid}:sid,
+// auth:{uid}, session_index:{uid}は同じスロットとなり、1つのトランザクションで更新できる.
+// Cluster以外ではハッシュタグを付与しないため、既存のキーはそのまま参照できる.
+func hashKey(client redis.UniversalClient, prefix string, key string) string {
+	if !isCluster(client) {
+		return fmt.Sprintf(format, prefix, key)
+	}
+
+	tag, rest, found := strings.Cut(key, ":")
+	if !found {
+		return fmt.Sprintf("%s:{%s}", prefix, tag)
+	}
+
+	return fmt.Sprintf("%s:{%s}:%s", prefix, tag, rest)
+}
+
+// unhashKey hashKeyで付与したハッシュタグを取り除く.
+func unhashKey(key string) string {
+	return strings.NewReplacer("{", "", "}", "").Replace(key)
+}
+
+func isCluster(client redis.UniversalClient) bool {
+	_, ok := client.(*redis.ClusterClient)
+
+	return ok
+}
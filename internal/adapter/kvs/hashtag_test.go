@@ -0,0 +1,81 @@
+package kvs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs/kvstest"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestHashTag(t *testing.T) {
+	t.Parallel()
+
+	cluster := redis.NewClusterClient(&redis.ClusterOptions{Addrs: []string{"localhost:6379"}})
+
+	single := redis.NewClient(&redis.Options{Addr: "localhost:6379"})
+
+	t.Cleanup(func() {
+		_ = cluster.Close()
+		_ = single.Close()
+	})
+
+	type args struct {
+		client redis.UniversalClient
+		prefix string
+		key    string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Clusterの場合はキーをハッシュタグで囲む",
+			args: args{
+				client: cluster,
+				prefix: "auth",
+				key:    "uid",
+			},
+			want: "auth:{uid}",
+		},
+		{
+			name: "Clusterの場合は先頭の要素をハッシュタグで囲む",
+			args: args{
+				client: cluster,
+				prefix: "session",
+				key:    "uid:sid",
+			},
+			want: "session:{uid}:sid",
+		},
+		{
+			name: "Cluster以外の場合はハッシュタグを付与しない",
+			args: args{
+				client: single,
+				prefix: "session",
+				key:    "uid:sid",
+			},
+			want: "session:uid:sid",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			kv := &kvs.KVS[kvstest.KV]{
+				Prefix: tt.args.prefix,
+				Client: tt.args.client,
+			}
+			cmd, err := kv.CreateTxSetCmd(context.Background(), tt.args.key, kvstest.KV{}, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cmd.Key != tt.want {
+				t.Errorf("CreateTxSetCmd() key = %v, want %v", cmd.Key, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package kvs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ cache.Index = (*Index)(nil)
+
+// Index Redisのセットによる集合のインデックス.
+type Index struct {
+	Prefix string
+	Client redis.UniversalClient
+}
+
+func (idx *Index) Members(ctx context.Context, key string) ([]string, error) {
+	key = hashKey(idx.Client, idx.Prefix, key)
+
+	members, err := idx.Client.SMembers(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get members: %w", err)
+	}
+
+	return members, nil
+}
+
+func (idx *Index) CreateTxAddCmd(_ context.Context, key string, member string, ttl time.Duration) (cache.TxSetCmd, error) {
+	return cache.TxSetCmd{
+		Key:    hashKey(idx.Client, idx.Prefix, key),
+		TTL:    ttl,
+		Member: member,
+	}, nil
+}
+
+func (idx *Index) CreateTxRemCmd(_ context.Context, key string, member string) (cache.TxDelCmd, error) {
+	return cache.TxDelCmd{
+		Key:    hashKey(idx.Client, idx.Prefix, key),
+		Member: member,
+	}, nil
+}
+
+func (idx *Index) CreateTxDelCmd(_ context.Context, key string) (cache.TxDelCmd, error) {
+	return cache.TxDelCmd{
+		Key: hashKey(idx.Client, idx.Prefix, key),
+	}, nil
+}
@@ -2,10 +2,10 @@ package kvs
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/morning-night-guild/platform-app/internal/domain/cache"
@@ -17,23 +17,26 @@ import (
 const (
 	format    = "%s:%s"
 	keyFormat = "%s:%s*"
+	// scanCount SCANの1回あたりの走査件数の目安.
+	scanCount = 100
 )
 
 type Factory[T any] interface {
-	KVS(string, *redis.Client) (*KVS[T], error)
+	KVS(string, redis.UniversalClient) (*KVS[T], error)
 }
 
 var _ cache.Cache[any] = (*KVS[any])(nil)
 
 type KVS[T any] struct {
 	Prefix string
-	Client *redis.Client
+	Client redis.UniversalClient
+	Codec  Codec
 }
 
 func (kvs *KVS[T]) Get(ctx context.Context, key string) (T, error) {
 	var value T
 
-	key = fmt.Sprintf(format, kvs.Prefix, key)
+	key = hashKey(kvs.Client, kvs.Prefix, key)
 
 	str, err := kvs.Client.Get(ctx, key).Result()
 	if err != nil {
@@ -42,27 +45,20 @@ func (kvs *KVS[T]) Get(ctx context.Context, key string) (T, error) {
 		return value, errors.NewNotFoundError("failed to get cache", err)
 	}
 
-	dec, err := base64.StdEncoding.DecodeString(str)
-	if err != nil {
-		return value, fmt.Errorf("failed to decode base64: %w", err)
-	}
-
-	if err := json.Unmarshal(dec, &value); err != nil {
-		return value, fmt.Errorf("failed to unmarshal json: %w", err)
+	if err := Decode(str, &value); err != nil {
+		return value, err
 	}
 
 	return value, nil
 }
 
 func (kvs *KVS[T]) Set(ctx context.Context, key string, value T, ttl time.Duration) error {
-	val, err := json.Marshal(value)
+	enc, err := kvs.Codec.Encode(value)
 	if err != nil {
-		return fmt.Errorf("failed to marshal json: %w", err)
+		return err
 	}
 
-	key = fmt.Sprintf(format, kvs.Prefix, key)
-
-	enc := base64.StdEncoding.EncodeToString(val)
+	key = hashKey(kvs.Client, kvs.Prefix, key)
 
 	if err := kvs.Client.Set(ctx, key, enc, ttl).Err(); err != nil {
 		return fmt.Errorf("failed to set cache: %w", err)
@@ -72,7 +68,7 @@ func (kvs *KVS[T]) Set(ctx context.Context, key string, value T, ttl time.Durati
 }
 
 func (kvs *KVS[T]) Del(ctx context.Context, key string) error {
-	key = fmt.Sprintf(format, kvs.Prefix, key)
+	key = hashKey(kvs.Client, kvs.Prefix, key)
 
 	if _, err := kvs.Client.Del(ctx, key).Result(); err != nil {
 		return fmt.Errorf("failed to del cache: %w", err)
@@ -84,7 +80,7 @@ func (kvs *KVS[T]) Del(ctx context.Context, key string) error {
 func (kvs *KVS[T]) GetDel(ctx context.Context, key string) (T, error) {
 	var value T
 
-	key = fmt.Sprintf(format, kvs.Prefix, key)
+	key = hashKey(kvs.Client, kvs.Prefix, key)
 
 	str, err := kvs.Client.GetDel(ctx, key).Result()
 	if err != nil {
@@ -93,27 +89,20 @@ func (kvs *KVS[T]) GetDel(ctx context.Context, key string) (T, error) {
 		return value, errors.NewNotFoundError("failed to get cache", err)
 	}
 
-	dec, err := base64.StdEncoding.DecodeString(str)
-	if err != nil {
-		return value, fmt.Errorf("failed to decode base64: %w", err)
-	}
-
-	if err := json.Unmarshal(dec, &value); err != nil {
-		return value, fmt.Errorf("failed to unmarshal json: %w", err)
+	if err := Decode(str, &value); err != nil {
+		return value, err
 	}
 
 	return value, nil
 }
 
 func (kvs *KVS[T]) CreateTxSetCmd(_ context.Context, key string, value T, ttl time.Duration) (cache.TxSetCmd, error) {
-	val, err := json.Marshal(value)
+	enc, err := kvs.Codec.Encode(value)
 	if err != nil {
-		return cache.TxSetCmd{}, fmt.Errorf("failed to marshal json: %w", err)
+		return cache.TxSetCmd{}, err
 	}
 
-	key = fmt.Sprintf(format, kvs.Prefix, key)
-
-	enc := base64.StdEncoding.EncodeToString(val)
+	key = hashKey(kvs.Client, kvs.Prefix, key)
 
 	return cache.TxSetCmd{
 		Key:   key,
@@ -123,7 +112,7 @@ func (kvs *KVS[T]) CreateTxSetCmd(_ context.Context, key string, value T, ttl ti
 }
 
 func (kvs *KVS[T]) CreateTxDelCmd(_ context.Context, key string) (cache.TxDelCmd, error) {
-	key = fmt.Sprintf(format, kvs.Prefix, key)
+	key = hashKey(kvs.Client, kvs.Prefix, key)
 
 	return cache.TxDelCmd{
 		Key: key,
@@ -136,20 +125,25 @@ func (kvs *KVS[T]) Tx(
 	dels []cache.TxDelCmd,
 ) error {
 	if _, err := kvs.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
-		if len(sets) != 0 {
-			for _, set := range sets {
-				if err := pipe.Set(ctx, set.Key, set.Value, set.TTL).Err(); err != nil {
-					return fmt.Errorf("failed to set cache: %w", err)
-				}
+		for _, set := range sets {
+			if set.Member != "" {
+				pipe.SAdd(ctx, set.Key, set.Member)
+				pipe.Expire(ctx, set.Key, set.TTL)
+
+				continue
 			}
+
+			pipe.Set(ctx, set.Key, set.Value, set.TTL)
 		}
 
-		if len(dels) != 0 {
-			for _, del := range dels {
-				if err := pipe.Del(ctx, del.Key).Err(); err != nil {
-					return fmt.Errorf("failed to del cache: %w", err)
-				}
+		for _, del := range dels {
+			if del.Member != "" {
+				pipe.SRem(ctx, del.Key, del.Member)
+
+				continue
 			}
+
+			pipe.Del(ctx, del.Key)
 		}
 
 		return nil
@@ -167,9 +161,10 @@ func (kvs *KVS[T]) Keys(
 ) ([]string, error) {
 	ptn := fmt.Sprintf(keyFormat, kvs.Prefix, pattern)
 
-	keys, err := kvs.Client.Keys(ctx, ptn).Result()
+	// KEYSはキー空間全体を走査する間Redisをブロックするため、カーソルで分割して走査する
+	keys, err := kvs.scan(ctx, ptn)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get keys: %w", err)
+		return nil, err
 	}
 
 	if prefix {
@@ -179,8 +174,59 @@ func (kvs *KVS[T]) Keys(
 	res := make([]string, len(keys))
 
 	for i, key := range keys {
-		res[i] = strings.ReplaceAll(key, fmt.Sprintf("%s:", kvs.Prefix), "")
+		res[i] = strings.ReplaceAll(unhashKey(key), fmt.Sprintf("%s:", kvs.Prefix), "")
 	}
 
 	return res, nil
 }
+
+// scan パターンに一致するキーを走査する.
+// Redis Clusterの場合はすべてのマスターノードを走査し、ハッシュタグを取り除いたキーでパターンを照合する.
+func (kvs *KVS[T]) scan(ctx context.Context, ptn string) ([]string, error) {
+	cluster, ok := kvs.Client.(*redis.ClusterClient)
+	if !ok {
+		return scanNode(ctx, kvs.Client, ptn)
+	}
+
+	var mu sync.Mutex
+
+	keys := make([]string, 0)
+
+	if err := cluster.ForEachMaster(ctx, func(ctx context.Context, client *redis.Client) error {
+		nodeKeys, err := scanNode(ctx, client, fmt.Sprintf(keyFormat, kvs.Prefix, ""))
+		if err != nil {
+			return err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, key := range nodeKeys {
+			if ok, _ := path.Match(ptn, unhashKey(key)); ok {
+				keys = append(keys, key)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func scanNode(ctx context.Context, client redis.Cmdable, ptn string) ([]string, error) {
+	keys := make([]string, 0)
+
+	iter := client.Scan(ctx, 0, ptn, scanCount).Iterator()
+
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+
+	if err := iter.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan keys: %w", err)
+	}
+
+	return keys, nil
+}
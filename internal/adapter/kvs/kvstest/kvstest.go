@@ -0,0 +1,416 @@
+// Package kvstest cache.Cacheの実装が満たすべき振る舞いを検証するテストスイートを提供する.
+package kvstest
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// TTL 有効期限の検証に用いる有効期限.
+const TTL = 200 * time.Millisecond
+
+type KV struct {
+	Key   string
+	Value string
+}
+
+// Factory 指定したプレフィックスのキャッシュを生成する.
+// 同じテスト内で生成したキャッシュは保存先を共有していなければならない.
+type Factory func(t *testing.T, prefix string) cache.Cache[KV]
+
+// IndexFactory 指定したプレフィックスのインデックスを生成する.
+// Factoryで生成したキャッシュと保存先を共有していなければならない.
+type IndexFactory func(t *testing.T, prefix string) cache.Index
+
+func generateKV() KV {
+	return KV{
+		Key:   uuid.NewString(),
+		Value: uuid.NewString(),
+	}
+}
+
+// Run テストスイートを実行する.
+func Run(t *testing.T, factory Factory, indexFactory IndexFactory) { //nolint:funlen,gocognit,cyclop,maintidx
+	t.Helper()
+
+	t.Run("保存と取得ができる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		kv := generateKV()
+
+		if err := c.Set(ctx, kv.Key, kv, time.Hour); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+
+		got, err := c.Get(ctx, kv.Key)
+		if err != nil {
+			t.Fatalf("failed to get: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, kv) {
+			t.Errorf("Get() = %v, want %v", got, kv)
+		}
+	})
+
+	t.Run("存在しないキーはNotFoundErrorとなる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		if _, err := c.Get(ctx, uuid.NewString()); !errors.AsNotFoundError(err) {
+			t.Errorf("Get() error = %v, want NotFoundError", err)
+		}
+
+		if _, err := c.GetDel(ctx, uuid.NewString()); !errors.AsNotFoundError(err) {
+			t.Errorf("GetDel() error = %v, want NotFoundError", err)
+		}
+	})
+
+	t.Run("削除ができる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		kv := generateKV()
+
+		if err := c.Set(ctx, kv.Key, kv, time.Hour); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+
+		if err := c.Del(ctx, kv.Key); err != nil {
+			t.Fatalf("failed to del: %v", err)
+		}
+
+		if _, err := c.Get(ctx, kv.Key); !errors.AsNotFoundError(err) {
+			t.Errorf("Get() error = %v, want NotFoundError", err)
+		}
+
+		if err := c.Del(ctx, kv.Key); err != nil {
+			t.Errorf("Del() of missing key error = %v", err)
+		}
+	})
+
+	t.Run("GetDelで取得と同時に削除される", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		kv := generateKV()
+
+		if err := c.Set(ctx, kv.Key, kv, time.Hour); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+
+		got, err := c.GetDel(ctx, kv.Key)
+		if err != nil {
+			t.Fatalf("failed to get del: %v", err)
+		}
+
+		if !reflect.DeepEqual(got, kv) {
+			t.Errorf("GetDel() = %v, want %v", got, kv)
+		}
+
+		if _, err := c.Get(ctx, kv.Key); !errors.AsNotFoundError(err) {
+			t.Errorf("Get() error = %v, want NotFoundError", err)
+		}
+	})
+
+	t.Run("GetDelを並行に呼び出しても取得できるのは一度だけ", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		kv := generateKV()
+
+		if err := c.Set(ctx, kv.Key, kv, time.Hour); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+
+		const n = 16
+
+		var (
+			wg sync.WaitGroup
+			mu sync.Mutex
+		)
+
+		count := 0
+
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				if _, err := c.GetDel(ctx, kv.Key); err == nil {
+					mu.Lock()
+					count++
+					mu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if count != 1 {
+			t.Errorf("GetDel() succeeded %d times, want 1", count)
+		}
+	})
+
+	t.Run("有効期限を過ぎると取得できない", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		kv := generateKV()
+
+		if err := c.Set(ctx, kv.Key, kv, TTL); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+
+		if _, err := c.Get(ctx, kv.Key); err != nil {
+			t.Fatalf("failed to get before expiry: %v", err)
+		}
+
+		time.Sleep(2 * TTL)
+
+		if _, err := c.Get(ctx, kv.Key); !errors.AsNotFoundError(err) {
+			t.Errorf("Get() error = %v, want NotFoundError", err)
+		}
+
+		keys, err := c.Keys(ctx, kv.Key, cache.WithoutPrefix)
+		if err != nil {
+			t.Fatalf("failed to get keys: %v", err)
+		}
+
+		if len(keys) != 0 {
+			t.Errorf("Keys() = %v, want empty", keys)
+		}
+	})
+
+	t.Run("前方一致でキーを取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		prefix := uuid.NewString()
+
+		c := factory(t, prefix)
+
+		other := factory(t, uuid.NewString())
+
+		group := uuid.NewString()
+
+		want := []string{group + ":1", group + ":2"}
+
+		for _, key := range append([]string{uuid.NewString()}, want...) {
+			if err := c.Set(ctx, key, generateKV(), time.Hour); err != nil {
+				t.Fatalf("failed to set: %v", err)
+			}
+		}
+
+		// 別のプレフィックスのキーは含まれない
+		if err := other.Set(ctx, group+":3", generateKV(), time.Hour); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+
+		got, err := c.Keys(ctx, group, cache.WithoutPrefix)
+		if err != nil {
+			t.Fatalf("failed to get keys: %v", err)
+		}
+
+		sort.Strings(got)
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Keys(WithoutPrefix) = %v, want %v", got, want)
+		}
+
+		got, err = c.Keys(ctx, group, cache.WithPrefix)
+		if err != nil {
+			t.Fatalf("failed to get keys: %v", err)
+		}
+
+		sort.Strings(got)
+
+		wantWithPrefix := []string{prefix + ":" + want[0], prefix + ":" + want[1]}
+
+		if !reflect.DeepEqual(got, wantWithPrefix) {
+			t.Errorf("Keys(WithPrefix) = %v, want %v", got, wantWithPrefix)
+		}
+	})
+
+	t.Run("Txで異なるプレフィックスへの保存と削除をまとめて適用できる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		first := factory(t, uuid.NewString())
+
+		second := factory(t, uuid.NewString())
+
+		stale := generateKV()
+
+		if err := second.Set(ctx, stale.Key, stale, time.Hour); err != nil {
+			t.Fatalf("failed to set: %v", err)
+		}
+
+		fresh := generateKV()
+
+		fCmd, err := first.CreateTxSetCmd(ctx, fresh.Key, fresh, time.Hour)
+		if err != nil {
+			t.Fatalf("failed to create set cmd: %v", err)
+		}
+
+		sCmd, err := second.CreateTxSetCmd(ctx, fresh.Key, fresh, time.Hour)
+		if err != nil {
+			t.Fatalf("failed to create set cmd: %v", err)
+		}
+
+		dCmd, err := second.CreateTxDelCmd(ctx, stale.Key)
+		if err != nil {
+			t.Fatalf("failed to create del cmd: %v", err)
+		}
+
+		if err := first.Tx(ctx, []cache.TxSetCmd{fCmd, sCmd}, []cache.TxDelCmd{dCmd}); err != nil {
+			t.Fatalf("failed to tx: %v", err)
+		}
+
+		for _, c := range []cache.Cache[KV]{first, second} {
+			got, err := c.Get(ctx, fresh.Key)
+			if err != nil {
+				t.Fatalf("failed to get: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, fresh) {
+				t.Errorf("Get() = %v, want %v", got, fresh)
+			}
+		}
+
+		if _, err := second.Get(ctx, stale.Key); !errors.AsNotFoundError(err) {
+			t.Errorf("Get() error = %v, want NotFoundError", err)
+		}
+	})
+	t.Run("Txで集合への追加と削除ができる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		idx := indexFactory(t, uuid.NewString())
+
+		key := uuid.NewString()
+
+		kv := generateKV()
+
+		sCmd, err := c.CreateTxSetCmd(ctx, kv.Key, kv, time.Hour)
+		if err != nil {
+			t.Fatalf("failed to create set cmd: %v", err)
+		}
+
+		aCmd, err := idx.CreateTxAddCmd(ctx, key, "a", time.Hour)
+		if err != nil {
+			t.Fatalf("failed to create add cmd: %v", err)
+		}
+
+		bCmd, err := idx.CreateTxAddCmd(ctx, key, "b", time.Hour)
+		if err != nil {
+			t.Fatalf("failed to create add cmd: %v", err)
+		}
+
+		if err := c.Tx(ctx, []cache.TxSetCmd{sCmd, aCmd, bCmd}, []cache.TxDelCmd{}); err != nil {
+			t.Fatalf("failed to tx: %v", err)
+		}
+
+		assertMembers(t, idx, key, []string{"a", "b"})
+
+		if _, err := c.Get(ctx, kv.Key); err != nil {
+			t.Errorf("failed to get: %v", err)
+		}
+
+		rCmd, err := idx.CreateTxRemCmd(ctx, key, "a")
+		if err != nil {
+			t.Fatalf("failed to create rem cmd: %v", err)
+		}
+
+		if err := c.Tx(ctx, []cache.TxSetCmd{}, []cache.TxDelCmd{rCmd}); err != nil {
+			t.Fatalf("failed to tx: %v", err)
+		}
+
+		assertMembers(t, idx, key, []string{"b"})
+
+		dCmd, err := idx.CreateTxDelCmd(ctx, key)
+		if err != nil {
+			t.Fatalf("failed to create del cmd: %v", err)
+		}
+
+		if err := c.Tx(ctx, []cache.TxSetCmd{}, []cache.TxDelCmd{dCmd}); err != nil {
+			t.Fatalf("failed to tx: %v", err)
+		}
+
+		assertMembers(t, idx, key, []string{})
+	})
+
+	t.Run("集合は有効期限を過ぎると空になる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		c := factory(t, uuid.NewString())
+
+		idx := indexFactory(t, uuid.NewString())
+
+		key := uuid.NewString()
+
+		aCmd, err := idx.CreateTxAddCmd(ctx, key, "a", TTL)
+		if err != nil {
+			t.Fatalf("failed to create add cmd: %v", err)
+		}
+
+		if err := c.Tx(ctx, []cache.TxSetCmd{aCmd}, []cache.TxDelCmd{}); err != nil {
+			t.Fatalf("failed to tx: %v", err)
+		}
+
+		assertMembers(t, idx, key, []string{"a"})
+
+		time.Sleep(2 * TTL)
+
+		assertMembers(t, idx, key, []string{})
+	})
+}
+
+func assertMembers(t *testing.T, idx cache.Index, key string, want []string) {
+	t.Helper()
+
+	got, err := idx.Members(context.Background(), key)
+	if err != nil {
+		t.Fatalf("failed to get members: %v", err)
+	}
+
+	sort.Strings(got)
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Members() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,105 @@
+package kvstest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+)
+
+// LimiterFactory 指定したプレフィックスのレート制限を生成する.
+type LimiterFactory func(t *testing.T, prefix string) cache.Limiter
+
+// RunLimiter レート制限のテストスイートを実行する.
+func RunLimiter(t *testing.T, factory LimiterFactory) { //nolint:funlen
+	t.Helper()
+
+	t.Run("バースト数まで許可し超過すると拒否する", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		lmt := factory(t, uuid.NewString())
+
+		limit := cache.Limit{Rate: 1, Period: time.Hour, Burst: 3}
+
+		key := uuid.NewString()
+
+		for i := 0; i < limit.Burst; i++ {
+			res, err := lmt.Allow(ctx, key, limit)
+			if err != nil {
+				t.Fatalf("failed to allow: %v", err)
+			}
+
+			if !res.Allowed {
+				t.Fatalf("Allow() #%d denied", i)
+			}
+
+			if res.Remaining != limit.Burst-i-1 {
+				t.Errorf("Allow() #%d remaining = %d, want %d", i, res.Remaining, limit.Burst-i-1)
+			}
+		}
+
+		res, err := lmt.Allow(ctx, key, limit)
+		if err != nil {
+			t.Fatalf("failed to allow: %v", err)
+		}
+
+		if res.Allowed {
+			t.Error("Allow() allowed over burst")
+		}
+
+		if res.RetryAfter <= 0 || res.RetryAfter > time.Hour {
+			t.Errorf("Allow() retry after = %v", res.RetryAfter)
+		}
+	})
+
+	t.Run("キーごとに独立して判定する", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		lmt := factory(t, uuid.NewString())
+
+		limit := cache.Limit{Rate: 1, Period: time.Hour, Burst: 1}
+
+		for _, key := range []string{uuid.NewString(), uuid.NewString()} {
+			res, err := lmt.Allow(ctx, key, limit)
+			if err != nil {
+				t.Fatalf("failed to allow: %v", err)
+			}
+
+			if !res.Allowed {
+				t.Errorf("Allow() denied key %s", key)
+			}
+		}
+	})
+
+	t.Run("間隔が経過すると再び許可する", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		lmt := factory(t, uuid.NewString())
+
+		limit := cache.Limit{Rate: 10, Period: time.Second, Burst: 1}
+
+		key := uuid.NewString()
+
+		if res, err := lmt.Allow(ctx, key, limit); err != nil || !res.Allowed {
+			t.Fatalf("Allow() = %v, %v", res, err)
+		}
+
+		if res, err := lmt.Allow(ctx, key, limit); err != nil || res.Allowed {
+			t.Fatalf("Allow() = %v, %v", res, err)
+		}
+
+		time.Sleep(limit.Interval() + 50*time.Millisecond)
+
+		if res, err := lmt.Allow(ctx, key, limit); err != nil || !res.Allowed {
+			t.Errorf("Allow() = %v, %v", res, err)
+		}
+	})
+}
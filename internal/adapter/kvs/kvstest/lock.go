@@ -0,0 +1,86 @@
+package kvstest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+)
+
+// LockFactory 指定したプレフィックスのロックを生成する.
+type LockFactory func(t *testing.T, prefix string) cache.Lock
+
+// RunLock ロックのテストスイートを実行する.
+func RunLock(t *testing.T, factory LockFactory) {
+	t.Helper()
+
+	t.Run("取得済みのロックは取得できず解放すると取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		lck := factory(t, uuid.NewString())
+
+		key := uuid.NewString()
+
+		if ok, err := lck.TryLock(ctx, key, "owner", time.Hour); err != nil || !ok {
+			t.Fatalf("TryLock() = %v, %v", ok, err)
+		}
+
+		if ok, err := lck.TryLock(ctx, key, "other", time.Hour); err != nil || ok {
+			t.Fatalf("TryLock() = %v, %v", ok, err)
+		}
+
+		if err := lck.Unlock(ctx, key, "owner"); err != nil {
+			t.Fatalf("failed to unlock: %v", err)
+		}
+
+		if ok, err := lck.TryLock(ctx, key, "other", time.Hour); err != nil || !ok {
+			t.Errorf("TryLock() = %v, %v", ok, err)
+		}
+	})
+
+	t.Run("他の所有者のロックは解放しない", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		lck := factory(t, uuid.NewString())
+
+		key := uuid.NewString()
+
+		if ok, err := lck.TryLock(ctx, key, "owner", time.Hour); err != nil || !ok {
+			t.Fatalf("TryLock() = %v, %v", ok, err)
+		}
+
+		if err := lck.Unlock(ctx, key, "other"); err != nil {
+			t.Fatalf("failed to unlock: %v", err)
+		}
+
+		if ok, err := lck.TryLock(ctx, key, "other", time.Hour); err != nil || ok {
+			t.Errorf("TryLock() = %v, %v", ok, err)
+		}
+	})
+
+	t.Run("有効期限が経過するとロックを取得できる", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+
+		lck := factory(t, uuid.NewString())
+
+		key := uuid.NewString()
+
+		if ok, err := lck.TryLock(ctx, key, "owner", TTL); err != nil || !ok {
+			t.Fatalf("TryLock() = %v, %v", ok, err)
+		}
+
+		time.Sleep(TTL + 100*time.Millisecond)
+
+		if ok, err := lck.TryLock(ctx, key, "other", time.Hour); err != nil || !ok {
+			t.Errorf("TryLock() = %v, %v", ok, err)
+		}
+	})
+}
@@ -0,0 +1,121 @@
+package kvs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ cache.Limiter = (*Limiter)(nil)
+
+// gcraScript GCRA(Generic Cell Rate Algorithm)によるレート制限をRedis上で原子的に判定する.
+// KEYS[1]: キー
+// ARGV[1]: バースト数, ARGV[2]: 許可する間隔(マイクロ秒)
+// 戻り値: {許可したか, 残り回数, 再試行までの時間(マイクロ秒), 上限まで回復する時間(マイクロ秒)}.
+var gcraScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local tolerance = interval * burst
+
+local time = redis.call("TIME")
+local now = tonumber(time[1]) * 1000000 + tonumber(time[2])
+
+local tat = tonumber(redis.call("GET", KEYS[1]))
+if tat == nil or tat < now then
+  tat = now
+end
+
+local next_tat = tat + interval
+local allow_at = next_tat - tolerance
+
+if now < allow_at then
+  return {0, 0, allow_at - now, tat - now}
+end
+
+redis.call("SET", KEYS[1], next_tat, "PX", math.ceil((next_tat - now) / 1000))
+
+local remaining = math.floor((now - allow_at) / interval)
+
+return {1, remaining, 0, next_tat - now}
+`) //nolint:gochecknoglobals
+
+// Limiter Redisによるレート制限.
+// 複数のインスタンスで同じ上限を共有する.
+type Limiter struct {
+	Prefix string
+	Client redis.UniversalClient
+}
+
+func (lmt *Limiter) Allow(ctx context.Context, key string, limit cache.Limit) (cache.LimitResult, error) {
+	key = hashKey(lmt.Client, lmt.Prefix, key)
+
+	res, err := gcraScript.Run(ctx, lmt.Client, []string{key}, limit.Burst, limit.Interval().Microseconds()).Int64Slice()
+	if err != nil {
+		return cache.LimitResult{}, fmt.Errorf("failed to run rate limit script: %w", err)
+	}
+
+	return cache.LimitResult{
+		Allowed:    res[0] == 1,
+		Limit:      limit.Burst,
+		Remaining:  int(res[1]),
+		RetryAfter: time.Duration(res[2]) * time.Microsecond,
+		ResetAfter: time.Duration(res[3]) * time.Microsecond,
+	}, nil
+}
+
+var _ cache.Limiter = (*MemoryLimiter)(nil)
+
+// MemoryLimiter インメモリのレート制限.
+// 上限はプロセスごとに独立するため、Redisを用いない単一ノードでの動作を目的とする.
+type MemoryLimiter struct {
+	Prefix string
+	Store  *Store
+}
+
+func (lmt *MemoryLimiter) Allow(_ context.Context, key string, limit cache.Limit) (cache.LimitResult, error) {
+	key = fmt.Sprintf(format, lmt.Prefix, key)
+
+	interval := limit.Interval()
+
+	tolerance := interval * time.Duration(limit.Burst)
+
+	lmt.Store.mu.Lock()
+	defer lmt.Store.mu.Unlock()
+
+	now := lmt.Store.now()
+
+	tat := now
+
+	if val, ok := lmt.Store.get(key); ok {
+		if stored, err := time.Parse(time.RFC3339Nano, val); err == nil && stored.After(now) {
+			tat = stored
+		}
+	}
+
+	next := tat.Add(interval)
+
+	allowAt := next.Add(-tolerance)
+
+	if now.Before(allowAt) {
+		return cache.LimitResult{
+			Allowed:    false,
+			Limit:      limit.Burst,
+			Remaining:  0,
+			RetryAfter: allowAt.Sub(now),
+			ResetAfter: tat.Sub(now),
+		}, nil
+	}
+
+	lmt.Store.set(key, next.Format(time.RFC3339Nano), next.Sub(now))
+
+	return cache.LimitResult{
+		Allowed:    true,
+		Limit:      limit.Burst,
+		Remaining:  int(now.Sub(allowAt) / interval),
+		RetryAfter: 0,
+		ResetAfter: next.Sub(now),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package kvs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/redis/go-redis/v9"
+)
+
+var _ cache.Lock = (*Lock)(nil)
+
+// unlockScript 所有者のトークンが一致する場合のみロックを削除する.
+// KEYS[1]: キー
+// ARGV[1]: 所有者のトークン
+// 戻り値: 削除したキーの数.
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+  return redis.call("DEL", KEYS[1])
+end
+
+return 0
+`) //nolint:gochecknoglobals
+
+// Lock RedisのSET NXによるロック.
+type Lock struct {
+	Prefix string
+	Client redis.UniversalClient
+}
+
+func (lck *Lock) TryLock(ctx context.Context, key string, token string, ttl time.Duration) (bool, error) {
+	key = hashKey(lck.Client, lck.Prefix, key)
+
+	ok, err := lck.Client.SetNX(ctx, key, token, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to lock: %w", err)
+	}
+
+	return ok, nil
+}
+
+func (lck *Lock) Unlock(ctx context.Context, key string, token string) error {
+	key = hashKey(lck.Client, lck.Prefix, key)
+
+	if err := unlockScript.Run(ctx, lck.Client, []string{key}, token).Err(); err != nil {
+		return fmt.Errorf("failed to unlock: %w", err)
+	}
+
+	return nil
+}
+
+var _ cache.Lock = (*MemoryLock)(nil)
+
+// MemoryLock インメモリのロック.
+type MemoryLock struct {
+	Prefix string
+	Store  *Store
+}
+
+func (lck *MemoryLock) TryLock(_ context.Context, key string, token string, ttl time.Duration) (bool, error) {
+	key = fmt.Sprintf(format, lck.Prefix, key)
+
+	lck.Store.mu.Lock()
+	defer lck.Store.mu.Unlock()
+
+	if _, ok := lck.Store.get(key); ok {
+		return false, nil
+	}
+
+	lck.Store.set(key, token, ttl)
+
+	return true, nil
+}
+
+func (lck *MemoryLock) Unlock(_ context.Context, key string, token string) error {
+	key = fmt.Sprintf(format, lck.Prefix, key)
+
+	lck.Store.mu.Lock()
+	defer lck.Store.mu.Unlock()
+
+	if value, ok := lck.Store.get(key); ok && value == token {
+		delete(lck.Store.entries, key)
+	}
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package kvs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+type MemoryFactory[T any] interface {
+	Memory(string, *Store) (*Memory[T], error)
+}
+
+type entry struct {
+	value     string
+	members   map[string]struct{}
+	expiresAt time.Time
+}
+
+func (e entry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// Store 複数のMemoryで共有するインメモリの保存先.
+// Redisのクライアントと同様に、異なるプレフィックスのMemoryが同じStoreを共有することでTxを跨いで適用できる.
+type Store struct {
+	mu      sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+func NewStore() *Store {
+	return &Store{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+// Run 有効期限切れのエントリを定期的に削除する.
+// 有効期限は参照時にも判定するため、これはメモリの解放のみを目的とする.
+func (s *Store) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep()
+		}
+	}
+}
+
+func (s *Store) sweep() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	for key, e := range s.entries {
+		if e.expired(now) {
+			delete(s.entries, key)
+		}
+	}
+}
+
+// get ロック取得済みの状態で呼び出すこと.
+func (s *Store) get(key string) (string, bool) {
+	e, ok := s.entries[key]
+	if !ok || e.members != nil {
+		return "", false
+	}
+
+	if e.expired(s.now()) {
+		delete(s.entries, key)
+
+		return "", false
+	}
+
+	return e.value, true
+}
+
+// set ロック取得済みの状態で呼び出すこと.
+// ttlが0以下の場合はRedisと同様に有効期限を設けない.
+func (s *Store) set(key string, value string, ttl time.Duration) {
+	e := entry{
+		value: value,
+	}
+
+	if ttl > 0 {
+		e.expiresAt = s.now().Add(ttl)
+	}
+
+	s.entries[key] = e
+}
+
+// add ロック取得済みの状態で呼び出すこと.
+func (s *Store) add(key string, member string, ttl time.Duration) {
+	e, ok := s.entries[key]
+	if !ok || e.expired(s.now()) || e.members == nil {
+		e = entry{
+			members: make(map[string]struct{}),
+		}
+	}
+
+	e.members[member] = struct{}{}
+
+	e.expiresAt = time.Time{}
+
+	if ttl > 0 {
+		e.expiresAt = s.now().Add(ttl)
+	}
+
+	s.entries[key] = e
+}
+
+// rem ロック取得済みの状態で呼び出すこと.
+func (s *Store) rem(key string, member string) {
+	e, ok := s.entries[key]
+	if !ok || e.members == nil {
+		return
+	}
+
+	delete(e.members, member)
+
+	// Redisと同様に空になった集合は削除する
+	if len(e.members) == 0 {
+		delete(s.entries, key)
+	}
+}
+
+// apply ロック取得済みの状態で呼び出すこと.
+func (s *Store) apply(sets []cache.TxSetCmd, dels []cache.TxDelCmd) {
+	for _, set := range sets {
+		if set.Member != "" {
+			s.add(set.Key, set.Member, set.TTL)
+
+			continue
+		}
+
+		s.set(set.Key, set.Value, set.TTL)
+	}
+
+	for _, del := range dels {
+		if del.Member != "" {
+			s.rem(del.Key, del.Member)
+
+			continue
+		}
+
+		delete(s.entries, del.Key)
+	}
+}
+
+var _ cache.Cache[any] = (*Memory[any])(nil)
+
+// Memory インメモリのキャッシュ.
+// テストやRedisを用意しない単一ノードでの動作を目的とする.
+type Memory[T any] struct {
+	Prefix string
+	Store  *Store
+	Codec  Codec
+}
+
+func (mem *Memory[T]) Get(_ context.Context, key string) (T, error) {
+	var value T
+
+	key = fmt.Sprintf(format, mem.Prefix, key)
+
+	mem.Store.mu.Lock()
+	str, ok := mem.Store.get(key)
+	mem.Store.mu.Unlock()
+
+	if !ok {
+		return value, errors.NewNotFoundError("failed to get cache")
+	}
+
+	return decode[T](str)
+}
+
+func (mem *Memory[T]) Set(_ context.Context, key string, value T, ttl time.Duration) error {
+	enc, err := mem.Codec.Encode(value)
+	if err != nil {
+		return err
+	}
+
+	key = fmt.Sprintf(format, mem.Prefix, key)
+
+	mem.Store.mu.Lock()
+	defer mem.Store.mu.Unlock()
+
+	mem.Store.set(key, enc, ttl)
+
+	return nil
+}
+
+func (mem *Memory[T]) Del(_ context.Context, key string) error {
+	key = fmt.Sprintf(format, mem.Prefix, key)
+
+	mem.Store.mu.Lock()
+	defer mem.Store.mu.Unlock()
+
+	delete(mem.Store.entries, key)
+
+	return nil
+}
+
+func (mem *Memory[T]) GetDel(_ context.Context, key string) (T, error) {
+	var value T
+
+	key = fmt.Sprintf(format, mem.Prefix, key)
+
+	mem.Store.mu.Lock()
+	str, ok := mem.Store.get(key)
+	delete(mem.Store.entries, key)
+	mem.Store.mu.Unlock()
+
+	if !ok {
+		return value, errors.NewNotFoundError("failed to get cache")
+	}
+
+	return decode[T](str)
+}
+
+func (mem *Memory[T]) CreateTxSetCmd(_ context.Context, key string, value T, ttl time.Duration) (cache.TxSetCmd, error) {
+	enc, err := mem.Codec.Encode(value)
+	if err != nil {
+		return cache.TxSetCmd{}, err
+	}
+
+	return cache.TxSetCmd{
+		Key:   fmt.Sprintf(format, mem.Prefix, key),
+		Value: enc,
+		TTL:   ttl,
+	}, nil
+}
+
+func (mem *Memory[T]) CreateTxDelCmd(_ context.Context, key string) (cache.TxDelCmd, error) {
+	return cache.TxDelCmd{
+		Key: fmt.Sprintf(format, mem.Prefix, key),
+	}, nil
+}
+
+// Tx 保存と削除をまとめて適用する.
+// コマンドは生成元のMemoryのプレフィックスを含むため、Storeを共有する他のMemoryのコマンドも適用できる.
+func (mem *Memory[T]) Tx(
+	_ context.Context,
+	sets []cache.TxSetCmd,
+	dels []cache.TxDelCmd,
+) error {
+	mem.Store.mu.Lock()
+	defer mem.Store.mu.Unlock()
+
+	mem.Store.apply(sets, dels)
+
+	return nil
+}
+
+// Keys 前方一致するキーを取得する.
+// Redisと異なり、patternに含まれるglobの特殊文字は通常の文字として扱う.
+func (mem *Memory[T]) Keys(
+	_ context.Context,
+	pattern string,
+	prefix cache.Prefix,
+) ([]string, error) {
+	ptn := fmt.Sprintf(format, mem.Prefix, pattern)
+
+	mem.Store.mu.Lock()
+	defer mem.Store.mu.Unlock()
+
+	keys := make([]string, 0)
+
+	now := mem.Store.now()
+
+	for key, e := range mem.Store.entries {
+		if !strings.HasPrefix(key, ptn) || e.expired(now) {
+			continue
+		}
+
+		if prefix {
+			keys = append(keys, key)
+		} else {
+			keys = append(keys, strings.TrimPrefix(key, fmt.Sprintf("%s:", mem.Prefix)))
+		}
+	}
+
+	return keys, nil
+}
+
+var _ cache.Index = (*MemoryIndex)(nil)
+
+// MemoryIndex インメモリの集合のインデックス.
+type MemoryIndex struct {
+	Prefix string
+	Store  *Store
+}
+
+func (idx *MemoryIndex) Members(_ context.Context, key string) ([]string, error) {
+	key = fmt.Sprintf(format, idx.Prefix, key)
+
+	idx.Store.mu.Lock()
+	defer idx.Store.mu.Unlock()
+
+	e, ok := idx.Store.entries[key]
+	if !ok || e.members == nil || e.expired(idx.Store.now()) {
+		return []string{}, nil
+	}
+
+	members := make([]string, 0, len(e.members))
+
+	for member := range e.members {
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+func (idx *MemoryIndex) CreateTxAddCmd(_ context.Context, key string, member string, ttl time.Duration) (cache.TxSetCmd, error) {
+	return cache.TxSetCmd{
+		Key:    fmt.Sprintf(format, idx.Prefix, key),
+		TTL:    ttl,
+		Member: member,
+	}, nil
+}
+
+func (idx *MemoryIndex) CreateTxRemCmd(_ context.Context, key string, member string) (cache.TxDelCmd, error) {
+	return cache.TxDelCmd{
+		Key:    fmt.Sprintf(format, idx.Prefix, key),
+		Member: member,
+	}, nil
+}
+
+func (idx *MemoryIndex) CreateTxDelCmd(_ context.Context, key string) (cache.TxDelCmd, error) {
+	return cache.TxDelCmd{
+		Key: fmt.Sprintf(format, idx.Prefix, key),
+	}, nil
+}
+
+func decode[T any](str string) (T, error) {
+	var value T
+
+	if err := Decode(str, &value); err != nil {
+		return value, err
+	}
+
+	return value, nil
+}
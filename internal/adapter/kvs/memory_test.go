@@ -0,0 +1,61 @@
+package kvs_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs/kvstest"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+)
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	store := kvs.NewStore()
+
+	kvstest.Run(t, func(t *testing.T, prefix string) cache.Cache[kvstest.KV] {
+		t.Helper()
+
+		return &kvs.Memory[kvstest.KV]{
+			Prefix: prefix,
+			Store:  store,
+		}
+	}, func(t *testing.T, prefix string) cache.Index {
+		t.Helper()
+
+		return &kvs.MemoryIndex{
+			Prefix: prefix,
+			Store:  store,
+		}
+	})
+}
+
+func TestMemoryLimiter(t *testing.T) {
+	t.Parallel()
+
+	store := kvs.NewStore()
+
+	kvstest.RunLimiter(t, func(t *testing.T, prefix string) cache.Limiter {
+		t.Helper()
+
+		return &kvs.MemoryLimiter{
+			Prefix: prefix,
+			Store:  store,
+		}
+	})
+}
+
+func TestMemoryLock(t *testing.T) {
+	t.Parallel()
+
+	store := kvs.NewStore()
+
+	kvstest.RunLock(t, func(t *testing.T, prefix string) cache.Lock {
+		t.Helper()
+
+		return &kvs.MemoryLock{
+			Prefix: prefix,
+			Store:  store,
+		}
+	})
+}
@@ -2,31 +2,73 @@ package interactor
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
 	"github.com/morning-night-guild/platform-app/internal/domain/cache"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
 	"github.com/morning-night-guild/platform-app/pkg/log"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// articleListCacheTTL 記事一覧のキャッシュの有効期間.
+	articleListCacheTTL = 30 * time.Second
+	// articleListVersionTTL 記事一覧のバージョンの有効期間.
+	// 一覧のキャッシュより長く保持し、失効により古い一覧を参照しないようにする.
+	articleListVersionTTL = 24 * time.Hour
+	// articleListAllKey 全体の記事一覧のバージョンのキー.
+	articleListAllKey = "all"
+	// articleListDeleteKey 記事の削除や復元、共有者の情報の変更により更新するバージョンのキー.
+	articleListDeleteKey = "delete"
+	// articleListOwnKeyFormat ユーザーの記事一覧のバージョンのキー.
+	articleListOwnKeyFormat = "own:%s"
+	// articleListCacheName メトリクスに用いるキャッシュの名前.
+	articleListCacheName = "article_list"
+	// articleListPrimaryWindow 無効化の後に一覧をライターから取得する期間.
+	// レプリカの遅延の上限として想定する期間で、遅延した一覧を新しいバージョンでキャッシュしないようにする.
+	articleListPrimaryWindow = 10 * time.Second
+	// articleListFetchTimeout まとめて行う記事一覧の取得とキャッシュの保存の期限.
+	articleListFetchTimeout = 10 * time.Second
 )
 
 var _ usecase.APIArticle = (*APIArticle)(nil)
 
 // APIArticle.
+// 記事一覧はバージョンをキーに含めてキャッシュし、共有や削除の際はバージョンを更新して無効化する.
+// 無効化の直後の一覧はレプリカの遅延により更新が反映されていない場合があるため、ライターから取得する.
 type APIArticle struct {
-	authCache  cache.Cache[model.Auth]
-	articleRPC rpc.Article
+	authCache        cache.Cache[model.Auth]
+	articleRPC       rpc.Article
+	listCache        cache.Cache[[]model.Article]
+	listVersionCache cache.Cache[string]
+	metrics          cache.Metrics
+	group            *singleflight.Group
 }
 
 func NewAPIArticle(
 	authCache cache.Cache[model.Auth],
 	articleRPC rpc.Article,
+	listCache cache.Cache[[]model.Article],
+	listVersionCache cache.Cache[string],
+	metrics cache.Metrics,
 ) *APIArticle {
 	return &APIArticle{
-		authCache:  authCache,
-		articleRPC: articleRPC,
+		authCache:        authCache,
+		articleRPC:       articleRPC,
+		listCache:        listCache,
+		listVersionCache: listVersionCache,
+		metrics:          metrics,
+		group:            &singleflight.Group{},
 	}
 }
 
@@ -34,11 +76,13 @@ func (itr *APIArticle) Share(
 	ctx context.Context,
 	input usecase.APIArticleShareInput,
 ) (usecase.APIArticleShareOutput, error) {
-	article, err := itr.articleRPC.Share(ctx, input.URL, input.Title, input.Description, input.Thumbnail)
+	article, err := itr.articleRPC.Share(ctx, input.URL, input.Title, input.Description, input.Thumbnail, input.SharedBy)
 	if err != nil {
 		return usecase.APIArticleShareOutput{}, err
 	}
 
+	itr.invalidateList(ctx, articleListAllKey)
+
 	return usecase.APIArticleShareOutput{
 		Article: article,
 	}, nil
@@ -61,7 +105,11 @@ func (itr *APIArticle) List(
 
 	switch input.Scope {
 	case article.All:
-		articles, err := itr.articleRPC.List(ctx, input.Index, input.Size, input.Filter...)
+		key, fctx := itr.listKey(ctx, []string{articleListAllKey}, input.Index, input.Size, input.Filter)
+
+		articles, err := itr.readThrough(fctx, key, func(ctx context.Context) ([]model.Article, error) {
+			return itr.articleRPC.List(ctx, input.Index, input.Size, input.Filter...)
+		})
 		if err != nil {
 			return usecase.APIArticleListOutput{}, err
 		}
@@ -70,7 +118,14 @@ func (itr *APIArticle) List(
 			Articles: articles,
 		}, nil
 	case article.Own:
-		articles, err := itr.articleRPC.ListByUser(ctx, input.UserID, input.Index, input.Size, input.Filter...)
+		// 記事の削除はすべてのユーザーの一覧に影響するため、削除のバージョンもキーに含める
+		own := fmt.Sprintf(articleListOwnKeyFormat, input.UserID.String())
+
+		key, fctx := itr.listKey(ctx, []string{articleListDeleteKey, own}, input.Index, input.Size, input.Filter)
+
+		articles, err := itr.readThrough(fctx, key, func(ctx context.Context) ([]model.Article, error) {
+			return itr.articleRPC.ListByUser(ctx, input.UserID, input.Index, input.Size, input.Filter...)
+		})
 		if err != nil {
 			return usecase.APIArticleListOutput{}, err
 		}
@@ -91,9 +146,41 @@ func (itr *APIArticle) Delete(
 		return usecase.APIArticleDeleteOutput{}, err
 	}
 
+	itr.invalidateList(ctx, articleListAllKey)
+	itr.invalidateList(ctx, articleListDeleteKey)
+
 	return usecase.APIArticleDeleteOutput{}, nil
 }
 
+func (itr *APIArticle) ListTrash(
+	ctx context.Context,
+	input usecase.APIArticleListTrashInput,
+) (usecase.APIArticleListTrashOutput, error) {
+	articles, err := itr.articleRPC.ListTrash(ctx, input.Index, input.Size)
+	if err != nil {
+		return usecase.APIArticleListTrashOutput{}, err
+	}
+
+	return usecase.APIArticleListTrashOutput{
+		Articles: articles,
+	}, nil
+}
+
+func (itr *APIArticle) Restore(
+	ctx context.Context,
+	input usecase.APIArticleRestoreInput,
+) (usecase.APIArticleRestoreOutput, error) {
+	if err := itr.articleRPC.Restore(ctx, input.ArticleID); err != nil {
+		return usecase.APIArticleRestoreOutput{}, err
+	}
+
+	// 復元した記事は全体の一覧と、ユーザーとの紐付けが残っている各ユーザーの一覧に戻る
+	itr.invalidateList(ctx, articleListAllKey)
+	itr.invalidateList(ctx, articleListDeleteKey)
+
+	return usecase.APIArticleRestoreOutput{}, nil
+}
+
 func (itr *APIArticle) AddToUser(
 	ctx context.Context,
 	input usecase.APIArticleAddToUserInput,
@@ -113,6 +200,8 @@ func (itr *APIArticle) AddToUser(
 		return usecase.APIArticleAddToUserOutput{}, err
 	}
 
+	itr.invalidateOwnList(ctx, input.UserID)
+
 	return usecase.APIArticleAddToUserOutput{}, nil
 }
 
@@ -135,5 +224,143 @@ func (itr *APIArticle) RemoveFromUser(
 		return usecase.APIArticleRemoveFromUserOutput{}, err
 	}
 
+	itr.invalidateOwnList(ctx, input.UserID)
+
 	return usecase.APIArticleRemoveFromUserOutput{}, nil
 }
+
+// readThrough キャッシュから記事一覧を取得し、存在しない場合はfetchで取得してキャッシュする.
+// 同じキーへの同時の取得はsingleflightで1回にまとめる.
+// まとめた取得は最初の呼び出し元のキャンセルに左右されないよう、値のみを引き継いだコンテキストで行う.
+func (itr *APIArticle) readThrough(
+	ctx context.Context,
+	key string,
+	fetch func(context.Context) ([]model.Article, error),
+) ([]model.Article, error) {
+	if articles, err := itr.listCache.Get(ctx, key); err == nil {
+		itr.metrics.Hit(articleListCacheName)
+
+		return articles, nil
+	}
+
+	itr.metrics.Miss(articleListCacheName)
+
+	ch := itr.group.DoChan(key, func() (interface{}, error) {
+		fctx, cancel := context.WithTimeout(detachedContext{Context: ctx}, articleListFetchTimeout)
+		defer cancel()
+
+		articles, err := fetch(fctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := itr.listCache.Set(fctx, key, articles, articleListCacheTTL); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to set article list cache", log.ErrorField(err))
+		}
+
+		return articles, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		articles, _ := res.Val.([]model.Article)
+
+		return articles, nil
+	}
+}
+
+// detachedContext 値のみを引き継ぎ、キャンセルと期限を引き継がないコンテキスト.
+type detachedContext struct {
+	context.Context //nolint:containedctx
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+// listKey 記事一覧のキャッシュのキーと、一覧の取得に用いるコンテキストを作成する.
+// キーには各バージョンとページング、フィルターを含める.
+// いずれかのバージョンがarticleListPrimaryWindow以内に更新されている場合は、ライターから取得するコンテキストを返す.
+func (itr *APIArticle) listKey(
+	ctx context.Context,
+	versions []string,
+	index value.Index,
+	size value.Size,
+	filter []value.Filter,
+) (string, context.Context) {
+	elems := make([]string, 0, len(versions)*2+3)
+
+	fctx := ctx
+
+	for _, key := range versions {
+		version, err := itr.listVersionCache.Get(ctx, key)
+		if err != nil {
+			// バージョンが存在しない場合は初期のバージョンとして扱う
+			version = ""
+		}
+
+		// バージョンは無効化した時刻のため、直後であればレプリカに未反映の可能性がある
+		if invalidatedAt, err := strconv.ParseInt(version, 10, 64); err == nil &&
+			time.Since(time.Unix(0, invalidatedAt)) < articleListPrimaryWindow {
+			fctx = rpc.SetPrimaryReadCtx(ctx)
+		}
+
+		elems = append(elems, key, version)
+	}
+
+	query := url.Values{}
+
+	for _, f := range filter {
+		query.Add(f.Name, f.Value)
+	}
+
+	elems = append(elems, strconv.Itoa(index.Int()), strconv.Itoa(size.Int()), url.QueryEscape(query.Encode()))
+
+	return strings.Join(elems, ":"), fctx
+}
+
+// invalidateList バージョンを更新して記事一覧のキャッシュを無効化する.
+func (itr *APIArticle) invalidateList(ctx context.Context, key string) {
+	if err := itr.listVersionCache.Set(ctx, key, newArticleListVersion(), articleListVersionTTL); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to invalidate article list cache", log.ErrorField(err))
+	}
+}
+
+// invalidateOwnList ユーザーの記事一覧のキャッシュを無効化する.
+func (itr *APIArticle) invalidateOwnList(ctx context.Context, userID user.ID) {
+	itr.invalidateList(ctx, fmt.Sprintf(articleListOwnKeyFormat, userID.String()))
+}
+
+// InvalidateArticleLists 全体の記事一覧とすべてのユーザーの記事一覧のキャッシュを無効化する.
+// 記事の共有者の表示名の変更やユーザーの完全な削除など、記事の共有者の情報が変わった際に用いる.
+func InvalidateArticleLists(ctx context.Context, listVersionCache cache.Cache[string]) error {
+	version := newArticleListVersion()
+
+	// ユーザーの記事一覧のキーにはいずれも削除のバージョンを含むため、まとめて無効化できる
+	for _, key := range []string{articleListAllKey, articleListDeleteKey} {
+		if err := listVersionCache.Set(ctx, key, version, articleListVersionTTL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// newArticleListVersion 無効化した時刻から記事一覧のバージョンを作成する.
+func newArticleListVersion() string {
+	return strconv.FormatInt(time.Now().UnixNano(), 10)
+}
@@ -2,13 +2,16 @@ package interactor_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
 	"github.com/morning-night-guild/platform-app/internal/application/interactor"
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
 	"github.com/morning-night-guild/platform-app/internal/domain/cache"
@@ -54,6 +57,7 @@ func TestAPIArticleShare(t *testing.T) {
 						article.Title("title"),
 						article.Description("description"),
 						article.Thumbnail("https://example.com"),
+						(*user.ID)(nil),
 					).Return(model.Article{
 						ArticleID:   id,
 						Title:       article.Title("title"),
@@ -97,6 +101,7 @@ func TestAPIArticleShare(t *testing.T) {
 						article.Title("title"),
 						article.Description("description"),
 						article.Thumbnail("https://example.com"),
+						(*user.ID)(nil),
 					).Return(model.Article{}, fmt.Errorf("error"))
 					return mock
 				},
@@ -124,6 +129,9 @@ func TestAPIArticleShare(t *testing.T) {
 			itr := interactor.NewAPIArticle(
 				tt.fields.authCache,
 				tt.fields.articleRPC(t),
+				ArticleListCache(),
+				ArticleListVersionCache(),
+				&cache.MetricsMock{},
 			)
 			got, err := itr.Share(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -451,6 +459,9 @@ func TestAPIArticleList(t *testing.T) {
 			itr := interactor.NewAPIArticle(
 				tt.fields.authCache,
 				tt.fields.articleRPC(t),
+				ArticleListCache(),
+				ArticleListVersionCache(),
+				&cache.MetricsMock{},
 			)
 			got, err := itr.List(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -569,6 +580,9 @@ func TestAPIArticleAddToUser(t *testing.T) {
 			itr := interactor.NewAPIArticle(
 				tt.fields.authCache,
 				tt.fields.articleRPC(t),
+				ArticleListCache(),
+				ArticleListVersionCache(),
+				&cache.MetricsMock{},
 			)
 			got, err := itr.AddToUser(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -687,6 +701,9 @@ func TestAPIArticleRemoveFromUser(t *testing.T) {
 			itr := interactor.NewAPIArticle(
 				tt.fields.authCache,
 				tt.fields.articleRPC(t),
+				ArticleListCache(),
+				ArticleListVersionCache(),
+				&cache.MetricsMock{},
 			)
 			got, err := itr.RemoveFromUser(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -699,3 +716,343 @@ func TestAPIArticleRemoveFromUser(t *testing.T) {
 		})
 	}
 }
+
+func ArticleListCache() cache.Cache[[]model.Article] {
+	return &kvs.Memory[[]model.Article]{
+		Prefix: "article_list",
+		Store:  kvs.NewStore(),
+	}
+}
+
+func ArticleListVersionCache() cache.Cache[string] {
+	return &kvs.Memory[string]{
+		Prefix: "article_list_version",
+		Store:  kvs.NewStore(),
+	}
+}
+
+func TestAPIArticleListCache(t *testing.T) {
+	t.Parallel()
+
+	uid := user.GenerateID()
+
+	now := time.Now()
+
+	authCache := &cache.CacheMock[model.Auth]{
+		T: t,
+		Value: model.Auth{
+			AuthID:    uid,
+			UserID:    uid,
+			IssuedAt:  now,
+			ExpiresAt: now.Add(time.Hour),
+		},
+		GetAssert: func(t *testing.T, key string) {
+			t.Helper()
+		},
+	}
+
+	articles := []model.Article{
+		{
+			ArticleID:   article.GenerateID(),
+			Title:       article.Title("title"),
+			URL:         article.URL("https://example.com"),
+			Description: article.Description("description"),
+			Thumbnail:   article.Thumbnail("https://example.com"),
+			TagList:     article.TagList{article.Tag("tag")},
+		},
+	}
+
+	all := usecase.APIArticleListInput{
+		UserID: uid,
+		Scope:  article.All,
+		Index:  value.Index(0),
+		Size:   value.Size(20),
+	}
+
+	own := usecase.APIArticleListInput{
+		UserID: uid,
+		Scope:  article.Own,
+		Index:  value.Index(0),
+		Size:   value.Size(20),
+	}
+
+	t.Run("同じ条件の記事一覧はキャッシュから取得できる", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil).Times(1)
+		metrics := &cache.MetricsMock{}
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), metrics)
+		for i := 0; i < 3; i++ {
+			got, err := itr.List(context.Background(), all)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Articles, articles) {
+				t.Errorf("APIArticle.List() = %v, want %v", got.Articles, articles)
+			}
+		}
+		if metrics.Hits["article_list"] != 2 || metrics.Misses["article_list"] != 1 {
+			t.Errorf("hits = %v, misses = %v", metrics.Hits, metrics.Misses)
+		}
+	})
+
+	t.Run("フィルターが異なる記事一覧は別にキャッシュされる", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		filter := value.NewFilter("title", "go")
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil).Times(1)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20), filter).Return(articles, nil).Times(1)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		filtered := all
+		filtered.Filter = []value.Filter{filter}
+		for _, input := range []usecase.APIArticleListInput{all, filtered, all, filtered} {
+			if _, err := itr.List(context.Background(), input); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	t.Run("記事を共有すると全体の記事一覧のキャッシュが無効化される", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil).Times(2)
+		mock.EXPECT().ListByUser(gomock.Any(), uid, value.Index(0), value.Size(20)).Return(articles, nil).Times(1)
+		mock.EXPECT().Share(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(articles[0], nil)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		ctx := context.Background()
+		if _, err := itr.List(ctx, all); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.List(ctx, own); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.Share(ctx, usecase.APIArticleShareInput{URL: articles[0].URL}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.List(ctx, all); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.List(ctx, own); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("記事を削除するとすべての記事一覧のキャッシュが無効化される", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil).Times(2)
+		mock.EXPECT().ListByUser(gomock.Any(), uid, value.Index(0), value.Size(20)).Return(articles, nil).Times(2)
+		mock.EXPECT().Delete(gomock.Any(), articles[0].ArticleID).Return(nil)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		ctx := context.Background()
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := itr.Delete(ctx, usecase.APIArticleDeleteInput{ArticleID: articles[0].ArticleID}); err != nil {
+			t.Fatal(err)
+		}
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	t.Run("記事を復元するとすべての記事一覧のキャッシュが無効化される", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil).Times(2)
+		mock.EXPECT().ListByUser(gomock.Any(), uid, value.Index(0), value.Size(20)).Return(articles, nil).Times(2)
+		mock.EXPECT().Restore(gomock.Any(), articles[0].ArticleID).Return(nil)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		ctx := context.Background()
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := itr.Restore(ctx, usecase.APIArticleRestoreInput{ArticleID: articles[0].ArticleID}); err != nil {
+			t.Fatal(err)
+		}
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	t.Run("記事をユーザーに追加するとユーザーの記事一覧のキャッシュのみ無効化される", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil).Times(1)
+		mock.EXPECT().ListByUser(gomock.Any(), uid, value.Index(0), value.Size(20)).Return(articles, nil).Times(3)
+		mock.EXPECT().AddToUser(gomock.Any(), articles[0].ArticleID, uid).Return(nil)
+		mock.EXPECT().RemoveFromUser(gomock.Any(), articles[0].ArticleID, uid).Return(nil)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		ctx := context.Background()
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := itr.AddToUser(ctx, usecase.APIArticleAddToUserInput{ArticleID: articles[0].ArticleID, UserID: uid}); err != nil {
+			t.Fatal(err)
+		}
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if _, err := itr.RemoveFromUser(ctx, usecase.APIArticleRemoveFromUserInput{ArticleID: articles[0].ArticleID, UserID: uid}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.List(ctx, own); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("無効化の直後の記事一覧はライターから取得する", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		primary := func(want bool) func(context.Context, value.Index, value.Size, ...value.Filter) ([]model.Article, error) {
+			return func(ctx context.Context, _ value.Index, _ value.Size, _ ...value.Filter) ([]model.Article, error) {
+				if got := rpc.IsPrimaryReadCtx(ctx); got != want {
+					t.Errorf("IsPrimaryReadCtx() = %v, want %v", got, want)
+				}
+				return articles, nil
+			}
+		}
+		gomock.InOrder(
+			mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).DoAndReturn(primary(false)),
+			mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).DoAndReturn(primary(true)),
+		)
+		mock.EXPECT().Share(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(articles[0], nil)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		ctx := context.Background()
+		if _, err := itr.List(ctx, all); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.Share(ctx, usecase.APIArticleShareInput{URL: articles[0].URL}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.List(ctx, all); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("同時の取得は1回にまとめられる", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).DoAndReturn(
+			func(_ context.Context, _ value.Index, _ value.Size, _ ...value.Filter) ([]model.Article, error) {
+				time.Sleep(100 * time.Millisecond)
+
+				return articles, nil
+			},
+		).Times(1)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := itr.List(context.Background(), all); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		wg.Wait()
+	})
+
+	t.Run("最初の呼び出し元がキャンセルしてもまとめた取得は継続してキャッシュされる", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		started := make(chan struct{})
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).DoAndReturn(
+			func(ctx context.Context, _ value.Index, _ value.Size, _ ...value.Filter) ([]model.Article, error) {
+				close(started)
+				time.Sleep(100 * time.Millisecond)
+				if err := ctx.Err(); err != nil {
+					return nil, err
+				}
+
+				return articles, nil
+			},
+		).Times(1)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		ctx, cancel := context.WithCancel(context.Background())
+		first := make(chan error, 1)
+		go func() {
+			_, err := itr.List(ctx, all)
+			first <- err
+		}()
+		<-started
+		second := make(chan error, 1)
+		go func() {
+			_, err := itr.List(context.Background(), all)
+			second <- err
+		}()
+		cancel()
+		if err := <-first; !errors.Is(err, context.Canceled) {
+			t.Errorf("APIArticle.List() error = %v, want %v", err, context.Canceled)
+		}
+		if err := <-second; err != nil {
+			t.Fatal(err)
+		}
+		if _, err := itr.List(context.Background(), all); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("共有者の情報が変わるとすべての記事一覧のキャッシュが無効化される", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil).Times(2)
+		mock.EXPECT().ListByUser(gomock.Any(), uid, value.Index(0), value.Size(20)).Return(articles, nil).Times(2)
+		versions := ArticleListVersionCache()
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), versions, &cache.MetricsMock{})
+		ctx := context.Background()
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := interactor.InvalidateArticleLists(ctx, versions); err != nil {
+			t.Fatal(err)
+		}
+		for _, input := range []usecase.APIArticleListInput{all, own} {
+			if _, err := itr.List(ctx, input); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+
+	t.Run("取得に失敗した場合はキャッシュしない", func(t *testing.T) {
+		t.Parallel()
+		ctrl := gomock.NewController(t)
+		mock := rpc.NewMockArticle(ctrl)
+		gomock.InOrder(
+			mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(nil, fmt.Errorf("error")),
+			mock.EXPECT().List(gomock.Any(), value.Index(0), value.Size(20)).Return(articles, nil),
+		)
+		itr := interactor.NewAPIArticle(authCache, mock, ArticleListCache(), ArticleListVersionCache(), &cache.MetricsMock{})
+		if _, err := itr.List(context.Background(), all); err == nil {
+			t.Error("APIArticle.List() error = nil")
+		}
+		if _, err := itr.List(context.Background(), all); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"github.com/morning-night-guild/platform-app/pkg/trace"
+)
+
+var _ usecase.APIAudit = (*APIAudit)(nil)
+
+// exportPageSize エクスポート時に一度に取得する件数.
+const exportPageSize = value.Size(100)
+
+// APIAudit.
+type APIAudit struct {
+	auditRepository repository.Audit
+}
+
+func NewAPIAudit(
+	auditRepository repository.Audit,
+) *APIAudit {
+	return &APIAudit{
+		auditRepository: auditRepository,
+	}
+}
+
+func (itr *APIAudit) List(
+	ctx context.Context,
+	input usecase.APIAuditListInput,
+) (usecase.APIAuditListOutput, error) {
+	logs, err := itr.auditRepository.List(ctx, input.Cursor, input.Size, input.Filter...)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to list audit logs", log.ErrorField(err))
+
+		return usecase.APIAuditListOutput{}, err
+	}
+
+	return usecase.APIAuditListOutput{
+		Logs: logs,
+	}, nil
+}
+
+func (itr *APIAudit) Export(
+	ctx context.Context,
+	input usecase.APIAuditExportInput,
+) (usecase.APIAuditExportOutput, error) {
+	logs := make([]model.AuditLog, 0, exportPageSize)
+
+	for cursor := (audit.Cursor{}); ; cursor = logs[len(logs)-1].Cursor() {
+		page, err := itr.auditRepository.List(ctx, cursor, exportPageSize, input.Filter...)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to list audit logs", log.ErrorField(err))
+
+			return usecase.APIAuditExportOutput{}, err
+		}
+
+		logs = append(logs, page...)
+
+		if len(logs) >= model.MaxAuditLogExport {
+			return usecase.APIAuditExportOutput{
+				Logs:      logs[:model.MaxAuditLogExport],
+				Truncated: true,
+			}, nil
+		}
+
+		if len(page) < exportPageSize.Int() {
+			break
+		}
+	}
+
+	return usecase.APIAuditExportOutput{
+		Logs: logs,
+	}, nil
+}
+
+// recordAudit 監査ログを記録する.
+// 監査ログの記録に失敗しても認証処理自体は継続する.
+func recordAudit(
+	ctx context.Context,
+	auditRepository repository.Audit,
+	eventType audit.EventType,
+	actorID user.ID,
+	email auth.Email,
+	err error,
+) {
+	al := model.IssueAuditLog(
+		actorID,
+		email,
+		eventType,
+		audit.OutcomeOf(err),
+		audit.GetClientCtx(ctx),
+		trace.GetTIDCtx(ctx),
+	)
+
+	if err := auditRepository.Save(ctx, al); err != nil {
+		log.GetLogCtx(ctx).Error("failed to save audit log", log.ErrorField(err))
+	}
+}
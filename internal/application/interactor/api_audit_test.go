@@ -0,0 +1,316 @@
+package interactor_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/morning-night-guild/platform-app/internal/application/interactor"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+)
+
+func AuditRepository(t *testing.T) *repository.MockAudit {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	mock := repository.NewMockAudit(ctrl)
+	mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return mock
+}
+
+func GenerateAuditLogs(t *testing.T, n int) []model.AuditLog {
+	t.Helper()
+
+	logs := make([]model.AuditLog, 0, n)
+
+	for i := 0; i < n; i++ {
+		logs = append(logs, model.IssueAuditLog(
+			user.GenerateID(),
+			auth.Email("test@example.com"),
+			audit.EventSignIn,
+			audit.OutcomeSuccess,
+			audit.Client{},
+			"",
+		))
+	}
+
+	return logs
+}
+
+func TestAPIAuditList(t *testing.T) {
+	t.Parallel()
+
+	logs := GenerateAuditLogs(t, 2)
+
+	filter := value.NewFilter("event_type", "signin")
+
+	cursor := GenerateAuditLogs(t, 1)[0].Cursor()
+
+	type fields struct {
+		auditRepository func(t *testing.T) repository.Audit
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIAuditListInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.APIAuditListOutput
+		wantErr bool
+	}{
+		{
+			name: "監査ログの一覧を取得できる",
+			fields: fields{
+				auditRepository: func(t *testing.T) repository.Audit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), cursor, value.Size(2), filter).Return(logs, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuditListInput{
+					Cursor: cursor,
+					Size:   value.Size(2),
+					Filter: []value.Filter{filter},
+				},
+			},
+			want: usecase.APIAuditListOutput{
+				Logs: logs,
+			},
+			wantErr: false,
+		},
+		{
+			name: "監査ログの取得に失敗する",
+			fields: fields{
+				auditRepository: func(t *testing.T) repository.Audit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), audit.Cursor{}, value.Size(2)).Return(nil, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuditListInput{
+					Cursor: audit.Cursor{},
+					Size:   value.Size(2),
+				},
+			},
+			want:    usecase.APIAuditListOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIAudit(tt.fields.auditRepository(t))
+			got, err := itr.List(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIAudit.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("APIAudit.List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIAuditExport(t *testing.T) {
+	t.Parallel()
+
+	const pageSize = 100
+
+	first := GenerateAuditLogs(t, pageSize)
+
+	second := GenerateAuditLogs(t, 1)
+
+	type fields struct {
+		auditRepository func(t *testing.T) repository.Audit
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIAuditExportInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.APIAuditExportOutput
+		wantErr bool
+	}{
+		{
+			name: "複数ページにまたがる監査ログをエクスポートできる",
+			fields: fields{
+				auditRepository: func(t *testing.T) repository.Audit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockAudit(ctrl)
+					gomock.InOrder(
+						mock.EXPECT().List(gomock.Any(), audit.Cursor{}, value.Size(pageSize)).Return(first, nil),
+						mock.EXPECT().List(gomock.Any(), first[pageSize-1].Cursor(), value.Size(pageSize)).Return(second, nil),
+					)
+					return mock
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: usecase.APIAuditExportInput{},
+			},
+			want: usecase.APIAuditExportOutput{
+				Logs: append(append([]model.AuditLog{}, first...), second...),
+			},
+			wantErr: false,
+		},
+		{
+			name: "上限件数に達した場合は途中までエクスポートする",
+			fields: fields{
+				auditRepository: func(t *testing.T) repository.Audit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), gomock.Any(), value.Size(pageSize)).Return(first, nil).Times(model.MaxAuditLogExport / pageSize)
+					return mock
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: usecase.APIAuditExportInput{},
+			},
+			want: func() usecase.APIAuditExportOutput {
+				logs := make([]model.AuditLog, 0, model.MaxAuditLogExport)
+				for i := 0; i < model.MaxAuditLogExport/pageSize; i++ {
+					logs = append(logs, first...)
+				}
+				return usecase.APIAuditExportOutput{
+					Logs:      logs,
+					Truncated: true,
+				}
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "監査ログの取得に失敗する",
+			fields: fields{
+				auditRepository: func(t *testing.T) repository.Audit {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockAudit(ctrl)
+					mock.EXPECT().List(gomock.Any(), audit.Cursor{}, value.Size(pageSize)).Return(nil, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: usecase.APIAuditExportInput{},
+			},
+			want:    usecase.APIAuditExportOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIAudit(tt.fields.auditRepository(t))
+			got, err := itr.Export(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIAudit.Export() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("APIAudit.Export() = %v, want %v", len(got.Logs), len(tt.want.Logs))
+			}
+		})
+	}
+}
+
+func TestAPIAuthSignInRecordAudit(t *testing.T) {
+	t.Parallel()
+
+	client := audit.Client{
+		IP:        "192.0.2.1",
+		UserAgent: "test",
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		outcome audit.Outcome
+	}{
+		{
+			name:    "サインインの失敗が監査ログに記録される",
+			err:     fmt.Errorf("error"),
+			outcome: audit.OutcomeFailure,
+		},
+		{
+			name:    "監査ログの記録に失敗してもサインインの結果は変わらない",
+			err:     fmt.Errorf("error"),
+			outcome: audit.OutcomeFailure,
+		},
+	}
+
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			authRPC := rpc.NewMockAuth(ctrl)
+			authRPC.EXPECT().SignIn(gomock.Any(), auth.Email("test@example.com"), auth.Password("password")).Return(model.User{}, tt.err)
+			auditRepository := repository.NewMockAudit(ctrl)
+			auditRepository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, al model.AuditLog) error {
+				if al.EventType != audit.EventSignIn {
+					t.Errorf("EventType = %v, want %v", al.EventType, audit.EventSignIn)
+				}
+				if al.Outcome != tt.outcome {
+					t.Errorf("Outcome = %v, want %v", al.Outcome, tt.outcome)
+				}
+				if al.Email != auth.Email("test@example.com") {
+					t.Errorf("Email = %v, want %v", al.Email, "test@example.com")
+				}
+				if !reflect.DeepEqual(al.Client, client) {
+					t.Errorf("Client = %v, want %v", al.Client, client)
+				}
+				if al.HasActor() {
+					t.Errorf("HasActor() = true, want false")
+				}
+				if i == 1 {
+					return fmt.Errorf("error")
+				}
+				return nil
+			})
+			itr := interactor.NewAPIAuth(nil, authRPC, nil, nil, nil, nil, nil, nil, nil, nil, auditRepository, auth.VerificationPolicyNone, "")
+			ctx := audit.SetClientCtx(context.Background(), client)
+			if _, err := itr.SignIn(ctx, usecase.APIAuthSignInInput{
+				Email:    auth.Email("test@example.com"),
+				Password: auth.Password("password"),
+			}); err == nil {
+				t.Errorf("APIAuth.SignIn() error = nil, want error")
+			}
+		})
+	}
+}
@@ -2,14 +2,18 @@ package interactor
 
 import (
 	"context"
+	"crypto/rsa"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
 	"github.com/morning-night-guild/platform-app/internal/domain/cache"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
 	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
 	"github.com/morning-night-guild/platform-app/pkg/log"
 )
@@ -17,14 +21,19 @@ import (
 var _ usecase.APIAuth = (*APIAuth)(nil)
 
 type APIAuth struct {
-	noticeRPC       rpc.Notice
-	authRPC         rpc.Auth
-	userRPC         rpc.User
-	invitationCache cache.Cache[model.Invitation]
-	userCache       cache.Cache[model.User]
-	authCache       cache.Cache[model.Auth]
-	codeCache       cache.Cache[model.Code]
-	sessionCache    cache.Cache[model.Session]
+	noticeRPC          rpc.Notice
+	authRPC            rpc.Auth
+	userRPC            rpc.User
+	invitationCache    cache.Cache[model.Invitation]
+	verificationCache  cache.Cache[model.Verification]
+	userCache          cache.Cache[model.User]
+	authCache          cache.Cache[model.Auth]
+	codeCache          cache.Cache[model.Code]
+	sessionCache       cache.Cache[model.Session]
+	sessionIndex       cache.Index
+	auditRepository    repository.Audit
+	verificationPolicy auth.VerificationPolicy
+	verificationURL    string
 }
 
 func NewAPIAuth(
@@ -32,20 +41,30 @@ func NewAPIAuth(
 	authRPC rpc.Auth,
 	userRPC rpc.User,
 	invitationCache cache.Cache[model.Invitation],
+	verificationCache cache.Cache[model.Verification],
 	userCache cache.Cache[model.User],
 	authCache cache.Cache[model.Auth],
 	codeCache cache.Cache[model.Code],
 	sessionCache cache.Cache[model.Session],
+	sessionIndex cache.Index,
+	auditRepository repository.Audit,
+	verificationPolicy auth.VerificationPolicy,
+	verificationURL string,
 ) *APIAuth {
 	return &APIAuth{
-		noticeRPC:       noticeRPC,
-		authRPC:         authRPC,
-		userRPC:         userRPC,
-		invitationCache: invitationCache,
-		userCache:       userCache,
-		authCache:       authCache,
-		codeCache:       codeCache,
-		sessionCache:    sessionCache,
+		noticeRPC:          noticeRPC,
+		authRPC:            authRPC,
+		userRPC:            userRPC,
+		invitationCache:    invitationCache,
+		verificationCache:  verificationCache,
+		userCache:          userCache,
+		authCache:          authCache,
+		codeCache:          codeCache,
+		sessionCache:       sessionCache,
+		sessionIndex:       sessionIndex,
+		auditRepository:    auditRepository,
+		verificationPolicy: verificationPolicy,
+		verificationURL:    verificationURL,
 	}
 }
 
@@ -82,18 +101,31 @@ func (itr *APIAuth) Join(
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to get invitation", log.ErrorField(err))
 
-		return usecase.APIAuthJoinOutput{}, errors.NewNotFoundError(fmt.Sprintf("invitation code: %s", input.InvitationCode))
+		err = errors.NewNotFoundError(fmt.Sprintf("invitation code: %s", input.InvitationCode))
+
+		recordAudit(ctx, itr.auditRepository, audit.EventInvitationUse, user.GenerateZeroID(), "", err)
+
+		return usecase.APIAuthJoinOutput{}, err
 	}
 
-	if _, err := itr.SignUp(ctx, usecase.APIAuthSignUpInput{
+	output, err := itr.SignUp(ctx, usecase.APIAuthSignUpInput{
 		Email:    inv.Email,
 		Password: input.Password,
-	}); err != nil {
+	})
+
+	recordAudit(ctx, itr.auditRepository, audit.EventInvitationUse, output.UserID, inv.Email, err)
+
+	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to sign up", log.ErrorField(err))
 
 		return usecase.APIAuthJoinOutput{}, err
 	}
 
+	// ユーザーは作成済みのため、検証メールの送信に失敗しても参加自体は成功とする
+	if err := itr.sendVerification(ctx, model.GenerateVerification(output.UserID, inv.Email)); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to send verification", log.ErrorField(err))
+	}
+
 	return usecase.APIAuthJoinOutput{}, nil
 }
 
@@ -114,45 +146,121 @@ func (itr *APIAuth) SignUp(
 		return usecase.APIAuthSignUpOutput{}, err
 	}
 
-	return usecase.APIAuthSignUpOutput{}, nil
+	return usecase.APIAuthSignUpOutput{
+		UserID: user.UserID,
+	}, nil
 }
 
 func (itr *APIAuth) SignIn(
 	ctx context.Context,
 	input usecase.APIAuthSignInInput,
+) (usecase.APIAuthSignInOutput, error) {
+	output, err := itr.signIn(ctx, input)
+
+	recordAudit(ctx, itr.auditRepository, audit.EventSignIn, output.Auth.UserID, input.Email, err)
+
+	return output, err
+}
+
+func (itr *APIAuth) signIn(
+	ctx context.Context,
+	input usecase.APIAuthSignInInput,
 ) (usecase.APIAuthSignInOutput, error) {
 	user, err := itr.authRPC.SignIn(ctx, input.Email, input.Password)
 	if err != nil {
 		return usecase.APIAuthSignInOutput{}, err
 	}
 
-	session := model.IssueSession(user.UserID, input.PublicKey)
+	if itr.verificationPolicy == auth.VerificationPolicyRestrict {
+		verified, err := itr.authRPC.IsVerified(ctx, user.UserID)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to get verification status", log.ErrorField(err))
+
+			return usecase.APIAuthSignInOutput{}, err
+		}
+
+		if !verified {
+			log.GetLogCtx(ctx).Warn("email is not verified")
+
+			return usecase.APIAuthSignInOutput{}, errors.NewUnauthorizedError("email is not verified")
+		}
+	}
+
+	if err := ensureActive(ctx, itr.userRPC, user.UserID); err != nil {
+		return usecase.APIAuthSignInOutput{}, err
+	}
+
+	return issueSession(ctx, itr.userCache, itr.authCache, itr.sessionCache, itr.sessionIndex, user, input.PublicKey, input.ExpiresIn, input.Secret, input.KeySet)
+}
 
-	uCmd, err := itr.userCache.CreateTxSetCmd(ctx, session.SessionID.String(), user, model.DefaultSessionExpiresIn)
+// ensureActive 削除を受け付けたユーザーでないことを確認する.
+// 認証基盤のユーザーは猶予期間を過ぎてから削除するため、猶予期間中のサインインはコアのユーザーの有無で拒否する.
+func ensureActive(
+	ctx context.Context,
+	userRPC rpc.User,
+	uid user.ID,
+) error {
+	if _, err := userRPC.Get(ctx, uid); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get user", log.ErrorField(err))
+
+		if errors.AsNotFoundError(err) {
+			return errors.NewUnauthorizedError("user is deleted", err)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// issueSession セッションと認証情報を発行してキャッシュに保存する.
+// パスワードによるサインインとOIDCによるサインインで共通の処理.
+func issueSession(
+	ctx context.Context,
+	userCache cache.Cache[model.User],
+	authCache cache.Cache[model.Auth],
+	sessionCache cache.Cache[model.Session],
+	sessionIndex cache.Index,
+	user model.User,
+	publicKey rsa.PublicKey,
+	expiresIn auth.ExpiresIn,
+	secret auth.Secret,
+	keys auth.KeySet,
+) (usecase.APIAuthSignInOutput, error) {
+	session := model.IssueSession(user.UserID, publicKey)
+
+	// Redis Clusterで1つのトランザクションとして実行できるよう、キーはすべてユーザーIDで始める
+	uCmd, err := userCache.CreateTxSetCmd(ctx, session.Key(), user, model.DefaultSessionExpiresIn)
 	if err != nil {
 		return usecase.APIAuthSignInOutput{}, err
 	}
 
-	sCmd, err := itr.sessionCache.CreateTxSetCmd(ctx, session.Key(), session, model.DefaultSessionExpiresIn)
+	sCmd, err := sessionCache.CreateTxSetCmd(ctx, session.Key(), session, model.DefaultSessionExpiresIn)
 	if err != nil {
 		return usecase.APIAuthSignInOutput{}, err
 	}
 
-	at := model.IssueAuth(user.UserID, input.ExpiresIn)
+	// 全セッションのサインアウトでキーを走査せずに済むよう、ユーザーごとのセッションIDの集合を同じトランザクションで更新する
+	iCmd, err := sessionIndex.CreateTxAddCmd(ctx, user.UserID.String(), session.SessionID.String(), model.DefaultSessionExpiresIn)
+	if err != nil {
+		return usecase.APIAuthSignInOutput{}, err
+	}
 
-	aCmd, err := itr.authCache.CreateTxSetCmd(ctx, at.UserID.String(), at, at.ExpiresIn().Duration())
+	at := model.IssueAuth(user.UserID, expiresIn)
+
+	aCmd, err := authCache.CreateTxSetCmd(ctx, at.UserID.String(), at, at.ExpiresIn().Duration())
 	if err != nil {
 		return usecase.APIAuthSignInOutput{}, err
 	}
 
-	if err := itr.sessionCache.Tx(ctx, []cache.TxSetCmd{uCmd, sCmd, aCmd}, []cache.TxDelCmd{}); err != nil {
+	if err := sessionCache.Tx(ctx, []cache.TxSetCmd{uCmd, sCmd, iCmd, aCmd}, []cache.TxDelCmd{}); err != nil {
 		return usecase.APIAuthSignInOutput{}, err
 	}
 
 	return usecase.APIAuthSignInOutput{
 		Auth:         at,
-		AuthToken:    at.ToToken(session.SessionID.ToSecret()),
-		SessionToken: session.ToToken(input.Secret),
+		AuthToken:    at.ToToken(session.SessionID.ToSecret(), keys),
+		SessionToken: session.ToToken(secret, keys),
 	}, nil
 }
 
@@ -167,7 +275,7 @@ func (itr *APIAuth) SignOut(
 		log.GetLogCtx(ctx).Warn("failed to create session cache delete command", log.ErrorField(err))
 	}
 
-	userDelCmd, err := itr.userCache.CreateTxDelCmd(ctx, input.SessionID.String())
+	userDelCmd, err := itr.userCache.CreateTxDelCmd(ctx, key)
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to create user cache delete command", log.ErrorField(err))
 	}
@@ -177,12 +285,19 @@ func (itr *APIAuth) SignOut(
 		log.GetLogCtx(ctx).Warn("failed to create auth cache delete command", log.ErrorField(err))
 	}
 
-	delCmds := []cache.TxDelCmd{sessionDelCmd, userDelCmd, authDelCmd}
+	indexRemCmd, err := itr.sessionIndex.CreateTxRemCmd(ctx, input.UserID.String(), input.SessionID.String())
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to create session index remove command", log.ErrorField(err))
+	}
+
+	delCmds := []cache.TxDelCmd{sessionDelCmd, userDelCmd, authDelCmd, indexRemCmd}
 
 	if err := itr.sessionCache.Tx(ctx, []cache.TxSetCmd{}, delCmds); err != nil {
 		log.GetLogCtx(ctx).Warn("failed to execute transaction", log.ErrorField(err))
 	}
 
+	recordAudit(ctx, itr.auditRepository, audit.EventSignOut, input.UserID, "", nil)
+
 	return usecase.APIAuthSignOutOutput{}, nil
 }
 
@@ -190,16 +305,18 @@ func (itr *APIAuth) SignOutAll(
 	ctx context.Context,
 	input usecase.APIAuthSignOutAllInput,
 ) (usecase.APIAuthSignOutAllOutput, error) {
-	keys, err := itr.sessionCache.Keys(ctx, input.UserID.String(), cache.WithoutPrefix)
+	sids, err := itr.sessionIndex.Members(ctx, input.UserID.String())
 	if err != nil {
-		log.GetLogCtx(ctx).Warn("failed to get session cache keys", log.ErrorField(err))
+		log.GetLogCtx(ctx).Warn("failed to get session index members", log.ErrorField(err))
 	}
 
-	const length = 1
+	const length = 2
 
-	delCmds := make([]cache.TxDelCmd, 0, len(keys)*2+length)
+	delCmds := make([]cache.TxDelCmd, 0, len(sids)*2+length)
+
+	for _, sid := range sids {
+		key := fmt.Sprintf(model.SessionKeyFormat, input.UserID.String(), sid)
 
-	for _, key := range keys {
 		sDelCmd, err := itr.sessionCache.CreateTxDelCmd(ctx, key)
 		if err != nil {
 			log.GetLogCtx(ctx).Warn("failed to create session cache delete command", log.ErrorField(err))
@@ -209,10 +326,7 @@ func (itr *APIAuth) SignOutAll(
 
 		delCmds = append(delCmds, sDelCmd)
 
-		// 取得したkey:${user_id}:${session_id}形式 -> ここから${session_id}のみを抽出
-		ukey := strings.ReplaceAll(key, fmt.Sprintf("%s:", input.UserID.String()), "")
-
-		uDelCmd, err := itr.userCache.CreateTxDelCmd(ctx, ukey)
+		uDelCmd, err := itr.userCache.CreateTxDelCmd(ctx, key)
 		if err != nil {
 			log.GetLogCtx(ctx).Warn("failed to create user cache delete command", log.ErrorField(err))
 
@@ -222,6 +336,13 @@ func (itr *APIAuth) SignOutAll(
 		delCmds = append(delCmds, uDelCmd)
 	}
 
+	indexDelCmd, err := itr.sessionIndex.CreateTxDelCmd(ctx, input.UserID.String())
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to create session index delete command", log.ErrorField(err))
+	} else {
+		delCmds = append(delCmds, indexDelCmd)
+	}
+
 	authDelCmd, err := itr.authCache.CreateTxDelCmd(ctx, input.UserID.String())
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to create auth cache delete command", log.ErrorField(err))
@@ -229,10 +350,17 @@ func (itr *APIAuth) SignOutAll(
 		delCmds = append(delCmds, authDelCmd)
 	}
 
+	// セッションが残ったままアカウントの削除などを続けないよう、削除に失敗した場合はエラーとする
 	if err := itr.sessionCache.Tx(ctx, []cache.TxSetCmd{}, delCmds); err != nil {
 		log.GetLogCtx(ctx).Warn("failed to transaction", log.ErrorField(err))
+
+		recordAudit(ctx, itr.auditRepository, audit.EventSignOutAll, input.UserID, "", err)
+
+		return usecase.APIAuthSignOutAllOutput{}, err
 	}
 
+	recordAudit(ctx, itr.auditRepository, audit.EventSignOutAll, input.UserID, "", nil)
+
 	return usecase.APIAuthSignOutAllOutput{}, nil
 }
 
@@ -275,7 +403,14 @@ func (itr *APIAuth) Refresh( //nolint:funlen,cyclop
 	ctx context.Context,
 	input usecase.APIAuthRefreshInput,
 ) (usecase.APIAuthRefreshOutput, error) {
-	code, err := itr.codeCache.Get(ctx, input.SessionID.String())
+	key, err := itr.sessionKey(ctx, input.UserID, input.SessionID)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to resolve session key", log.ErrorField(err))
+
+		return usecase.APIAuthRefreshOutput{}, errors.NewNotFoundError("user is not found", err)
+	}
+
+	code, err := itr.codeCache.Get(ctx, key)
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to get code cache", log.ErrorField(err))
 
@@ -294,15 +429,12 @@ func (itr *APIAuth) Refresh( //nolint:funlen,cyclop
 		return usecase.APIAuthRefreshOutput{}, errors.NewValidationError("Code is expired")
 	}
 
-	user, err := itr.userCache.Get(ctx, input.SessionID.String())
-	if err != nil {
+	if _, err := itr.sessionUser(ctx, key, input.SessionID); err != nil {
 		log.GetLogCtx(ctx).Warn("failed to get user cache", log.ErrorField(err))
 
 		return usecase.APIAuthRefreshOutput{}, errors.NewNotFoundError("user is not found", err)
 	}
 
-	key := fmt.Sprintf(model.SessionKeyFormat, user.UserID.String(), input.SessionID.String())
-
 	session, err := itr.sessionCache.Get(ctx, key)
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to get session cache", log.ErrorField(err))
@@ -322,7 +454,7 @@ func (itr *APIAuth) Refresh( //nolint:funlen,cyclop
 		return usecase.APIAuthRefreshOutput{}, errors.NewUnauthorizedError("signature invalid")
 	}
 
-	cCmd, err := itr.codeCache.CreateTxDelCmd(ctx, input.SessionID.String())
+	cCmd, err := itr.codeCache.CreateTxDelCmd(ctx, key)
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to create code cache delete command", log.ErrorField(err))
 
@@ -345,13 +477,24 @@ func (itr *APIAuth) Refresh( //nolint:funlen,cyclop
 	}
 
 	return usecase.APIAuthRefreshOutput{
-		AuthToken: at.ToToken(session.SessionID.ToSecret()),
+		AuthToken: at.ToToken(session.SessionID.ToSecret(), input.KeySet),
 	}, nil
 }
 
 func (itr *APIAuth) ChangePassword(
 	ctx context.Context,
 	input usecase.APIAuthChangePasswordInput,
+) (usecase.APIAuthChangePasswordOutput, error) {
+	output, err := itr.changePassword(ctx, input)
+
+	recordAudit(ctx, itr.auditRepository, audit.EventPasswordChange, input.UserID, "", err)
+
+	return output, err
+}
+
+func (itr *APIAuth) changePassword(
+	ctx context.Context,
+	input usecase.APIAuthChangePasswordInput,
 ) (usecase.APIAuthChangePasswordOutput, error) {
 	email, err := itr.authRPC.GetEmail(ctx, input.UserID)
 	if err != nil {
@@ -380,8 +523,9 @@ func (itr *APIAuth) ChangePassword(
 		return usecase.APIAuthChangePasswordOutput{}, errors.NewUnknownError("failed to sign out all", err)
 	}
 
-	output, err := itr.SignIn(ctx, usecase.APIAuthSignInInput{
+	output, err := itr.signIn(ctx, usecase.APIAuthSignInInput{
 		Secret:    input.Secret,
+		KeySet:    input.KeySet,
 		Email:     email,
 		Password:  input.NewPassword,
 		PublicKey: input.PublicKey,
@@ -402,7 +546,14 @@ func (itr *APIAuth) GenerateCode(
 ) (usecase.APIAuthGenerateCodeOutput, error) {
 	code := model.GenerateCode(input.SessionID)
 
-	if err := itr.codeCache.Set(ctx, input.SessionID.String(), code, model.DefaultCodeExpiresIn); err != nil {
+	key, err := itr.sessionKey(ctx, input.UserID, input.SessionID)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to resolve session key", log.ErrorField(err))
+
+		return usecase.APIAuthGenerateCodeOutput{}, err
+	}
+
+	if err := itr.codeCache.Set(ctx, key, code, model.DefaultCodeExpiresIn); err != nil {
 		return usecase.APIAuthGenerateCodeOutput{}, err
 	}
 
@@ -410,3 +561,182 @@ func (itr *APIAuth) GenerateCode(
 		Code: code,
 	}, nil
 }
+
+// sessionKey セッションに関するキャッシュのキーを作成する.
+// uidクレームを含まない以前のセッショントークンの場合、セッションIDをキーとしていた以前のユーザーのキャッシュからユーザーIDを取得する.
+// 以前のセッションもサインインし直すことなく有効期限まで利用できる.
+func (itr *APIAuth) sessionKey(
+	ctx context.Context,
+	uid user.ID,
+	sid auth.SessionID,
+) (string, error) {
+	if uid == user.GenerateZeroID() {
+		usr, err := itr.userCache.Get(ctx, sid.String())
+		if err != nil {
+			return "", err
+		}
+
+		uid = usr.UserID
+	}
+
+	return fmt.Sprintf(model.SessionKeyFormat, uid.String(), sid.String()), nil
+}
+
+// sessionUser セッションのユーザーをキャッシュから取得する.
+// 以前のセッションのユーザーはセッションIDをキーとしているため、見つからない場合は以前のキーで取得する.
+func (itr *APIAuth) sessionUser(
+	ctx context.Context,
+	key string,
+	sid auth.SessionID,
+) (model.User, error) {
+	usr, err := itr.userCache.Get(ctx, key)
+	if err == nil || !errors.AsNotFoundError(err) {
+		return usr, err
+	}
+
+	return itr.userCache.Get(ctx, sid.String())
+}
+
+func (itr *APIAuth) ChangeEmail(
+	ctx context.Context,
+	input usecase.APIAuthChangeEmailInput,
+) (usecase.APIAuthChangeEmailOutput, error) {
+	output, err := itr.changeEmail(ctx, input)
+
+	recordAudit(ctx, itr.auditRepository, audit.EventEmailChange, input.UserID, input.Email, err)
+
+	return output, err
+}
+
+func (itr *APIAuth) changeEmail(
+	ctx context.Context,
+	input usecase.APIAuthChangeEmailInput,
+) (usecase.APIAuthChangeEmailOutput, error) {
+	email, err := itr.authRPC.GetEmail(ctx, input.UserID)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get email", log.ErrorField(err))
+
+		return usecase.APIAuthChangeEmailOutput{}, errors.NewUnknownError("failed to get email", err)
+	}
+
+	if email == input.Email {
+		log.GetLogCtx(ctx).Warn("new email is same as current email")
+
+		return usecase.APIAuthChangeEmailOutput{}, errors.NewValidationError("new email is same as current email")
+	}
+
+	if _, err := itr.authRPC.SignIn(ctx, email, input.Password); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to sign in with password", log.ErrorField(err))
+
+		return usecase.APIAuthChangeEmailOutput{}, errors.NewUnauthorizedError("failed to sign in", err)
+	}
+
+	// 新しいメールアドレスで確認が取れるまでは変更を反映しない
+	if err := itr.sendVerification(ctx, model.GenerateEmailChange(input.UserID, input.Email, email)); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to send verification", log.ErrorField(err))
+
+		return usecase.APIAuthChangeEmailOutput{}, err
+	}
+
+	return usecase.APIAuthChangeEmailOutput{}, nil
+}
+
+func (itr *APIAuth) VerifyEmail(
+	ctx context.Context,
+	input usecase.APIAuthVerifyEmailInput,
+) (usecase.APIAuthVerifyEmailOutput, error) {
+	// 変更の反映に失敗した場合に再度検証できるよう、検証コードは反映した後に削除する
+	vrf, err := itr.verificationCache.Get(ctx, input.Code.String())
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get verification", log.ErrorField(err))
+
+		return usecase.APIAuthVerifyEmailOutput{}, errors.NewNotFoundError(fmt.Sprintf("verification code: %s", input.Code))
+	}
+
+	if vrf.IsEmailChange() {
+		if err := itr.authRPC.UpdateEmail(ctx, vrf.UserID, vrf.Email); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to update email", log.ErrorField(err))
+
+			return usecase.APIAuthVerifyEmailOutput{}, errors.NewUnknownError("failed to update email", err)
+		}
+	}
+
+	if err := itr.authRPC.MarkVerified(ctx, vrf.UserID); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to mark verified", log.ErrorField(err))
+
+		return usecase.APIAuthVerifyEmailOutput{}, errors.NewUnknownError("failed to mark verified", err)
+	}
+
+	// 変更は反映済みのため削除の失敗はエラーとしない. 再度検証しても同じ変更となる
+	if err := itr.verificationCache.Del(ctx, input.Code.String()); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to delete verification", log.ErrorField(err))
+	}
+
+	if vrf.IsEmailChange() {
+		// 変更は完了しているため、旧メールアドレスへの通知失敗はエラーとしない
+		if _, err := itr.noticeRPC.Notify(ctx, vrf.PreviousEmail, vrf.ChangedSubject(), vrf.ChangedMessage()); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to notify previous email", log.ErrorField(err))
+		}
+	}
+
+	return usecase.APIAuthVerifyEmailOutput{}, nil
+}
+
+func (itr *APIAuth) sendVerification(
+	ctx context.Context,
+	vrf model.Verification,
+) error {
+	if err := itr.verificationCache.Set(ctx, vrf.Code.String(), vrf, model.DefaultVerificationExpiresIn); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to set verification", log.ErrorField(err))
+
+		return err
+	}
+
+	if _, err := itr.noticeRPC.Notify(ctx, vrf.Email, vrf.Subject(), vrf.Message(itr.verificationURL)); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to notify", log.ErrorField(err))
+
+		return err
+	}
+
+	return nil
+}
+
+// Delete アカウントを削除する.
+// すべてのセッションは即座に削除し、コアのデータはデータの写しを取得したうえで猶予期間の後に完全に削除する.
+// 猶予期間中は復旧できるよう認証基盤のユーザーは残し、猶予期間を過ぎた後に完全な削除と合わせて削除する.
+// いずれの処理も繰り返し実行できるため、途中で失敗した場合は再度実行すればよい.
+func (itr *APIAuth) Delete(
+	ctx context.Context,
+	input usecase.APIAuthDeleteInput,
+) (usecase.APIAuthDeleteOutput, error) {
+	output, err := itr.delete(ctx, input)
+
+	recordAudit(ctx, itr.auditRepository, audit.EventAccountDelete, input.UserID, "", err)
+
+	return output, err
+}
+
+func (itr *APIAuth) delete(
+	ctx context.Context,
+	input usecase.APIAuthDeleteInput,
+) (usecase.APIAuthDeleteOutput, error) {
+	// 削除の受付後に既存のセッションで操作できないよう、先にすべてのセッションを削除する
+	if _, err := itr.SignOutAll(ctx, usecase.APIAuthSignOutAllInput{
+		UserID: input.UserID,
+	}); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to sign out all", log.ErrorField(err))
+
+		return usecase.APIAuthDeleteOutput{}, errors.NewUnknownError("failed to sign out all", err)
+	}
+
+	deletion, err := itr.userRPC.Delete(ctx, input.UserID)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to delete user core", log.ErrorField(err))
+
+		return usecase.APIAuthDeleteOutput{}, err
+	}
+
+	return usecase.APIAuthDeleteOutput{
+		Deletion: deletion,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package interactor_test
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+	"github.com/morning-night-guild/platform-app/internal/application/interactor"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+)
+
+// sessionsPerUser ベンチマークで1ユーザーあたりに発行するセッション数.
+const sessionsPerUser = 5
+
+// signIn ユーザーのセッションを発行してキャッシュとインデックスに登録する.
+func signIn(
+	b *testing.B,
+	sessionCache cache.Cache[model.Session],
+	sessionIndex cache.Index,
+	userCache cache.Cache[model.User],
+	uid user.ID,
+) {
+	b.Helper()
+
+	ctx := context.Background()
+
+	cmds := make([]cache.TxSetCmd, 0, sessionsPerUser*3)
+
+	for i := 0; i < sessionsPerUser; i++ {
+		session := model.IssueSession(uid, rsa.PublicKey{})
+
+		sCmd, _ := sessionCache.CreateTxSetCmd(ctx, session.Key(), session, model.DefaultSessionExpiresIn)
+
+		iCmd, _ := sessionIndex.CreateTxAddCmd(ctx, uid.String(), session.SessionID.String(), model.DefaultSessionExpiresIn)
+
+		uCmd, _ := userCache.CreateTxSetCmd(ctx, session.SessionID.String(), model.User{UserID: uid}, model.DefaultSessionExpiresIn)
+
+		cmds = append(cmds, sCmd, iCmd, uCmd)
+	}
+
+	if err := sessionCache.Tx(ctx, cmds, []cache.TxDelCmd{}); err != nil {
+		b.Fatalf("failed to sign in: %v", err)
+	}
+}
+
+// BenchmarkAPIAuthSignOutAll 全体のセッション数が増えても全セッションのサインアウトの所要時間が変わらないことを確認する.
+func BenchmarkAPIAuthSignOutAll(b *testing.B) {
+	for _, total := range []int{1_000, 10_000, 100_000} {
+		total := total
+
+		b.Run(fmt.Sprintf("sessions=%d", total), func(b *testing.B) {
+			store := kvs.NewStore()
+
+			sessionCache := &kvs.Memory[model.Session]{Prefix: "session", Store: store}
+			sessionIndex := &kvs.MemoryIndex{Prefix: "session_index", Store: store}
+			userCache := &kvs.Memory[model.User]{Prefix: "user", Store: store}
+			authCache := &kvs.Memory[model.Auth]{Prefix: "auth", Store: store}
+
+			for i := 0; i < total/sessionsPerUser; i++ {
+				signIn(b, sessionCache, sessionIndex, userCache, user.GenerateID())
+			}
+
+			auditRepository := repository.NewMockAudit(gomock.NewController(b))
+			auditRepository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+			itr := interactor.NewAPIAuth(
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				userCache,
+				authCache,
+				nil,
+				sessionCache,
+				sessionIndex,
+				auditRepository,
+				auth.VerificationPolicyNone,
+				"",
+			)
+
+			uid := user.GenerateID()
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				signIn(b, sessionCache, sessionIndex, userCache, uid)
+				b.StartTimer()
+
+				if _, err := itr.SignOutAll(context.Background(), usecase.APIAuthSignOutAllInput{
+					UserID: uid,
+				}); err != nil {
+					b.Fatalf("failed to sign out all: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSessionKeys 比較のため、キーの走査によってユーザーのセッションを取得する場合の所要時間を計測する.
+func BenchmarkSessionKeys(b *testing.B) {
+	for _, total := range []int{1_000, 10_000, 100_000} {
+		total := total
+
+		b.Run(fmt.Sprintf("sessions=%d", total), func(b *testing.B) {
+			store := kvs.NewStore()
+
+			sessionCache := &kvs.Memory[model.Session]{Prefix: "session", Store: store}
+			sessionIndex := &kvs.MemoryIndex{Prefix: "session_index", Store: store}
+			userCache := &kvs.Memory[model.User]{Prefix: "user", Store: store}
+
+			for i := 0; i < total/sessionsPerUser; i++ {
+				signIn(b, sessionCache, sessionIndex, userCache, user.GenerateID())
+			}
+
+			uid := user.GenerateID()
+
+			signIn(b, sessionCache, sessionIndex, userCache, uid)
+
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := sessionCache.Keys(context.Background(), uid.String(), cache.WithoutPrefix); err != nil {
+					b.Fatalf("failed to get keys: %v", err)
+				}
+			}
+		})
+	}
+}
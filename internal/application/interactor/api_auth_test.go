@@ -8,6 +8,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -18,11 +19,14 @@ import (
 	"github.com/morning-night-guild/platform-app/internal/domain/cache"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/notice"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
 )
 
+const verificationURL = "http://localhost:8080/api/v1/auth/email/verify"
+
 func sign(t *testing.T, prv *rsa.PrivateKey, code string) auth.Signature {
 	t.Helper()
 
@@ -51,18 +55,33 @@ func generateKey(t *testing.T) *rsa.PrivateKey {
 	return prv
 }
 
+// ActiveUserRPC 削除を受け付けていないユーザーを返すUserRPC.
+func ActiveUserRPC(t *testing.T, uid user.ID) *rpc.MockUser {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	mock := rpc.NewMockUser(ctrl)
+	mock.EXPECT().Get(gomock.Any(), uid).Return(model.NewUser(uid), nil)
+
+	return mock
+}
+
 func TestAPIAuthInvite(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       func(t *testing.T) rpc.Notice
-		authRPC         rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          func(t *testing.T) rpc.Notice
+		authRPC            rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -185,10 +204,15 @@ func TestAPIAuthInvite(t *testing.T) {
 				tt.fields.authRPC,
 				tt.fields.userRPC,
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			got, err := itr.Invite(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -206,14 +230,17 @@ func TestAPIAuthJoin(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         func(t *testing.T) rpc.Auth
-		userRPC         func(t *testing.T) rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          func(t *testing.T) rpc.Notice
+		authRPC            func(t *testing.T) rpc.Auth
+		userRPC            func(t *testing.T) rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -238,6 +265,33 @@ func TestAPIAuthJoin(t *testing.T) {
 				},
 			},
 			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					mock.EXPECT().Notify(
+						gomock.Any(),
+						auth.Email("test@example.com"),
+						gomock.Any(),
+						gomock.Any(),
+					).Return(notice.ID(""), nil)
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T: t,
+					SetAssert: func(t *testing.T, key string, value model.Verification, ttl time.Duration) {
+						t.Helper()
+						if value.Email != auth.Email("test@example.com") {
+							t.Errorf("email = %v, want %v", value.Email, "test@example.com")
+						}
+						if value.IsEmailChange() {
+							t.Errorf("verification must not be email change")
+						}
+						if !reflect.DeepEqual(ttl, model.DefaultVerificationExpiresIn) {
+							t.Errorf("ttl = %v, want %v", ttl, model.DefaultVerificationExpiresIn)
+						}
+					},
+				},
 				invitationCache: &cache.CacheMock[model.Invitation]{
 					T: t,
 					Value: model.Invitation{
@@ -286,6 +340,12 @@ func TestAPIAuthJoin(t *testing.T) {
 				},
 			},
 			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
 				invitationCache: &cache.CacheMock[model.Invitation]{
 					T: t,
 					GetDelAssert: func(t *testing.T, key string) {
@@ -322,6 +382,12 @@ func TestAPIAuthJoin(t *testing.T) {
 				},
 			},
 			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
 				invitationCache: &cache.CacheMock[model.Invitation]{
 					T: t,
 					Value: model.Invitation{
@@ -362,6 +428,12 @@ func TestAPIAuthJoin(t *testing.T) {
 				},
 			},
 			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
 				invitationCache: &cache.CacheMock[model.Invitation]{
 					T: t,
 					Value: model.Invitation{
@@ -400,6 +472,64 @@ func TestAPIAuthJoin(t *testing.T) {
 			want:    usecase.APIAuthJoinOutput{},
 			wantErr: true,
 		},
+		{
+			name: "VerificationCache.Set()でエラーが発生しても参加できる",
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthJoinInput{
+					InvitationCode: auth.InvitationCode("01234567"),
+					Password:       auth.Password("password"),
+				},
+			},
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T:      t,
+					SetErr: fmt.Errorf("error"),
+					SetAssert: func(t *testing.T, key string, value model.Verification, ttl time.Duration) {
+						t.Helper()
+					},
+				},
+				invitationCache: &cache.CacheMock[model.Invitation]{
+					T: t,
+					Value: model.Invitation{
+						Code:  auth.InvitationCode("01234567"),
+						Email: auth.Email("test@example.com"),
+					},
+					GetDelAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+				},
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Create(gomock.Any()).Return(model.User{
+						UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					}, nil)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().SignUp(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						auth.Email("test@example.com"),
+						auth.Password("password"),
+					).Return(nil)
+					return mock
+				},
+			},
+			want:    usecase.APIAuthJoinOutput{},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -407,14 +537,19 @@ func TestAPIAuthJoin(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			itr := interactor.NewAPIAuth(
-				tt.fields.noticeRPC,
+				tt.fields.noticeRPC(t),
 				tt.fields.authRPC(t),
 				tt.fields.userRPC(t),
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			got, err := itr.Join(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -432,14 +567,17 @@ func TestAPIAuthSignUp(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         func(t *testing.T) rpc.Auth
-		userRPC         func(t *testing.T) rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            func(t *testing.T) rpc.Auth
+		userRPC            func(t *testing.T) rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -486,7 +624,9 @@ func TestAPIAuthSignUp(t *testing.T) {
 					return mock
 				},
 			},
-			want:    usecase.APIAuthSignUpOutput{},
+			want: usecase.APIAuthSignUpOutput{
+				UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+			},
 			wantErr: false,
 		},
 		{
@@ -562,10 +702,15 @@ func TestAPIAuthSignUp(t *testing.T) {
 				tt.fields.authRPC(t),
 				tt.fields.userRPC(t),
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			got, err := itr.SignUp(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -583,14 +728,17 @@ func TestAPIAuthSignIn(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         func(t *testing.T) rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            func(t *testing.T) rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -608,6 +756,7 @@ func TestAPIAuthSignIn(t *testing.T) {
 		{
 			name: "サインインできる",
 			fields: fields{
+				userRPC: ActiveUserRPC(t, user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab"))),
 				authRPC: func(t *testing.T) rpc.Auth {
 					t.Helper()
 					ctrl := gomock.NewController(t)
@@ -648,6 +797,7 @@ func TestAPIAuthSignIn(t *testing.T) {
 						t.Helper()
 					},
 				},
+				sessionIndex: &cache.IndexMock{T: t},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -662,15 +812,158 @@ func TestAPIAuthSignIn(t *testing.T) {
 				AuthToken: auth.GenerateAuthToken(
 					user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
 					auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")).ToSecret(),
+					auth.KeySet{},
 					auth.DefaultExpiresIn,
 				),
 				SessionToken: auth.GenerateSessionToken(
 					auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
 					auth.Secret("secret"),
+					auth.KeySet{},
 				),
 			},
 			wantErr: false,
 		},
+		{
+			name: "検証方針がrestrictの場合に未検証のユーザーはサインインできない",
+			fields: fields{
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().SignIn(
+						gomock.Any(),
+						auth.Email("test@example.com"),
+						auth.Password("password"),
+					).Return(model.User{
+						UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					}, nil)
+					mock.EXPECT().IsVerified(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(false, nil)
+					return mock
+				},
+				verificationPolicy: auth.VerificationPolicyRestrict,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthSignInInput{
+					Email:     auth.Email("test@example.com"),
+					Password:  auth.Password("password"),
+					PublicKey: rsa.PublicKey{},
+					ExpiresIn: auth.DefaultExpiresIn,
+				},
+			},
+			want:    usecase.APIAuthSignInOutput{},
+			wantErr: true,
+		},
+		{
+			name: "削除を受け付けたユーザーはサインインできない",
+			fields: fields{
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().SignIn(
+						gomock.Any(),
+						auth.Email("test@example.com"),
+						auth.Password("password"),
+					).Return(model.User{
+						UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					}, nil)
+					return mock
+				},
+				userRPC: func() rpc.User {
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Get(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(model.User{}, errors.NewNotFoundError("not found"))
+					return mock
+				}(),
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthSignInInput{
+					Email:     auth.Email("test@example.com"),
+					Password:  auth.Password("password"),
+					PublicKey: rsa.PublicKey{},
+					ExpiresIn: auth.DefaultExpiresIn,
+				},
+			},
+			want:    usecase.APIAuthSignInOutput{},
+			wantErr: true,
+		},
+		{
+			name: "検証方針がrestrictの場合に検証済みのユーザーはサインインできる",
+			fields: fields{
+				userRPC: ActiveUserRPC(t, user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab"))),
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().SignIn(
+						gomock.Any(),
+						auth.Email("test@example.com"),
+						auth.Password("password"),
+					).Return(model.User{
+						UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					}, nil)
+					mock.EXPECT().IsVerified(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(true, nil)
+					return mock
+				},
+				userCache: &cache.CacheMock[model.User]{
+					T: t,
+					CreateTxSetCmdAssert: func(t *testing.T, key string, value model.User, ttl time.Duration) {
+						t.Helper()
+					},
+				},
+				authCache: &cache.CacheMock[model.Auth]{
+					T: t,
+					CreateTxSetCmdAssert: func(t *testing.T, key string, value model.Auth, ttl time.Duration) {
+						t.Helper()
+					},
+				},
+				sessionCache: &cache.CacheMock[model.Session]{
+					T: t,
+					CreateTxSetCmdAssert: func(t *testing.T, key string, value model.Session, ttl time.Duration) {
+						t.Helper()
+					},
+					TxAssert: func(t *testing.T, setCmds []cache.TxSetCmd, delCmds []cache.TxDelCmd) {
+						t.Helper()
+					},
+				},
+				sessionIndex: &cache.IndexMock{
+					T: t,
+					CreateTxAddCmdAssert: func(t *testing.T, key string, member string, ttl time.Duration) {
+						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab")
+						}
+						if !reflect.DeepEqual(ttl, model.DefaultSessionExpiresIn) {
+							t.Errorf("ttl = %v, want %v", ttl, model.DefaultSessionExpiresIn)
+						}
+					},
+				},
+				verificationPolicy: auth.VerificationPolicyRestrict,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthSignInInput{
+					Email:     auth.Email("test@example.com"),
+					Password:  auth.Password("password"),
+					PublicKey: rsa.PublicKey{},
+					ExpiresIn: auth.DefaultExpiresIn,
+				},
+			},
+			want:    usecase.APIAuthSignInOutput{},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -682,10 +975,15 @@ func TestAPIAuthSignIn(t *testing.T) {
 				tt.fields.authRPC(t),
 				tt.fields.userRPC,
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			_, err := itr.SignIn(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -703,14 +1001,17 @@ func TestAPIAuthSignOut(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -749,6 +1050,7 @@ func TestAPIAuthSignOut(t *testing.T) {
 						t.Helper()
 					},
 				},
+				sessionIndex: &cache.IndexMock{T: t},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -785,6 +1087,7 @@ func TestAPIAuthSignOut(t *testing.T) {
 						t.Helper()
 					},
 				},
+				sessionIndex: &cache.IndexMock{T: t},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -821,6 +1124,7 @@ func TestAPIAuthSignOut(t *testing.T) {
 						t.Helper()
 					},
 				},
+				sessionIndex: &cache.IndexMock{T: t},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -857,6 +1161,7 @@ func TestAPIAuthSignOut(t *testing.T) {
 						t.Helper()
 					},
 				},
+				sessionIndex: &cache.IndexMock{T: t},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -879,10 +1184,15 @@ func TestAPIAuthSignOut(t *testing.T) {
 				tt.fields.authRPC,
 				tt.fields.userRPC,
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			got, err := itr.SignOut(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -900,14 +1210,17 @@ func TestAPIAuthSignOutAll(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -933,19 +1246,29 @@ func TestAPIAuthSignOutAll(t *testing.T) {
 				},
 				sessionCache: &cache.CacheMock[model.Session]{
 					T: t,
-					KeysValue: []string{
-						"session:01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab",
-						"session:01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ac",
-						"session:01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ad",
-					},
-					KeysAssert: func(t *testing.T, pattern string, prefix cache.Prefix) {
-						t.Helper()
-					},
 					CreateTxDelCmdAssert: func(t *testing.T, key string) {
 						t.Helper()
 					},
 					TxAssert: func(t *testing.T, setCmds []cache.TxSetCmd, delCmds []cache.TxDelCmd) {
 						t.Helper()
+						// セッション3件とユーザー3件、インデックスと認証情報が削除される
+						if len(delCmds) != 8 {
+							t.Errorf("len(delCmds) = %v, want %v", len(delCmds), 8)
+						}
+					},
+				},
+				sessionIndex: &cache.IndexMock{
+					T: t,
+					MembersValue: []string{
+						"01234567-0123-0123-0123-0123456789ab",
+						"01234567-0123-0123-0123-0123456789ac",
+						"01234567-0123-0123-0123-0123456789ad",
+					},
+					MembersAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab")
+						}
 					},
 				},
 				userCache: &cache.CacheMock[model.User]{
@@ -964,24 +1287,73 @@ func TestAPIAuthSignOutAll(t *testing.T) {
 			want:    usecase.APIAuthSignOutAllOutput{},
 			wantErr: false,
 		},
-	}
-
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			itr := interactor.NewAPIAuth(
-				tt.fields.noticeRPC,
-				tt.fields.authRPC,
-				tt.fields.userRPC,
-				tt.fields.invitationCache,
-				tt.fields.userCache,
-				tt.fields.authCache,
-				tt.fields.codeCache,
-				tt.fields.sessionCache,
-			)
-			got, err := itr.SignOutAll(tt.args.ctx, tt.args.input)
-			if (err != nil) != tt.wantErr {
+		{
+			name: "セッションの削除に失敗した場合はエラーを返す",
+			fields: fields{
+				authCache: &cache.CacheMock[model.Auth]{
+					T: t,
+					CreateTxDelCmdAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+				},
+				sessionCache: &cache.CacheMock[model.Session]{
+					T: t,
+					CreateTxDelCmdAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+					TxAssert: func(t *testing.T, setCmds []cache.TxSetCmd, delCmds []cache.TxDelCmd) {
+						t.Helper()
+					},
+					TxErr: fmt.Errorf("error"),
+				},
+				sessionIndex: &cache.IndexMock{
+					T: t,
+					MembersValue: []string{
+						"01234567-0123-0123-0123-0123456789ab",
+					},
+					MembersAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+				},
+				userCache: &cache.CacheMock[model.User]{
+					T: t,
+					CreateTxDelCmdAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthSignOutAllInput{
+					UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				},
+			},
+			want:    usecase.APIAuthSignOutAllOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIAuth(
+				tt.fields.noticeRPC,
+				tt.fields.authRPC,
+				tt.fields.userRPC,
+				tt.fields.invitationCache,
+				tt.fields.verificationCache,
+				tt.fields.userCache,
+				tt.fields.authCache,
+				tt.fields.codeCache,
+				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
+			)
+			got, err := itr.SignOutAll(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
 				t.Errorf("APIAuth.SignOutAll() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
@@ -996,14 +1368,17 @@ func TestAPIAuthVerify(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -1194,10 +1569,15 @@ func TestAPIAuthVerify(t *testing.T) {
 				tt.fields.authRPC,
 				tt.fields.userRPC,
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			got, err := itr.Verify(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -1215,14 +1595,17 @@ func TestAPIAuthRefresh(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -1254,9 +1637,15 @@ func TestAPIAuthRefresh(t *testing.T) {
 					},
 					GetAssert: func(t *testing.T, key string) {
 						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
 					},
 					CreateTxDelCmdAssert: func(t *testing.T, key string) {
 						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
 					},
 				},
 				userCache: &cache.CacheMock[model.User]{
@@ -1266,6 +1655,9 @@ func TestAPIAuthRefresh(t *testing.T) {
 					},
 					GetAssert: func(t *testing.T, key string) {
 						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
 					},
 				},
 				authCache: &cache.CacheMock[model.Auth]{
@@ -1291,6 +1683,77 @@ func TestAPIAuthRefresh(t *testing.T) {
 					},
 					GetAssert: func(t *testing.T, key string) {
 						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthRefreshInput{
+					CodeID:    auth.CodeID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					Signature: sign(t, key, "01234567-0123-0123-0123-0123456789ab"),
+					SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					UserID:    user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					ExpiresIn: auth.DefaultExpiresIn,
+				},
+			},
+			want:    usecase.APIAuthRefreshOutput{},
+			wantErr: false,
+		},
+		{
+			name: "uidクレームを含まない以前のセッションでもリフレッシュできる",
+			fields: fields{
+				codeCache: &cache.CacheMock[model.Code]{
+					T: t,
+					Value: model.Code{
+						CodeID:    auth.CodeID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						IssuedAt:  now,
+						ExpiresAt: now.Add(model.DefaultCodeExpiresIn),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "89abcdef-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "89abcdef-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
+					},
+					CreateTxDelCmdAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+				},
+				userCache: &cache.CacheMock[model.User]{
+					T: t,
+					Value: model.User{
+						UserID: user.ID(uuid.MustParse("89abcdef-0123-0123-0123-0123456789ab")),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab" && key != "89abcdef-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want legacy or new key", key)
+						}
+					},
+				},
+				authCache: &cache.CacheMock[model.Auth]{
+					T:                    t,
+					CreateTxSetCmdAssert: func(t *testing.T, key string, value model.Auth, ttl time.Duration) { t.Helper() },
+					TxAssert:             func(t *testing.T, setCmds []cache.TxSetCmd, delCmds []cache.TxDelCmd) { t.Helper() },
+				},
+				sessionCache: &cache.CacheMock[model.Session]{
+					T: t,
+					Value: model.Session{
+						SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						UserID:    user.ID(uuid.MustParse("89abcdef-0123-0123-0123-0123456789ab")),
+						PublicKey: key.PublicKey,
+						IssuedAt:  now,
+						ExpiresAt: now.Add(model.DefaultSessionExpiresIn),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "89abcdef-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "89abcdef-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
 					},
 				},
 			},
@@ -1300,12 +1763,40 @@ func TestAPIAuthRefresh(t *testing.T) {
 					CodeID:    auth.CodeID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
 					Signature: sign(t, key, "01234567-0123-0123-0123-0123456789ab"),
 					SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					UserID:    user.GenerateZeroID(),
 					ExpiresIn: auth.DefaultExpiresIn,
 				},
 			},
 			want:    usecase.APIAuthRefreshOutput{},
 			wantErr: false,
 		},
+		{
+			name: "以前のセッションのユーザーが見つからない場合はリフレッシュできない",
+			fields: fields{
+				userCache: &cache.CacheMock[model.User]{
+					T: t,
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab")
+						}
+					},
+					GetErr: errors.NewNotFoundError("not found"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthRefreshInput{
+					CodeID:    auth.CodeID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					Signature: sign(t, key, "01234567-0123-0123-0123-0123456789ab"),
+					SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					UserID:    user.GenerateZeroID(),
+					ExpiresIn: auth.DefaultExpiresIn,
+				},
+			},
+			want:    usecase.APIAuthRefreshOutput{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1317,10 +1808,15 @@ func TestAPIAuthRefresh(t *testing.T) {
 				tt.fields.authRPC,
 				tt.fields.userRPC,
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			_, err := itr.Refresh(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -1338,14 +1834,17 @@ func TestAPIAuthGenerateCode(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -1369,6 +1868,9 @@ func TestAPIAuthGenerateCode(t *testing.T) {
 					T: t,
 					SetAssert: func(t *testing.T, key string, value model.Code, ttl time.Duration) {
 						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
 					},
 				},
 			},
@@ -1376,6 +1878,7 @@ func TestAPIAuthGenerateCode(t *testing.T) {
 				ctx: context.Background(),
 				input: usecase.APIAuthGenerateCodeInput{
 					SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					UserID:    user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
 				},
 			},
 			want: usecase.APIAuthGenerateCodeOutput{
@@ -1388,6 +1891,64 @@ func TestAPIAuthGenerateCode(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "uidクレームを含まない以前のセッションでもコードが生成できる",
+			fields: fields{
+				userCache: &cache.CacheMock[model.User]{
+					T: t,
+					Value: model.User{
+						UserID: user.ID(uuid.MustParse("89abcdef-0123-0123-0123-0123456789ab")),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "01234567-0123-0123-0123-0123456789ab")
+						}
+					},
+				},
+				codeCache: &cache.CacheMock[model.Code]{
+					T: t,
+					SetAssert: func(t *testing.T, key string, value model.Code, ttl time.Duration) {
+						t.Helper()
+						if key != "89abcdef-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab" {
+							t.Errorf("key = %v, want %v", key, "89abcdef-0123-0123-0123-0123456789ab:01234567-0123-0123-0123-0123456789ab")
+						}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthGenerateCodeInput{
+					SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					UserID:    user.GenerateZeroID(),
+				},
+			},
+			want: usecase.APIAuthGenerateCodeOutput{
+				Code: model.Code{
+					SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "以前のセッションのユーザーが見つからない場合はコードが生成できない",
+			fields: fields{
+				userCache: &cache.CacheMock[model.User]{
+					T:         t,
+					GetAssert: func(t *testing.T, key string) { t.Helper() },
+					GetErr:    errors.NewNotFoundError("not found"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthGenerateCodeInput{
+					SessionID: auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					UserID:    user.GenerateZeroID(),
+				},
+			},
+			want:    usecase.APIAuthGenerateCodeOutput{},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1399,10 +1960,15 @@ func TestAPIAuthGenerateCode(t *testing.T) {
 				tt.fields.authRPC,
 				tt.fields.userRPC,
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			got, err := itr.GenerateCode(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -1420,14 +1986,17 @@ func TestAPIAuthChangePassword(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
-		noticeRPC       rpc.Notice
-		authRPC         func(t *testing.T) rpc.Auth
-		userRPC         rpc.User
-		invitationCache cache.Cache[model.Invitation]
-		userCache       cache.Cache[model.User]
-		authCache       cache.Cache[model.Auth]
-		codeCache       cache.Cache[model.Code]
-		sessionCache    cache.Cache[model.Session]
+		noticeRPC          rpc.Notice
+		authRPC            func(t *testing.T) rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
 	}
 
 	type args struct {
@@ -1447,6 +2016,7 @@ func TestAPIAuthChangePassword(t *testing.T) {
 		{
 			name: "パスワードを変更できる",
 			fields: fields{
+				userRPC: ActiveUserRPC(t, user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab"))),
 				authRPC: func(t *testing.T) rpc.Auth {
 					t.Helper()
 					ctrl := gomock.NewController(t)
@@ -1513,6 +2083,7 @@ func TestAPIAuthChangePassword(t *testing.T) {
 						t.Helper()
 					},
 				},
+				sessionIndex: &cache.IndexMock{T: t},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -1535,11 +2106,14 @@ func TestAPIAuthChangePassword(t *testing.T) {
 				AuthToken: auth.GenerateAuthToken(
 					user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
 					auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")).ToSecret(),
+					auth.KeySet{},
 					auth.DefaultExpiresIn,
 				),
 				SessionToken: auth.GenerateSessionToken(
 					auth.SessionID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
 					auth.Secret("secret"),
+					auth.KeySet{},
 				),
 			},
 			wantErr: false,
@@ -1555,10 +2129,15 @@ func TestAPIAuthChangePassword(t *testing.T) {
 				tt.fields.authRPC(t),
 				tt.fields.userRPC,
 				tt.fields.invitationCache,
+				tt.fields.verificationCache,
 				tt.fields.userCache,
 				tt.fields.authCache,
 				tt.fields.codeCache,
 				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
 			)
 			_, err := itr.ChangePassword(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -1571,3 +2150,694 @@ func TestAPIAuthChangePassword(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIAuthChangeEmail(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		noticeRPC          func(t *testing.T) rpc.Notice
+		authRPC            func(t *testing.T) rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIAuthChangeEmailInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.APIAuthChangeEmailOutput
+		wantErr bool
+	}{
+		{
+			name: "メールアドレスの変更を申請できる",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					mock.EXPECT().Notify(
+						gomock.Any(),
+						auth.Email("new@example.com"),
+						gomock.Any(),
+						gomock.Any(),
+					).Return(notice.ID(""), nil)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().GetEmail(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(auth.Email("old@example.com"), nil)
+					mock.EXPECT().SignIn(
+						gomock.Any(),
+						auth.Email("old@example.com"),
+						auth.Password("password"),
+					).Return(model.User{
+						UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					}, nil)
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T: t,
+					SetAssert: func(t *testing.T, key string, value model.Verification, ttl time.Duration) {
+						t.Helper()
+						if value.Email != auth.Email("new@example.com") {
+							t.Errorf("email = %v, want %v", value.Email, "new@example.com")
+						}
+						if value.PreviousEmail != auth.Email("old@example.com") {
+							t.Errorf("previous email = %v, want %v", value.PreviousEmail, "old@example.com")
+						}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthChangeEmailInput{
+					UserID:   user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					Email:    auth.Email("new@example.com"),
+					Password: auth.Password("password"),
+				},
+			},
+			want:    usecase.APIAuthChangeEmailOutput{},
+			wantErr: false,
+		},
+		{
+			name: "現在と同じメールアドレスには変更できない",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().GetEmail(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(auth.Email("old@example.com"), nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthChangeEmailInput{
+					UserID:   user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					Email:    auth.Email("old@example.com"),
+					Password: auth.Password("password"),
+				},
+			},
+			want:    usecase.APIAuthChangeEmailOutput{},
+			wantErr: true,
+		},
+		{
+			name: "パスワードが誤っている場合はメールアドレスの変更を申請できない",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().GetEmail(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(auth.Email("old@example.com"), nil)
+					mock.EXPECT().SignIn(
+						gomock.Any(),
+						auth.Email("old@example.com"),
+						auth.Password("password"),
+					).Return(model.User{}, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthChangeEmailInput{
+					UserID:   user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					Email:    auth.Email("new@example.com"),
+					Password: auth.Password("password"),
+				},
+			},
+			want:    usecase.APIAuthChangeEmailOutput{},
+			wantErr: true,
+		},
+		{
+			name: "NoticeRPC.Notify()でエラーが発生してメールアドレスの変更を申請できない",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					mock.EXPECT().Notify(
+						gomock.Any(),
+						auth.Email("new@example.com"),
+						gomock.Any(),
+						gomock.Any(),
+					).Return(notice.ID(""), fmt.Errorf("error"))
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().GetEmail(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(auth.Email("old@example.com"), nil)
+					mock.EXPECT().SignIn(
+						gomock.Any(),
+						auth.Email("old@example.com"),
+						auth.Password("password"),
+					).Return(model.User{
+						UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					}, nil)
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T: t,
+					SetAssert: func(t *testing.T, key string, value model.Verification, ttl time.Duration) {
+						t.Helper()
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthChangeEmailInput{
+					UserID:   user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					Email:    auth.Email("new@example.com"),
+					Password: auth.Password("password"),
+				},
+			},
+			want:    usecase.APIAuthChangeEmailOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIAuth(
+				tt.fields.noticeRPC(t),
+				tt.fields.authRPC(t),
+				tt.fields.userRPC,
+				tt.fields.invitationCache,
+				tt.fields.verificationCache,
+				tt.fields.userCache,
+				tt.fields.authCache,
+				tt.fields.codeCache,
+				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
+			)
+			got, err := itr.ChangeEmail(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIAuth.ChangeEmail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("APIAuth.ChangeEmail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIAuthVerifyEmail(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		noticeRPC          func(t *testing.T) rpc.Notice
+		authRPC            func(t *testing.T) rpc.Auth
+		userRPC            rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		verificationCache  cache.Cache[model.Verification]
+		userCache          cache.Cache[model.User]
+		authCache          cache.Cache[model.Auth]
+		codeCache          cache.Cache[model.Code]
+		sessionCache       cache.Cache[model.Session]
+		sessionIndex       cache.Index
+		verificationPolicy auth.VerificationPolicy
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIAuthVerifyEmailInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.APIAuthVerifyEmailOutput
+		wantErr bool
+	}{
+		{
+			name: "メールアドレスを検証できる",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().MarkVerified(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(nil)
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T: t,
+					Value: model.Verification{
+						Code:   auth.VerificationCode("code"),
+						UserID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						Email:  auth.Email("test@example.com"),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "code" {
+							t.Errorf("key = %v, want %v", key, "code")
+						}
+					},
+					DelAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "code" {
+							t.Errorf("key = %v, want %v", key, "code")
+						}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthVerifyEmailInput{
+					Code: auth.VerificationCode("code"),
+				},
+			},
+			want:    usecase.APIAuthVerifyEmailOutput{},
+			wantErr: false,
+		},
+		{
+			name: "メールアドレスの変更を確定し旧メールアドレスへ通知できる",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					mock.EXPECT().Notify(
+						gomock.Any(),
+						auth.Email("old@example.com"),
+						gomock.Any(),
+						gomock.Any(),
+					).Return(notice.ID(""), nil)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().UpdateEmail(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						auth.Email("new@example.com"),
+					).Return(nil)
+					mock.EXPECT().MarkVerified(
+						gomock.Any(),
+						user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+					).Return(nil)
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T: t,
+					Value: model.Verification{
+						Code:          auth.VerificationCode("code"),
+						UserID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						Email:         auth.Email("new@example.com"),
+						PreviousEmail: auth.Email("old@example.com"),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+					DelAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthVerifyEmailInput{
+					Code: auth.VerificationCode("code"),
+				},
+			},
+			want:    usecase.APIAuthVerifyEmailOutput{},
+			wantErr: false,
+		},
+		{
+			name: "存在しない検証コードでは検証できない",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T:      t,
+					GetErr: fmt.Errorf("error"),
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthVerifyEmailInput{
+					Code: auth.VerificationCode("code"),
+				},
+			},
+			want:    usecase.APIAuthVerifyEmailOutput{},
+			wantErr: true,
+		},
+		{
+			name: "AuthRPC.UpdateEmail()でエラーが発生した場合は検証コードを消費しない",
+			fields: fields{
+				noticeRPC: func(t *testing.T) rpc.Notice {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockNotice(ctrl)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().UpdateEmail(
+						gomock.Any(),
+						gomock.Any(),
+						gomock.Any(),
+					).Return(fmt.Errorf("error"))
+					return mock
+				},
+				verificationCache: &cache.CacheMock[model.Verification]{
+					T: t,
+					Value: model.Verification{
+						Code:          auth.VerificationCode("code"),
+						UserID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+						Email:         auth.Email("new@example.com"),
+						PreviousEmail: auth.Email("old@example.com"),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+					DelAssert: func(t *testing.T, key string) {
+						t.Helper()
+						t.Error("verification code is consumed before the email is updated")
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthVerifyEmailInput{
+					Code: auth.VerificationCode("code"),
+				},
+			},
+			want:    usecase.APIAuthVerifyEmailOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIAuth(
+				tt.fields.noticeRPC(t),
+				tt.fields.authRPC(t),
+				tt.fields.userRPC,
+				tt.fields.invitationCache,
+				tt.fields.verificationCache,
+				tt.fields.userCache,
+				tt.fields.authCache,
+				tt.fields.codeCache,
+				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				tt.fields.verificationPolicy,
+				verificationURL,
+			)
+			got, err := itr.VerifyEmail(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIAuth.VerifyEmail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("APIAuth.VerifyEmail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIAuthDelete(t *testing.T) {
+	t.Parallel()
+
+	uid := user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab"))
+
+	deletion := model.UserDeletion{
+		Export: model.UserExport{
+			User:       model.NewUser(uid),
+			Articles:   []model.Article{},
+			ExportedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		PurgeAt: time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	// セッションの削除が完了したことを記録し、コアのデータの削除より先に行われたことを確認する
+	var signedOut int32
+
+	sessionCache := func(txErr error, signedOut *int32) *cache.CacheMock[model.Session] {
+		return &cache.CacheMock[model.Session]{
+			T: t,
+			CreateTxDelCmdAssert: func(t *testing.T, key string) {
+				t.Helper()
+			},
+			TxAssert: func(t *testing.T, setCmds []cache.TxSetCmd, delCmds []cache.TxDelCmd) {
+				t.Helper()
+				// セッション1件とユーザー1件、インデックスと認証情報が削除される
+				if len(delCmds) != 4 {
+					t.Errorf("len(delCmds) = %v, want %v", len(delCmds), 4)
+				}
+				if txErr == nil {
+					atomic.StoreInt32(signedOut, 1)
+				}
+			},
+			TxErr: txErr,
+		}
+	}
+
+	sessionIndex := &cache.IndexMock{
+		T: t,
+		MembersValue: []string{
+			"01234567-0123-0123-0123-0123456789ac",
+		},
+		MembersAssert: func(t *testing.T, key string) {
+			t.Helper()
+			if key != uid.String() {
+				t.Errorf("key = %v, want %v", key, uid.String())
+			}
+		},
+	}
+
+	authCache := &cache.CacheMock[model.Auth]{
+		T: t,
+		CreateTxDelCmdAssert: func(t *testing.T, key string) {
+			t.Helper()
+		},
+	}
+
+	userCache := &cache.CacheMock[model.User]{
+		T: t,
+		CreateTxDelCmdAssert: func(t *testing.T, key string) {
+			t.Helper()
+		},
+	}
+
+	type fields struct {
+		authRPC      func(t *testing.T) rpc.Auth
+		userRPC      func(t *testing.T) rpc.User
+		userCache    cache.Cache[model.User]
+		authCache    cache.Cache[model.Auth]
+		sessionCache cache.Cache[model.Session]
+		sessionIndex cache.Index
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIAuthDeleteInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.APIAuthDeleteOutput
+		wantErr bool
+	}{
+		{
+			name: "すべてのセッションを失効してからアカウントの削除を受け付け、認証基盤のユーザーは残す",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), uid).DoAndReturn(
+						func(_ context.Context, _ user.ID) (model.UserDeletion, error) {
+							if atomic.LoadInt32(&signedOut) != 1 {
+								t.Error("user is deleted before signing out all sessions")
+							}
+							return deletion, nil
+						},
+					)
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return rpc.NewMockAuth(ctrl)
+				},
+				authCache:    authCache,
+				sessionCache: sessionCache(nil, &signedOut),
+				sessionIndex: sessionIndex,
+				userCache:    userCache,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthDeleteInput{
+					UserID: uid,
+				},
+			},
+			want: usecase.APIAuthDeleteOutput{
+				Deletion: deletion,
+			},
+			wantErr: false,
+		},
+		{
+			name: "セッションの失効に失敗した場合は削除を受け付けない",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return rpc.NewMockUser(ctrl)
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return rpc.NewMockAuth(ctrl)
+				},
+				authCache:    authCache,
+				sessionCache: sessionCache(fmt.Errorf("test"), new(int32)),
+				sessionIndex: sessionIndex,
+				userCache:    userCache,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthDeleteInput{
+					UserID: uid,
+				},
+			},
+			want:    usecase.APIAuthDeleteOutput{},
+			wantErr: true,
+		},
+		{
+			name: "UserRPC.Delete()でエラーが発生した場合はエラーを返す",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), uid).Return(model.UserDeletion{}, fmt.Errorf("test"))
+					return mock
+				},
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return rpc.NewMockAuth(ctrl)
+				},
+				authCache:    authCache,
+				sessionCache: sessionCache(nil, new(int32)),
+				sessionIndex: sessionIndex,
+				userCache:    userCache,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIAuthDeleteInput{
+					UserID: uid,
+				},
+			},
+			want:    usecase.APIAuthDeleteOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIAuth(
+				nil,
+				tt.fields.authRPC(t),
+				tt.fields.userRPC(t),
+				nil,
+				nil,
+				tt.fields.userCache,
+				tt.fields.authCache,
+				nil,
+				tt.fields.sessionCache,
+				tt.fields.sessionIndex,
+				AuditRepository(t),
+				auth.VerificationPolicyNone,
+				verificationURL,
+			)
+			got, err := itr.Delete(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIAuth.Delete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("APIAuth.Delete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package interactor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+var _ usecase.APIOIDC = (*APIOIDC)(nil)
+
+// APIOIDC.
+type APIOIDC struct {
+	oidcRPC            rpc.OIDC
+	authRPC            rpc.Auth
+	userRPC            rpc.User
+	invitationCache    cache.Cache[model.Invitation]
+	authorizationCache cache.Cache[model.OIDCAuthorization]
+	userCache          cache.Cache[model.User]
+	authCache          cache.Cache[model.Auth]
+	sessionCache       cache.Cache[model.Session]
+	sessionIndex       cache.Index
+	auditRepository    repository.Audit
+	redirectURL        string
+}
+
+func NewAPIOIDC(
+	oidcRPC rpc.OIDC,
+	authRPC rpc.Auth,
+	userRPC rpc.User,
+	invitationCache cache.Cache[model.Invitation],
+	authorizationCache cache.Cache[model.OIDCAuthorization],
+	userCache cache.Cache[model.User],
+	authCache cache.Cache[model.Auth],
+	sessionCache cache.Cache[model.Session],
+	sessionIndex cache.Index,
+	auditRepository repository.Audit,
+	redirectURL string,
+) *APIOIDC {
+	return &APIOIDC{
+		oidcRPC:            oidcRPC,
+		authRPC:            authRPC,
+		userRPC:            userRPC,
+		invitationCache:    invitationCache,
+		authorizationCache: authorizationCache,
+		userCache:          userCache,
+		authCache:          authCache,
+		sessionCache:       sessionCache,
+		sessionIndex:       sessionIndex,
+		auditRepository:    auditRepository,
+		redirectURL:        redirectURL,
+	}
+}
+
+func (itr *APIOIDC) Authorize(
+	ctx context.Context,
+	input usecase.APIOIDCAuthorizeInput,
+) (usecase.APIOIDCAuthorizeOutput, error) {
+	if !itr.oidcRPC.Supports(input.Provider) {
+		return usecase.APIOIDCAuthorizeOutput{}, errors.NewNotFoundError(fmt.Sprintf("oidc provider: %s", input.Provider))
+	}
+
+	authz := model.GenerateOIDCAuthorization(input.Provider, input.InvitationCode, input.PublicKey, input.ExpiresIn)
+
+	url, err := itr.oidcRPC.AuthorizationURL(ctx, authz)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to generate authorization url", log.ErrorField(err))
+
+		return usecase.APIOIDCAuthorizeOutput{}, err
+	}
+
+	if err := itr.authorizationCache.Set(ctx, authz.State.String(), authz, model.DefaultOIDCAuthorizationExpiresIn); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to set oidc authorization", log.ErrorField(err))
+
+		return usecase.APIOIDCAuthorizeOutput{}, err
+	}
+
+	return usecase.APIOIDCAuthorizeOutput{
+		URL:   url,
+		State: authz.State,
+	}, nil
+}
+
+func (itr *APIOIDC) Callback(
+	ctx context.Context,
+	input usecase.APIOIDCCallbackInput,
+) (usecase.APIOIDCCallbackOutput, error) {
+	output, email, err := itr.callback(ctx, input)
+
+	recordAudit(ctx, itr.auditRepository, audit.EventSignIn, output.Auth.UserID, email, err)
+
+	return output, err
+}
+
+func (itr *APIOIDC) callback(
+	ctx context.Context,
+	input usecase.APIOIDCCallbackInput,
+) (usecase.APIOIDCCallbackOutput, auth.Email, error) {
+	// stateは一度しか利用できないよう取得と同時に削除する
+	authz, err := itr.authorizationCache.GetDel(ctx, input.State.String())
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get oidc authorization", log.ErrorField(err))
+
+		return usecase.APIOIDCCallbackOutput{}, "", errors.NewUnauthorizedError("invalid state", err)
+	}
+
+	if authz.Provider != input.Provider {
+		return usecase.APIOIDCCallbackOutput{}, "", errors.NewUnauthorizedError("oidc provider mismatch")
+	}
+
+	identity, err := itr.oidcRPC.Exchange(ctx, authz, input.Code)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to exchange authorization code", log.ErrorField(err))
+
+		return usecase.APIOIDCCallbackOutput{}, "", err
+	}
+
+	// 未検証のメールアドレスで既存ユーザーに紐付けると乗っ取りが可能になるため拒否する
+	if !identity.EmailVerified {
+		return usecase.APIOIDCCallbackOutput{}, identity.Email, errors.NewUnauthorizedError("email is not verified by oidc provider")
+	}
+
+	user, err := itr.findOrJoin(ctx, authz, identity)
+	if err != nil {
+		return usecase.APIOIDCCallbackOutput{}, identity.Email, err
+	}
+
+	output, err := issueSession(ctx, itr.userCache, itr.authCache, itr.sessionCache, itr.sessionIndex, user, authz.PublicKey, authz.ExpiresIn, input.Secret, input.KeySet)
+	if err != nil {
+		return usecase.APIOIDCCallbackOutput{}, identity.Email, err
+	}
+
+	return usecase.APIOIDCCallbackOutput{
+		RedirectURL:  itr.redirectURL,
+		Auth:         output.Auth,
+		AuthToken:    output.AuthToken,
+		SessionToken: output.SessionToken,
+	}, identity.Email, nil
+}
+
+// findOrJoin 検証済みメールアドレスで既存ユーザーを取得する.
+// 削除を受け付けたユーザーは猶予期間中も取得しない.
+// 該当するユーザーがいない場合は、Joinと同様に招待されたメールアドレスに限りユーザーを作成する.
+func (itr *APIOIDC) findOrJoin(
+	ctx context.Context,
+	authz model.OIDCAuthorization,
+	identity model.OIDCIdentity,
+) (model.User, error) {
+	user, err := itr.authRPC.FindByEmail(ctx, identity.Email)
+	if err == nil {
+		if err := ensureActive(ctx, itr.userRPC, user.UserID); err != nil {
+			return model.User{}, err
+		}
+
+		return user, nil
+	}
+
+	if !errors.AsNotFoundError(err) {
+		log.GetLogCtx(ctx).Warn("failed to find user by email", log.ErrorField(err))
+
+		return model.User{}, err
+	}
+
+	if !authz.HasInvitation() {
+		return model.User{}, errors.NewUnauthorizedError("invitation is required to join")
+	}
+
+	// 異なるメールアドレスでの使用によって招待が失われないよう、消費する前にメールアドレスを確認する
+	inv, err := itr.invitationCache.Get(ctx, authz.InvitationCode.String())
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get invitation", log.ErrorField(err))
+
+		return model.User{}, errors.NewNotFoundError(fmt.Sprintf("invitation code: %s", authz.InvitationCode))
+	}
+
+	if inv.Email != identity.Email {
+		return model.User{}, errors.NewUnauthorizedError("invitation email mismatch")
+	}
+
+	// 同じ招待が並行して使用されないよう、取得と同時に削除する
+	if _, err := itr.invitationCache.GetDel(ctx, authz.InvitationCode.String()); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to consume invitation", log.ErrorField(err))
+
+		return model.User{}, errors.NewNotFoundError(fmt.Sprintf("invitation code: %s", authz.InvitationCode))
+	}
+
+	user, err = itr.userRPC.Create(ctx)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to create user", log.ErrorField(err))
+
+		return model.User{}, err
+	}
+
+	if err := itr.authRPC.SignUp(ctx, user.UserID, identity.Email, auth.GenerateRandomPassword()); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to sign up", log.ErrorField(err))
+
+		return model.User{}, err
+	}
+
+	// 認証プロバイダで検証済みのため、メールアドレスの検証は不要とする
+	if err := itr.authRPC.MarkVerified(ctx, user.UserID); err != nil {
+		log.GetLogCtx(ctx).Warn("failed to mark verified", log.ErrorField(err))
+	}
+
+	recordAudit(ctx, itr.auditRepository, audit.EventInvitationUse, user.UserID, identity.Email, nil)
+
+	return user, nil
+}
@@ -0,0 +1,529 @@
+package interactor_test
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/application/interactor"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+)
+
+const redirectURL = "http://localhost:5173"
+
+func TestAPIOIDCAuthorize(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		oidcRPC            func(t *testing.T) rpc.OIDC
+		authorizationCache cache.Cache[model.OIDCAuthorization]
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIOIDCAuthorizeInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantURL string
+		wantErr bool
+	}{
+		{
+			name: "認可URLを発行できる",
+			fields: fields{
+				oidcRPC: func(t *testing.T) rpc.OIDC {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockOIDC(ctrl)
+					mock.EXPECT().Supports(auth.OIDCProvider("google")).Return(true)
+					mock.EXPECT().AuthorizationURL(gomock.Any(), gomock.Any()).Return("https://accounts.google.com/o/oauth2/v2/auth", nil)
+					return mock
+				},
+				authorizationCache: &cache.CacheMock[model.OIDCAuthorization]{
+					T: t,
+					SetAssert: func(t *testing.T, key string, value model.OIDCAuthorization, ttl time.Duration) {
+						t.Helper()
+						if key != value.State.String() {
+							t.Errorf("key = %v, want %v", key, value.State)
+						}
+						if value.Provider != auth.OIDCProvider("google") {
+							t.Errorf("provider = %v, want %v", value.Provider, "google")
+						}
+						if ttl != model.DefaultOIDCAuthorizationExpiresIn {
+							t.Errorf("ttl = %v, want %v", ttl, model.DefaultOIDCAuthorizationExpiresIn)
+						}
+					},
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIOIDCAuthorizeInput{
+					Provider:  auth.OIDCProvider("google"),
+					PublicKey: rsa.PublicKey{},
+					ExpiresIn: auth.DefaultExpiresIn,
+				},
+			},
+			wantURL: "https://accounts.google.com/o/oauth2/v2/auth",
+			wantErr: false,
+		},
+		{
+			name: "未設定の認証プロバイダでは認可URLを発行できない",
+			fields: fields{
+				oidcRPC: func(t *testing.T) rpc.OIDC {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockOIDC(ctrl)
+					mock.EXPECT().Supports(auth.OIDCProvider("unknown")).Return(false)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIOIDCAuthorizeInput{
+					Provider: auth.OIDCProvider("unknown"),
+				},
+			},
+			wantURL: "",
+			wantErr: true,
+		},
+		{
+			name: "キャッシュへの保存に失敗して認可URLを発行できない",
+			fields: fields{
+				oidcRPC: func(t *testing.T) rpc.OIDC {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockOIDC(ctrl)
+					mock.EXPECT().Supports(gomock.Any()).Return(true)
+					mock.EXPECT().AuthorizationURL(gomock.Any(), gomock.Any()).Return("https://accounts.google.com/o/oauth2/v2/auth", nil)
+					return mock
+				},
+				authorizationCache: &cache.CacheMock[model.OIDCAuthorization]{
+					T: t,
+					SetAssert: func(t *testing.T, key string, value model.OIDCAuthorization, ttl time.Duration) {
+						t.Helper()
+					},
+					SetErr: fmt.Errorf("error"),
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIOIDCAuthorizeInput{
+					Provider: auth.OIDCProvider("google"),
+				},
+			},
+			wantURL: "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIOIDC(
+				tt.fields.oidcRPC(t),
+				nil,
+				nil,
+				nil,
+				tt.fields.authorizationCache,
+				nil,
+				nil,
+				nil,
+				nil,
+				AuditRepository(t),
+				redirectURL,
+			)
+			got, err := itr.Authorize(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIOIDC.Authorize() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got.URL != tt.wantURL {
+				t.Errorf("APIOIDC.Authorize() = %v, want %v", got.URL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func newSessionCaches(t *testing.T) (cache.Cache[model.User], cache.Cache[model.Auth], cache.Cache[model.Session]) {
+	t.Helper()
+
+	return &cache.CacheMock[model.User]{
+		T: t,
+		CreateTxSetCmdAssert: func(t *testing.T, key string, value model.User, ttl time.Duration) {
+			t.Helper()
+		},
+	}, &cache.CacheMock[model.Auth]{
+		T: t,
+		CreateTxSetCmdAssert: func(t *testing.T, key string, value model.Auth, ttl time.Duration) {
+			t.Helper()
+		},
+	}, &cache.CacheMock[model.Session]{
+		T: t,
+		CreateTxSetCmdAssert: func(t *testing.T, key string, value model.Session, ttl time.Duration) {
+			t.Helper()
+		},
+		TxAssert: func(t *testing.T, setCmds []cache.TxSetCmd, delCmds []cache.TxDelCmd) {
+			t.Helper()
+		},
+	}
+}
+
+//nolint:funlen,maintidx
+func TestAPIOIDCCallback(t *testing.T) {
+	t.Parallel()
+
+	uid := user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab"))
+
+	authz := model.OIDCAuthorization{
+		State:     auth.OIDCState("state"),
+		Nonce:     auth.OIDCNonce("nonce"),
+		Provider:  auth.OIDCProvider("google"),
+		ExpiresIn: auth.DefaultExpiresIn,
+	}
+
+	invited := authz
+	invited.InvitationCode = auth.InvitationCode("invitation")
+
+	identity := model.OIDCIdentity{
+		Provider:      auth.OIDCProvider("google"),
+		Subject:       "subject",
+		Email:         auth.Email("test@example.com"),
+		EmailVerified: true,
+	}
+
+	type fields struct {
+		oidcRPC            func(t *testing.T) rpc.OIDC
+		authRPC            func(t *testing.T) rpc.Auth
+		userRPC            func(t *testing.T) rpc.User
+		invitationCache    cache.Cache[model.Invitation]
+		authorizationCache cache.Cache[model.OIDCAuthorization]
+		session            bool
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIOIDCCallbackInput
+	}
+
+	noOIDC := func(t *testing.T) rpc.OIDC {
+		t.Helper()
+
+		return rpc.NewMockOIDC(gomock.NewController(t))
+	}
+
+	noAuth := func(t *testing.T) rpc.Auth {
+		t.Helper()
+
+		return rpc.NewMockAuth(gomock.NewController(t))
+	}
+
+	noUser := func(t *testing.T) rpc.User {
+		t.Helper()
+
+		return rpc.NewMockUser(gomock.NewController(t))
+	}
+
+	getUser := func(err error) func(t *testing.T) rpc.User {
+		return func(t *testing.T) rpc.User {
+			t.Helper()
+			ctrl := gomock.NewController(t)
+			mock := rpc.NewMockUser(ctrl)
+			mock.EXPECT().Get(gomock.Any(), uid).Return(model.NewUser(uid), err)
+			return mock
+		}
+	}
+
+	exchange := func(idt model.OIDCIdentity) func(t *testing.T) rpc.OIDC {
+		return func(t *testing.T) rpc.OIDC {
+			t.Helper()
+			ctrl := gomock.NewController(t)
+			mock := rpc.NewMockOIDC(ctrl)
+			mock.EXPECT().Exchange(gomock.Any(), gomock.Any(), "code").Return(idt, nil)
+			return mock
+		}
+	}
+
+	authorization := func(value model.OIDCAuthorization) *cache.CacheMock[model.OIDCAuthorization] {
+		return &cache.CacheMock[model.OIDCAuthorization]{
+			T:     t,
+			Value: value,
+			GetDelAssert: func(t *testing.T, key string) {
+				t.Helper()
+				if key != "state" {
+					t.Errorf("key = %v, want %v", key, "state")
+				}
+			},
+		}
+	}
+
+	input := usecase.APIOIDCCallbackInput{
+		Secret:   auth.Secret("secret"),
+		Provider: auth.OIDCProvider("google"),
+		State:    auth.OIDCState("state"),
+		Code:     "code",
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "検証済みメールアドレスで既存ユーザーとしてサインインできる",
+			fields: fields{
+				oidcRPC: exchange(identity),
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().FindByEmail(gomock.Any(), auth.Email("test@example.com")).Return(model.User{UserID: uid}, nil)
+					return mock
+				},
+				userRPC:            getUser(nil),
+				authorizationCache: authorization(authz),
+				session:            true,
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: false,
+		},
+		{
+			name: "削除を受け付けたユーザーはサインインできない",
+			fields: fields{
+				oidcRPC: exchange(identity),
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().FindByEmail(gomock.Any(), auth.Email("test@example.com")).Return(model.User{UserID: uid}, nil)
+					return mock
+				},
+				userRPC:            getUser(errors.NewNotFoundError("not found")),
+				authorizationCache: authorization(authz),
+				session:            false,
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "招待されたメールアドレスであれば新規ユーザーとして参加できる",
+			fields: fields{
+				oidcRPC: exchange(identity),
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().FindByEmail(gomock.Any(), gomock.Any()).Return(model.User{}, errors.NewNotFoundError("not found"))
+					mock.EXPECT().SignUp(gomock.Any(), uid, auth.Email("test@example.com"), gomock.Any()).Return(nil)
+					mock.EXPECT().MarkVerified(gomock.Any(), uid).Return(nil)
+					return mock
+				},
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Create(gomock.Any()).Return(model.User{UserID: uid}, nil)
+					return mock
+				},
+				invitationCache: &cache.CacheMock[model.Invitation]{
+					T: t,
+					Value: model.Invitation{
+						Code:  auth.InvitationCode("invitation"),
+						Email: auth.Email("test@example.com"),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "invitation" {
+							t.Errorf("key = %v, want %v", key, "invitation")
+						}
+					},
+					GetDelAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != "invitation" {
+							t.Errorf("key = %v, want %v", key, "invitation")
+						}
+					},
+				},
+				authorizationCache: authorization(invited),
+				session:            true,
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: false,
+		},
+		{
+			name: "招待がない場合は新規ユーザーとして参加できない",
+			fields: fields{
+				oidcRPC: exchange(identity),
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().FindByEmail(gomock.Any(), gomock.Any()).Return(model.User{}, errors.NewNotFoundError("not found"))
+					return mock
+				},
+				userRPC:            noUser,
+				authorizationCache: authorization(authz),
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "招待されたメールアドレスと異なる場合は参加できない",
+			fields: fields{
+				oidcRPC: exchange(identity),
+				authRPC: func(t *testing.T) rpc.Auth {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockAuth(ctrl)
+					mock.EXPECT().FindByEmail(gomock.Any(), gomock.Any()).Return(model.User{}, errors.NewNotFoundError("not found"))
+					return mock
+				},
+				userRPC: noUser,
+				invitationCache: &cache.CacheMock[model.Invitation]{
+					T: t,
+					Value: model.Invitation{
+						Code:  auth.InvitationCode("invitation"),
+						Email: auth.Email("other@example.com"),
+					},
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+					GetDelAssert: func(t *testing.T, key string) {
+						t.Helper()
+						t.Error("invitation must not be consumed on email mismatch")
+					},
+				},
+				authorizationCache: authorization(invited),
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "未検証のメールアドレスではサインインできない",
+			fields: fields{
+				oidcRPC: exchange(model.OIDCIdentity{
+					Provider:      auth.OIDCProvider("google"),
+					Subject:       "subject",
+					Email:         auth.Email("test@example.com"),
+					EmailVerified: false,
+				}),
+				authRPC:            noAuth,
+				userRPC:            noUser,
+				authorizationCache: authorization(authz),
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "存在しないstateではサインインできない",
+			fields: fields{
+				oidcRPC: noOIDC,
+				authRPC: noAuth,
+				userRPC: noUser,
+				authorizationCache: &cache.CacheMock[model.OIDCAuthorization]{
+					T: t,
+					GetDelAssert: func(t *testing.T, key string) {
+						t.Helper()
+					},
+					GetDelErr: fmt.Errorf("error"),
+				},
+			},
+			args: args{
+				ctx:   context.Background(),
+				input: input,
+			},
+			wantErr: true,
+		},
+		{
+			name: "認可リクエストと異なる認証プロバイダではサインインできない",
+			fields: fields{
+				oidcRPC:            noOIDC,
+				authRPC:            noAuth,
+				userRPC:            noUser,
+				authorizationCache: authorization(authz),
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIOIDCCallbackInput{
+					Secret:   auth.Secret("secret"),
+					Provider: auth.OIDCProvider("github"),
+					State:    auth.OIDCState("state"),
+					Code:     "code",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var (
+				userCache    cache.Cache[model.User]
+				authCache    cache.Cache[model.Auth]
+				sessionCache cache.Cache[model.Session]
+			)
+			if tt.fields.session {
+				userCache, authCache, sessionCache = newSessionCaches(t)
+			}
+			itr := interactor.NewAPIOIDC(
+				tt.fields.oidcRPC(t),
+				tt.fields.authRPC(t),
+				tt.fields.userRPC(t),
+				tt.fields.invitationCache,
+				tt.fields.authorizationCache,
+				userCache,
+				authCache,
+				sessionCache,
+				&cache.IndexMock{T: t},
+				AuditRepository(t),
+				redirectURL,
+			)
+			got, err := itr.Callback(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIOIDC.Callback() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.RedirectURL != redirectURL {
+				t.Errorf("APIOIDC.Callback() redirect url = %v, want %v", got.RedirectURL, redirectURL)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got.Auth.UserID, uid) {
+				t.Errorf("APIOIDC.Callback() = %v, want %v", got.Auth.UserID, uid)
+			}
+		})
+	}
+}
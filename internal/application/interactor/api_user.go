@@ -0,0 +1,79 @@
+package interactor
+
+import (
+	"context"
+
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+var _ usecase.APIUser = (*APIUser)(nil)
+
+// APIUser.
+// 記事の共有者として表示する項目を更新した場合は、記事一覧のキャッシュを無効化する.
+type APIUser struct {
+	userRPC                 rpc.User
+	articleListVersionCache cache.Cache[string]
+}
+
+func NewAPIUser(
+	userRPC rpc.User,
+	articleListVersionCache cache.Cache[string],
+) *APIUser {
+	return &APIUser{
+		userRPC:                 userRPC,
+		articleListVersionCache: articleListVersionCache,
+	}
+}
+
+func (itr *APIUser) Get(
+	ctx context.Context,
+	input usecase.APIUserGetInput,
+) (usecase.APIUserGetOutput, error) {
+	usr, err := itr.userRPC.Get(ctx, input.UserID)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to get user", log.ErrorField(err))
+
+		return usecase.APIUserGetOutput{}, err
+	}
+
+	return usecase.APIUserGetOutput{
+		User: usr,
+	}, nil
+}
+
+func (itr *APIUser) Update(
+	ctx context.Context,
+	input usecase.APIUserUpdateInput,
+) (usecase.APIUserUpdateOutput, error) {
+	usr, err := itr.userRPC.Update(ctx, input.UserID, input.Profile, input.Fields)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to update user", log.ErrorField(err))
+
+		return usecase.APIUserUpdateOutput{}, err
+	}
+
+	if updatesSharer(input.Fields) {
+		if err := InvalidateArticleLists(ctx, itr.articleListVersionCache); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to invalidate article list cache", log.ErrorField(err))
+		}
+	}
+
+	return usecase.APIUserUpdateOutput{
+		User: usr,
+	}, nil
+}
+
+// updatesSharer 記事の共有者として表示する項目を更新するかどうかを判定する.
+func updatesSharer(fields []user.ProfileField) bool {
+	for _, field := range fields {
+		if field == user.FieldDisplayName || field == user.FieldAvatarURL {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,283 @@
+package interactor_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/application/interactor"
+	"github.com/morning-night-guild/platform-app/internal/application/usecase"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+)
+
+func TestAPIUserGet(t *testing.T) {
+	t.Parallel()
+
+	uid := user.ID(uuid.New())
+
+	usr := model.User{
+		UserID: uid,
+		Profile: model.UserProfile{
+			DisplayName: user.DisplayName("name"),
+			Locale:      user.Locale("ja"),
+			Timezone:    user.Timezone("Asia/Tokyo"),
+		},
+	}
+
+	type fields struct {
+		userRPC func(t *testing.T) rpc.User
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIUserGetInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.APIUserGetOutput
+		wantErr bool
+	}{
+		{
+			name: "ユーザーを取得できる",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Get(gomock.Any(), uid).Return(usr, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIUserGetInput{
+					UserID: uid,
+				},
+			},
+			want: usecase.APIUserGetOutput{
+				User: usr,
+			},
+			wantErr: false,
+		},
+		{
+			name: "rpcでerrorが発生してユーザーを取得できない",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Get(gomock.Any(), uid).Return(model.User{}, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIUserGetInput{
+					UserID: uid,
+				},
+			},
+			want:    usecase.APIUserGetOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIUser(tt.fields.userRPC(t), &cache.CacheMock[string]{T: t})
+			got, err := itr.Get(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIUser.Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("APIUser.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIUserUpdate(t *testing.T) {
+	t.Parallel()
+
+	uid := user.ID(uuid.New())
+
+	profile := model.UserProfile{
+		DisplayName: user.DisplayName("name"),
+		Bio:         user.Bio("bio"),
+	}
+
+	mask := []user.ProfileField{user.FieldDisplayName}
+
+	type fields struct {
+		userRPC                 func(t *testing.T) rpc.User
+		articleListVersionCache func(t *testing.T) cache.Cache[string]
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.APIUserUpdateInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.APIUserUpdateOutput
+		wantErr bool
+	}{
+		{
+			name: "フィールドマスクで指定した項目を更新できる",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Update(gomock.Any(), uid, profile, mask).Return(model.User{
+						UserID: uid,
+						Profile: model.UserProfile{
+							DisplayName: user.DisplayName("name"),
+						},
+					}, nil)
+					return mock
+				},
+				articleListVersionCache: func(t *testing.T) cache.Cache[string] {
+					t.Helper()
+					keys := map[string]bool{}
+					t.Cleanup(func() {
+						if !keys["all"] || !keys["delete"] {
+							t.Errorf("article list versions = %v, want all and delete", keys)
+						}
+					})
+					return &cache.CacheMock[string]{
+						T: t,
+						SetAssert: func(t *testing.T, key string, value string, ttl time.Duration) {
+							t.Helper()
+							keys[key] = true
+						},
+					}
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIUserUpdateInput{
+					UserID:  uid,
+					Profile: profile,
+					Fields:  mask,
+				},
+			},
+			want: usecase.APIUserUpdateOutput{
+				User: model.User{
+					UserID: uid,
+					Profile: model.UserProfile{
+						DisplayName: user.DisplayName("name"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "共有者として表示しない項目の更新では記事一覧のキャッシュを無効化しない",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Update(gomock.Any(), uid, profile, []user.ProfileField{user.FieldBio}).Return(model.User{
+						UserID: uid,
+						Profile: model.UserProfile{
+							Bio: user.Bio("bio"),
+						},
+					}, nil)
+					return mock
+				},
+				articleListVersionCache: func(t *testing.T) cache.Cache[string] {
+					t.Helper()
+					return &cache.CacheMock[string]{
+						T: t,
+						SetAssert: func(t *testing.T, key string, value string, ttl time.Duration) {
+							t.Helper()
+							t.Error("article list cache must not be invalidated")
+						},
+					}
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIUserUpdateInput{
+					UserID:  uid,
+					Profile: profile,
+					Fields:  []user.ProfileField{user.FieldBio},
+				},
+			},
+			want: usecase.APIUserUpdateOutput{
+				User: model.User{
+					UserID: uid,
+					Profile: model.UserProfile{
+						Bio: user.Bio("bio"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "rpcでerrorが発生してユーザーを更新できない",
+			fields: fields{
+				userRPC: func(t *testing.T) rpc.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := rpc.NewMockUser(ctrl)
+					mock.EXPECT().Update(gomock.Any(), uid, profile, mask).Return(model.User{}, fmt.Errorf("error"))
+					return mock
+				},
+				articleListVersionCache: func(t *testing.T) cache.Cache[string] {
+					t.Helper()
+					return &cache.CacheMock[string]{
+						T: t,
+						SetAssert: func(t *testing.T, key string, value string, ttl time.Duration) {
+							t.Helper()
+							t.Error("article list cache must not be invalidated")
+						},
+					}
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.APIUserUpdateInput{
+					UserID:  uid,
+					Profile: profile,
+					Fields:  mask,
+				},
+			},
+			want:    usecase.APIUserUpdateOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewAPIUser(tt.fields.userRPC(t), tt.fields.articleListVersionCache(t))
+			got, err := itr.Update(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("APIUser.Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("APIUser.Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -18,25 +18,38 @@ var _ usecase.CoreArticle = (*CoreArticle)(nil)
 type CoreArticle struct {
 	articleRepository repository.Article
 	userRepository    repository.User
+	transactor        repository.Transactor
 }
 
 func NewCoreArticle(
 	articleRepository repository.Article,
 	userRepository repository.User,
+	transactor repository.Transactor,
 ) *CoreArticle {
 	return &CoreArticle{
 		articleRepository: articleRepository,
 		userRepository:    userRepository,
+		transactor:        transactor,
 	}
 }
 
 // Share.
+// 共有したユーザーを指定した場合は、存在するユーザーであることを確認して記録する.
 func (itr *CoreArticle) Share(
 	ctx context.Context,
 	input usecase.CoreArticleShareInput,
 ) (usecase.CoreArticleShareOutput, error) {
 	item := model.CreateArticle(input.URL, input.Title, input.Description, input.Thumbnail, []article.Tag{})
 
+	if input.SharedBy != nil {
+		sharer, err := itr.userRepository.Find(ctx, *input.SharedBy)
+		if err != nil {
+			return usecase.CoreArticleShareOutput{}, err
+		}
+
+		item.SharedBy = model.NewArticleSharer(sharer)
+	}
+
 	if err := itr.articleRepository.Save(ctx, item); err != nil {
 		return usecase.CoreArticleShareOutput{}, err
 	}
@@ -96,6 +109,33 @@ func (itr *CoreArticle) Delete(
 	return usecase.CoreArticleDeleteOutput{}, nil
 }
 
+// ListTrash.
+func (itr *CoreArticle) ListTrash(
+	ctx context.Context,
+	input usecase.CoreArticleListTrashInput,
+) (usecase.CoreArticleListTrashOutput, error) {
+	articles, err := itr.articleRepository.ListTrash(ctx, input.Index, input.Size)
+	if err != nil {
+		return usecase.CoreArticleListTrashOutput{}, err
+	}
+
+	return usecase.CoreArticleListTrashOutput{
+		Articles: articles,
+	}, nil
+}
+
+// Restore.
+func (itr *CoreArticle) Restore(
+	ctx context.Context,
+	input usecase.CoreArticleRestoreInput,
+) (usecase.CoreArticleRestoreOutput, error) {
+	if err := itr.articleRepository.Restore(ctx, input.ArticleID); err != nil {
+		return usecase.CoreArticleRestoreOutput{}, err
+	}
+
+	return usecase.CoreArticleRestoreOutput{}, nil
+}
+
 func (itr *CoreArticle) AddToUser(
 	ctx context.Context,
 	input usecase.CoreArticleAddToUserInput,
@@ -105,23 +145,30 @@ func (itr *CoreArticle) AddToUser(
 	// UserとArticleのFindは不要だが(存在しなければ追加に失敗&複数無駄な通信が発生)
 	// UseCaseのわかりやすさ優先で以下の実装とする
 	// パフォーマンス問題が発生したら修正する
-	if _, err := itr.userRepository.Find(ctx, input.UserID); err != nil {
-		log.GetLogCtx(ctx).Warn(fmt.Sprintf("user not found. id=%s", input.UserID), log.ErrorField(err))
+	// 確認から追加までの間に削除されないよう、同じトランザクションで行う
+	if err := itr.transactor.RunInTx(ctx, func(ctx context.Context) error {
+		if _, err := itr.userRepository.Find(ctx, input.UserID); err != nil {
+			log.GetLogCtx(ctx).Warn(fmt.Sprintf("user not found. id=%s", input.UserID), log.ErrorField(err))
 
-		return usecase.CoreArticleAddToUserOutput{}, err
-	}
+			return err
+		}
 
-	if _, err := itr.articleRepository.Find(ctx, input.ArticleID); err != nil {
-		log.GetLogCtx(ctx).Warn(fmt.Sprintf("article not found. id=%s", input.ArticleID), log.ErrorField(err))
+		if _, err := itr.articleRepository.Find(ctx, input.ArticleID); err != nil {
+			log.GetLogCtx(ctx).Warn(fmt.Sprintf("article not found. id=%s", input.ArticleID), log.ErrorField(err))
 
-		return usecase.CoreArticleAddToUserOutput{}, err
-	}
+			return err
+		}
+
+		if err := itr.articleRepository.AddToUser(ctx, input.ArticleID, input.UserID); err != nil {
+			msg := fmt.Sprintf("failed to add article to user. article_id=%s, user_id=%s", input.ArticleID, input.UserID)
 
-	if err := itr.articleRepository.AddToUser(ctx, input.ArticleID, input.UserID); err != nil {
-		msg := fmt.Sprintf("failed to add article to user. article_id=%s, user_id=%s", input.ArticleID, input.UserID)
+			log.GetLogCtx(ctx).Warn(msg, log.ErrorField(err))
 
-		log.GetLogCtx(ctx).Warn(msg, log.ErrorField(err))
+			return err
+		}
 
+		return nil
+	}); err != nil {
 		return usecase.CoreArticleAddToUserOutput{}, err
 	}
 
@@ -132,13 +179,15 @@ func (itr *CoreArticle) RemoveFromUser(
 	ctx context.Context,
 	input usecase.CoreArticleRemoveFromUserInput,
 ) (usecase.CoreArticleRemoveFromUserOutput, error) {
-	if exists, err := itr.articleRepository.ExistsByUser(ctx, input.ArticleID, input.UserID); err != nil {
-		return usecase.CoreArticleRemoveFromUserOutput{}, err
-	} else if !exists {
-		return usecase.CoreArticleRemoveFromUserOutput{}, errors.NewNotFoundError("user article not found")
-	}
-
-	if err := itr.articleRepository.RemoveFromUser(ctx, input.ArticleID, input.UserID); err != nil {
+	if err := itr.transactor.RunInTx(ctx, func(ctx context.Context) error {
+		if exists, err := itr.articleRepository.ExistsByUser(ctx, input.ArticleID, input.UserID); err != nil {
+			return err
+		} else if !exists {
+			return errors.NewNotFoundError("user article not found")
+		}
+
+		return itr.articleRepository.RemoveFromUser(ctx, input.ArticleID, input.UserID)
+	}); err != nil {
 		return usecase.CoreArticleRemoveFromUserOutput{}, err
 	}
 
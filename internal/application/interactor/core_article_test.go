@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
@@ -21,8 +22,16 @@ import (
 func TestCoreArticleShare(t *testing.T) {
 	t.Parallel()
 
+	sharer := model.User{
+		UserID: user.ID(uuid.MustParse(uid)),
+		Profile: model.UserProfile{
+			DisplayName: user.DisplayName("name"),
+		},
+	}
+
 	type fields struct {
 		articleRepository func(t *testing.T) repository.Article
+		userRepository    func(t *testing.T) repository.User
 	}
 
 	type args struct {
@@ -50,6 +59,11 @@ func TestCoreArticleShare(t *testing.T) {
 					).Return(nil)
 					return mock
 				},
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return repository.NewMockUser(ctrl)
+				},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -84,6 +98,82 @@ func TestCoreArticleShare(t *testing.T) {
 					).Return(fmt.Errorf("error"))
 					return mock
 				},
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return repository.NewMockUser(ctrl)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreArticleShareInput{
+					URL:         article.URL("https://example.com"),
+					Title:       article.Title("title"),
+					Description: article.Description("description"),
+					Thumbnail:   article.Thumbnail("https://example.com"),
+				},
+			},
+			want:    usecase.CoreArticleShareOutput{},
+			wantErr: true,
+		},
+		{
+			name: "共有したユーザーを記録して記事を共有できる",
+			fields: fields{
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					mock.EXPECT().Save(
+						gomock.Any(),
+						gomock.Any(),
+					).Return(nil)
+					return mock
+				},
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Find(gomock.Any(), sharer.UserID).Return(sharer, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreArticleShareInput{
+					URL:         article.URL("https://example.com"),
+					Title:       article.Title("title"),
+					Description: article.Description("description"),
+					Thumbnail:   article.Thumbnail("https://example.com"),
+					SharedBy:    &sharer.UserID,
+				},
+			},
+			want: usecase.CoreArticleShareOutput{
+				Article: model.Article{
+					ArticleID:   article.ID(uuid.New()),
+					URL:         article.URL("https://example.com"),
+					Title:       article.Title("title"),
+					Description: article.Description("description"),
+					Thumbnail:   article.Thumbnail("https://example.com"),
+					SharedBy:    model.NewArticleSharer(sharer),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "共有したユーザーが存在しない場合は記事を共有しない",
+			fields: fields{
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return repository.NewMockArticle(ctrl)
+				},
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Find(gomock.Any(), sharer.UserID).Return(model.User{}, fmt.Errorf("error"))
+					return mock
+				},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -92,6 +182,7 @@ func TestCoreArticleShare(t *testing.T) {
 					Title:       article.Title("title"),
 					Description: article.Description("description"),
 					Thumbnail:   article.Thumbnail("https://example.com"),
+					SharedBy:    &sharer.UserID,
 				},
 			},
 			want:    usecase.CoreArticleShareOutput{},
@@ -105,6 +196,7 @@ func TestCoreArticleShare(t *testing.T) {
 			t.Parallel()
 			itr := interactor.NewCoreArticle(
 				tt.fields.articleRepository(t),
+				tt.fields.userRepository(t),
 				nil,
 			)
 			got, err := itr.Share(tt.args.ctx, tt.args.input)
@@ -127,6 +219,9 @@ func TestCoreArticleShare(t *testing.T) {
 			if !reflect.DeepEqual(got.Article.Thumbnail, tt.want.Article.Thumbnail) {
 				t.Errorf("CoreArticle.Share() got Article.Thumbnail = %v, want %v", got.Article.Thumbnail, tt.want.Article.Thumbnail)
 			}
+			if !reflect.DeepEqual(got.Article.SharedBy, tt.want.Article.SharedBy) {
+				t.Errorf("CoreArticle.Share() got Article.SharedBy = %v, want %v", got.Article.SharedBy, tt.want.Article.SharedBy)
+			}
 		})
 	}
 }
@@ -314,6 +409,7 @@ func TestCoreArticleListByUser(t *testing.T) {
 			itr := interactor.NewCoreArticle(
 				tt.fields.articleRepository(t),
 				tt.fields.userRepository(t),
+				nil,
 			)
 			got, err := itr.ListByUser(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -449,6 +545,7 @@ func TestCoreArticleList(t *testing.T) {
 			itr := interactor.NewCoreArticle(
 				tt.fields.articleRepository(t),
 				nil,
+				nil,
 			)
 			got, err := itr.List(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -578,6 +675,7 @@ func TestCoreArticleDelete(t *testing.T) {
 			itr := interactor.NewCoreArticle(
 				tt.fields.articleRepository(t),
 				nil,
+				nil,
 			)
 			got, err := itr.Delete(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -591,12 +689,209 @@ func TestCoreArticleDelete(t *testing.T) {
 	}
 }
 
+func TestCoreArticleListTrash(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		articleRepository func(*testing.T) repository.Article
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.CoreArticleListTrashInput
+	}
+
+	trashed := model.TrashedArticle{
+		Article: model.Article{
+			ArticleID:   article.ID(uuid.New()),
+			Title:       article.Title("title"),
+			URL:         article.URL("https://example.com"),
+			Description: article.Description("description"),
+			Thumbnail:   article.Thumbnail("https://example.com"),
+			TagList:     article.TagList{},
+		},
+		DeletedAt: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.CoreArticleListTrashOutput
+		wantErr bool
+	}{
+		{
+			name: "ゴミ箱の記事を一覧できる",
+			fields: fields{
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					mock.EXPECT().ListTrash(
+						gomock.Any(),
+						value.Index(0),
+						value.Size(10),
+					).Return([]model.TrashedArticle{trashed}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreArticleListTrashInput{
+					Index: value.Index(0),
+					Size:  value.Size(10),
+				},
+			},
+			want: usecase.CoreArticleListTrashOutput{
+				Articles: []model.TrashedArticle{trashed},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ゴミ箱の一覧のerrorを握りつぶさない",
+			fields: fields{
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					mock.EXPECT().ListTrash(
+						gomock.Any(),
+						value.Index(0),
+						value.Size(10),
+					).Return(nil, fmt.Errorf("error"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreArticleListTrashInput{
+					Index: value.Index(0),
+					Size:  value.Size(10),
+				},
+			},
+			want:    usecase.CoreArticleListTrashOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewCoreArticle(
+				tt.fields.articleRepository(t),
+				nil,
+				nil,
+			)
+			got, err := itr.ListTrash(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CoreArticle.ListTrash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CoreArticle.ListTrash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCoreArticleRestore(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		articleRepository func(*testing.T) repository.Article
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.CoreArticleRestoreInput
+	}
+
+	id := article.ID(uuid.New())
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.CoreArticleRestoreOutput
+		wantErr bool
+	}{
+		{
+			name: "ゴミ箱の記事を復元できる",
+			fields: fields{
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					mock.EXPECT().Restore(
+						gomock.Any(),
+						id,
+					).Return(nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreArticleRestoreInput{
+					ArticleID: id,
+				},
+			},
+			want:    usecase.CoreArticleRestoreOutput{},
+			wantErr: false,
+		},
+		{
+			name: "ゴミ箱にない記事は復元できない",
+			fields: fields{
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					mock.EXPECT().Restore(
+						gomock.Any(),
+						id,
+					).Return(errors.NewNotFoundError("article not found in trash"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreArticleRestoreInput{
+					ArticleID: id,
+				},
+			},
+			want:    usecase.CoreArticleRestoreOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewCoreArticle(
+				tt.fields.articleRepository(t),
+				nil,
+				nil,
+			)
+			got, err := itr.Restore(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CoreArticle.Restore() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CoreArticle.Restore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCoreArticleAddToUser(t *testing.T) {
 	t.Parallel()
 
 	type fields struct {
 		articleRepository func(*testing.T) repository.Article
 		userRepository    func(*testing.T) repository.User
+		transactor        func(*testing.T) repository.Transactor
 	}
 
 	type args struct {
@@ -654,6 +949,7 @@ func TestCoreArticleAddToUser(t *testing.T) {
 					}, nil)
 					return mock
 				},
+				transactor: runInTx,
 			},
 			args: args{
 				ctx: context.Background(),
@@ -684,6 +980,7 @@ func TestCoreArticleAddToUser(t *testing.T) {
 					).Return(model.User{}, fmt.Errorf("error"))
 					return mock
 				},
+				transactor: runInTx,
 			},
 			args: args{
 				ctx: context.Background(),
@@ -720,6 +1017,63 @@ func TestCoreArticleAddToUser(t *testing.T) {
 					}, nil)
 					return mock
 				},
+				transactor: runInTx,
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreArticleAddToUserInput{
+					ArticleID: aid,
+					UserID:    uid,
+				},
+			},
+			want:    usecase.CoreArticleAddToUserOutput{},
+			wantErr: true,
+		},
+		{
+			name: "トランザクションのコミットに失敗した場合はエラーになる",
+			fields: fields{
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					mock.EXPECT().Find(
+						gomock.Any(),
+						aid,
+					).Return(article, nil)
+					mock.EXPECT().AddToUser(
+						gomock.Any(),
+						aid,
+						uid,
+					).Return(nil)
+					return mock
+				},
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Find(
+						gomock.Any(),
+						uid,
+					).Return(model.User{
+						UserID: uid,
+					}, nil)
+					return mock
+				},
+				transactor: func(t *testing.T) repository.Transactor {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockTransactor(ctrl)
+					mock.EXPECT().RunInTx(
+						gomock.Any(),
+						gomock.Any(),
+					).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+						if err := fn(ctx); err != nil {
+							return err
+						}
+						return fmt.Errorf("commit error")
+					})
+					return mock
+				},
 			},
 			args: args{
 				ctx: context.Background(),
@@ -740,6 +1094,7 @@ func TestCoreArticleAddToUser(t *testing.T) {
 			itr := interactor.NewCoreArticle(
 				tt.fields.articleRepository(t),
 				tt.fields.userRepository(t),
+				tt.fields.transactor(t),
 			)
 			got, err := itr.AddToUser(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -759,6 +1114,7 @@ func TestCoreArticleRemoveFromUser(t *testing.T) {
 	type fields struct {
 		articleRepository func(*testing.T) repository.Article
 		userRepository    func(*testing.T) repository.User
+		transactor        func(*testing.T) repository.Transactor
 	}
 
 	type args struct {
@@ -802,6 +1158,7 @@ func TestCoreArticleRemoveFromUser(t *testing.T) {
 					mock := repository.NewMockUser(ctrl)
 					return mock
 				},
+				transactor: runInTx,
 			},
 			args: args{
 				ctx: context.Background(),
@@ -833,6 +1190,7 @@ func TestCoreArticleRemoveFromUser(t *testing.T) {
 					mock := repository.NewMockUser(ctrl)
 					return mock
 				},
+				transactor: runInTx,
 			},
 			args: args{
 				ctx: context.Background(),
@@ -853,6 +1211,7 @@ func TestCoreArticleRemoveFromUser(t *testing.T) {
 			itr := interactor.NewCoreArticle(
 				tt.fields.articleRepository(t),
 				tt.fields.userRepository(t),
+				tt.fields.transactor(t),
 			)
 			got, err := itr.RemoveFromUser(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
@@ -865,3 +1224,17 @@ func TestCoreArticleRemoveFromUser(t *testing.T) {
 		})
 	}
 }
+
+// runInTx トランザクション内でfnを実行するトランザクションのモック.
+func runInTx(t *testing.T) repository.Transactor {
+	t.Helper()
+	ctrl := gomock.NewController(t)
+	mock := repository.NewMockTransactor(ctrl)
+	mock.EXPECT().RunInTx(
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	return mock
+}
@@ -2,24 +2,32 @@ package interactor
 
 import (
 	"context"
+	"time"
 
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
 	"github.com/morning-night-guild/platform-app/pkg/log"
 )
 
 var _ usecase.CoreUser = (*CoreUser)(nil)
 
 type CoreUser struct {
-	userRepository repository.User
+	userRepository    repository.User
+	articleRepository repository.Article
+	transactor        repository.Transactor
 }
 
 func NewCoreUser(
 	userRepository repository.User,
+	articleRepository repository.Article,
+	transactor repository.Transactor,
 ) *CoreUser {
 	return &CoreUser{
-		userRepository: userRepository,
+		userRepository:    userRepository,
+		articleRepository: articleRepository,
+		transactor:        transactor,
 	}
 }
 
@@ -40,19 +48,32 @@ func (itr *CoreUser) Create(
 	}, nil
 }
 
-func (itr *CoreUser) Update(
+func (itr *CoreUser) Get(
 	ctx context.Context,
-	input usecase.CoreUserUpdateInput,
-) (usecase.CoreUserUpdateOutput, error) {
+	input usecase.CoreUserGetInput,
+) (usecase.CoreUserGetOutput, error) {
 	user, err := itr.userRepository.Find(ctx, input.UserID)
 	if err != nil {
 		log.GetLogCtx(ctx).Warn("failed to find user", log.ErrorField(err))
 
-		return usecase.CoreUserUpdateOutput{}, err
+		return usecase.CoreUserGetOutput{}, err
 	}
 
-	if err := itr.userRepository.Save(ctx, user); err != nil {
-		log.GetLogCtx(ctx).Warn("failed to save user", log.ErrorField(err))
+	return usecase.CoreUserGetOutput{
+		User: user,
+	}, nil
+}
+
+// Update 指定した項目のみプロフィールを更新する.
+// 項目を指定しない場合は更新日時のみ更新する.
+func (itr *CoreUser) Update(
+	ctx context.Context,
+	input usecase.CoreUserUpdateInput,
+) (usecase.CoreUserUpdateOutput, error) {
+	// 読み取ってから保存すると同時の更新を上書きするため、指定した項目のみ1回で更新する
+	user, err := itr.userRepository.UpdateProfile(ctx, input.UserID, input.Profile, input.Fields)
+	if err != nil {
+		log.GetLogCtx(ctx).Warn("failed to update user", log.ErrorField(err))
 
 		return usecase.CoreUserUpdateOutput{}, err
 	}
@@ -61,3 +82,69 @@ func (itr *CoreUser) Update(
 		User: user,
 	}, nil
 }
+
+// Delete ユーザーの削除を受け付ける.
+// 削除の受付とエクスポートを同じトランザクションで行い、エクスポートに失敗した場合は削除を受け付けない.
+// 受け付け済みのユーザーを再度削除した場合も、最初に受け付けた日時を起点とした削除日時とデータの写しを返す.
+func (itr *CoreUser) Delete(
+	ctx context.Context,
+	input usecase.CoreUserDeleteInput,
+) (usecase.CoreUserDeleteOutput, error) {
+	var deletion model.UserDeletion
+
+	if err := itr.transactor.RunInTx(ctx, func(ctx context.Context) error {
+		deleted, err := itr.userRepository.Delete(ctx, input.UserID)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to delete user", log.ErrorField(err))
+
+			return err
+		}
+
+		export, err := itr.export(ctx, deleted.User)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to export user", log.ErrorField(err))
+
+			return err
+		}
+
+		deletion = model.NewUserDeletion(export, deleted.DeletedAt)
+
+		return nil
+	}); err != nil {
+		return usecase.CoreUserDeleteOutput{}, err
+	}
+
+	return usecase.CoreUserDeleteOutput{
+		Deletion: deletion,
+	}, nil
+}
+
+// export ユーザーのデータの写しを作成する.
+// ゴミ箱に移動した記事も完全に削除されるまではユーザーのデータのため含める.
+func (itr *CoreUser) export(
+	ctx context.Context,
+	usr model.User,
+) (model.UserExport, error) {
+	articles := make([]model.Article, 0)
+
+	size := model.UserExportPageSize
+
+	for index := value.Index(0); ; index += value.Index(size) {
+		items, err := itr.articleRepository.ListAllByUser(ctx, usr.UserID, index, size)
+		if err != nil {
+			return model.UserExport{}, err
+		}
+
+		articles = append(articles, items...)
+
+		if len(items) < size.Int() {
+			break
+		}
+	}
+
+	return model.UserExport{
+		User:       usr,
+		Articles:   articles,
+		ExportedAt: time.Now().UTC(),
+	}, nil
+}
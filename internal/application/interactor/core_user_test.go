@@ -5,14 +5,17 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/internal/application/interactor"
 	"github.com/morning-night-guild/platform-app/internal/application/usecase"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
 )
 
 const uid = "01234567-0123-0123-0123-0123456789ab"
@@ -78,7 +81,7 @@ func TestCoreUserCreate(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			itr := interactor.NewCoreUser(tt.fields.userRepository(t))
+			itr := interactor.NewCoreUser(tt.fields.userRepository(t), nil, nil)
 			got, err := itr.Create(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CoreUser.Create() error = %v, wantErr %v", err, tt.wantErr)
@@ -91,6 +94,96 @@ func TestCoreUserCreate(t *testing.T) {
 	}
 }
 
+func TestCoreUserGet(t *testing.T) {
+	t.Parallel()
+
+	type fields struct {
+		userRepository func(t *testing.T) repository.User
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.CoreUserGetInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.CoreUserGetOutput
+		wantErr bool
+	}{
+		{
+			name: "ユーザーが取得できる",
+			fields: fields{
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Find(gomock.Any(), user.ID(uuid.MustParse(uid))).Return(model.User{
+						UserID: user.ID(uuid.MustParse(uid)),
+						Profile: model.UserProfile{
+							DisplayName: user.DisplayName("name"),
+						},
+					}, nil)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreUserGetInput{
+					UserID: user.ID(uuid.MustParse(uid)),
+				},
+			},
+			want: usecase.CoreUserGetOutput{
+				User: model.User{
+					UserID: user.ID(uuid.MustParse(uid)),
+					Profile: model.UserProfile{
+						DisplayName: user.DisplayName("name"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "UserRepository.Find()でエラーが発生してユーザーが取得できない",
+			fields: fields{
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Find(gomock.Any(), gomock.Any()).Return(model.User{}, fmt.Errorf("test"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreUserGetInput{
+					UserID: user.ID(uuid.MustParse(uid)),
+				},
+			},
+			want:    usecase.CoreUserGetOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewCoreUser(tt.fields.userRepository(t), nil, nil)
+			got, err := itr.Get(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CoreUser.Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CoreUser.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCoreUserUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -117,10 +210,9 @@ func TestCoreUserUpdate(t *testing.T) {
 					t.Helper()
 					ctrl := gomock.NewController(t)
 					mock := repository.NewMockUser(ctrl)
-					mock.EXPECT().Find(gomock.Any(), gomock.Any()).Return(model.User{
+					mock.EXPECT().UpdateProfile(gomock.Any(), user.ID(uuid.MustParse(uid)), model.UserProfile{}, nil).Return(model.User{
 						UserID: user.ID(uuid.MustParse(uid)),
 					}, nil)
-					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
 					return mock
 				},
 			},
@@ -138,13 +230,26 @@ func TestCoreUserUpdate(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "UserRepository.Find()でエラーが発生してユーザーが更新できない",
+			name: "指定した項目のみプロフィールを更新できる",
 			fields: fields{
 				userRepository: func(t *testing.T) repository.User {
 					t.Helper()
 					ctrl := gomock.NewController(t)
 					mock := repository.NewMockUser(ctrl)
-					mock.EXPECT().Find(gomock.Any(), user.ID(uuid.MustParse(uid))).Return(model.User{}, fmt.Errorf("test"))
+					mock.EXPECT().UpdateProfile(
+						gomock.Any(),
+						user.ID(uuid.MustParse(uid)),
+						model.UserProfile{
+							DisplayName: user.DisplayName("after"),
+						},
+						[]user.ProfileField{user.FieldDisplayName},
+					).Return(model.User{
+						UserID: user.ID(uuid.MustParse(uid)),
+						Profile: model.UserProfile{
+							DisplayName: user.DisplayName("after"),
+							Bio:         user.Bio("bio"),
+						},
+					}, nil)
 					return mock
 				},
 			},
@@ -152,24 +257,31 @@ func TestCoreUserUpdate(t *testing.T) {
 				ctx: context.Background(),
 				input: usecase.CoreUserUpdateInput{
 					UserID: user.ID(uuid.MustParse(uid)),
+					Profile: model.UserProfile{
+						DisplayName: user.DisplayName("after"),
+					},
+					Fields: []user.ProfileField{user.FieldDisplayName},
 				},
 			},
 			want: usecase.CoreUserUpdateOutput{
-				User: model.User{},
+				User: model.User{
+					UserID: user.ID(uuid.MustParse(uid)),
+					Profile: model.UserProfile{
+						DisplayName: user.DisplayName("after"),
+						Bio:         user.Bio("bio"),
+					},
+				},
 			},
-			wantErr: true,
+			wantErr: false,
 		},
 		{
-			name: "UserRepository.Save()でエラーが発生してユーザーが更新できない",
+			name: "UserRepository.UpdateProfile()でエラーが発生してユーザーが更新できない",
 			fields: fields{
 				userRepository: func(t *testing.T) repository.User {
 					t.Helper()
 					ctrl := gomock.NewController(t)
 					mock := repository.NewMockUser(ctrl)
-					mock.EXPECT().Find(gomock.Any(), user.ID(uuid.MustParse(uid))).Return(model.User{
-						UserID: user.ID(uuid.MustParse(uid)),
-					}, nil)
-					mock.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("test"))
+					mock.EXPECT().UpdateProfile(gomock.Any(), user.ID(uuid.MustParse(uid)), gomock.Any(), gomock.Any()).Return(model.User{}, fmt.Errorf("test"))
 					return mock
 				},
 			},
@@ -190,7 +302,7 @@ func TestCoreUserUpdate(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			itr := interactor.NewCoreUser(tt.fields.userRepository(t))
+			itr := interactor.NewCoreUser(tt.fields.userRepository(t), nil, nil)
 			got, err := itr.Update(tt.args.ctx, tt.args.input)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("CoreUser.Update() error = %v, wantErr %v", err, tt.wantErr)
@@ -202,3 +314,161 @@ func TestCoreUserUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestCoreUserDelete(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	deleted := model.DeletedUser{
+		User: model.User{
+			UserID: user.ID(uuid.MustParse(uid)),
+			Profile: model.UserProfile{
+				DisplayName: user.DisplayName("name"),
+			},
+		},
+		DeletedAt: deletedAt,
+	}
+
+	// 1ページ分の記事と次のページの1件
+	articles := make([]model.Article, model.UserExportPageSize.Int()+1)
+	for i := range articles {
+		articles[i] = model.CreateArticle(
+			article.URL(fmt.Sprintf("https://example.com/%d", i)),
+			article.Title("title"),
+			article.Description("description"),
+			article.Thumbnail("https://example.com"),
+			article.TagList{},
+		)
+	}
+
+	type fields struct {
+		userRepository    func(t *testing.T) repository.User
+		articleRepository func(t *testing.T) repository.Article
+	}
+
+	type args struct {
+		ctx   context.Context
+		input usecase.CoreUserDeleteInput
+	}
+
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    usecase.CoreUserDeleteOutput
+		wantErr bool
+	}{
+		{
+			name: "削除を受け付け、共有した記事を含むデータの写しを返す",
+			fields: fields{
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), user.ID(uuid.MustParse(uid))).Return(deleted, nil)
+					return mock
+				},
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					size := model.UserExportPageSize
+					gomock.InOrder(
+						mock.EXPECT().ListAllByUser(gomock.Any(), user.ID(uuid.MustParse(uid)), value.Index(0), size).
+							Return(articles[:size.Int()], nil),
+						mock.EXPECT().ListAllByUser(gomock.Any(), user.ID(uuid.MustParse(uid)), value.Index(size.Int()), size).
+							Return(articles[size.Int():], nil),
+					)
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreUserDeleteInput{
+					UserID: user.ID(uuid.MustParse(uid)),
+				},
+			},
+			want: usecase.CoreUserDeleteOutput{
+				Deletion: model.UserDeletion{
+					Export: model.UserExport{
+						User:     deleted.User,
+						Articles: articles,
+					},
+					PurgeAt: deletedAt.Add(model.UserDeletionGracePeriod),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "UserRepository.Delete()でエラーが発生した場合はエクスポートせずにエラーを返す",
+			fields: fields{
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(model.DeletedUser{}, fmt.Errorf("test"))
+					return mock
+				},
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					return repository.NewMockArticle(ctrl)
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreUserDeleteInput{
+					UserID: user.ID(uuid.MustParse(uid)),
+				},
+			},
+			want:    usecase.CoreUserDeleteOutput{},
+			wantErr: true,
+		},
+		{
+			name: "ArticleRepository.ListAllByUser()でエラーが発生した場合はエラーを返す",
+			fields: fields{
+				userRepository: func(t *testing.T) repository.User {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockUser(ctrl)
+					mock.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(deleted, nil)
+					return mock
+				},
+				articleRepository: func(t *testing.T) repository.Article {
+					t.Helper()
+					ctrl := gomock.NewController(t)
+					mock := repository.NewMockArticle(ctrl)
+					mock.EXPECT().ListAllByUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("test"))
+					return mock
+				},
+			},
+			args: args{
+				ctx: context.Background(),
+				input: usecase.CoreUserDeleteInput{
+					UserID: user.ID(uuid.MustParse(uid)),
+				},
+			},
+			want:    usecase.CoreUserDeleteOutput{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			itr := interactor.NewCoreUser(tt.fields.userRepository(t), tt.fields.articleRepository(t), runInTx(t))
+			got, err := itr.Delete(tt.args.ctx, tt.args.input)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CoreUser.Delete() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			// エクスポートした日時は実行時の日時のため比較しない
+			got.Deletion.Export.ExportedAt = time.Time{}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CoreUser.Delete() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,6 +16,8 @@ type APIArticle interface {
 	Share(context.Context, APIArticleShareInput) (APIArticleShareOutput, error)
 	List(context.Context, APIArticleListInput) (APIArticleListOutput, error)
 	Delete(context.Context, APIArticleDeleteInput) (APIArticleDeleteOutput, error)
+	ListTrash(context.Context, APIArticleListTrashInput) (APIArticleListTrashOutput, error)
+	Restore(context.Context, APIArticleRestoreInput) (APIArticleRestoreOutput, error)
 	AddToUser(context.Context, APIArticleAddToUserInput) (APIArticleAddToUserOutput, error)
 	RemoveFromUser(context.Context, APIArticleRemoveFromUserInput) (APIArticleRemoveFromUserOutput, error)
 }
@@ -26,6 +28,7 @@ type APIArticleShareInput struct {
 	Title       article.Title
 	Description article.Description
 	Thumbnail   article.Thumbnail
+	SharedBy    *user.ID // 共有したユーザー(任意)
 }
 
 // APIArticleShareOutput.
@@ -55,6 +58,25 @@ type APIArticleDeleteInput struct {
 // APIArticleDeleteOutput.
 type APIArticleDeleteOutput struct{}
 
+// APIArticleListTrashInput.
+type APIArticleListTrashInput struct {
+	Index value.Index
+	Size  value.Size
+}
+
+// APIArticleListTrashOutput.
+type APIArticleListTrashOutput struct {
+	Articles []model.TrashedArticle
+}
+
+// APIArticleRestoreInput.
+type APIArticleRestoreInput struct {
+	ArticleID article.ID
+}
+
+// APIArticleRestoreOutput.
+type APIArticleRestoreOutput struct{}
+
 // APIArticleAddToUserInput.
 type APIArticleAddToUserInput struct {
 	ArticleID article.ID
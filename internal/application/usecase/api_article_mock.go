@@ -79,6 +79,21 @@ func (mr *MockAPIArticleMockRecorder) List(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIArticle)(nil).List), arg0, arg1)
 }
 
+// ListTrash mocks base method.
+func (m *MockAPIArticle) ListTrash(arg0 context.Context, arg1 APIArticleListTrashInput) (APIArticleListTrashOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0, arg1)
+	ret0, _ := ret[0].(APIArticleListTrashOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockAPIArticleMockRecorder) ListTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockAPIArticle)(nil).ListTrash), arg0, arg1)
+}
+
 // RemoveFromUser mocks base method.
 func (m *MockAPIArticle) RemoveFromUser(arg0 context.Context, arg1 APIArticleRemoveFromUserInput) (APIArticleRemoveFromUserOutput, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +109,21 @@ func (mr *MockAPIArticleMockRecorder) RemoveFromUser(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromUser", reflect.TypeOf((*MockAPIArticle)(nil).RemoveFromUser), arg0, arg1)
 }
 
+// Restore mocks base method.
+func (m *MockAPIArticle) Restore(arg0 context.Context, arg1 APIArticleRestoreInput) (APIArticleRestoreOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(APIArticleRestoreOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAPIArticleMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockAPIArticle)(nil).Restore), arg0, arg1)
+}
+
 // Share mocks base method.
 func (m *MockAPIArticle) Share(arg0 context.Context, arg1 APIArticleShareInput) (APIArticleShareOutput, error) {
 	m.ctrl.T.Helper()
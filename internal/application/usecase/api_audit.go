@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+)
+
+//go:generate mockgen -source api_audit.go -destination api_audit_mock.go -package usecase
+
+// APIAudit 監査ログの参照.
+type APIAudit interface {
+	List(context.Context, APIAuditListInput) (APIAuditListOutput, error)
+	Export(context.Context, APIAuditExportInput) (APIAuditExportOutput, error)
+}
+
+// APIAuditListInput.
+type APIAuditListInput struct {
+	Cursor audit.Cursor
+	Size   value.Size
+	Filter []value.Filter
+}
+
+// APIAuditListOutput.
+type APIAuditListOutput struct {
+	Logs []model.AuditLog
+}
+
+// APIAuditExportInput.
+type APIAuditExportInput struct {
+	Filter []value.Filter
+}
+
+// APIAuditExportOutput.
+type APIAuditExportOutput struct {
+	Logs []model.AuditLog
+	// Truncated 上限件数に達したため途中までしか出力していない場合にtrueとなる.
+	Truncated bool
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api_audit.go
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAPIAudit is a mock of APIAudit interface.
+type MockAPIAudit struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIAuditMockRecorder
+}
+
+// MockAPIAuditMockRecorder is the mock recorder for MockAPIAudit.
+type MockAPIAuditMockRecorder struct {
+	mock *MockAPIAudit
+}
+
+// NewMockAPIAudit creates a new mock instance.
+func NewMockAPIAudit(ctrl *gomock.Controller) *MockAPIAudit {
+	mock := &MockAPIAudit{ctrl: ctrl}
+	mock.recorder = &MockAPIAuditMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIAudit) EXPECT() *MockAPIAuditMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockAPIAudit) Export(arg0 context.Context, arg1 APIAuditExportInput) (APIAuditExportOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", arg0, arg1)
+	ret0, _ := ret[0].(APIAuditExportOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockAPIAuditMockRecorder) Export(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockAPIAudit)(nil).Export), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockAPIAudit) List(arg0 context.Context, arg1 APIAuditListInput) (APIAuditListOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(APIAuditListOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockAPIAuditMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockAPIAudit)(nil).List), arg0, arg1)
+}
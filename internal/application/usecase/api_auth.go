@@ -23,6 +23,9 @@ type APIAuth interface {
 	GenerateCode(context.Context, APIAuthGenerateCodeInput) (APIAuthGenerateCodeOutput, error)
 	Refresh(context.Context, APIAuthRefreshInput) (APIAuthRefreshOutput, error)
 	ChangePassword(context.Context, APIAuthChangePasswordInput) (APIAuthChangePasswordOutput, error)
+	ChangeEmail(context.Context, APIAuthChangeEmailInput) (APIAuthChangeEmailOutput, error)
+	VerifyEmail(context.Context, APIAuthVerifyEmailInput) (APIAuthVerifyEmailOutput, error)
+	Delete(context.Context, APIAuthDeleteInput) (APIAuthDeleteOutput, error)
 }
 
 type APIAuthInviteInput struct {
@@ -45,10 +48,13 @@ type APIAuthSignUpInput struct {
 	Password auth.Password
 }
 
-type APIAuthSignUpOutput struct{}
+type APIAuthSignUpOutput struct {
+	UserID user.ID
+}
 
 type APIAuthSignInInput struct {
 	Secret    auth.Secret
+	KeySet    auth.KeySet
 	Email     auth.Email
 	Password  auth.Password
 	PublicKey rsa.PublicKey
@@ -82,6 +88,7 @@ type APIAuthVerifyInput struct {
 type APIAuthVerifyOutput struct{}
 
 type APIAuthGenerateCodeInput struct {
+	UserID    user.ID
 	SessionID auth.SessionID
 }
 
@@ -92,8 +99,10 @@ type APIAuthGenerateCodeOutput struct {
 type APIAuthRefreshInput struct {
 	CodeID    auth.CodeID
 	Signature auth.Signature
+	UserID    user.ID
 	SessionID auth.SessionID
 	ExpiresIn auth.ExpiresIn
+	KeySet    auth.KeySet
 }
 
 type APIAuthRefreshOutput struct {
@@ -104,6 +113,7 @@ type APIAuthRefreshOutput struct {
 type APIAuthChangePasswordInput struct {
 	UserID      user.ID
 	Secret      auth.Secret
+	KeySet      auth.KeySet
 	PublicKey   rsa.PublicKey
 	ExpiresIn   auth.ExpiresIn
 	OldPassword auth.Password
@@ -115,3 +125,25 @@ type APIAuthChangePasswordOutput struct {
 	AuthToken    auth.AuthToken
 	SessionToken auth.SessionToken
 }
+
+type APIAuthChangeEmailInput struct {
+	UserID   user.ID
+	Email    auth.Email
+	Password auth.Password
+}
+
+type APIAuthChangeEmailOutput struct{}
+
+type APIAuthVerifyEmailInput struct {
+	Code auth.VerificationCode
+}
+
+type APIAuthVerifyEmailOutput struct{}
+
+type APIAuthDeleteInput struct {
+	UserID user.ID
+}
+
+type APIAuthDeleteOutput struct {
+	Deletion model.UserDeletion
+}
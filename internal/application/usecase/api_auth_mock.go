@@ -34,6 +34,21 @@ func (m *MockAPIAuth) EXPECT() *MockAPIAuthMockRecorder {
 	return m.recorder
 }
 
+// ChangeEmail mocks base method.
+func (m *MockAPIAuth) ChangeEmail(arg0 context.Context, arg1 APIAuthChangeEmailInput) (APIAuthChangeEmailOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeEmail", arg0, arg1)
+	ret0, _ := ret[0].(APIAuthChangeEmailOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeEmail indicates an expected call of ChangeEmail.
+func (mr *MockAPIAuthMockRecorder) ChangeEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeEmail", reflect.TypeOf((*MockAPIAuth)(nil).ChangeEmail), arg0, arg1)
+}
+
 // ChangePassword mocks base method.
 func (m *MockAPIAuth) ChangePassword(arg0 context.Context, arg1 APIAuthChangePasswordInput) (APIAuthChangePasswordOutput, error) {
 	m.ctrl.T.Helper()
@@ -49,6 +64,21 @@ func (mr *MockAPIAuthMockRecorder) ChangePassword(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAPIAuth)(nil).ChangePassword), arg0, arg1)
 }
 
+// Delete mocks base method.
+func (m *MockAPIAuth) Delete(arg0 context.Context, arg1 APIAuthDeleteInput) (APIAuthDeleteOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(APIAuthDeleteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAPIAuthMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAPIAuth)(nil).Delete), arg0, arg1)
+}
+
 // GenerateCode mocks base method.
 func (m *MockAPIAuth) GenerateCode(arg0 context.Context, arg1 APIAuthGenerateCodeInput) (APIAuthGenerateCodeOutput, error) {
 	m.ctrl.T.Helper()
@@ -183,3 +213,18 @@ func (mr *MockAPIAuthMockRecorder) Verify(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockAPIAuth)(nil).Verify), arg0, arg1)
 }
+
+// VerifyEmail mocks base method.
+func (m *MockAPIAuth) VerifyEmail(arg0 context.Context, arg1 APIAuthVerifyEmailInput) (APIAuthVerifyEmailOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", arg0, arg1)
+	ret0, _ := ret[0].(APIAuthVerifyEmailOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockAPIAuthMockRecorder) VerifyEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAPIAuth)(nil).VerifyEmail), arg0, arg1)
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rsa"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+//go:generate mockgen -source api_oidc.go -destination api_oidc_mock.go -package usecase
+
+// APIOIDC.
+type APIOIDC interface {
+	Authorize(context.Context, APIOIDCAuthorizeInput) (APIOIDCAuthorizeOutput, error)
+	Callback(context.Context, APIOIDCCallbackInput) (APIOIDCCallbackOutput, error)
+}
+
+type APIOIDCAuthorizeInput struct {
+	Provider       auth.OIDCProvider
+	InvitationCode auth.InvitationCode
+	PublicKey      rsa.PublicKey
+	ExpiresIn      auth.ExpiresIn
+}
+
+type APIOIDCAuthorizeOutput struct {
+	URL   string
+	State auth.OIDCState
+}
+
+type APIOIDCCallbackInput struct {
+	Secret   auth.Secret
+	KeySet   auth.KeySet
+	Provider auth.OIDCProvider
+	State    auth.OIDCState
+	Code     string
+}
+
+type APIOIDCCallbackOutput struct {
+	RedirectURL  string
+	Auth         model.Auth
+	AuthToken    auth.AuthToken
+	SessionToken auth.SessionToken
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api_oidc.go
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAPIOIDC is a mock of APIOIDC interface.
+type MockAPIOIDC struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIOIDCMockRecorder
+}
+
+// MockAPIOIDCMockRecorder is the mock recorder for MockAPIOIDC.
+type MockAPIOIDCMockRecorder struct {
+	mock *MockAPIOIDC
+}
+
+// NewMockAPIOIDC creates a new mock instance.
+func NewMockAPIOIDC(ctrl *gomock.Controller) *MockAPIOIDC {
+	mock := &MockAPIOIDC{ctrl: ctrl}
+	mock.recorder = &MockAPIOIDCMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIOIDC) EXPECT() *MockAPIOIDCMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockAPIOIDC) Authorize(arg0 context.Context, arg1 APIOIDCAuthorizeInput) (APIOIDCAuthorizeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", arg0, arg1)
+	ret0, _ := ret[0].(APIOIDCAuthorizeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAPIOIDCMockRecorder) Authorize(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAPIOIDC)(nil).Authorize), arg0, arg1)
+}
+
+// Callback mocks base method.
+func (m *MockAPIOIDC) Callback(arg0 context.Context, arg1 APIOIDCCallbackInput) (APIOIDCCallbackOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Callback", arg0, arg1)
+	ret0, _ := ret[0].(APIOIDCCallbackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Callback indicates an expected call of Callback.
+func (mr *MockAPIOIDCMockRecorder) Callback(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Callback", reflect.TypeOf((*MockAPIOIDC)(nil).Callback), arg0, arg1)
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+//go:generate mockgen -source api_user.go -destination api_user_mock.go -package usecase
+
+// APIUser.
+type APIUser interface {
+	Get(context.Context, APIUserGetInput) (APIUserGetOutput, error)
+	Update(context.Context, APIUserUpdateInput) (APIUserUpdateOutput, error)
+}
+
+// APIUserGetInput.
+type APIUserGetInput struct {
+	UserID user.ID
+}
+
+// APIUserGetOutput.
+type APIUserGetOutput struct {
+	User model.User
+}
+
+// APIUserUpdateInput.
+type APIUserUpdateInput struct {
+	UserID  user.ID
+	Profile model.UserProfile
+	Fields  []user.ProfileField // 更新する項目(フィールドマスク)
+}
+
+// APIUserUpdateOutput.
+type APIUserUpdateOutput struct {
+	User model.User
+}
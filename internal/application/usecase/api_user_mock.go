@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: api_user.go
+
+// Package usecase is a generated GoMock package.
+package usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAPIUser is a mock of APIUser interface.
+type MockAPIUser struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIUserMockRecorder
+}
+
+// MockAPIUserMockRecorder is the mock recorder for MockAPIUser.
+type MockAPIUserMockRecorder struct {
+	mock *MockAPIUser
+}
+
+// NewMockAPIUser creates a new mock instance.
+func NewMockAPIUser(ctrl *gomock.Controller) *MockAPIUser {
+	mock := &MockAPIUser{ctrl: ctrl}
+	mock.recorder = &MockAPIUserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIUser) EXPECT() *MockAPIUserMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAPIUser) Get(arg0 context.Context, arg1 APIUserGetInput) (APIUserGetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(APIUserGetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAPIUserMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAPIUser)(nil).Get), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockAPIUser) Update(arg0 context.Context, arg1 APIUserUpdateInput) (APIUserUpdateOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(APIUserUpdateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockAPIUserMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAPIUser)(nil).Update), arg0, arg1)
+}
@@ -17,6 +17,8 @@ type CoreArticle interface {
 	List(context.Context, CoreArticleListInput) (CoreArticleListOutput, error)
 	ListByUser(context.Context, CoreArticleListByUserInput) (CoreArticleListByUserOutput, error)
 	Delete(context.Context, CoreArticleDeleteInput) (CoreArticleDeleteOutput, error)
+	ListTrash(context.Context, CoreArticleListTrashInput) (CoreArticleListTrashOutput, error)
+	Restore(context.Context, CoreArticleRestoreInput) (CoreArticleRestoreOutput, error)
 	AddToUser(context.Context, CoreArticleAddToUserInput) (CoreArticleAddToUserOutput, error)
 	RemoveFromUser(context.Context, CoreArticleRemoveFromUserInput) (CoreArticleRemoveFromUserOutput, error)
 }
@@ -27,6 +29,7 @@ type CoreArticleShareInput struct {
 	Title       article.Title
 	Description article.Description
 	Thumbnail   article.Thumbnail
+	SharedBy    *user.ID // 共有したユーザー(不明な場合はnil)
 }
 
 // CoreArticleShareOutput.
@@ -67,6 +70,25 @@ type CoreArticleDeleteInput struct {
 // CoreArticleDeleteOutput.
 type CoreArticleDeleteOutput struct{}
 
+// CoreArticleListTrashInput.
+type CoreArticleListTrashInput struct {
+	Index value.Index
+	Size  value.Size
+}
+
+// CoreArticleListTrashOutput.
+type CoreArticleListTrashOutput struct {
+	Articles []model.TrashedArticle
+}
+
+// CoreArticleRestoreInput.
+type CoreArticleRestoreInput struct {
+	ArticleID article.ID
+}
+
+// CoreArticleRestoreOutput.
+type CoreArticleRestoreOutput struct{}
+
 // CoreArticleAddToUserInput.
 type CoreArticleAddToUserInput struct {
 	ArticleID article.ID
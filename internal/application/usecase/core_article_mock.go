@@ -94,6 +94,21 @@ func (mr *MockCoreArticleMockRecorder) ListByUser(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockCoreArticle)(nil).ListByUser), arg0, arg1)
 }
 
+// ListTrash mocks base method.
+func (m *MockCoreArticle) ListTrash(arg0 context.Context, arg1 CoreArticleListTrashInput) (CoreArticleListTrashOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0, arg1)
+	ret0, _ := ret[0].(CoreArticleListTrashOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockCoreArticleMockRecorder) ListTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockCoreArticle)(nil).ListTrash), arg0, arg1)
+}
+
 // RemoveFromUser mocks base method.
 func (m *MockCoreArticle) RemoveFromUser(arg0 context.Context, arg1 CoreArticleRemoveFromUserInput) (CoreArticleRemoveFromUserOutput, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +124,21 @@ func (mr *MockCoreArticleMockRecorder) RemoveFromUser(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromUser", reflect.TypeOf((*MockCoreArticle)(nil).RemoveFromUser), arg0, arg1)
 }
 
+// Restore mocks base method.
+func (m *MockCoreArticle) Restore(arg0 context.Context, arg1 CoreArticleRestoreInput) (CoreArticleRestoreOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(CoreArticleRestoreOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCoreArticleMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCoreArticle)(nil).Restore), arg0, arg1)
+}
+
 // Share mocks base method.
 func (m *MockCoreArticle) Share(arg0 context.Context, arg1 CoreArticleShareInput) (CoreArticleShareOutput, error) {
 	m.ctrl.T.Helper()
@@ -12,7 +12,9 @@ import (
 // CoreUser.
 type CoreUser interface {
 	Create(context.Context, CoreUserCreateInput) (CoreUserCreateOutput, error)
+	Get(context.Context, CoreUserGetInput) (CoreUserGetOutput, error)
 	Update(context.Context, CoreUserUpdateInput) (CoreUserUpdateOutput, error)
+	Delete(context.Context, CoreUserDeleteInput) (CoreUserDeleteOutput, error)
 }
 
 // CoreUserCreateInput.
@@ -23,12 +25,35 @@ type CoreUserCreateOutput struct {
 	User model.User
 }
 
+// CoreUserGetInput.
+type CoreUserGetInput struct {
+	UserID user.ID
+}
+
+// CoreUserGetOutput.
+type CoreUserGetOutput struct {
+	User model.User
+}
+
 // CoreUserUpdateInput.
+// Fieldsに指定したプロフィールの項目のみProfileの値で更新する.
 type CoreUserUpdateInput struct {
-	UserID user.ID
+	UserID  user.ID
+	Profile model.UserProfile
+	Fields  []user.ProfileField
 }
 
 // CoreUserUpdateOutput.
 type CoreUserUpdateOutput struct {
 	User model.User
 }
+
+// CoreUserDeleteInput.
+type CoreUserDeleteInput struct {
+	UserID user.ID
+}
+
+// CoreUserDeleteOutput.
+type CoreUserDeleteOutput struct {
+	Deletion model.UserDeletion
+}
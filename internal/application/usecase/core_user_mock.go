@@ -49,6 +49,36 @@ func (mr *MockCoreUserMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCoreUser)(nil).Create), arg0, arg1)
 }
 
+// Delete mocks base method.
+func (m *MockCoreUser) Delete(arg0 context.Context, arg1 CoreUserDeleteInput) (CoreUserDeleteOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(CoreUserDeleteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCoreUserMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCoreUser)(nil).Delete), arg0, arg1)
+}
+
+// Get mocks base method.
+func (m *MockCoreUser) Get(arg0 context.Context, arg1 CoreUserGetInput) (CoreUserGetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(CoreUserGetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCoreUserMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCoreUser)(nil).Get), arg0, arg1)
+}
+
 // Update mocks base method.
 func (m *MockCoreUser) Update(arg0 context.Context, arg1 CoreUserUpdateInput) (CoreUserUpdateOutput, error) {
 	m.ctrl.T.Helper()
@@ -23,12 +23,25 @@ type Cache[T any] interface {
 	Keys(context.Context, string, Prefix) ([]string, error)
 }
 
+// Index キーに紐づく文字列の集合を管理する.
+// 集合への追加と削除はCacheのTxで他のコマンドとまとめて適用する.
+type Index interface {
+	Members(context.Context, string) ([]string, error)
+	CreateTxAddCmd(context.Context, string, string, time.Duration) (TxSetCmd, error)
+	CreateTxRemCmd(context.Context, string, string) (TxDelCmd, error)
+	CreateTxDelCmd(context.Context, string) (TxDelCmd, error)
+}
+
+// TxSetCmd Memberが指定されている場合はKeyの集合にMemberを追加し、集合の有効期限をTTLに更新する.
 type TxSetCmd struct {
-	Key   string
-	Value string
-	TTL   time.Duration
+	Key    string
+	Value  string
+	TTL    time.Duration
+	Member string
 }
 
+// TxDelCmd Memberが指定されている場合はKeyの集合からMemberのみを削除する.
 type TxDelCmd struct {
-	Key string
+	Key    string
+	Member string
 }
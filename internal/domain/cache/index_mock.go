@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+var _ Index = (*IndexMock)(nil)
+
+// IndexMock Assertを指定しない場合は引数を検証しない.
+type IndexMock struct {
+	T                    *testing.T
+	MembersValue         []string
+	MembersAssert        func(t *testing.T, key string)
+	MembersErr           error
+	CreateTxAddCmdAssert func(t *testing.T, key string, member string, ttl time.Duration)
+	CreateTxAddCmdErr    error
+	CreateTxRemCmdAssert func(t *testing.T, key string, member string)
+	CreateTxRemCmdErr    error
+	CreateTxDelCmdAssert func(t *testing.T, key string)
+	CreateTxDelCmdErr    error
+}
+
+func (mock *IndexMock) Members(ctx context.Context, key string) ([]string, error) {
+	mock.T.Helper()
+
+	if mock.MembersAssert != nil {
+		mock.MembersAssert(mock.T, key)
+	}
+
+	return mock.MembersValue, mock.MembersErr
+}
+
+func (mock *IndexMock) CreateTxAddCmd(ctx context.Context, key string, member string, ttl time.Duration) (TxSetCmd, error) {
+	mock.T.Helper()
+
+	if mock.CreateTxAddCmdAssert != nil {
+		mock.CreateTxAddCmdAssert(mock.T, key, member, ttl)
+	}
+
+	return TxSetCmd{
+		Key:    key,
+		TTL:    ttl,
+		Member: member,
+	}, mock.CreateTxAddCmdErr
+}
+
+func (mock *IndexMock) CreateTxRemCmd(ctx context.Context, key string, member string) (TxDelCmd, error) {
+	mock.T.Helper()
+
+	if mock.CreateTxRemCmdAssert != nil {
+		mock.CreateTxRemCmdAssert(mock.T, key, member)
+	}
+
+	return TxDelCmd{
+		Key:    key,
+		Member: member,
+	}, mock.CreateTxRemCmdErr
+}
+
+func (mock *IndexMock) CreateTxDelCmd(ctx context.Context, key string) (TxDelCmd, error) {
+	mock.T.Helper()
+
+	if mock.CreateTxDelCmdAssert != nil {
+		mock.CreateTxDelCmdAssert(mock.T, key)
+	}
+
+	return TxDelCmd{
+		Key: key,
+	}, mock.CreateTxDelCmdErr
+}
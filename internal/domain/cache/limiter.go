@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Limit レート制限の上限.
+// Periodあたり Rate 回のリクエストを許可し、Burst 回までの連続したリクエストを許容する.
+type Limit struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+// Unlimited 上限を設けないかどうか.
+func (lmt Limit) Unlimited() bool {
+	return lmt.Rate <= 0 || lmt.Period <= 0
+}
+
+// Interval リクエストを1回許可する間隔.
+func (lmt Limit) Interval() time.Duration {
+	return lmt.Period / time.Duration(lmt.Rate)
+}
+
+// LimitResult レート制限の判定結果.
+type LimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+// Limiter キーごとにレート制限を判定する.
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (LimitResult, error)
+}
@@ -0,0 +1,16 @@
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Lock キーごとの排他制御.
+// プロセスの異常終了で解放されない場合に備え、ロックは有効期限が経過すると解放される.
+// 有効期限の経過後に他の所有者が取得したロックを誤って解放しないよう、取得時に所有者を表すトークンを指定する.
+type Lock interface {
+	// TryLock tokenを所有者としてロックを取得する. 既に取得されている場合はfalseを返す.
+	TryLock(ctx context.Context, key string, token string, ttl time.Duration) (bool, error)
+	// Unlock tokenが所有するロックを解放する. 他の所有者のロックは解放しない.
+	Unlock(ctx context.Context, key string, token string) error
+}
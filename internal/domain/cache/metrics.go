@@ -0,0 +1,7 @@
+package cache
+
+// Metrics キャッシュのヒット率を計測する.
+type Metrics interface {
+	Hit(name string)
+	Miss(name string)
+}
@@ -0,0 +1,33 @@
+package cache
+
+import "sync"
+
+var _ Metrics = (*MetricsMock)(nil)
+
+type MetricsMock struct {
+	mu     sync.Mutex
+	Hits   map[string]int
+	Misses map[string]int
+}
+
+func (mock *MetricsMock) Hit(name string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if mock.Hits == nil {
+		mock.Hits = make(map[string]int)
+	}
+
+	mock.Hits[name]++
+}
+
+func (mock *MetricsMock) Miss(name string) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	if mock.Misses == nil {
+		mock.Misses = make(map[string]int)
+	}
+
+	mock.Misses[name]++
+}
@@ -1,13 +1,19 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/internal/domain/value"
 )
 
 const DefaultArticleSize = value.Size(20)
 
+// DefaultArticleTrashRetention ゴミ箱に移動した記事を完全に削除するまでの既定の保持期間.
+const DefaultArticleTrashRetention = 30 * 24 * time.Hour
+
 // Article 記事モデル.
 type Article struct {
 	ArticleID   article.ID          // ID
@@ -16,6 +22,29 @@ type Article struct {
 	Description article.Description // 記事の説明
 	Thumbnail   article.Thumbnail   // サムネイル
 	TagList     article.TagList     // タグリスト
+	SharedBy    *ArticleSharer      // 共有したユーザー(不明な場合はnil)
+}
+
+// ArticleSharer 記事を共有したユーザー.
+type ArticleSharer struct {
+	UserID      user.ID          // ID
+	DisplayName user.DisplayName // 表示名
+	AvatarURL   user.AvatarURL   // アバター画像のURL
+}
+
+// NewArticleSharer 記事を共有したユーザーのファクトリー関数.
+func NewArticleSharer(u User) *ArticleSharer {
+	return &ArticleSharer{
+		UserID:      u.UserID,
+		DisplayName: u.Profile.DisplayName,
+		AvatarURL:   u.Profile.AvatarURL,
+	}
+}
+
+// TrashedArticle ゴミ箱に移動した記事モデル.
+type TrashedArticle struct {
+	Article
+	DeletedAt time.Time // ゴミ箱に移動した日時
 }
 
 // NewArticle 記事モデルのファクトリー関数.
@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+const (
+	DefaultAuditLogSize = 50
+	// MaxAuditLogExport エクスポートで一度に出力する監査ログの上限.
+	MaxAuditLogExport = 10000
+)
+
+// AuditLog 認証に関するイベントの監査ログ.
+// 主体が特定できない場合(存在しないメールアドレスでのサインインなど)はActorIDをゼロ値とする.
+type AuditLog struct {
+	ID         audit.ID
+	ActorID    user.ID
+	Email      auth.Email
+	EventType  audit.EventType
+	Outcome    audit.Outcome
+	Client     audit.Client
+	TraceID    string
+	OccurredAt time.Time
+}
+
+func NewAuditLog(
+	id audit.ID,
+	actorID user.ID,
+	email auth.Email,
+	eventType audit.EventType,
+	outcome audit.Outcome,
+	client audit.Client,
+	traceID string,
+	occurredAt time.Time,
+) AuditLog {
+	return AuditLog{
+		ID:         id,
+		ActorID:    actorID,
+		Email:      email,
+		EventType:  eventType,
+		Outcome:    outcome,
+		Client:     client,
+		TraceID:    traceID,
+		OccurredAt: occurredAt,
+	}
+}
+
+func IssueAuditLog(
+	actorID user.ID,
+	email auth.Email,
+	eventType audit.EventType,
+	outcome audit.Outcome,
+	client audit.Client,
+	traceID string,
+) AuditLog {
+	return AuditLog{
+		ID:         audit.GenerateID(),
+		ActorID:    actorID,
+		Email:      email,
+		EventType:  eventType,
+		Outcome:    outcome,
+		Client:     client,
+		TraceID:    traceID,
+		OccurredAt: time.Now(),
+	}
+}
+
+// Cursor 監査ログの直後から一覧を取得するカーソルを提供する.
+func (al AuditLog) Cursor() audit.Cursor {
+	return audit.NewCursor(al.OccurredAt, al.ID)
+}
+
+// HasActor 主体が特定できているかどうかを判定する.
+func (al AuditLog) HasActor() bool {
+	return al.ActorID != user.GenerateZeroID()
+}
@@ -0,0 +1,30 @@
+package audit
+
+import "context"
+
+type key struct{}
+
+// Client リクエスト元の情報.
+// X-Forwarded-Forは偽装できるため、接続元のIPとは分けて記録する.
+type Client struct {
+	IP           string
+	ForwardedFor string
+	UserAgent    string
+}
+
+// SetClientCtx リクエスト元の情報をContextに設定するメソッド.
+func SetClientCtx(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, key{}, client)
+}
+
+// GetClientCtx リクエスト元の情報をContextから取得するメソッド.
+func GetClientCtx(ctx context.Context) Client {
+	v := ctx.Value(key{})
+
+	client, ok := v.(Client)
+	if !ok {
+		return Client{}
+	}
+
+	return client
+}
@@ -0,0 +1,48 @@
+package audit_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+)
+
+func TestSetClientCtxAndGetClientCtx(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		ctx  func() context.Context
+		want audit.Client
+	}{
+		{
+			name: "contextにリクエスト元の情報を設定できる",
+			ctx: func() context.Context {
+				return audit.SetClientCtx(context.Background(), audit.Client{
+					IP:        "192.0.2.1",
+					UserAgent: "agent",
+				})
+			},
+			want: audit.Client{
+				IP:        "192.0.2.1",
+				UserAgent: "agent",
+			},
+		},
+		{
+			name: "未設定の場合は空の情報を取得する",
+			ctx:  context.Background,
+			want: audit.Client{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := audit.GetClientCtx(tt.ctx()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetClientCtx() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// cursorSeparator カーソルのトークンにおける発生日時とIDの区切り文字.
+const cursorSeparator = "_"
+
+// Cursor 監査ログの一覧を取得する位置.
+// 直前のページの最後の監査ログの発生日時とIDの組で表し、それより古い監査ログから取得する.
+// オフセットと異なり、一覧の取得中に監査ログが追記されても重複や欠落が生じない.
+type Cursor struct {
+	OccurredAt time.Time
+	ID         ID
+}
+
+// NewCursor カーソルを作成するファクトリー関数.
+func NewCursor(occurredAt time.Time, id ID) Cursor {
+	return Cursor{
+		OccurredAt: occurredAt,
+		ID:         id,
+	}
+}
+
+// ParseCursor ページトークンからカーソルを作成する関数.
+// 空のトークンは先頭を指すゼロ値とする.
+func ParseCursor(token string) (Cursor, error) {
+	if token == "" {
+		return Cursor{}, nil
+	}
+
+	dec, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, errors.NewValidationError(fmt.Sprintf("invalid page token: %s", token), err)
+	}
+
+	at, id, ok := strings.Cut(string(dec), cursorSeparator)
+	if !ok {
+		return Cursor{}, errors.NewValidationError(fmt.Sprintf("invalid page token: %s", token))
+	}
+
+	occurredAt, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return Cursor{}, errors.NewValidationError(fmt.Sprintf("invalid page token: %s", token), err)
+	}
+
+	aid, err := NewID(id)
+	if err != nil {
+		return Cursor{}, errors.NewValidationError(fmt.Sprintf("invalid page token: %s", token), err)
+	}
+
+	return NewCursor(occurredAt, aid), nil
+}
+
+// IsZero 先頭を指すカーソルかどうかを判定する.
+func (c Cursor) IsZero() bool {
+	return c.OccurredAt.IsZero() && c.ID == ID{}
+}
+
+// Token カーソルをページトークンとして提供するメソッド.
+func (c Cursor) Token() string {
+	raw := c.OccurredAt.UTC().Format(time.RFC3339Nano) + cursorSeparator + c.ID.String()
+
+	return base64.StdEncoding.EncodeToString([]byte(raw))
+}
@@ -0,0 +1,93 @@
+package audit_test
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+)
+
+func TestParseCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := audit.NewCursor(time.Date(2026, 10, 19, 12, 34, 56, 789000, time.UTC), audit.GenerateID())
+
+	type args struct {
+		token string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    audit.Cursor
+		wantErr bool
+	}{
+		{
+			name: "ページトークンからカーソルを作成できる",
+			args: args{
+				token: cursor.Token(),
+			},
+			want:    cursor,
+			wantErr: false,
+		},
+		{
+			name: "空のページトークンは先頭を指すカーソルとなる",
+			args: args{
+				token: "",
+			},
+			want:    audit.Cursor{},
+			wantErr: false,
+		},
+		{
+			name: "base64でないページトークンではカーソルを作成できない",
+			args: args{
+				token: "!!!",
+			},
+			want:    audit.Cursor{},
+			wantErr: true,
+		},
+		{
+			name: "区切り文字を含まないページトークンではカーソルを作成できない",
+			args: args{
+				token: base64.StdEncoding.EncodeToString([]byte("10")),
+			},
+			want:    audit.Cursor{},
+			wantErr: true,
+		},
+		{
+			name: "発生日時が不正なページトークンではカーソルを作成できない",
+			args: args{
+				token: base64.StdEncoding.EncodeToString([]byte("yesterday_" + cursor.ID.String())),
+			},
+			want:    audit.Cursor{},
+			wantErr: true,
+		},
+		{
+			name: "IDが不正なページトークンではカーソルを作成できない",
+			args: args{
+				token: base64.StdEncoding.EncodeToString([]byte(cursor.OccurredAt.Format(time.RFC3339Nano) + "_id")),
+			},
+			want:    audit.Cursor{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := audit.ParseCursor(tt.args.token)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseCursor() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.OccurredAt.Equal(tt.want.OccurredAt) || got.ID != tt.want.ID {
+				t.Errorf("ParseCursor() = %v, want %v", got, tt.want)
+			}
+			if got.IsZero() != tt.want.IsZero() {
+				t.Errorf("Cursor.IsZero() = %v, want %v", got.IsZero(), tt.want.IsZero())
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// EventType 監査対象のイベント種別.
+type EventType string
+
+const (
+	// EventSignIn サインイン(失敗を含む).
+	EventSignIn EventType = "signin"
+	// EventSignOut サインアウト.
+	EventSignOut EventType = "signout"
+	// EventSignOutAll 全セッションの失効.
+	EventSignOutAll EventType = "signout_all"
+	// EventPasswordChange パスワード変更.
+	EventPasswordChange EventType = "password_change"
+	// EventEmailChange メールアドレス変更.
+	EventEmailChange EventType = "email_change"
+	// EventInvitationUse 招待コードの利用.
+	EventInvitationUse EventType = "invitation_use"
+	// EventAccountDelete アカウントの削除.
+	EventAccountDelete EventType = "account_delete"
+)
+
+// NewEventType イベント種別を作成するファクトリー関数.
+func NewEventType(value string) (EventType, error) {
+	et := EventType(value)
+
+	switch et {
+	case EventSignIn, EventSignOut, EventSignOutAll, EventPasswordChange, EventEmailChange, EventInvitationUse,
+		EventAccountDelete:
+		return et, nil
+	default:
+		return EventType(""), errors.NewValidationError(fmt.Sprintf("invalid event type: %s", value))
+	}
+}
+
+func (et EventType) String() string {
+	return string(et)
+}
@@ -0,0 +1,70 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+)
+
+func TestNewEventType(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    audit.EventType
+		wantErr bool
+	}{
+		{
+			name: "signinでイベント種別が作成できる",
+			args: args{
+				value: "signin",
+			},
+			want:    audit.EventSignIn,
+			wantErr: false,
+		},
+		{
+			name: "invitation_useでイベント種別が作成できる",
+			args: args{
+				value: "invitation_use",
+			},
+			want:    audit.EventInvitationUse,
+			wantErr: false,
+		},
+		{
+			name: "account_deleteでイベント種別が作成できる",
+			args: args{
+				value: "account_delete",
+			},
+			want:    audit.EventAccountDelete,
+			wantErr: false,
+		},
+		{
+			name: "不正な値でイベント種別が作成できない",
+			args: args{
+				value: "invalid",
+			},
+			want:    audit.EventType(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := audit.NewEventType(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewEventType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewEventType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
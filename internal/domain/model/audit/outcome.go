@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// Outcome イベントの結果.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// NewOutcome イベントの結果を作成するファクトリー関数.
+func NewOutcome(value string) (Outcome, error) {
+	oc := Outcome(value)
+
+	if oc != OutcomeSuccess && oc != OutcomeFailure {
+		return Outcome(""), errors.NewValidationError(fmt.Sprintf("invalid outcome: %s", value))
+	}
+
+	return oc, nil
+}
+
+// OutcomeOf 処理のエラーからイベントの結果を判定する.
+func OutcomeOf(err error) Outcome {
+	if err != nil {
+		return OutcomeFailure
+	}
+
+	return OutcomeSuccess
+}
+
+func (oc Outcome) String() string {
+	return string(oc)
+}
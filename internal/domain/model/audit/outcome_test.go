@@ -0,0 +1,94 @@
+package audit_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+)
+
+func TestNewOutcome(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    audit.Outcome
+		wantErr bool
+	}{
+		{
+			name: "successで結果が作成できる",
+			args: args{
+				value: "success",
+			},
+			want:    audit.OutcomeSuccess,
+			wantErr: false,
+		},
+		{
+			name: "failureで結果が作成できる",
+			args: args{
+				value: "failure",
+			},
+			want:    audit.OutcomeFailure,
+			wantErr: false,
+		},
+		{
+			name: "不正な値で結果が作成できない",
+			args: args{
+				value: "invalid",
+			},
+			want:    audit.Outcome(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := audit.NewOutcome(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOutcome() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewOutcome() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutcomeOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want audit.Outcome
+	}{
+		{
+			name: "エラーがない場合は成功となる",
+			err:  nil,
+			want: audit.OutcomeSuccess,
+		},
+		{
+			name: "エラーがある場合は失敗となる",
+			err:  fmt.Errorf("error"),
+			want: audit.OutcomeFailure,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := audit.OutcomeOf(tt.err); got != tt.want {
+				t.Errorf("OutcomeOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestAuditLogHasActor(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		actorID user.ID
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "主体が特定できている",
+			args: args{
+				actorID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+			},
+			want: true,
+		},
+		{
+			name: "主体が特定できていない",
+			args: args{
+				actorID: user.GenerateZeroID(),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			al := model.IssueAuditLog(
+				tt.args.actorID,
+				auth.Email("test@example.com"),
+				audit.EventSignIn,
+				audit.OutcomeSuccess,
+				audit.Client{},
+				"tid",
+			)
+			if got := al.HasActor(); got != tt.want {
+				t.Errorf("AuditLog.HasActor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -65,8 +65,9 @@ func (at Auth) IsExpired() bool {
 
 func (at Auth) ToToken(
 	secret auth.Secret,
+	keys auth.KeySet,
 ) auth.AuthToken {
-	return auth.GenerateAuthToken(at.UserID, secret, at.ExpiresIn())
+	return auth.GenerateAuthToken(at.UserID, secret, keys, at.ExpiresIn())
 }
 
 func (at Auth) ExpiresIn() auth.ExpiresIn {
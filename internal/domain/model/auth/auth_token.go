@@ -3,7 +3,6 @@ package auth
 import (
 	"encoding/base64"
 	"encoding/json"
-	"fmt"
 	"strings"
 	"time"
 
@@ -19,19 +18,24 @@ type AuthToken string //nolint:revive
 func ParseAuthToken(
 	token string,
 	secret Secret,
+	keys KeySet,
 ) (AuthToken, error) {
 	at := AuthToken(token)
 
-	if err := at.validate(secret); err != nil {
+	if err := at.validate(secret, keys); err != nil {
 		return AuthToken(""), err
 	}
 
 	return at, nil
 }
 
+// GenerateAuthToken 認証トークンを発行する関数.
+// 署名鍵がない場合はセッションから導出した共通鍵で署名し、
+// ある場合は非対称鍵で署名してセッションとの紐付けをクレームに含める.
 func GenerateAuthToken(
 	userID user.ID,
 	secret Secret,
+	keys KeySet,
 	expiresIn ExpiresIn,
 ) AuthToken {
 	now := time.Now()
@@ -42,23 +46,17 @@ func GenerateAuthToken(
 		"exp": now.Add(expiresIn.Duration()).Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	if !keys.IsEmpty() {
+		claims[sessionBindingClaim] = bindingHash(secret)
+	}
 
-	strToken, _ := token.SignedString([]byte(secret))
+	strToken, _ := signToken(claims, secret, keys)
 
 	return AuthToken(strToken)
 }
 
-func (at AuthToken) validate(secret Secret) error {
-	parsedToken, err := jwt.Parse(at.String(), func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			msg := fmt.Sprintf("unexpected signing method: %v", token.Header["alg"])
-
-			return nil, errors.NewValidationError(msg)
-		}
-
-		return []byte(secret.String()), nil
-	})
+func (at AuthToken) validate(secret Secret, keys KeySet) error {
+	parsedToken, err := parseToken(at.String(), secret, keys, DefaultExpiresIn.Duration())
 	if err != nil {
 		return err
 	}
@@ -67,6 +65,16 @@ func (at AuthToken) validate(secret Secret) error {
 		return errors.NewValidationError("invalid token")
 	}
 
+	if _, ok := parsedToken.Method.(*jwt.SigningMethodHMAC); ok {
+		return nil
+	}
+
+	claims, _ := parsedToken.Claims.(jwt.MapClaims)
+
+	if hash, _ := claims[sessionBindingClaim].(string); hash != bindingHash(secret) {
+		return errors.NewValidationError("token is not bound to the session")
+	}
+
 	return nil
 }
 
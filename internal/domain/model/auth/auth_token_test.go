@@ -3,6 +3,7 @@ package auth_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
@@ -19,7 +20,7 @@ func TestAuthTokenUserID(t *testing.T) {
 	}{
 		{
 			name: "認証トークンからUserIDを取得できる",
-			at:   auth.GenerateAuthToken(user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")), auth.Secret("secret"), auth.DefaultExpiresIn),
+			at:   auth.GenerateAuthToken(user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")), auth.Secret("secret"), auth.KeySet{}, auth.DefaultExpiresIn),
 			want: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
 		},
 	}
@@ -35,16 +36,40 @@ func TestAuthTokenUserID(t *testing.T) {
 	}
 }
 
+func NewKeySet(t *testing.T, alg auth.SigningAlgorithm) auth.KeySet {
+	t.Helper()
+
+	key, err := auth.GenerateSigningKey(alg, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return auth.NewKeySet(key)
+}
+
 func TestParseAuthTokenToken(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
 		userID        user.ID
 		encryptSecret auth.Secret
+		encryptKeys   auth.KeySet
 		expiresIn     auth.ExpiresIn
 		decryptSecret auth.Secret
+		decryptKeys   auth.KeySet
+	}
+
+	rsaKeys := NewKeySet(t, auth.SigningAlgorithmRS256)
+
+	edKeys := NewKeySet(t, auth.SigningAlgorithmEdDSA)
+
+	migrated, err := auth.GenerateSigningKey(auth.SigningAlgorithmRS256, time.Now().Add(-2*auth.DefaultExpiresIn.Duration()))
+	if err != nil {
+		t.Fatal(err)
 	}
 
+	migratedKeys := auth.NewKeySet(migrated)
+
 	tests := []struct {
 		name    string
 		args    args
@@ -80,14 +105,84 @@ func TestParseAuthTokenToken(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "RS256で署名した認証トークンを検証できる",
+			args: args{
+				userID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				encryptSecret: auth.Secret("secret"),
+				encryptKeys:   rsaKeys,
+				expiresIn:     auth.DefaultExpiresIn,
+				decryptSecret: auth.Secret("secret"),
+				decryptKeys:   rsaKeys,
+			},
+			wantErr: false,
+		},
+		{
+			name: "EdDSAで署名した認証トークンを検証できる",
+			args: args{
+				userID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				encryptSecret: auth.Secret("secret"),
+				encryptKeys:   edKeys,
+				expiresIn:     auth.DefaultExpiresIn,
+				decryptSecret: auth.Secret("secret"),
+				decryptKeys:   edKeys,
+			},
+			wantErr: false,
+		},
+		{
+			name: "共通鍵で署名した認証トークンを署名鍵の導入から有効期限が過ぎるまでは検証できる",
+			args: args{
+				userID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				encryptSecret: auth.Secret("secret"),
+				expiresIn:     auth.DefaultExpiresIn,
+				decryptSecret: auth.Secret("secret"),
+				decryptKeys:   rsaKeys,
+			},
+			wantErr: false,
+		},
+		{
+			name: "共通鍵で署名した認証トークンは署名鍵の導入から有効期限が過ぎると検証できない",
+			args: args{
+				userID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				encryptSecret: auth.Secret("secret"),
+				expiresIn:     auth.DefaultExpiresIn,
+				decryptSecret: auth.Secret("secret"),
+				decryptKeys:   migratedKeys,
+			},
+			wantErr: true,
+		},
+		{
+			name: "署名鍵の集合に含まれない鍵で署名されている",
+			args: args{
+				userID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				encryptSecret: auth.Secret("secret"),
+				encryptKeys:   rsaKeys,
+				expiresIn:     auth.DefaultExpiresIn,
+				decryptSecret: auth.Secret("secret"),
+				decryptKeys:   edKeys,
+			},
+			wantErr: true,
+		},
+		{
+			name: "非対称鍵で署名した認証トークンが別のセッションに紐付いている",
+			args: args{
+				userID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				encryptSecret: auth.Secret("encrypt"),
+				encryptKeys:   rsaKeys,
+				expiresIn:     auth.DefaultExpiresIn,
+				decryptSecret: auth.Secret("decrypt"),
+				decryptKeys:   rsaKeys,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			got := auth.GenerateAuthToken(tt.args.userID, tt.args.encryptSecret, tt.args.expiresIn)
-			if _, err := auth.ParseAuthToken(got.String(), tt.args.decryptSecret); (err != nil) != tt.wantErr {
+			got := auth.GenerateAuthToken(tt.args.userID, tt.args.encryptSecret, tt.args.encryptKeys, tt.args.expiresIn)
+			if _, err := auth.ParseAuthToken(got.String(), tt.args.decryptSecret, tt.args.decryptKeys); (err != nil) != tt.wantErr {
 				t.Errorf("ParseAuthToken() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+)
+
+// CodeChallengeMethod PKCEのcode_challenge_method.
+const CodeChallengeMethod = "S256"
+
+// CodeVerifier PKCE(RFC 7636)のcode_verifier.
+type CodeVerifier string
+
+func GenerateCodeVerifier() CodeVerifier {
+	return CodeVerifier(generateOIDCRandom())
+}
+
+func (cv CodeVerifier) String() string {
+	return string(cv)
+}
+
+// Challenge S256方式のcode_challengeを返すメソッド.
+func (cv CodeVerifier) Challenge() string {
+	sum := sha256.Sum256([]byte(cv.String()))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
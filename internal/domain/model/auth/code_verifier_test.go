@@ -0,0 +1,33 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestCodeVerifierChallenge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cv   auth.CodeVerifier
+		want string
+	}{
+		{
+			name: "RFC 7636のテストベクタと一致するcode_challengeが生成できる",
+			cv:   auth.CodeVerifier("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"),
+			want: "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.cv.Challenge(); got != tt.want {
+				t.Errorf("CodeVerifier.Challenge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// sessionBindingClaim 非対称鍵で署名した認証トークンをセッションに紐付けるクレーム.
+// 従来はセッションIDを共通鍵として署名することで紐付けていた.
+const sessionBindingClaim = "sbh"
+
+// KeySet トークンの署名鍵の集合.
+// 有効化済みの最も新しい鍵で署名し、それ以外の鍵は検証にのみ利用する.
+// 新しい鍵は全てのインスタンスが読み込むまで有効化を遅らせ、
+// 退役した鍵は発行済みのトークンが失効するまで保持する.
+// 共通鍵で署名されたトークンは、非対称鍵での署名に移行してからトークンの有効期限が過ぎるまでに限り受け入れる.
+type KeySet struct {
+	Keys []SigningKey `json:"keys"`
+	// MigratedAt 最初の鍵を有効化して非対称鍵での署名に移行した時刻.
+	MigratedAt time.Time `json:"migratedAt"`
+}
+
+// NewKeySet 署名鍵の集合を作成するファクトリー関数.
+func NewKeySet(keys ...SigningKey) KeySet {
+	sorted := make([]SigningKey, len(keys))
+
+	copy(sorted, keys)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ActivatedAt.Before(sorted[j].ActivatedAt)
+	})
+
+	return KeySet{
+		Keys: sorted,
+	}
+}
+
+// Parse 全ての鍵の秘密鍵を解析して保持した署名鍵の集合を提供する.
+// 署名のたびに秘密鍵を解析しないよう、読み込んだ時点で一度だけ呼び出す.
+func (ks KeySet) Parse() (KeySet, error) {
+	keys := make([]SigningKey, 0, len(ks.Keys))
+
+	for _, key := range ks.Keys {
+		parsed, err := key.parse()
+		if err != nil {
+			return KeySet{}, err
+		}
+
+		keys = append(keys, parsed)
+	}
+
+	return KeySet{
+		Keys:       keys,
+		MigratedAt: ks.MigratedAt,
+	}, nil
+}
+
+// IsEmpty 署名に利用できる鍵がないかどうかを判定する.
+// 空の場合は従来の共通鍵(HS256)で署名する.
+func (ks KeySet) IsEmpty() bool {
+	_, ok := ks.Current()
+
+	return !ok
+}
+
+// Current 署名に利用する鍵を取得する.
+func (ks KeySet) Current() (SigningKey, bool) {
+	now := time.Now()
+
+	for i := len(ks.Keys) - 1; i >= 0; i-- {
+		if ks.Keys[i].IsActive(now) {
+			return ks.Keys[i], true
+		}
+	}
+
+	return SigningKey{}, false
+}
+
+// Find kidに対応する鍵を取得する.
+func (ks KeySet) Find(kid string) (SigningKey, bool) {
+	for _, key := range ks.Keys {
+		if key.KID == kid {
+			return key, true
+		}
+	}
+
+	return SigningKey{}, false
+}
+
+// Rotate 新しい鍵を発行し、delay後に既存の鍵と入れ替える.
+// 退役から保持期間を過ぎた鍵は破棄する.
+func (ks KeySet) Rotate(
+	alg SigningAlgorithm,
+	now time.Time,
+	delay time.Duration,
+	retention time.Duration,
+) (KeySet, error) {
+	next, err := GenerateSigningKey(alg, now)
+	if err != nil {
+		return KeySet{}, err
+	}
+
+	next.ActivatedAt = now.Add(delay)
+
+	keys := make([]SigningKey, 0, len(ks.Keys)+1)
+
+	for _, key := range ks.Keys {
+		if key.RetiredAt.IsZero() {
+			key.RetiredAt = next.ActivatedAt
+		}
+
+		if key.RetiredAt.Add(retention).Before(now) {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	rotated := NewKeySet(append(keys, next)...)
+
+	rotated.MigratedAt = ks.migratedAt()
+	if rotated.MigratedAt.IsZero() {
+		rotated.MigratedAt = next.ActivatedAt
+	}
+
+	return rotated, nil
+}
+
+// migratedAt 非対称鍵での署名に移行した時刻を取得する.
+// 移行した時刻を記録する前に保存された集合では、最も古い鍵の有効化の時刻とみなす.
+func (ks KeySet) migratedAt() time.Time {
+	if !ks.MigratedAt.IsZero() || len(ks.Keys) == 0 {
+		return ks.MigratedAt
+	}
+
+	return ks.Keys[0].ActivatedAt
+}
+
+// acceptsSecret 共通鍵で署名されたトークンを受け入れるかどうかを判定する.
+// 移行後に共通鍵で署名されたトークンは発行されないため、移行前に発行されたトークンが失効するまでに限り受け入れる.
+func (ks KeySet) acceptsSecret(now time.Time, lifetime time.Duration) bool {
+	if ks.IsEmpty() {
+		return true
+	}
+
+	return now.Before(ks.migratedAt().Add(lifetime))
+}
+
+// JWKS 検証に利用できる公開鍵の一覧を提供するメソッド.
+func (ks KeySet) JWKS() (JWKS, error) {
+	keys := make([]JWK, 0, len(ks.Keys))
+
+	for _, key := range ks.Keys {
+		jwk, err := key.JWK()
+		if err != nil {
+			return JWKS{}, err
+		}
+
+		keys = append(keys, jwk)
+	}
+
+	return JWKS{
+		Keys: keys,
+	}, nil
+}
+
+func (ks KeySet) sign(claims jwt.MapClaims) (string, error) {
+	key, ok := ks.Current()
+	if !ok {
+		return "", errors.NewValidationError("signing key is not found")
+	}
+
+	signer, err := key.signer()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(key.Algorithm.method(), claims)
+
+	token.Header["kid"] = key.KID
+
+	return token.SignedString(signer)
+}
+
+func (ks KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	key, ok := ks.Find(kid)
+	if !ok {
+		return nil, errors.NewValidationError(fmt.Sprintf("unknown kid: %s", kid))
+	}
+
+	if token.Method.Alg() != key.Algorithm.String() {
+		msg := fmt.Sprintf("unexpected signing method: %v", token.Header["alg"])
+
+		return nil, errors.NewValidationError(msg)
+	}
+
+	return key.publicKey()
+}
+
+// signToken 署名鍵があれば非対称鍵で、なければ共通鍵(HS256)でトークンに署名する.
+func signToken(claims jwt.MapClaims, secret Secret, keys KeySet) (string, error) {
+	if keys.IsEmpty() {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+	}
+
+	return keys.sign(claims)
+}
+
+// parseToken トークンの署名方式に応じて共通鍵または署名鍵の集合で検証する.
+// 共通鍵で署名されたトークンは、移行からトークンの有効期限(lifetime)が過ぎるまでに限り受け入れる.
+func parseToken(token string, secret Secret, keys KeySet, lifetime time.Duration) (*jwt.Token, error) {
+	return jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		switch token.Method.(type) {
+		case *jwt.SigningMethodHMAC:
+			if !keys.acceptsSecret(time.Now(), lifetime) {
+				return nil, errors.NewValidationError("tokens signed with the shared secret are no longer accepted")
+			}
+
+			return []byte(secret.String()), nil
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodEd25519:
+			return keys.keyfunc(token)
+		default:
+			msg := fmt.Sprintf("unexpected signing method: %v", token.Header["alg"])
+
+			return nil, errors.NewValidationError(msg)
+		}
+	})
+}
+
+// bindingHash 共通鍵からセッションとの紐付けに利用するハッシュ値を算出する.
+func bindingHash(secret Secret) string {
+	sum := sha256.Sum256([]byte(secret))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
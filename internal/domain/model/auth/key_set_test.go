@@ -0,0 +1,230 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestKeySetRotate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	retention := time.Hour
+
+	old, err := auth.GenerateSigningKey(auth.SigningAlgorithmEdDSA, now.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired, err := auth.GenerateSigningKey(auth.SigningAlgorithmEdDSA, now.Add(-3*retention))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired.RetiredAt = now.Add(-2 * retention)
+
+	type args struct {
+		keys  auth.KeySet
+		alg   auth.SigningAlgorithm
+		delay time.Duration
+	}
+
+	tests := []struct {
+		name           string
+		args           args
+		wantLen        int
+		wantCurrent    string
+		wantMigratedAt time.Time
+		wantErr        bool
+	}{
+		{
+			name: "空の署名鍵の集合に鍵を発行できる",
+			args: args{
+				keys:  auth.KeySet{},
+				alg:   auth.SigningAlgorithmRS256,
+				delay: 0,
+			},
+			wantLen:        1,
+			wantCurrent:    "next",
+			wantMigratedAt: now,
+			wantErr:        false,
+		},
+		{
+			name: "既存の鍵を退役させて検証用に残すことができる",
+			args: args{
+				keys:  auth.NewKeySet(old),
+				alg:   auth.SigningAlgorithmEdDSA,
+				delay: 0,
+			},
+			wantLen:        2,
+			wantCurrent:    "next",
+			wantMigratedAt: old.ActivatedAt,
+			wantErr:        false,
+		},
+		{
+			name: "有効化されるまでは既存の鍵で署名する",
+			args: args{
+				keys:  auth.NewKeySet(old),
+				alg:   auth.SigningAlgorithmRS256,
+				delay: time.Minute,
+			},
+			wantLen:        2,
+			wantCurrent:    old.KID,
+			wantMigratedAt: old.ActivatedAt,
+			wantErr:        false,
+		},
+		{
+			name: "有効化されるまでは空の署名鍵の集合として扱う",
+			args: args{
+				keys:  auth.KeySet{},
+				alg:   auth.SigningAlgorithmRS256,
+				delay: time.Minute,
+			},
+			wantLen:        1,
+			wantCurrent:    "",
+			wantMigratedAt: now.Add(time.Minute),
+			wantErr:        false,
+		},
+		{
+			name: "保持期間を過ぎた鍵を破棄できる",
+			args: args{
+				keys:  auth.NewKeySet(expired),
+				alg:   auth.SigningAlgorithmRS256,
+				delay: 0,
+			},
+			wantLen:        1,
+			wantCurrent:    "next",
+			wantMigratedAt: expired.ActivatedAt,
+			wantErr:        false,
+		},
+		{
+			name: "不正な署名アルゴリズムで鍵を発行できない",
+			args: args{
+				keys:  auth.KeySet{},
+				alg:   auth.SigningAlgorithm("HS256"),
+				delay: 0,
+			},
+			wantLen: 0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.args.keys.Rotate(tt.args.alg, now, tt.args.delay, retention)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("KeySet.Rotate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got.Keys) != tt.wantLen {
+				t.Errorf("KeySet.Rotate() len = %v, want %v", len(got.Keys), tt.wantLen)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := tt.wantCurrent
+			if want == "next" {
+				want = got.Keys[len(got.Keys)-1].KID
+			}
+			current, _ := got.Current()
+			if current.KID != want {
+				t.Errorf("KeySet.Current() = %v, want %v", current.KID, want)
+			}
+			if !got.MigratedAt.Equal(tt.wantMigratedAt) {
+				t.Errorf("KeySet.MigratedAt = %v, want %v", got.MigratedAt, tt.wantMigratedAt)
+			}
+			jwks, err := got.JWKS()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jwks.Keys) != tt.wantLen {
+				t.Errorf("KeySet.JWKS() len = %v, want %v", len(jwks.Keys), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestSigningKeyJWK(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		alg     auth.SigningAlgorithm
+		wantKty string
+	}{
+		{
+			name:    "RS256の鍵をJWK形式で提供できる",
+			alg:     auth.SigningAlgorithmRS256,
+			wantKty: "RSA",
+		},
+		{
+			name:    "EdDSAの鍵をJWK形式で提供できる",
+			alg:     auth.SigningAlgorithmEdDSA,
+			wantKty: "OKP",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			key, err := auth.GenerateSigningKey(tt.alg, time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := key.JWK()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Kty != tt.wantKty || got.Kid != key.KID || got.Alg != tt.alg.String() || got.Use != "sig" {
+				t.Errorf("SigningKey.JWK() = %+v", got)
+			}
+		})
+	}
+}
+
+func TestKeySetParse(t *testing.T) {
+	t.Parallel()
+
+	key, err := auth.GenerateSigningKey(auth.SigningAlgorithmEdDSA, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		keys    auth.KeySet
+		wantErr bool
+	}{
+		{
+			name:    "秘密鍵を解析できる",
+			keys:    auth.KeySet{Keys: []auth.SigningKey{{KID: key.KID, Algorithm: key.Algorithm, PrivateKey: key.PrivateKey}}},
+			wantErr: false,
+		},
+		{
+			name:    "不正な秘密鍵は解析できない",
+			keys:    auth.KeySet{Keys: []auth.SigningKey{{KID: key.KID, Algorithm: key.Algorithm, PrivateKey: []byte("broken")}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.keys.Parse()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("KeySet.Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(got.Keys) != len(tt.keys.Keys) {
+				t.Errorf("KeySet.Parse() len = %v, want %v", len(got.Keys), len(tt.keys.Keys))
+			}
+		})
+	}
+}
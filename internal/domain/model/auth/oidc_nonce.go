@@ -0,0 +1,17 @@
+package auth
+
+// OIDCNonce IDトークンのリプレイを防ぐためのnonce.
+type OIDCNonce string
+
+func GenerateOIDCNonce() OIDCNonce {
+	return OIDCNonce(generateOIDCRandom())
+}
+
+func (nc OIDCNonce) String() string {
+	return string(nc)
+}
+
+// Equal IDトークンに含まれるnonceと一致するかを判定するメソッド.
+func (nc OIDCNonce) Equal(value string) bool {
+	return nc != "" && nc.String() == value
+}
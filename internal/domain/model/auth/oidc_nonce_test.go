@@ -0,0 +1,57 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestOIDCNonceEqual(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name string
+		nc   auth.OIDCNonce
+		args args
+		want bool
+	}{
+		{
+			name: "同じnonceであれば一致する",
+			nc:   auth.OIDCNonce("nonce"),
+			args: args{
+				value: "nonce",
+			},
+			want: true,
+		},
+		{
+			name: "異なるnonceであれば一致しない",
+			nc:   auth.OIDCNonce("nonce"),
+			args: args{
+				value: "other",
+			},
+			want: false,
+		},
+		{
+			name: "空のnonce同士は一致しない",
+			nc:   auth.OIDCNonce(""),
+			args: args{
+				value: "",
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.nc.Equal(tt.args.value); got != tt.want {
+				t.Errorf("OIDCNonce.Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
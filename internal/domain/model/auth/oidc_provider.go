@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"regexp"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+var oidcProviderPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// OIDCProvider OpenID Connectの認証プロバイダ名(google, github など).
+type OIDCProvider string
+
+func NewOIDCProvider(value string) (OIDCProvider, error) {
+	op := OIDCProvider(value)
+
+	if err := op.validate(); err != nil {
+		return OIDCProvider(""), err
+	}
+
+	return op, nil
+}
+
+func (op OIDCProvider) String() string {
+	return string(op)
+}
+
+func (op OIDCProvider) validate() error {
+	if !oidcProviderPattern.MatchString(op.String()) {
+		return errors.NewValidationError("invalid oidc provider")
+	}
+
+	return nil
+}
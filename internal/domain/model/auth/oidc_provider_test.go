@@ -0,0 +1,78 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestNewOIDCProvider(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    auth.OIDCProvider
+		wantErr bool
+	}{
+		{
+			name: "googleで認証プロバイダが作成できる",
+			args: args{
+				value: "google",
+			},
+			want:    auth.OIDCProvider("google"),
+			wantErr: false,
+		},
+		{
+			name: "ハイフンを含む名前で認証プロバイダが作成できる",
+			args: args{
+				value: "corp-sso",
+			},
+			want:    auth.OIDCProvider("corp-sso"),
+			wantErr: false,
+		},
+		{
+			name: "空文字で認証プロバイダが作成できない",
+			args: args{
+				value: "",
+			},
+			want:    auth.OIDCProvider(""),
+			wantErr: true,
+		},
+		{
+			name: "大文字を含む名前で認証プロバイダが作成できない",
+			args: args{
+				value: "Google",
+			},
+			want:    auth.OIDCProvider(""),
+			wantErr: true,
+		},
+		{
+			name: "パス区切りを含む名前で認証プロバイダが作成できない",
+			args: args{
+				value: "../google",
+			},
+			want:    auth.OIDCProvider(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := auth.NewOIDCProvider(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOIDCProvider() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewOIDCProvider() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+const OIDCStateKey = "oidc-state"
+
+const oidcRandomLength = 32
+
+// OIDCState 認可リクエストとコールバックを紐付けるstateパラメータ.
+type OIDCState string
+
+func NewOIDCState(value string) (OIDCState, error) {
+	st := OIDCState(value)
+
+	if err := st.validate(); err != nil {
+		return OIDCState(""), err
+	}
+
+	return st, nil
+}
+
+func GenerateOIDCState() OIDCState {
+	return OIDCState(generateOIDCRandom())
+}
+
+func (st OIDCState) String() string {
+	return string(st)
+}
+
+func (st OIDCState) validate() error {
+	if st == "" {
+		return errors.NewValidationError("state is required")
+	}
+
+	return nil
+}
+
+func generateOIDCRandom() string {
+	buf := make([]byte, oidcRandomLength)
+
+	// crypto/randの読み込みは失敗しない前提とする
+	_, _ = rand.Read(buf)
+
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
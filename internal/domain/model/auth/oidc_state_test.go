@@ -0,0 +1,73 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestNewOIDCState(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    auth.OIDCState
+		wantErr bool
+	}{
+		{
+			name: "stateが作成できる",
+			args: args{
+				value: "state",
+			},
+			want:    auth.OIDCState("state"),
+			wantErr: false,
+		},
+		{
+			name: "空文字でstateが作成できない",
+			args: args{
+				value: "",
+			},
+			want:    auth.OIDCState(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := auth.NewOIDCState(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewOIDCState() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewOIDCState() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateOIDCState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("推測困難なstateが生成できる", func(t *testing.T) {
+		t.Parallel()
+
+		first := auth.GenerateOIDCState()
+		second := auth.GenerateOIDCState()
+
+		if len(first) != 43 {
+			t.Errorf("GenerateOIDCState() length = %v, want %v", len(first), 43)
+		}
+
+		if first == second {
+			t.Errorf("GenerateOIDCState() = %v, want different values", first)
+		}
+	})
+}
@@ -29,3 +29,9 @@ func (pw Password) validate() error {
 func (pw Password) Equal(password Password) bool {
 	return pw.String() == password.String()
 }
+
+// GenerateRandomPassword 推測不可能なパスワードを生成する関数.
+// 外部の認証プロバイダ経由で作成したユーザーなど、パスワードを利用しない場合に使う.
+func GenerateRandomPassword() Password {
+	return Password(generateOIDCRandom())
+}
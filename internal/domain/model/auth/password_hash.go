@@ -0,0 +1,171 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// argon2idのパラメータ(OWASP推奨値).
+const (
+	argon2Memory  uint32 = 64 * 1024
+	argon2Time    uint32 = 3
+	argon2Threads uint8  = 2
+	argon2SaltLen        = 16
+	argon2KeyLen  uint32 = 32
+)
+
+const argon2Prefix = "$argon2id$"
+
+// DummyPasswordHash 存在しないユーザーのサインインで検証に用いるハッシュ.
+// 現在のパラメータのargon2idと同じ計算量とし、応答時間からユーザーの有無を判別できないようにする.
+// どのパスワードとも一致しない.
+var DummyPasswordHash = PasswordHash(fmt.Sprintf(
+	"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+	argon2Prefix,
+	argon2.Version,
+	argon2Memory,
+	argon2Time,
+	argon2Threads,
+	base64.RawStdEncoding.EncodeToString(make([]byte, argon2SaltLen)),
+	base64.RawStdEncoding.EncodeToString(make([]byte, argon2KeyLen)),
+))
+
+// PasswordHash パスワードハッシュ.
+// argon2idのPHC文字列形式を基本とし、移行元のbcryptハッシュも検証できる.
+type PasswordHash string
+
+func NewPasswordHash(value string) (PasswordHash, error) {
+	ph := PasswordHash(value)
+
+	if err := ph.validate(); err != nil {
+		return PasswordHash(""), err
+	}
+
+	return ph, nil
+}
+
+// GeneratePasswordHash パスワードからargon2idのハッシュを生成する関数.
+func GeneratePasswordHash(password Password) (PasswordHash, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return PasswordHash(""), err
+	}
+
+	key := argon2.IDKey([]byte(password.String()), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+	return PasswordHash(fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix,
+		argon2.Version,
+		argon2Memory,
+		argon2Time,
+		argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)), nil
+}
+
+func (ph PasswordHash) String() string {
+	return string(ph)
+}
+
+func (ph PasswordHash) validate() error {
+	if ph.isArgon2() || ph.isBcrypt() {
+		return nil
+	}
+
+	return errors.NewValidationError("unsupported password hash")
+}
+
+func (ph PasswordHash) isArgon2() bool {
+	return strings.HasPrefix(ph.String(), argon2Prefix)
+}
+
+func (ph PasswordHash) isBcrypt() bool {
+	_, err := bcrypt.Cost([]byte(ph.String()))
+
+	return err == nil
+}
+
+// Verify パスワードがハッシュと一致するかを検証するメソッド.
+func (ph PasswordHash) Verify(password Password) bool {
+	if ph.isBcrypt() {
+		return bcrypt.CompareHashAndPassword([]byte(ph.String()), []byte(password.String())) == nil
+	}
+
+	params, salt, key, err := ph.decode()
+	if err != nil {
+		return false
+	}
+
+	//nolint:gosec
+	actual := argon2.IDKey([]byte(password.String()), salt, params.time, params.memory, params.threads, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, actual) == 1
+}
+
+// NeedsRehash 現在のパラメータで再ハッシュすべきかを判定するメソッド.
+// bcryptや古いパラメータのargon2idはサインイン成功時に再ハッシュする.
+func (ph PasswordHash) NeedsRehash() bool {
+	params, salt, key, err := ph.decode()
+	if err != nil {
+		return true
+	}
+
+	return params != currentArgon2Params() ||
+		len(salt) != argon2SaltLen ||
+		uint32(len(key)) != argon2KeyLen //nolint:gosec
+}
+
+type argon2Params struct {
+	version int
+	memory  uint32
+	time    uint32
+	threads uint8
+}
+
+func currentArgon2Params() argon2Params {
+	return argon2Params{
+		version: argon2.Version,
+		memory:  argon2Memory,
+		time:    argon2Time,
+		threads: argon2Threads,
+	}
+}
+
+func (ph PasswordHash) decode() (argon2Params, []byte, []byte, error) {
+	// "$argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>"
+	parts := strings.Split(ph.String(), "$")
+	if len(parts) != 6 || !ph.isArgon2() {
+		return argon2Params{}, nil, nil, errors.NewValidationError("invalid argon2id hash")
+	}
+
+	var params argon2Params
+
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &params.version); err != nil {
+		return argon2Params{}, nil, nil, errors.NewValidationError("invalid argon2id version", err)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.time, &params.threads); err != nil {
+		return argon2Params{}, nil, nil, errors.NewValidationError("invalid argon2id params", err)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return argon2Params{}, nil, nil, errors.NewValidationError("invalid argon2id salt", err)
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return argon2Params{}, nil, nil, errors.NewValidationError("invalid argon2id key", err)
+	}
+
+	return params, salt, key, nil
+}
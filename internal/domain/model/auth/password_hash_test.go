@@ -0,0 +1,217 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestNewPasswordHash(t *testing.T) {
+	t.Parallel()
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    auth.PasswordHash
+		wantErr bool
+	}{
+		{
+			name: "argon2idのハッシュでパスワードハッシュが作成できる",
+			args: args{
+				value: "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5",
+			},
+			want:    auth.PasswordHash("$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5"),
+			wantErr: false,
+		},
+		{
+			name: "bcryptのハッシュでパスワードハッシュが作成できる",
+			args: args{
+				value: string(bcryptHash),
+			},
+			want:    auth.PasswordHash(bcryptHash),
+			wantErr: false,
+		},
+		{
+			name: "空文字でパスワードハッシュが作成できない",
+			args: args{
+				value: "",
+			},
+			want:    auth.PasswordHash(""),
+			wantErr: true,
+		},
+		{
+			name: "平文でパスワードハッシュが作成できない",
+			args: args{
+				value: "password",
+			},
+			want:    auth.PasswordHash(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := auth.NewPasswordHash(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewPasswordHash() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewPasswordHash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordHashVerify(t *testing.T) {
+	t.Parallel()
+
+	argon2Hash, err := auth.GeneratePasswordHash(auth.Password("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		password auth.Password
+	}
+
+	tests := []struct {
+		name string
+		ph   auth.PasswordHash
+		args args
+		want bool
+	}{
+		{
+			name: "argon2idのハッシュと一致するパスワードを検証できる",
+			ph:   argon2Hash,
+			args: args{
+				password: auth.Password("password"),
+			},
+			want: true,
+		},
+		{
+			name: "argon2idのハッシュと一致しないパスワードは検証に失敗する",
+			ph:   argon2Hash,
+			args: args{
+				password: auth.Password("invalid"),
+			},
+			want: false,
+		},
+		{
+			name: "bcryptのハッシュと一致するパスワードを検証できる",
+			ph:   auth.PasswordHash(bcryptHash),
+			args: args{
+				password: auth.Password("password"),
+			},
+			want: true,
+		},
+		{
+			name: "bcryptのハッシュと一致しないパスワードは検証に失敗する",
+			ph:   auth.PasswordHash(bcryptHash),
+			args: args{
+				password: auth.Password("invalid"),
+			},
+			want: false,
+		},
+		{
+			name: "不正なハッシュでは検証に失敗する",
+			ph:   auth.PasswordHash("$argon2id$invalid"),
+			args: args{
+				password: auth.Password("password"),
+			},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.ph.Verify(tt.args.password); got != tt.want {
+				t.Errorf("PasswordHash.Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPasswordHashNeedsRehash(t *testing.T) {
+	t.Parallel()
+
+	argon2Hash, err := auth.GeneratePasswordHash(auth.Password("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		ph   auth.PasswordHash
+		want bool
+	}{
+		{
+			name: "現在のパラメータのargon2idは再ハッシュ不要",
+			ph:   argon2Hash,
+			want: false,
+		},
+		{
+			name: "ダミーのハッシュは現在のパラメータのargon2idと同じ計算量となる",
+			ph:   auth.DummyPasswordHash,
+			want: false,
+		},
+		{
+			name: "bcryptは再ハッシュが必要",
+			ph:   auth.PasswordHash(bcryptHash),
+			want: true,
+		},
+		{
+			name: "古いパラメータのargon2idは再ハッシュが必要",
+			ph:   auth.PasswordHash("$argon2id$v=19$m=4096,t=1,p=1$c2FsdHNhbHRzYWx0c2FsdA$a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2V5a2U"),
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.ph.NeedsRehash(); got != tt.want {
+				t.Errorf("PasswordHash.NeedsRehash() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDummyPasswordHash(t *testing.T) {
+	t.Parallel()
+
+	if _, err := auth.NewPasswordHash(auth.DummyPasswordHash.String()); err != nil {
+		t.Fatalf("NewPasswordHash() error = %v", err)
+	}
+
+	for _, password := range []auth.Password{auth.Password(""), auth.Password("password")} {
+		if auth.DummyPasswordHash.Verify(password) {
+			t.Errorf("DummyPasswordHash.Verify(%q) = true, want false", password)
+		}
+	}
+}
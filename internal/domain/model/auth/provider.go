@@ -0,0 +1,33 @@
+package auth
+
+import "github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+
+// Provider 認証基盤の種別.
+type Provider string
+
+const (
+	// ProviderFirebase Firebase Authenticationを利用する.
+	ProviderFirebase Provider = "firebase"
+	// ProviderLocal PostgreSQLに保存したパスワードハッシュを利用する.
+	ProviderLocal Provider = "local"
+)
+
+// NewProvider 認証基盤の種別を作成するファクトリー関数.
+// 未指定の場合はFirebaseを利用する.
+func NewProvider(value string) (Provider, error) {
+	if value == "" {
+		return ProviderFirebase, nil
+	}
+
+	pv := Provider(value)
+
+	if pv != ProviderFirebase && pv != ProviderLocal {
+		return Provider(""), errors.NewValidationError("invalid auth provider")
+	}
+
+	return pv, nil
+}
+
+func (pv Provider) String() string {
+	return string(pv)
+}
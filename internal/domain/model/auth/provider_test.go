@@ -0,0 +1,70 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    auth.Provider
+		wantErr bool
+	}{
+		{
+			name: "firebaseで認証基盤の種別が作成できる",
+			args: args{
+				value: "firebase",
+			},
+			want:    auth.ProviderFirebase,
+			wantErr: false,
+		},
+		{
+			name: "localで認証基盤の種別が作成できる",
+			args: args{
+				value: "local",
+			},
+			want:    auth.ProviderLocal,
+			wantErr: false,
+		},
+		{
+			name: "未指定の場合はfirebaseとなる",
+			args: args{
+				value: "",
+			},
+			want:    auth.ProviderFirebase,
+			wantErr: false,
+		},
+		{
+			name: "不正な値で認証基盤の種別が作成できない",
+			args: args{
+				value: "invalid",
+			},
+			want:    auth.Provider(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := auth.NewProvider(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewProvider() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewProvider() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,58 +1,60 @@
 package auth
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 )
 
-const SessionTokenKey = "session-token"
+const (
+	SessionTokenKey = "session-token"
+	// sessionTokenLifetime セッショントークンの有効期限.
+	// model.DefaultSessionExpiresInと揃える.
+	sessionTokenLifetime = 30 * 24 * time.Hour
+)
 
 type SessionToken string
 
 func ParseSessionToken(
 	token string,
 	secret Secret,
+	keys KeySet,
 ) (SessionToken, error) {
 	st := SessionToken(token)
 
-	if err := st.validate(secret); err != nil {
+	if err := st.validate(secret, keys); err != nil {
 		return SessionToken(""), err
 	}
 
 	return st, nil
 }
 
+// GenerateSessionToken セッショントークンを発行する関数.
+// 署名鍵がない場合は共通鍵(HS256)で署名する.
+// Redis Clusterでユーザーのキーを同じスロットに配置するため、ユーザーIDをuidクレームに含める.
 func GenerateSessionToken(
 	sessionID SessionID,
+	userID user.ID,
 	secret Secret,
+	keys KeySet,
 ) SessionToken {
 	now := time.Now()
 
 	claims := jwt.MapClaims{
 		"sub": sessionID.String(),
+		"uid": userID.String(),
 		"iat": now.Unix(),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	strToken, _ := token.SignedString([]byte(secret))
+	strToken, _ := signToken(claims, secret, keys)
 
 	return SessionToken(strToken)
 }
 
-func (st SessionToken) validate(secret Secret) error {
-	parsedToken, err := jwt.Parse(st.String(), func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			msg := fmt.Sprintf("unexpected signing method: %v", token.Header["alg"])
-
-			return nil, errors.NewValidationError(msg)
-		}
-
-		return []byte(secret.String()), nil
-	})
+func (st SessionToken) validate(secret Secret, keys KeySet) error {
+	parsedToken, err := parseToken(st.String(), secret, keys, sessionTokenLifetime)
 	if err != nil {
 		return err
 	}
@@ -68,20 +70,41 @@ func (st SessionToken) String() string {
 	return string(st)
 }
 
-func (st SessionToken) ID(secret Secret) SessionID {
-	parsedToken, _ := jwt.Parse(st.String(), func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret.String()), nil
-	})
-
-	claims, _ := parsedToken.Claims.(jwt.MapClaims)
-
-	id, _ := claims["sub"].(string)
+// ID セッショントークンからSessionIDを取り出す.
+// 署名を検証できたトークンのクレームからのみ取り出し、検証できない場合はゼロ値を返す.
+func (st SessionToken) ID(secret Secret, keys KeySet) SessionID {
+	id, _ := st.claims(secret, keys)["sub"].(string)
 
 	sid, _ := NewSessionID(id)
 
 	return sid
 }
 
-func (st SessionToken) ToSecret(secret Secret) Secret {
-	return st.ID(secret).ToSecret()
+// UserID セッショントークンからユーザーIDを取り出す.
+// 検証できない場合やuidクレームを含まない場合はゼロ値を返す.
+func (st SessionToken) UserID(secret Secret, keys KeySet) user.ID {
+	id, _ := st.claims(secret, keys)["uid"].(string)
+
+	uid, err := user.NewID(id)
+	if err != nil {
+		return user.GenerateZeroID()
+	}
+
+	return uid
+}
+
+// claims 署名を検証したトークンのクレームを取り出す.
+func (st SessionToken) claims(secret Secret, keys KeySet) jwt.MapClaims {
+	parsedToken, err := parseToken(st.String(), secret, keys, sessionTokenLifetime)
+	if err != nil || !parsedToken.Valid {
+		return jwt.MapClaims{}
+	}
+
+	claims, _ := parsedToken.Claims.(jwt.MapClaims)
+
+	return claims
+}
+
+func (st SessionToken) ToSecret(secret Secret, keys KeySet) Secret {
+	return st.ID(secret, keys).ToSecret()
 }
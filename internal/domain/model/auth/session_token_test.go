@@ -0,0 +1,167 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestSessionTokenID(t *testing.T) {
+	t.Parallel()
+
+	sid := auth.GenerateSessionID()
+
+	uid := user.GenerateID()
+
+	secret := auth.Secret("secret")
+
+	rsa, err := auth.GenerateSigningKey(auth.SigningAlgorithmRS256, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eddsa, err := auth.GenerateSigningKey(auth.SigningAlgorithmEdDSA, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		secret auth.Secret
+		keys   auth.KeySet
+	}
+
+	tests := []struct {
+		name string
+		st   auth.SessionToken
+		args args
+		want auth.SessionID
+	}{
+		{
+			name: "共通鍵で署名したトークンからSessionIDを取り出せる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.KeySet{}),
+			args: args{
+				secret: secret,
+				keys:   auth.KeySet{},
+			},
+			want: sid,
+		},
+		{
+			name: "RS256で署名したトークンからSessionIDを取り出せる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.NewKeySet(rsa)),
+			args: args{
+				secret: secret,
+				keys:   auth.NewKeySet(rsa),
+			},
+			want: sid,
+		},
+		{
+			name: "EdDSAで署名したトークンからSessionIDを取り出せる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.NewKeySet(eddsa)),
+			args: args{
+				secret: secret,
+				keys:   auth.NewKeySet(eddsa),
+			},
+			want: sid,
+		},
+		{
+			name: "署名鍵が異なる場合はゼロ値になる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.NewKeySet(rsa)),
+			args: args{
+				secret: secret,
+				keys:   auth.NewKeySet(eddsa),
+			},
+			want: auth.SessionID{},
+		},
+		{
+			name: "共通鍵が異なる場合はゼロ値になる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.KeySet{}),
+			args: args{
+				secret: auth.Secret("other"),
+				keys:   auth.KeySet{},
+			},
+			want: auth.SessionID{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.st.ID(tt.args.secret, tt.args.keys); got != tt.want {
+				t.Errorf("SessionToken.ID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSessionTokenUserID(t *testing.T) {
+	t.Parallel()
+
+	sid := auth.GenerateSessionID()
+
+	uid := user.GenerateID()
+
+	secret := auth.Secret("secret")
+
+	rsa, err := auth.GenerateSigningKey(auth.SigningAlgorithmRS256, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	eddsa, err := auth.GenerateSigningKey(auth.SigningAlgorithmEdDSA, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type args struct {
+		secret auth.Secret
+		keys   auth.KeySet
+	}
+
+	tests := []struct {
+		name string
+		st   auth.SessionToken
+		args args
+		want user.ID
+	}{
+		{
+			name: "共通鍵で署名したトークンからUserIDを取り出せる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.KeySet{}),
+			args: args{
+				secret: secret,
+				keys:   auth.KeySet{},
+			},
+			want: uid,
+		},
+		{
+			name: "RS256で署名したトークンからUserIDを取り出せる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.NewKeySet(rsa)),
+			args: args{
+				secret: secret,
+				keys:   auth.NewKeySet(rsa),
+			},
+			want: uid,
+		},
+		{
+			name: "署名鍵が異なる場合はゼロ値になる",
+			st:   auth.GenerateSessionToken(sid, uid, secret, auth.NewKeySet(rsa)),
+			args: args{
+				secret: secret,
+				keys:   auth.NewKeySet(eddsa),
+			},
+			want: user.GenerateZeroID(),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.st.UserID(tt.args.secret, tt.args.keys); got != tt.want {
+				t.Errorf("SessionToken.UserID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// SigningAlgorithm トークンの署名アルゴリズム.
+type SigningAlgorithm string
+
+const (
+	// SigningAlgorithmRS256 RSA(2048bit)のPKCS#1 v1.5署名.
+	SigningAlgorithmRS256 SigningAlgorithm = "RS256"
+	// SigningAlgorithmEdDSA Ed25519署名.
+	SigningAlgorithmEdDSA SigningAlgorithm = "EdDSA"
+)
+
+// NewSigningAlgorithm 署名アルゴリズムを作成するファクトリー関数.
+// 未指定の場合はRS256を利用する.
+func NewSigningAlgorithm(value string) (SigningAlgorithm, error) {
+	if value == "" {
+		return SigningAlgorithmRS256, nil
+	}
+
+	sa := SigningAlgorithm(value)
+
+	if sa != SigningAlgorithmRS256 && sa != SigningAlgorithmEdDSA {
+		return SigningAlgorithm(""), errors.NewValidationError("invalid signing algorithm")
+	}
+
+	return sa, nil
+}
+
+func (sa SigningAlgorithm) String() string {
+	return string(sa)
+}
+
+func (sa SigningAlgorithm) method() jwt.SigningMethod {
+	if sa == SigningAlgorithmEdDSA {
+		return jwt.SigningMethodEdDSA
+	}
+
+	return jwt.SigningMethodRS256
+}
@@ -0,0 +1,70 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestNewSigningAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    auth.SigningAlgorithm
+		wantErr bool
+	}{
+		{
+			name: "RS256で署名アルゴリズムが作成できる",
+			args: args{
+				value: "RS256",
+			},
+			want:    auth.SigningAlgorithmRS256,
+			wantErr: false,
+		},
+		{
+			name: "EdDSAで署名アルゴリズムが作成できる",
+			args: args{
+				value: "EdDSA",
+			},
+			want:    auth.SigningAlgorithmEdDSA,
+			wantErr: false,
+		},
+		{
+			name: "未指定の場合はRS256となる",
+			args: args{
+				value: "",
+			},
+			want:    auth.SigningAlgorithmRS256,
+			wantErr: false,
+		},
+		{
+			name: "HS256では署名アルゴリズムが作成できない",
+			args: args{
+				value: "HS256",
+			},
+			want:    auth.SigningAlgorithm(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := auth.NewSigningAlgorithm(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSigningAlgorithm() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewSigningAlgorithm() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+const (
+	rsaKeyBits = 2048
+	kidBytes   = 16
+	keyUse     = "sig"
+)
+
+// SigningKey トークンの署名鍵.
+// 秘密鍵はPKCS#8(DER)形式で保持する.
+type SigningKey struct {
+	KID         string           `json:"kid"`
+	Algorithm   SigningAlgorithm `json:"alg"`
+	PrivateKey  []byte           `json:"privateKey"`
+	CreatedAt   time.Time        `json:"createdAt"`
+	ActivatedAt time.Time        `json:"activatedAt"`
+	RetiredAt   time.Time        `json:"retiredAt"`
+	// prv 解析済みの秘密鍵. 署名のたびにPKCS#8を解析しないよう保持する.
+	prv crypto.Signer
+}
+
+// GenerateSigningKey 署名鍵を新規に発行する関数.
+// kidは公開鍵のSHA-256ハッシュから導出する.
+func GenerateSigningKey(
+	alg SigningAlgorithm,
+	now time.Time,
+) (SigningKey, error) {
+	var prv crypto.Signer
+
+	switch alg {
+	case SigningAlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return SigningKey{}, fmt.Errorf("failed to generate rsa key: %w", err)
+		}
+
+		prv = key
+	case SigningAlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return SigningKey{}, fmt.Errorf("failed to generate ed25519 key: %w", err)
+		}
+
+		prv = key
+	default:
+		return SigningKey{}, errors.NewValidationError(fmt.Sprintf("unsupported signing algorithm: %s", alg))
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(prv)
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	pub, err := x509.MarshalPKIXPublicKey(prv.Public())
+	if err != nil {
+		return SigningKey{}, fmt.Errorf("failed to marshal public key: %w", err)
+	}
+
+	sum := sha256.Sum256(pub)
+
+	return SigningKey{
+		KID:         base64.RawURLEncoding.EncodeToString(sum[:kidBytes]),
+		Algorithm:   alg,
+		PrivateKey:  der,
+		CreatedAt:   now,
+		ActivatedAt: now,
+		prv:         prv,
+	}, nil
+}
+
+// IsActive 署名に利用できる鍵かどうかを判定する.
+// 有効化前の鍵も検証には利用する.
+func (sk SigningKey) IsActive(now time.Time) bool {
+	return !sk.ActivatedAt.After(now)
+}
+
+func (sk SigningKey) signer() (crypto.Signer, error) {
+	if sk.prv != nil {
+		return sk.prv, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(sk.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.NewValidationError("private key is not a signer")
+	}
+
+	return signer, nil
+}
+
+// parse 秘密鍵を解析して保持した署名鍵を提供する.
+func (sk SigningKey) parse() (SigningKey, error) {
+	signer, err := sk.signer()
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	sk.prv = signer
+
+	return sk, nil
+}
+
+func (sk SigningKey) publicKey() (crypto.PublicKey, error) {
+	signer, err := sk.signer()
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.Public(), nil
+}
+
+// JWK 署名鍵の公開鍵をJWK形式で提供するメソッド.
+func (sk SigningKey) JWK() (JWK, error) {
+	pub, err := sk.publicKey()
+	if err != nil {
+		return JWK{}, err
+	}
+
+	jwk := JWK{
+		Kid: sk.KID,
+		Use: keyUse,
+		Alg: sk.Algorithm.String(),
+	}
+
+	switch key := pub.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(key)
+	default:
+		return JWK{}, errors.NewValidationError("unsupported public key")
+	}
+
+	return jwk, nil
+}
+
+// JWK JSON Web Key(RFC 7517).
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS JSON Web Key Set(RFC 7517).
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
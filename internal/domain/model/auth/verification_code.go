@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+type VerificationCode string
+
+func (vc VerificationCode) String() string {
+	return string(vc)
+}
+
+func (vc VerificationCode) validate() error {
+	if vc == "" {
+		return errors.NewValidationError("verification code is required")
+	}
+
+	return nil
+}
+
+func NewVerificationCode(value string) (VerificationCode, error) {
+	code := VerificationCode(value)
+
+	if err := code.validate(); err != nil {
+		return VerificationCode(""), err
+	}
+
+	return code, nil
+}
+
+// GenerateVerificationCode メールアドレス検証用のコードを発行する関数.
+// リンクに埋め込まれるため推測困難なUUIDをそのまま用いる.
+func GenerateVerificationCode() VerificationCode {
+	return VerificationCode(uuid.NewString())
+}
@@ -0,0 +1,78 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestNewVerificationCode(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    auth.VerificationCode
+		wantErr bool
+	}{
+		{
+			name: "検証コードが作成できる",
+			args: args{
+				value: "01234567-0123-0123-0123-0123456789ab",
+			},
+			want:    auth.VerificationCode("01234567-0123-0123-0123-0123456789ab"),
+			wantErr: false,
+		},
+		{
+			name: "空文字で検証コードが作成できない",
+			args: args{
+				value: "",
+			},
+			want:    auth.VerificationCode(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := auth.NewVerificationCode(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewVerificationCode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewVerificationCode() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateVerificationCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+	}{
+		{
+			name: "検証コードが生成できる",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := auth.GenerateVerificationCode()
+			if _, err := uuid.Parse(got.String()); err != nil {
+				t.Errorf("GenerateVerificationCode() = %v", got)
+			}
+		})
+	}
+}
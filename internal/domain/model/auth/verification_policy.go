@@ -0,0 +1,33 @@
+package auth
+
+import "github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+
+// VerificationPolicy メールアドレス未検証ユーザーに対する制限方針.
+type VerificationPolicy string
+
+const (
+	// VerificationPolicyNone 未検証ユーザーに制限を設けない.
+	VerificationPolicyNone VerificationPolicy = "none"
+	// VerificationPolicyRestrict 未検証ユーザーのサインインを制限する.
+	VerificationPolicyRestrict VerificationPolicy = "restrict"
+)
+
+// NewVerificationPolicy 検証方針を作成するファクトリー関数.
+// 未指定の場合は制限を設けない.
+func NewVerificationPolicy(value string) (VerificationPolicy, error) {
+	if value == "" {
+		return VerificationPolicyNone, nil
+	}
+
+	vp := VerificationPolicy(value)
+
+	if vp != VerificationPolicyNone && vp != VerificationPolicyRestrict {
+		return VerificationPolicy(""), errors.NewValidationError("invalid verification policy")
+	}
+
+	return vp, nil
+}
+
+func (vp VerificationPolicy) String() string {
+	return string(vp)
+}
@@ -0,0 +1,70 @@
+package auth_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestNewVerificationPolicy(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		value string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    auth.VerificationPolicy
+		wantErr bool
+	}{
+		{
+			name: "noneで検証方針が作成できる",
+			args: args{
+				value: "none",
+			},
+			want:    auth.VerificationPolicyNone,
+			wantErr: false,
+		},
+		{
+			name: "restrictで検証方針が作成できる",
+			args: args{
+				value: "restrict",
+			},
+			want:    auth.VerificationPolicyRestrict,
+			wantErr: false,
+		},
+		{
+			name: "未指定の場合はnoneとなる",
+			args: args{
+				value: "",
+			},
+			want:    auth.VerificationPolicyNone,
+			wantErr: false,
+		},
+		{
+			name: "不正な値で検証方針が作成できない",
+			args: args{
+				value: "invalid",
+			},
+			want:    auth.VerificationPolicy(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := auth.NewVerificationPolicy(tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewVerificationPolicy() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewVerificationPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
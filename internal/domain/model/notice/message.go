@@ -27,3 +27,34 @@ func GenerateInvitationMessage(code auth.InvitationCode) Message {
 
 	return Message(msg)
 }
+
+const verificationMessage = `Please verify your email address for Morning Night Guild Platform.
+
+Open the following link to complete the verification.
+
+%s?code=%s
+
+If you did not request this, please ignore this email.
+`
+
+func GenerateVerificationMessage(url string, code auth.VerificationCode) Message {
+	msg := fmt.Sprintf(verificationMessage, url, code.String())
+
+	return Message(msg)
+}
+
+const emailChangedMessage = `The email address of your Morning Night Guild Platform account has been changed.
+
+New Email Address
+====================
+%s
+====================
+
+If you did not make this change, please contact the administrator immediately.
+`
+
+func GenerateEmailChangedMessage(email auth.Email) Message {
+	msg := fmt.Sprintf(emailChangedMessage, email.String())
+
+	return Message(msg)
+}
@@ -48,3 +48,88 @@ func TestGenerateInvitationMessage(t *testing.T) {
 		})
 	}
 }
+
+const wantVerificationMessage = `Please verify your email address for Morning Night Guild Platform.
+
+Open the following link to complete the verification.
+
+http://localhost:8080/api/v1/auth/email/verify?code=test
+
+If you did not request this, please ignore this email.
+`
+
+func TestGenerateVerificationMessage(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		url  string
+		code auth.VerificationCode
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want notice.Message
+	}{
+		{
+			name: "検証メッセージが生成できる",
+			args: args{
+				url:  "http://localhost:8080/api/v1/auth/email/verify",
+				code: auth.VerificationCode("test"),
+			},
+			want: notice.Message(wantVerificationMessage),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := notice.GenerateVerificationMessage(tt.args.url, tt.args.code); got != tt.want {
+				t.Errorf("GenerateVerificationMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+const wantEmailChangedMessage = `The email address of your Morning Night Guild Platform account has been changed.
+
+New Email Address
+====================
+new@example.com
+====================
+
+If you did not make this change, please contact the administrator immediately.
+`
+
+func TestGenerateEmailChangedMessage(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		email auth.Email
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want notice.Message
+	}{
+		{
+			name: "メールアドレス変更通知メッセージが生成できる",
+			args: args{
+				email: auth.Email("new@example.com"),
+			},
+			want: notice.Message(wantEmailChangedMessage),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := notice.GenerateEmailChangedMessage(tt.args.email); got != tt.want {
+				t.Errorf("GenerateEmailChangedMessage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
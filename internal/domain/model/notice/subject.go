@@ -13,3 +13,11 @@ func NewSubject(value string) (Subject, error) {
 func GenerateInvitationSubject() Subject {
 	return Subject("Welcome to Morning Night Guild Platform!")
 }
+
+func GenerateVerificationSubject() Subject {
+	return Subject("Verify your email address")
+}
+
+func GenerateEmailChangedSubject() Subject {
+	return Subject("Your email address has been changed")
+}
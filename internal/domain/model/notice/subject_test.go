@@ -29,3 +29,51 @@ func TestGenerateInvitationSubject(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateVerificationSubject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want notice.Subject
+	}{
+		{
+			name: "検証メールの件名が生成できる",
+			want: notice.Subject("Verify your email address"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := notice.GenerateVerificationSubject(); got != tt.want {
+				t.Errorf("GenerateVerificationSubject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateEmailChangedSubject(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		want notice.Subject
+	}{
+		{
+			name: "メールアドレス変更通知の件名が生成できる",
+			want: notice.Subject("Your email address has been changed"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := notice.GenerateEmailChangedSubject(); got != tt.want {
+				t.Errorf("GenerateEmailChangedSubject() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
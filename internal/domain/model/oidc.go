@@ -0,0 +1,53 @@
+package model
+
+import (
+	"crypto/rsa"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+const DefaultOIDCAuthorizationExpiresIn = 10 * time.Minute
+
+// OIDCAuthorization OpenID Connectの認可リクエスト.
+// コールバックまでの間stateをキーとしてキャッシュに保持する.
+type OIDCAuthorization struct {
+	State          auth.OIDCState      `json:"state"`
+	Nonce          auth.OIDCNonce      `json:"nonce"`
+	CodeVerifier   auth.CodeVerifier   `json:"codeVerifier"`
+	Provider       auth.OIDCProvider   `json:"provider"`
+	InvitationCode auth.InvitationCode `json:"invitationCode"`
+	PublicKey      rsa.PublicKey       `json:"publicKey"`
+	ExpiresIn      auth.ExpiresIn      `json:"expiresIn"`
+}
+
+// GenerateOIDCAuthorization 認可リクエストを発行する関数.
+func GenerateOIDCAuthorization(
+	provider auth.OIDCProvider,
+	invitationCode auth.InvitationCode,
+	publicKey rsa.PublicKey,
+	expiresIn auth.ExpiresIn,
+) OIDCAuthorization {
+	return OIDCAuthorization{
+		State:          auth.GenerateOIDCState(),
+		Nonce:          auth.GenerateOIDCNonce(),
+		CodeVerifier:   auth.GenerateCodeVerifier(),
+		Provider:       provider,
+		InvitationCode: invitationCode,
+		PublicKey:      publicKey,
+		ExpiresIn:      expiresIn,
+	}
+}
+
+// HasInvitation 招待コード付きの認可リクエストであるかを判定するメソッド.
+func (oa OIDCAuthorization) HasInvitation() bool {
+	return oa.InvitationCode != ""
+}
+
+// OIDCIdentity 認証プロバイダから取得したユーザー情報.
+type OIDCIdentity struct {
+	Provider      auth.OIDCProvider
+	Subject       string
+	Email         auth.Email
+	EmailVerified bool
+}
@@ -0,0 +1,51 @@
+package model_test
+
+import (
+	"crypto/rsa"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+func TestGenerateOIDCAuthorization(t *testing.T) {
+	t.Parallel()
+
+	t.Run("認可リクエストを発行できる", func(t *testing.T) {
+		t.Parallel()
+
+		got := model.GenerateOIDCAuthorization(
+			auth.OIDCProvider("google"),
+			auth.InvitationCode(""),
+			rsa.PublicKey{},
+			auth.DefaultExpiresIn,
+		)
+
+		if got.State == "" || got.Nonce == "" || got.CodeVerifier == "" {
+			t.Errorf("GenerateOIDCAuthorization() = %+v, want random values", got)
+		}
+
+		if got.State.String() == got.Nonce.String() {
+			t.Errorf("GenerateOIDCAuthorization() state and nonce must differ")
+		}
+
+		if got.HasInvitation() {
+			t.Errorf("HasInvitation() = true, want false")
+		}
+	})
+
+	t.Run("招待コード付きの認可リクエストを発行できる", func(t *testing.T) {
+		t.Parallel()
+
+		got := model.GenerateOIDCAuthorization(
+			auth.OIDCProvider("google"),
+			auth.InvitationCode("code"),
+			rsa.PublicKey{},
+			auth.DefaultExpiresIn,
+		)
+
+		if !got.HasInvitation() {
+			t.Errorf("HasInvitation() = false, want true")
+		}
+	})
+}
@@ -74,8 +74,9 @@ func (sss Session) IsExpired() bool {
 
 func (sss Session) ToToken(
 	secret auth.Secret,
+	keys auth.KeySet,
 ) auth.SessionToken {
-	return auth.GenerateSessionToken(sss.SessionID, secret)
+	return auth.GenerateSessionToken(sss.SessionID, sss.UserID, secret, keys)
 }
 
 func (sss Session) Key() string {
@@ -1,11 +1,32 @@
 package model
 
 import (
+	"time"
+
+	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
 )
 
+// UserDeletionGracePeriod 削除を受け付けてからユーザーのデータを完全に削除するまでの猶予期間.
+// 猶予期間中はユーザーとして扱わないが、誤って削除した場合に復旧できるようデータを残す.
+const UserDeletionGracePeriod = 30 * 24 * time.Hour
+
+// UserExportPageSize エクスポート時に1回で取得する記事の件数.
+const UserExportPageSize = value.Size(100)
+
 type User struct {
-	UserID user.ID
+	UserID  user.ID
+	Profile UserProfile // プロフィール
+}
+
+// UserProfile ユーザーのプロフィール. 未設定の項目は空文字となる.
+type UserProfile struct {
+	DisplayName user.DisplayName // 表示名
+	AvatarURL   user.AvatarURL   // アバター画像のURL
+	Bio         user.Bio         // 自己紹介
+	Locale      user.Locale      // ロケール
+	Timezone    user.Timezone    // タイムゾーン
 }
 
 func NewUser(
@@ -16,8 +37,84 @@ func NewUser(
 	}
 }
 
+// ReconstructUser ユーザーモデルの再構築関数.
+func ReconstructUser(
+	id uuid.UUID,
+	displayName string,
+	avatarURL string,
+	bio string,
+	locale string,
+	timezone string,
+) User {
+	return User{
+		UserID: user.ID(id),
+		Profile: UserProfile{
+			DisplayName: user.DisplayName(displayName),
+			AvatarURL:   user.AvatarURL(avatarURL),
+			Bio:         user.Bio(bio),
+			Locale:      user.Locale(locale),
+			Timezone:    user.Timezone(timezone),
+		},
+	}
+}
+
+// UpdateProfile 指定した項目のみプロフィールを更新したユーザーを返すメソッド.
+// 項目を指定しない場合はプロフィールを変更しない.
+func (u User) UpdateProfile(
+	profile UserProfile,
+	fields []user.ProfileField,
+) User {
+	for _, field := range fields {
+		switch field {
+		case user.FieldDisplayName:
+			u.Profile.DisplayName = profile.DisplayName
+		case user.FieldAvatarURL:
+			u.Profile.AvatarURL = profile.AvatarURL
+		case user.FieldBio:
+			u.Profile.Bio = profile.Bio
+		case user.FieldLocale:
+			u.Profile.Locale = profile.Locale
+		case user.FieldTimezone:
+			u.Profile.Timezone = profile.Timezone
+		}
+	}
+
+	return u
+}
+
 func CreateUser() User {
 	id := user.GenerateID()
 
 	return NewUser(id)
 }
+
+// DeletedUser 削除を受け付けたユーザーモデル.
+type DeletedUser struct {
+	User
+	DeletedAt time.Time // 削除を受け付けた日時
+}
+
+// UserExport 削除前に利用者へ渡すユーザーのデータの写し.
+type UserExport struct {
+	User       User      // ユーザー
+	Articles   []Article // 共有した記事
+	ExportedAt time.Time // エクスポートした日時
+}
+
+// UserDeletion 削除を受け付けたユーザー.
+type UserDeletion struct {
+	Export  UserExport // 削除前に取得したデータの写し
+	PurgeAt time.Time  // データを完全に削除する日時
+}
+
+// NewUserDeletion 削除の受付モデルのファクトリー関数.
+// 削除を受け付けた日時から猶予期間が経過した時点でデータを完全に削除する.
+func NewUserDeletion(
+	export UserExport,
+	deletedAt time.Time,
+) UserDeletion {
+	return UserDeletion{
+		Export:  export,
+		PurgeAt: deletedAt.Add(UserDeletionGracePeriod),
+	}
+}
@@ -0,0 +1,67 @@
+package user
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// maxAvatarURLLength アバター画像のURLの最大長.
+const maxAvatarURLLength = 2048
+
+// AvatarURL アバター画像のURL.
+type AvatarURL string
+
+// String アバター画像のURLを文字列として提供するメソッド.
+func (au AvatarURL) String() string {
+	return string(au)
+}
+
+// NewAvatarURL アバター画像のURLを新規作成するファクトリー関数.
+func NewAvatarURL(au string) (AvatarURL, error) {
+	avatar := AvatarURL(au)
+
+	if err := avatar.validate(); err != nil {
+		return AvatarURL(""), err
+	}
+
+	return avatar, nil
+}
+
+// validate アバター画像のURLを検証するメソッド.
+func (au AvatarURL) validate() error {
+	// 空文字も扱うこととし
+	// 空文字だったらバリデーションを実施しない
+	if len(au.String()) == 0 {
+		return nil
+	}
+
+	if len(au.String()) > maxAvatarURLLength {
+		msg := fmt.Sprintf("avatar url must be less than or equal to %d bytes", maxAvatarURLLength)
+
+		return errors.NewValidationError(msg)
+	}
+
+	if !strings.HasPrefix(au.String(), "https://") {
+		msg := fmt.Sprintf("avatar url must be start with `https://`. value is %s", au.String())
+
+		return errors.NewValidationError(msg)
+	}
+
+	u, err := url.Parse(au.String())
+	if err != nil {
+		msg := fmt.Sprintf("avatar url is invalid. value is %s", au.String())
+
+		return errors.NewValidationError(msg, err)
+	}
+
+	if u.Host == "" {
+		msg := fmt.Sprintf("avatar url must have a host. value is %s", au.String())
+
+		return errors.NewValidationError(msg)
+	}
+
+	return nil
+}
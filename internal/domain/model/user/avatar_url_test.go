@@ -0,0 +1,79 @@
+package user_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestNewAvatarURL(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		au string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    user.AvatarURL
+		wantErr bool
+	}{
+		{
+			name: "空のアバター画像のURLが作成できる",
+			args: args{
+				au: "",
+			},
+			want:    user.AvatarURL(""),
+			wantErr: false,
+		},
+		{
+			name: "`https://`から始まるアバター画像のURLが作成できる",
+			args: args{
+				au: "https://example.com/avatar.png",
+			},
+			want:    user.AvatarURL("https://example.com/avatar.png"),
+			wantErr: false,
+		},
+		{
+			name: "`https://`から始まらないアバター画像のURLは作成に失敗する",
+			args: args{
+				au: "http://example.com/avatar.png",
+			},
+			want:    user.AvatarURL(""),
+			wantErr: true,
+		},
+		{
+			name: "ホストのないアバター画像のURLは作成に失敗する",
+			args: args{
+				au: "https:///avatar.png",
+			},
+			want:    user.AvatarURL(""),
+			wantErr: true,
+		},
+		{
+			name: "長すぎるアバター画像のURLは作成に失敗する",
+			args: args{
+				au: "https://example.com/" + strings.Repeat("a", 2048),
+			},
+			want:    user.AvatarURL(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := user.NewAvatarURL(tt.args.au)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewAvatarURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewAvatarURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
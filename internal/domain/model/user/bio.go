@@ -0,0 +1,42 @@
+package user
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// maxBioLength 自己紹介の最大文字数.
+const maxBioLength = 500
+
+// Bio 自己紹介.
+type Bio string
+
+// String 自己紹介を文字列として提供するメソッド.
+func (b Bio) String() string {
+	return string(b)
+}
+
+// NewBio 自己紹介を新規作成するファクトリー関数.
+func NewBio(b string) (Bio, error) {
+	bio := Bio(b)
+
+	if err := bio.validate(); err != nil {
+		return Bio(""), err
+	}
+
+	return bio, nil
+}
+
+// validate 自己紹介を検証するメソッド.
+// 未設定を表す空文字も扱う.
+func (b Bio) validate() error {
+	if utf8.RuneCountInString(b.String()) > maxBioLength {
+		msg := fmt.Sprintf("bio must be less than or equal to %d characters", maxBioLength)
+
+		return errors.NewValidationError(msg)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package user_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestNewBio(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		b string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    user.Bio
+		wantErr bool
+	}{
+		{
+			name: "空の自己紹介が作成できる",
+			args: args{
+				b: "",
+			},
+			want:    user.Bio(""),
+			wantErr: false,
+		},
+		{
+			name: "500文字の自己紹介が作成できる",
+			args: args{
+				b: strings.Repeat("あ", 500),
+			},
+			want:    user.Bio(strings.Repeat("あ", 500)),
+			wantErr: false,
+		},
+		{
+			name: "501文字の自己紹介は作成に失敗する",
+			args: args{
+				b: strings.Repeat("あ", 501),
+			},
+			want:    user.Bio(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := user.NewBio(tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewBio() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewBio() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
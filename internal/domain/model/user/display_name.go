@@ -0,0 +1,42 @@
+package user
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// maxDisplayNameLength 表示名の最大文字数.
+const maxDisplayNameLength = 50
+
+// DisplayName 表示名.
+type DisplayName string
+
+// String 表示名を文字列として提供するメソッド.
+func (dn DisplayName) String() string {
+	return string(dn)
+}
+
+// NewDisplayName 表示名を新規作成するファクトリー関数.
+func NewDisplayName(dn string) (DisplayName, error) {
+	name := DisplayName(dn)
+
+	if err := name.validate(); err != nil {
+		return DisplayName(""), err
+	}
+
+	return name, nil
+}
+
+// validate 表示名を検証するメソッド.
+// 未設定を表す空文字も扱う.
+func (dn DisplayName) validate() error {
+	if utf8.RuneCountInString(dn.String()) > maxDisplayNameLength {
+		msg := fmt.Sprintf("display name must be less than or equal to %d characters", maxDisplayNameLength)
+
+		return errors.NewValidationError(msg)
+	}
+
+	return nil
+}
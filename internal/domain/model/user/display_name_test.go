@@ -0,0 +1,63 @@
+package user_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestNewDisplayName(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		dn string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    user.DisplayName
+		wantErr bool
+	}{
+		{
+			name: "空の表示名が作成できる",
+			args: args{
+				dn: "",
+			},
+			want:    user.DisplayName(""),
+			wantErr: false,
+		},
+		{
+			name: "50文字の表示名が作成できる",
+			args: args{
+				dn: strings.Repeat("あ", 50),
+			},
+			want:    user.DisplayName(strings.Repeat("あ", 50)),
+			wantErr: false,
+		},
+		{
+			name: "51文字の表示名は作成に失敗する",
+			args: args{
+				dn: strings.Repeat("あ", 51),
+			},
+			want:    user.DisplayName(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := user.NewDisplayName(tt.args.dn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewDisplayName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewDisplayName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"golang.org/x/text/language"
+)
+
+// Locale 言語と地域の設定(BCP 47の言語タグ).
+type Locale string
+
+// String ロケールを文字列として提供するメソッド.
+func (l Locale) String() string {
+	return string(l)
+}
+
+// NewLocale ロケールを新規作成するファクトリー関数.
+// 大文字と小文字の表記揺れを吸収するため、正規化した言語タグを保持する.
+func NewLocale(l string) (Locale, error) {
+	if l == "" {
+		return Locale(""), nil
+	}
+
+	tag, err := language.Parse(l)
+	if err != nil {
+		msg := fmt.Sprintf("locale must be a BCP 47 language tag. value is %s", l)
+
+		return Locale(""), errors.NewValidationError(msg, err)
+	}
+
+	return Locale(tag.String()), nil
+}
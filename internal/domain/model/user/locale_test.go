@@ -0,0 +1,70 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestNewLocale(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		l string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    user.Locale
+		wantErr bool
+	}{
+		{
+			name: "空のロケールが作成できる",
+			args: args{
+				l: "",
+			},
+			want:    user.Locale(""),
+			wantErr: false,
+		},
+		{
+			name: "言語タグのロケールが作成できる",
+			args: args{
+				l: "ja-JP",
+			},
+			want:    user.Locale("ja-JP"),
+			wantErr: false,
+		},
+		{
+			name: "表記揺れのあるロケールは正規化して作成できる",
+			args: args{
+				l: "en-us",
+			},
+			want:    user.Locale("en-US"),
+			wantErr: false,
+		},
+		{
+			name: "言語タグでないロケールは作成に失敗する",
+			args: args{
+				l: "japanese!",
+			},
+			want:    user.Locale(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := user.NewLocale(tt.args.l)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewLocale() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewLocale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package user
+
+import (
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// ProfileField 更新するプロフィールの項目.
+type ProfileField string
+
+const (
+	FieldDisplayName ProfileField = "display_name"
+	FieldAvatarURL   ProfileField = "avatar_url"
+	FieldBio         ProfileField = "bio"
+	FieldLocale      ProfileField = "locale"
+	FieldTimezone    ProfileField = "timezone"
+)
+
+// String プロフィールの項目を文字列として提供するメソッド.
+func (pf ProfileField) String() string {
+	return string(pf)
+}
+
+// NewProfileField プロフィールの項目を新規作成するファクトリー関数.
+func NewProfileField(pf string) (ProfileField, error) {
+	field := ProfileField(pf)
+
+	switch field {
+	case FieldDisplayName, FieldAvatarURL, FieldBio, FieldLocale, FieldTimezone:
+		return field, nil
+	default:
+		msg := fmt.Sprintf("unknown profile field. value is %s", pf)
+
+		return ProfileField(""), errors.NewValidationError(msg)
+	}
+}
+
+// NewProfileFields 更新するプロフィールの項目の一覧を新規作成するファクトリー関数.
+// 重複した項目は1つにまとめる.
+func NewProfileFields(pfs []string) ([]ProfileField, error) {
+	fields := make([]ProfileField, 0, len(pfs))
+
+	seen := make(map[ProfileField]bool, len(pfs))
+
+	for _, pf := range pfs {
+		field, err := NewProfileField(pf)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[field] {
+			continue
+		}
+
+		seen[field] = true
+
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
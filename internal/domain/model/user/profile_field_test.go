@@ -0,0 +1,63 @@
+package user_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestNewProfileFields(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		pfs []string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    []user.ProfileField
+		wantErr bool
+	}{
+		{
+			name: "項目を指定しない場合は空の一覧が作成できる",
+			args: args{
+				pfs: []string{},
+			},
+			want:    []user.ProfileField{},
+			wantErr: false,
+		},
+		{
+			name: "重複した項目を1つにまとめて作成できる",
+			args: args{
+				pfs: []string{"display_name", "bio", "display_name"},
+			},
+			want:    []user.ProfileField{user.FieldDisplayName, user.FieldBio},
+			wantErr: false,
+		},
+		{
+			name: "存在しない項目を含む場合は作成に失敗する",
+			args: args{
+				pfs: []string{"display_name", "email"},
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := user.NewProfileFields(tt.args.pfs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewProfileFields() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewProfileFields() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
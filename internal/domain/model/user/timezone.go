@@ -0,0 +1,51 @@
+package user
+
+import (
+	"fmt"
+	"time"
+
+	// 実行環境にタイムゾーンのデータベースがなくても検証できるよう埋め込む.
+	_ "time/tzdata"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+)
+
+// Timezone タイムゾーン(IANAタイムゾーンデータベースの名前).
+type Timezone string
+
+// String タイムゾーンを文字列として提供するメソッド.
+func (tz Timezone) String() string {
+	return string(tz)
+}
+
+// NewTimezone タイムゾーンを新規作成するファクトリー関数.
+func NewTimezone(tz string) (Timezone, error) {
+	timezone := Timezone(tz)
+
+	if err := timezone.validate(); err != nil {
+		return Timezone(""), err
+	}
+
+	return timezone, nil
+}
+
+// validate タイムゾーンを検証するメソッド.
+// 未設定を表す空文字も扱う.
+func (tz Timezone) validate() error {
+	if len(tz.String()) == 0 {
+		return nil
+	}
+
+	// Localは実行環境によって意味が変わるため扱わない
+	if tz.String() == "Local" {
+		return errors.NewValidationError("timezone must not be Local")
+	}
+
+	if _, err := time.LoadLocation(tz.String()); err != nil {
+		msg := fmt.Sprintf("timezone must be an IANA time zone name. value is %s", tz.String())
+
+		return errors.NewValidationError(msg, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package user_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestNewTimezone(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		tz string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    user.Timezone
+		wantErr bool
+	}{
+		{
+			name: "空のタイムゾーンが作成できる",
+			args: args{
+				tz: "",
+			},
+			want:    user.Timezone(""),
+			wantErr: false,
+		},
+		{
+			name: "IANAタイムゾーンデータベースの名前のタイムゾーンが作成できる",
+			args: args{
+				tz: "Asia/Tokyo",
+			},
+			want:    user.Timezone("Asia/Tokyo"),
+			wantErr: false,
+		},
+		{
+			name: "Localは作成に失敗する",
+			args: args{
+				tz: "Local",
+			},
+			want:    user.Timezone(""),
+			wantErr: true,
+		},
+		{
+			name: "存在しないタイムゾーンは作成に失敗する",
+			args: args{
+				tz: "Asia/Nowhere",
+			},
+			want:    user.Timezone(""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := user.NewTimezone(tt.args.tz)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTimezone() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("NewTimezone() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package model_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
@@ -42,3 +43,115 @@ func TestNewUser(t *testing.T) {
 		})
 	}
 }
+
+func TestNewUserDeletion(t *testing.T) {
+	t.Parallel()
+
+	deletedAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	export := model.UserExport{
+		User:       model.NewUser(user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab"))),
+		Articles:   []model.Article{},
+		ExportedAt: deletedAt,
+	}
+
+	got := model.NewUserDeletion(export, deletedAt)
+
+	want := model.UserDeletion{
+		Export:  export,
+		PurgeAt: time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewUserDeletion() = %v, want %v", got, want)
+	}
+}
+
+func TestUserUpdateProfile(t *testing.T) {
+	t.Parallel()
+
+	uid := user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab"))
+
+	current := model.User{
+		UserID: uid,
+		Profile: model.UserProfile{
+			DisplayName: user.DisplayName("before"),
+			AvatarURL:   user.AvatarURL("https://example.com/before.png"),
+			Bio:         user.Bio("before"),
+			Locale:      user.Locale("ja-JP"),
+			Timezone:    user.Timezone("Asia/Tokyo"),
+		},
+	}
+
+	profile := model.UserProfile{
+		DisplayName: user.DisplayName("after"),
+		AvatarURL:   user.AvatarURL(""),
+		Bio:         user.Bio("after"),
+		Locale:      user.Locale("en-US"),
+		Timezone:    user.Timezone("UTC"),
+	}
+
+	type args struct {
+		profile model.UserProfile
+		fields  []user.ProfileField
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want model.User
+	}{
+		{
+			name: "指定した項目のみ更新できる",
+			args: args{
+				profile: profile,
+				fields:  []user.ProfileField{user.FieldDisplayName, user.FieldAvatarURL},
+			},
+			want: model.User{
+				UserID: uid,
+				Profile: model.UserProfile{
+					DisplayName: user.DisplayName("after"),
+					AvatarURL:   user.AvatarURL(""),
+					Bio:         user.Bio("before"),
+					Locale:      user.Locale("ja-JP"),
+					Timezone:    user.Timezone("Asia/Tokyo"),
+				},
+			},
+		},
+		{
+			name: "すべての項目を更新できる",
+			args: args{
+				profile: profile,
+				fields: []user.ProfileField{
+					user.FieldDisplayName,
+					user.FieldAvatarURL,
+					user.FieldBio,
+					user.FieldLocale,
+					user.FieldTimezone,
+				},
+			},
+			want: model.User{
+				UserID:  uid,
+				Profile: profile,
+			},
+		},
+		{
+			name: "項目を指定しない場合は更新しない",
+			args: args{
+				profile: profile,
+				fields:  []user.ProfileField{},
+			},
+			want: current,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := current.UpdateProfile(tt.args.profile, tt.args.fields); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UpdateProfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
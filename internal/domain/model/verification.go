@@ -0,0 +1,80 @@
+package model
+
+import (
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/notice"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+const DefaultVerificationExpiresIn = 24 * time.Hour // 1 day
+
+// Verification メールアドレス検証.
+// PreviousEmailが設定されている場合はメールアドレス変更の確認を表す.
+type Verification struct {
+	Code          auth.VerificationCode `json:"code"`
+	UserID        user.ID               `json:"userId"`
+	Email         auth.Email            `json:"email"`
+	PreviousEmail auth.Email            `json:"previousEmail"`
+}
+
+func NewVerification(
+	code auth.VerificationCode,
+	userID user.ID,
+	email auth.Email,
+	previousEmail auth.Email,
+) (Verification, error) {
+	return Verification{
+		Code:          code,
+		UserID:        userID,
+		Email:         email,
+		PreviousEmail: previousEmail,
+	}, nil
+}
+
+// GenerateVerification 登録済みメールアドレスの検証を発行する関数.
+func GenerateVerification(
+	userID user.ID,
+	email auth.Email,
+) Verification {
+	return Verification{
+		Code:   auth.GenerateVerificationCode(),
+		UserID: userID,
+		Email:  email,
+	}
+}
+
+// GenerateEmailChange 新しいメールアドレスへの変更確認を発行する関数.
+func GenerateEmailChange(
+	userID user.ID,
+	email auth.Email,
+	previousEmail auth.Email,
+) Verification {
+	return Verification{
+		Code:          auth.GenerateVerificationCode(),
+		UserID:        userID,
+		Email:         email,
+		PreviousEmail: previousEmail,
+	}
+}
+
+func (vrf Verification) IsEmailChange() bool {
+	return vrf.PreviousEmail != ""
+}
+
+func (vrf Verification) Subject() notice.Subject {
+	return notice.GenerateVerificationSubject()
+}
+
+func (vrf Verification) Message(url string) notice.Message {
+	return notice.GenerateVerificationMessage(url, vrf.Code)
+}
+
+func (vrf Verification) ChangedSubject() notice.Subject {
+	return notice.GenerateEmailChangedSubject()
+}
+
+func (vrf Verification) ChangedMessage() notice.Message {
+	return notice.GenerateEmailChangedMessage(vrf.Email)
+}
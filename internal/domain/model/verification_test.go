@@ -0,0 +1,87 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+)
+
+func TestGenerateVerification(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		userID user.ID
+		email  auth.Email
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "メールアドレス検証が生成できる",
+			args: args{
+				userID: user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				email:  auth.Email("test@example.com"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := model.GenerateVerification(tt.args.userID, tt.args.email)
+			if got.UserID != tt.args.userID || got.Email != tt.args.email {
+				t.Errorf("GenerateVerification() = %v", got)
+			}
+			if got.Code == "" {
+				t.Errorf("GenerateVerification() code is empty")
+			}
+			if got.IsEmailChange() {
+				t.Errorf("GenerateVerification() must not be email change")
+			}
+		})
+	}
+}
+
+func TestGenerateEmailChange(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		userID        user.ID
+		email         auth.Email
+		previousEmail auth.Email
+	}
+
+	tests := []struct {
+		name string
+		args args
+	}{
+		{
+			name: "メールアドレス変更確認が生成できる",
+			args: args{
+				userID:        user.ID(uuid.MustParse("01234567-0123-0123-0123-0123456789ab")),
+				email:         auth.Email("new@example.com"),
+				previousEmail: auth.Email("old@example.com"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := model.GenerateEmailChange(tt.args.userID, tt.args.email, tt.args.previousEmail)
+			if got.Email != tt.args.email || got.PreviousEmail != tt.args.previousEmail {
+				t.Errorf("GenerateEmailChange() = %v", got)
+			}
+			if !got.IsEmailChange() {
+				t.Errorf("GenerateEmailChange() must be email change")
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
@@ -15,8 +16,12 @@ type Article interface {
 	Save(context.Context, model.Article) error
 	List(context.Context, value.Index, value.Size, ...value.Filter) ([]model.Article, error)
 	ListByUser(context.Context, user.ID, value.Index, value.Size, ...value.Filter) ([]model.Article, error)
+	ListAllByUser(context.Context, user.ID, value.Index, value.Size) ([]model.Article, error)
 	Find(context.Context, article.ID) (model.Article, error)
 	Delete(context.Context, article.ID) error
+	ListTrash(context.Context, value.Index, value.Size) ([]model.TrashedArticle, error)
+	Restore(context.Context, article.ID) error
+	Purge(context.Context, time.Time) (int, error)
 	ExistsByUser(context.Context, article.ID, user.ID) (bool, error)
 	AddToUser(context.Context, article.ID, user.ID) error
 	RemoveFromUser(context.Context, article.ID, user.ID) error
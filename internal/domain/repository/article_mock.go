@@ -7,6 +7,7 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/morning-night-guild/platform-app/internal/domain/model"
@@ -116,6 +117,21 @@ func (mr *MockArticleMockRecorder) List(arg0, arg1, arg2 interface{}, arg3 ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockArticle)(nil).List), varargs...)
 }
 
+// ListAllByUser mocks base method.
+func (m *MockArticle) ListAllByUser(arg0 context.Context, arg1 user.ID, arg2 value.Index, arg3 value.Size) ([]model.Article, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllByUser", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]model.Article)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllByUser indicates an expected call of ListAllByUser.
+func (mr *MockArticleMockRecorder) ListAllByUser(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllByUser", reflect.TypeOf((*MockArticle)(nil).ListAllByUser), arg0, arg1, arg2, arg3)
+}
+
 // ListByUser mocks base method.
 func (m *MockArticle) ListByUser(arg0 context.Context, arg1 user.ID, arg2 value.Index, arg3 value.Size, arg4 ...value.Filter) ([]model.Article, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +152,36 @@ func (mr *MockArticleMockRecorder) ListByUser(arg0, arg1, arg2, arg3 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockArticle)(nil).ListByUser), varargs...)
 }
 
+// ListTrash mocks base method.
+func (m *MockArticle) ListTrash(arg0 context.Context, arg1 value.Index, arg2 value.Size) ([]model.TrashedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.TrashedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockArticleMockRecorder) ListTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockArticle)(nil).ListTrash), arg0, arg1, arg2)
+}
+
+// Purge mocks base method.
+func (m *MockArticle) Purge(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockArticleMockRecorder) Purge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockArticle)(nil).Purge), arg0, arg1)
+}
+
 // RemoveFromUser mocks base method.
 func (m *MockArticle) RemoveFromUser(arg0 context.Context, arg1 article.ID, arg2 user.ID) error {
 	m.ctrl.T.Helper()
@@ -150,6 +196,20 @@ func (mr *MockArticleMockRecorder) RemoveFromUser(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromUser", reflect.TypeOf((*MockArticle)(nil).RemoveFromUser), arg0, arg1, arg2)
 }
 
+// Restore mocks base method.
+func (m *MockArticle) Restore(arg0 context.Context, arg1 article.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockArticleMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockArticle)(nil).Restore), arg0, arg1)
+}
+
 // Save mocks base method.
 func (m *MockArticle) Save(arg0 context.Context, arg1 model.Article) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/value"
+)
+
+//go:generate mockgen -source audit.go -destination audit_mock.go -package repository
+
+// Audit 監査ログは追記のみとし、更新と削除は提供しない.
+type Audit interface {
+	Save(context.Context, model.AuditLog) error
+	List(context.Context, audit.Cursor, value.Size, ...value.Filter) ([]model.AuditLog, error)
+}
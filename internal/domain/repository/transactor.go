@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source transactor.go -destination transactor_mock.go -package repository
+
+// Transactor 複数のリポジトリへの書き込みを1つのトランザクションで行う.
+// fnに渡すコンテキストを用いたリポジトリの操作はトランザクションに参加し、fnがエラーを返した場合はロールバックする.
+// 既にトランザクション内のコンテキストで呼び出した場合は、そのトランザクションに参加する.
+type Transactor interface {
+	RunInTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
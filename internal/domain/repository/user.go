@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/morning-night-guild/platform-app/internal/domain/model"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
@@ -11,5 +12,9 @@ import (
 
 type User interface {
 	Save(context.Context, model.User) error
+	UpdateProfile(context.Context, user.ID, model.UserProfile, []user.ProfileField) (model.User, error)
 	Find(context.Context, user.ID) (model.User, error)
+	Delete(context.Context, user.ID) (model.DeletedUser, error)
+	FindPurgeable(context.Context, time.Time) ([]user.ID, error)
+	Purge(context.Context, time.Time) (int, error)
 }
@@ -7,6 +7,7 @@ package repository
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/morning-night-guild/platform-app/internal/domain/model"
@@ -36,6 +37,21 @@ func (m *MockUser) EXPECT() *MockUserMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockUser) Delete(arg0 context.Context, arg1 user.ID) (model.DeletedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(model.DeletedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUser)(nil).Delete), arg0, arg1)
+}
+
 // Find mocks base method.
 func (m *MockUser) Find(arg0 context.Context, arg1 user.ID) (model.User, error) {
 	m.ctrl.T.Helper()
@@ -51,6 +67,36 @@ func (mr *MockUserMockRecorder) Find(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockUser)(nil).Find), arg0, arg1)
 }
 
+// FindPurgeable mocks base method.
+func (m *MockUser) FindPurgeable(arg0 context.Context, arg1 time.Time) ([]user.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPurgeable", arg0, arg1)
+	ret0, _ := ret[0].([]user.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPurgeable indicates an expected call of FindPurgeable.
+func (mr *MockUserMockRecorder) FindPurgeable(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPurgeable", reflect.TypeOf((*MockUser)(nil).FindPurgeable), arg0, arg1)
+}
+
+// Purge mocks base method.
+func (m *MockUser) Purge(arg0 context.Context, arg1 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockUserMockRecorder) Purge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockUser)(nil).Purge), arg0, arg1)
+}
+
 // Save mocks base method.
 func (m *MockUser) Save(arg0 context.Context, arg1 model.User) error {
 	m.ctrl.T.Helper()
@@ -64,3 +110,18 @@ func (mr *MockUserMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUser)(nil).Save), arg0, arg1)
 }
+
+// UpdateProfile mocks base method.
+func (m *MockUser) UpdateProfile(arg0 context.Context, arg1 user.ID, arg2 model.UserProfile, arg3 []user.ProfileField) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile.
+func (mr *MockUserMockRecorder) UpdateProfile(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUser)(nil).UpdateProfile), arg0, arg1, arg2, arg3)
+}
@@ -12,10 +12,12 @@ import (
 //go:generate mockgen -source article.go -destination article_mock.go -package rpc
 
 type Article interface {
-	Share(context.Context, article.URL, article.Title, article.Description, article.Thumbnail) (model.Article, error)
+	Share(context.Context, article.URL, article.Title, article.Description, article.Thumbnail, *user.ID) (model.Article, error)
 	List(context.Context, value.Index, value.Size, ...value.Filter) ([]model.Article, error)
 	ListByUser(context.Context, user.ID, value.Index, value.Size, ...value.Filter) ([]model.Article, error)
 	Delete(context.Context, article.ID) error
+	ListTrash(context.Context, value.Index, value.Size) ([]model.TrashedArticle, error)
+	Restore(context.Context, article.ID) error
 	AddToUser(context.Context, article.ID, user.ID) error
 	RemoveFromUser(context.Context, article.ID, user.ID) error
 }
@@ -106,6 +106,21 @@ func (mr *MockArticleMockRecorder) ListByUser(arg0, arg1, arg2, arg3 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUser", reflect.TypeOf((*MockArticle)(nil).ListByUser), varargs...)
 }
 
+// ListTrash mocks base method.
+func (m *MockArticle) ListTrash(arg0 context.Context, arg1 value.Index, arg2 value.Size) ([]model.TrashedArticle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]model.TrashedArticle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockArticleMockRecorder) ListTrash(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockArticle)(nil).ListTrash), arg0, arg1, arg2)
+}
+
 // RemoveFromUser mocks base method.
 func (m *MockArticle) RemoveFromUser(arg0 context.Context, arg1 article.ID, arg2 user.ID) error {
 	m.ctrl.T.Helper()
@@ -120,17 +135,31 @@ func (mr *MockArticleMockRecorder) RemoveFromUser(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromUser", reflect.TypeOf((*MockArticle)(nil).RemoveFromUser), arg0, arg1, arg2)
 }
 
+// Restore mocks base method.
+func (m *MockArticle) Restore(arg0 context.Context, arg1 article.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockArticleMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockArticle)(nil).Restore), arg0, arg1)
+}
+
 // Share mocks base method.
-func (m *MockArticle) Share(arg0 context.Context, arg1 article.URL, arg2 article.Title, arg3 article.Description, arg4 article.Thumbnail) (model.Article, error) {
+func (m *MockArticle) Share(arg0 context.Context, arg1 article.URL, arg2 article.Title, arg3 article.Description, arg4 article.Thumbnail, arg5 *user.ID) (model.Article, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Share", arg0, arg1, arg2, arg3, arg4)
+	ret := m.ctrl.Call(m, "Share", arg0, arg1, arg2, arg3, arg4, arg5)
 	ret0, _ := ret[0].(model.Article)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Share indicates an expected call of Share.
-func (mr *MockArticleMockRecorder) Share(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+func (mr *MockArticleMockRecorder) Share(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockArticle)(nil).Share), arg0, arg1, arg2, arg3, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockArticle)(nil).Share), arg0, arg1, arg2, arg3, arg4, arg5)
 }
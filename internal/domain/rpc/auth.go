@@ -15,4 +15,9 @@ type Auth interface {
 	SignIn(context.Context, auth.Email, auth.Password) (model.User, error)
 	ChangePassword(context.Context, user.ID, auth.Password) error
 	GetEmail(context.Context, user.ID) (auth.Email, error)
+	FindByEmail(context.Context, auth.Email) (model.User, error)
+	UpdateEmail(context.Context, user.ID, auth.Email) error
+	MarkVerified(context.Context, user.ID) error
+	IsVerified(context.Context, user.ID) (bool, error)
+	Delete(context.Context, user.ID) error
 }
@@ -51,6 +51,35 @@ func (mr *MockAuthMockRecorder) ChangePassword(arg0, arg1, arg2 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockAuth)(nil).ChangePassword), arg0, arg1, arg2)
 }
 
+// Delete mocks base method.
+func (m *MockAuth) Delete(arg0 context.Context, arg1 user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAuthMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAuth)(nil).Delete), arg0, arg1)
+}
+
+// FindByEmail mocks base method.
+func (m *MockAuth) FindByEmail(arg0 context.Context, arg1 auth.Email) (model.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmail", arg0, arg1)
+	ret0, _ := ret[0].(model.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmail indicates an expected call of FindByEmail.
+func (mr *MockAuthMockRecorder) FindByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockAuth)(nil).FindByEmail), arg0, arg1)
+}
+
 // GetEmail mocks base method.
 func (m *MockAuth) GetEmail(arg0 context.Context, arg1 user.ID) (auth.Email, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +95,35 @@ func (mr *MockAuthMockRecorder) GetEmail(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmail", reflect.TypeOf((*MockAuth)(nil).GetEmail), arg0, arg1)
 }
 
+// IsVerified mocks base method.
+func (m *MockAuth) IsVerified(arg0 context.Context, arg1 user.ID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVerified", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsVerified indicates an expected call of IsVerified.
+func (mr *MockAuthMockRecorder) IsVerified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVerified", reflect.TypeOf((*MockAuth)(nil).IsVerified), arg0, arg1)
+}
+
+// MarkVerified mocks base method.
+func (m *MockAuth) MarkVerified(arg0 context.Context, arg1 user.ID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkVerified", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkVerified indicates an expected call of MarkVerified.
+func (mr *MockAuthMockRecorder) MarkVerified(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkVerified", reflect.TypeOf((*MockAuth)(nil).MarkVerified), arg0, arg1)
+}
+
 // SignIn mocks base method.
 func (m *MockAuth) SignIn(arg0 context.Context, arg1 auth.Email, arg2 auth.Password) (model.User, error) {
 	m.ctrl.T.Helper()
@@ -94,3 +152,17 @@ func (mr *MockAuthMockRecorder) SignUp(arg0, arg1, arg2, arg3 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignUp", reflect.TypeOf((*MockAuth)(nil).SignUp), arg0, arg1, arg2, arg3)
 }
+
+// UpdateEmail mocks base method.
+func (m *MockAuth) UpdateEmail(arg0 context.Context, arg1 user.ID, arg2 auth.Email) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmail", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmail indicates an expected call of UpdateEmail.
+func (mr *MockAuthMockRecorder) UpdateEmail(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockAuth)(nil).UpdateEmail), arg0, arg1, arg2)
+}
@@ -0,0 +1,18 @@
+package rpc
+
+import "context"
+
+type primaryKey struct{}
+
+// SetPrimaryReadCtx 呼び出し先での読み取りをレプリカではなくライターで行うよう指定したコンテキストを作成する.
+// 更新の直後などレプリカの遅延により更新前の値を読み取ってはならない場合に用いる.
+func SetPrimaryReadCtx(ctx context.Context) context.Context {
+	return context.WithValue(ctx, primaryKey{}, true)
+}
+
+// IsPrimaryReadCtx 呼び出し先での読み取りをライターで行うよう指定されているかを判定する.
+func IsPrimaryReadCtx(ctx context.Context) bool {
+	primary, ok := ctx.Value(primaryKey{}).(bool)
+
+	return ok && primary
+}
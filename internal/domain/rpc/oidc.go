@@ -0,0 +1,16 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+)
+
+//go:generate mockgen -source oidc.go -destination oidc_mock.go -package rpc
+
+type OIDC interface {
+	AuthorizationURL(context.Context, model.OIDCAuthorization) (string, error)
+	Exchange(context.Context, model.OIDCAuthorization, string) (model.OIDCIdentity, error)
+	Supports(auth.OIDCProvider) bool
+}
@@ -11,5 +11,7 @@ import (
 
 type User interface {
 	Create(context.Context) (model.User, error)
-	Update(context.Context, user.ID) (model.User, error)
+	Get(context.Context, user.ID) (model.User, error)
+	Update(context.Context, user.ID, model.UserProfile, []user.ProfileField) (model.User, error)
+	Delete(context.Context, user.ID) (model.UserDeletion, error)
 }
@@ -0,0 +1,142 @@
+// Package backup はデータベースの論理バックアップをアーカイブに書き出し、空のデータベースに復元する.
+// アーカイブはテーブルごとのNDJSONとマニフェストを格納したzipで、行をストリームで処理するためメモリ使用量は行数に依存しない.
+// 差分バックアップは前回のアーカイブのウォーターマーク以降に更新された行と、削除を記録したトゥームストーンを格納する.
+package backup
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	entschema "entgo.io/ent/dialect/sql/schema"
+	entmigrate "github.com/morning-night-guild/platform-app/pkg/ent/migrate"
+	"github.com/morning-night-guild/platform-app/pkg/ent/tombstone"
+)
+
+const (
+	// FormatVersion アーカイブの形式のバージョン. 形式を変更した場合は上げる.
+	FormatVersion = 2
+	// ManifestFile マニフェストのファイル名.
+	ManifestFile = "manifest.json"
+	// batchSize 復元時に1回のINSERTで登録する行数.
+	batchSize = 500
+	// overlap 差分の起点を前回のウォーターマークから遡る時間.
+	// 前回の取得時点で未コミットだったトランザクションの更新を取りこぼさないようにする.
+	// 重複して取得した行は復元時に上書きされる.
+	overlap = 5 * time.Minute
+)
+
+// Kind アーカイブの種類.
+type Kind string
+
+const (
+	// KindFull 全ての行を格納したアーカイブ.
+	KindFull Kind = "full"
+	// KindIncremental 前回のアーカイブからの差分を格納したアーカイブ.
+	KindIncremental Kind = "incremental"
+)
+
+var (
+	// ErrUnsupportedFormat アーカイブの形式に対応していない.
+	ErrUnsupportedFormat = errors.New("unsupported archive format")
+	// ErrSchemaMismatch アーカイブと復元先のスキーマのバージョンが一致しない.
+	ErrSchemaMismatch = errors.New("schema version mismatch")
+	// ErrNotEmpty 復元先のテーブルが空ではない.
+	ErrNotEmpty = errors.New("table is not empty")
+	// ErrVerification 復元したデータの件数またはチェックサムがマニフェストと一致しない.
+	ErrVerification = errors.New("verification failed")
+	// ErrBrokenChain 差分バックアップの連なりが途切れている.
+	ErrBrokenChain = errors.New("broken backup chain")
+	// ErrNoWatermark 差分の判定に使うカラムがない.
+	ErrNoWatermark = errors.New("watermark column not found")
+	// ErrUnknownTable アーカイブにバックアップ対象ではないテーブルが含まれている.
+	ErrUnknownTable = errors.New("unknown table")
+)
+
+// Manifest アーカイブの内容.
+type Manifest struct {
+	Version       int        `json:"version"`
+	ID            string     `json:"id"`
+	Kind          Kind       `json:"kind"`
+	Parent        string     `json:"parent,omitempty"`
+	SchemaVersion string     `json:"schemaVersion"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	Since         *time.Time `json:"since,omitempty"`
+	Watermark     time.Time  `json:"watermark"`
+	Tables        []Table    `json:"tables"`
+	Tombstones    *Table     `json:"tombstones,omitempty"`
+}
+
+// Table アーカイブに格納したテーブル.
+type Table struct {
+	Name     string   `json:"name"`
+	File     string   `json:"file"`
+	Columns  []string `json:"columns"`
+	Count    int64    `json:"count"`
+	Checksum string   `json:"checksum"`
+}
+
+// tables 外部キーの参照先が先になるように並べたバックアップ対象のテーブル.
+// トゥームストーンはバックアップのための記録のため対象に含めない.
+func tables() []*entschema.Table {
+	all := make([]*entschema.Table, 0, len(entmigrate.Tables))
+
+	for _, t := range entmigrate.Tables {
+		if t.Name != tombstone.Table {
+			all = append(all, t)
+		}
+	}
+
+	sort.SliceStable(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+
+	visited := make(map[string]bool, len(all))
+	sorted := make([]*entschema.Table, 0, len(all))
+
+	var visit func(t *entschema.Table)
+
+	visit = func(t *entschema.Table) {
+		if visited[t.Name] {
+			return
+		}
+
+		visited[t.Name] = true
+
+		for _, fk := range t.ForeignKeys {
+			if fk.RefTable != nil && fk.RefTable != t {
+				visit(fk.RefTable)
+			}
+		}
+
+		sorted = append(sorted, t)
+	}
+
+	for _, t := range all {
+		visit(t)
+	}
+
+	return sorted
+}
+
+// watermarkColumn 差分の判定に使う更新日時のカラム.
+// 追記のみのテーブルは作成日時、トゥームストーンは削除日時を使う.
+func watermarkColumn(t *entschema.Table) (string, bool) {
+	for _, name := range []string{"updated_at", "occurred_at", "deleted_at"} {
+		if t.HasColumn(name) {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+func columns(t *entschema.Table) []string {
+	cols := make([]string, len(t.Columns))
+
+	for i, c := range t.Columns {
+		cols[i] = c.Name
+	}
+
+	return cols
+}
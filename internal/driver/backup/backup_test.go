@@ -0,0 +1,501 @@
+package backup_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/article"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/driver/backup"
+	"github.com/morning-night-guild/platform-app/internal/driver/migration"
+	"github.com/morning-night-guild/platform-app/internal/driver/sqlite"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	"github.com/morning-night-guild/platform-app/pkg/ent/enttest"
+)
+
+// open スキーマを作成済みの空のデータベースを開く.
+func open(t *testing.T, version string) (*sql.DB, *ent.Client) {
+	t.Helper()
+
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString())
+
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	client := enttest.Open(t, "sqlite3", dsn)
+
+	t.Cleanup(func() { client.Close() })
+
+	ctx := context.Background()
+
+	rrw := migration.NewRevisions(db)
+
+	if err := rrw.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rrw.WriteRevision(ctx, &migrate.Revision{
+		Version:    version,
+		Type:       migrate.RevisionTypeExecute,
+		ExecutedAt: time.Now(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	return db, client
+}
+
+func seed(t *testing.T, client *ent.Client) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// 復元時に複数のバッチに分かれる件数を登録する
+	for i := 0; i < 1200; i++ {
+		art := client.Article.Create().
+			SetID(uuid.New()).
+			SetTitle(fmt.Sprintf("title-%d", i)).
+			SetURL(fmt.Sprintf("https://example.com/%d", i)).
+			SetDescription("description").
+			SetThumbnail("https://example.com/thumbnail").
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			SaveX(ctx)
+
+		client.ArticleTag.Create().
+			SetTag("tag").
+			SetArticleID(art.ID).
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			SaveX(ctx)
+	}
+
+	usr := client.User.Create().SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+
+	client.AuditLog.Create().
+		SetEventType("sign_in").
+		SetOutcome("success").
+		SetOccurredAt(now).
+		SaveX(ctx)
+
+	client.AuditLog.Create().
+		SetActorID(usr.ID).
+		SetEventType("sign_out").
+		SetOutcome("success").
+		SetOccurredAt(now).
+		SaveX(ctx)
+}
+
+func TestDumpRestore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	src, client := open(t, "1")
+
+	seed(t, client)
+
+	buf := &bytes.Buffer{}
+
+	dumped, err := backup.Dump(ctx, src, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]int64{}
+	for _, table := range dumped.Tables {
+		counts[table.Name] = table.Count
+	}
+
+	want := map[string]int64{
+		"articles":      1200,
+		"article_tags":  1200,
+		"audit_logs":    2,
+		"credentials":   0,
+		"user_articles": 0,
+		"users":         1,
+	}
+
+	if !reflect.DeepEqual(counts, want) {
+		t.Errorf("Dump() counts = %v, want %v", counts, want)
+	}
+
+	if dumped.SchemaVersion != "1" {
+		t.Errorf("Dump() schema version = %s, want 1", dumped.SchemaVersion)
+	}
+
+	dst, _ := open(t, "1")
+
+	restored, err := backup.Restore(ctx, dst, archive(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(restored.Tables, dumped.Tables) {
+		t.Errorf("Restore() tables = %v, want %v", restored.Tables, dumped.Tables)
+	}
+
+	// 復元したデータベースを書き出しても同じ内容になる
+	redumped, err := backup.Dump(ctx, dst, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(redumped.Tables, dumped.Tables) {
+		t.Errorf("Dump() after Restore() tables = %v, want %v", redumped.Tables, dumped.Tables)
+	}
+
+	// 空でないデータベースには復元できない
+	if _, err := backup.Restore(ctx, dst, archive(buf.Bytes())); !errors.Is(err, backup.ErrNotEmpty) {
+		t.Errorf("Restore() error = %v, want %v", err, backup.ErrNotEmpty)
+	}
+}
+
+func TestRestoreError(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	src, client := open(t, "1")
+
+	seed(t, client)
+
+	buf := &bytes.Buffer{}
+
+	if _, err := backup.Dump(ctx, src, buf); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		version string
+		modify  func(*backup.Manifest)
+		want    error
+	}{
+		{
+			name:    "スキーマのバージョンが異なるデータベースには復元できない",
+			version: "2",
+			modify:  func(*backup.Manifest) {},
+			want:    backup.ErrSchemaMismatch,
+		},
+		{
+			name:    "件数がマニフェストと一致しない場合は復元しない",
+			version: "1",
+			modify: func(m *backup.Manifest) {
+				m.Tables[len(m.Tables)-1].Count++
+			},
+			want: backup.ErrVerification,
+		},
+		{
+			name:    "チェックサムがマニフェストと一致しない場合は復元しない",
+			version: "1",
+			modify: func(m *backup.Manifest) {
+				m.Tables[len(m.Tables)-1].Checksum = "sha256:invalid"
+			},
+			want: backup.ErrVerification,
+		},
+		{
+			name:    "対応していない形式のアーカイブは復元しない",
+			version: "1",
+			modify: func(m *backup.Manifest) {
+				m.Version = backup.FormatVersion + 1
+			},
+			want: backup.ErrUnsupportedFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dst, client := open(t, tt.version)
+
+			if _, err := backup.Restore(ctx, dst, archive(rewrite(t, buf.Bytes(), tt.modify))); !errors.Is(err, tt.want) {
+				t.Fatalf("Restore() error = %v, want %v", err, tt.want)
+			}
+
+			// 失敗した場合は途中まで登録したデータも残らない
+			if n := client.Article.Query().CountX(ctx); n != 0 {
+				t.Errorf("articles = %d, want 0", n)
+			}
+		})
+	}
+}
+
+func TestIncremental(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	src, client := open(t, "1")
+
+	seed(t, client)
+
+	full := &bytes.Buffer{}
+
+	base, err := backup.Dump(ctx, src, full)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 記事の更新・追加とタグの削除を行う
+	arts := client.Article.Query().Limit(2).AllX(ctx)
+
+	client.Article.UpdateOne(arts[0]).SetTitle("updated").ExecX(ctx)
+
+	client.Article.Create().
+		SetTitle("created").
+		SetURL("https://example.com/created").
+		SetDescription("description").
+		SetThumbnail("https://example.com/thumbnail").
+		ExecX(ctx)
+
+	tag := arts[1].QueryTags().OnlyX(ctx)
+
+	client.ArticleTag.DeleteOne(tag).ExecX(ctx)
+
+	// PostgreSQLではトリガーで記録されるトゥームストーンを登録する
+	client.Tombstone.Create().SetTableName("article_tags").SetRowID(tag.ID).ExecX(ctx)
+
+	incr := &bytes.Buffer{}
+
+	diff, err := backup.DumpIncremental(ctx, src, incr, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff.Kind != backup.KindIncremental || diff.Parent != base.ID {
+		t.Fatalf("DumpIncremental() kind = %s, parent = %s, want %s, %s", diff.Kind, diff.Parent, backup.KindIncremental, base.ID)
+	}
+
+	counts := map[string]int64{}
+	for _, table := range diff.Tables {
+		counts[table.Name] = table.Count
+	}
+
+	if counts["articles"] != 2 || counts["article_tags"] != 0 || diff.Tombstones.Count != 1 {
+		t.Fatalf("DumpIncremental() counts = %v, tombstones = %d, want 2 articles and 1 tombstone", counts, diff.Tombstones.Count)
+	}
+
+	want, err := backup.Dump(ctx, src, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("全体のアーカイブに差分を適用すると最新の状態を復元できる", func(t *testing.T) {
+		t.Parallel()
+
+		dst, _ := open(t, "1")
+
+		if _, err := backup.Restore(ctx, dst, archive(full.Bytes()), archive(incr.Bytes())); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := backup.Dump(ctx, dst, io.Discard)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(got.Tables, want.Tables) {
+			t.Errorf("Dump() after Restore() tables = %v, want %v", got.Tables, want.Tables)
+		}
+	})
+
+	t.Run("連なっていない差分は適用できない", func(t *testing.T) {
+		t.Parallel()
+
+		dst, _ := open(t, "1")
+
+		other := rewrite(t, incr.Bytes(), func(m *backup.Manifest) {
+			m.Parent = uuid.NewString()
+		})
+
+		if _, err := backup.Restore(ctx, dst, archive(full.Bytes()), archive(other)); !errors.Is(err, backup.ErrBrokenChain) {
+			t.Errorf("Restore() error = %v, want %v", err, backup.ErrBrokenChain)
+		}
+
+		if _, err := backup.Restore(ctx, dst, archive(incr.Bytes())); !errors.Is(err, backup.ErrBrokenChain) {
+			t.Errorf("Restore() error = %v, want %v", err, backup.ErrBrokenChain)
+		}
+	})
+
+	t.Run("スキーマのバージョンが変わった場合は差分を取得できない", func(t *testing.T) {
+		t.Parallel()
+
+		old := *base
+		old.SchemaVersion = "0"
+
+		if _, err := backup.DumpIncremental(ctx, src, io.Discard, &old); !errors.Is(err, backup.ErrSchemaMismatch) {
+			t.Errorf("DumpIncremental() error = %v, want %v", err, backup.ErrSchemaMismatch)
+		}
+	})
+}
+
+func TestIncrementalDefaultTimestamp(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	rdb, err := sqlite.New().Of("sqlite://" + uuid.NewString() + "?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { rdb.Close() })
+
+	rrw := migration.NewRevisions(rdb.DB)
+
+	if err := rrw.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rrw.WriteRevision(ctx, &migrate.Revision{
+		Version:    "1",
+		Type:       migrate.RevisionTypeExecute,
+		ExecutedAt: time.Now(),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	usr := rdb.User.Create().SetCreatedAt(now).SetUpdatedAt(now).SaveX(ctx)
+
+	arts := make([]*ent.Article, 2)
+	for i := range arts {
+		arts[i] = rdb.Article.Create().
+			SetTitle(fmt.Sprintf("title-%d", i)).
+			SetURL(fmt.Sprintf("https://example.com/%d", i)).
+			SetDescription("description").
+			SetThumbnail("https://example.com/thumbnail").
+			SetCreatedAt(now).
+			SetUpdatedAt(now).
+			SaveX(ctx)
+	}
+
+	rdb.Article.UpdateOne(arts[1]).SetDeletedAt(now).SetUpdatedAt(now).ExecX(ctx)
+
+	base, err := backup.Dump(ctx, rdb.DB, io.Discard)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// プロセスの起動より後に前回のアーカイブを取得した状態にする
+	base.Watermark = time.Now().UTC().Add(backup.Overlap)
+
+	// 更新日時を既定値に任せる更新を行う
+	articleGateway := gateway.NewArticle(rdb)
+	userGateway := gateway.NewUser(rdb)
+
+	if err := articleGateway.Delete(ctx, article.ID(arts[0].ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := articleGateway.Restore(ctx, article.ID(arts[1].ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := articleGateway.AddToUser(ctx, article.ID(arts[1].ID), user.ID(usr.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := userGateway.Delete(ctx, user.ID(usr.ID)); err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := backup.DumpIncremental(ctx, rdb.DB, io.Discard, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	counts := map[string]int64{}
+	for _, table := range diff.Tables {
+		counts[table.Name] = table.Count
+	}
+
+	want := map[string]int64{"articles": 2, "user_articles": 1, "users": 1}
+	for name, count := range want {
+		if counts[name] != count {
+			t.Errorf("DumpIncremental() %s count = %d, want %d", name, counts[name], count)
+		}
+	}
+}
+
+func archive(b []byte) backup.Archive {
+	return backup.Archive{
+		Reader: bytes.NewReader(b),
+		Size:   int64(len(b)),
+	}
+}
+
+// rewrite マニフェストを書き換えたアーカイブを作成する.
+func rewrite(t *testing.T, archive []byte, modify func(*backup.Manifest)) []byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manifest, err := backup.ReadManifest(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	modify(manifest)
+
+	buf := &bytes.Buffer{}
+	zw := zip.NewWriter(buf)
+
+	for _, f := range zr.File {
+		w, err := zw.Create(f.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if f.Name == backup.ManifestFile {
+			if err := json.NewEncoder(w).Encode(manifest); err != nil {
+				t.Fatal(err)
+			}
+
+			continue
+		}
+
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := io.Copy(w, r); err != nil {
+			t.Fatal(err)
+		}
+
+		r.Close()
+	}
+
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,249 @@
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	entschema "entgo.io/ent/dialect/sql/schema"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/driver/migration"
+	entmigrate "github.com/morning-night-guild/platform-app/pkg/ent/migrate"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+// Dump 全てのテーブルの全ての行をアーカイブに書き出す.
+// 読み取り専用のトランザクション内で実行するため、テーブル間で一貫したスナップショットになる.
+func Dump(ctx context.Context, db *sql.DB, w io.Writer) (*Manifest, error) {
+	return dump(ctx, db, w, nil)
+}
+
+// DumpIncremental baseのウォーターマーク以降に更新された行と削除された行をアーカイブに書き出す.
+// baseには直前に取得したアーカイブ(全体または差分)のマニフェストを指定する.
+func DumpIncremental(ctx context.Context, db *sql.DB, w io.Writer, base *Manifest) (*Manifest, error) {
+	if base.ID == "" {
+		return nil, fmt.Errorf("%w: base archive has no id", ErrBrokenChain)
+	}
+
+	return dump(ctx, db, w, base)
+}
+
+func dump(ctx context.Context, db *sql.DB, w io.Writer, base *Manifest) (*Manifest, error) {
+	// トランザクションの開始より前の時刻を次回の差分の起点とする
+	watermark := time.Now().UTC()
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err := tx.Rollback(); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to rollback transaction", log.ErrorField(err))
+		}
+	}()
+
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Version:       FormatVersion,
+		ID:            uuid.NewString(),
+		Kind:          KindFull,
+		SchemaVersion: version,
+		CreatedAt:     watermark,
+		Watermark:     watermark,
+		Tables:        make([]Table, 0, len(tables())),
+	}
+
+	if base != nil {
+		// スキーマが変わった場合は差分を積み重ねられないため全体を取り直す
+		if base.SchemaVersion != version {
+			return nil, fmt.Errorf("%w: base %q, database %q", ErrSchemaMismatch, base.SchemaVersion, version)
+		}
+
+		since := base.Watermark.Add(-overlap)
+
+		manifest.Kind = KindIncremental
+		manifest.Parent = base.ID
+		manifest.Since = &since
+	}
+
+	zw := zip.NewWriter(w)
+
+	for _, t := range tables() {
+		table, err := dumpTable(ctx, tx, zw, t, manifest.Since)
+		if err != nil {
+			return nil, err
+		}
+
+		log.GetLogCtx(ctx).Info("dumped table", zap.String("table", table.Name), zap.Int64("count", table.Count))
+
+		manifest.Tables = append(manifest.Tables, table)
+	}
+
+	if manifest.Since != nil {
+		table, err := dumpTable(ctx, tx, zw, entmigrate.TombstonesTable, manifest.Since)
+		if err != nil {
+			return nil, err
+		}
+
+		log.GetLogCtx(ctx).Info("dumped tombstones", zap.Int64("count", table.Count))
+
+		manifest.Tombstones = &table
+	}
+
+	mw, err := zw.Create(ManifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	enc := json.NewEncoder(mw)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(manifest); err != nil {
+		return nil, fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to close archive: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// dumpTable テーブルの行をNDJSONで書き出す. sinceを指定した場合はそれ以降に更新された行のみ書き出す.
+func dumpTable(
+	ctx context.Context,
+	tx *sql.Tx,
+	zw *zip.Writer,
+	t *entschema.Table,
+	since *time.Time,
+) (Table, error) {
+	cols := columns(t)
+
+	table := Table{
+		Name:    t.Name,
+		File:    t.Name + ".ndjson",
+		Columns: cols,
+	}
+
+	order := make([]string, len(t.PrimaryKey))
+	for i, c := range t.PrimaryKey {
+		order[i] = quote(c.Name)
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", quoteAll(cols), quote(t.Name))
+	args := []any{}
+
+	if since != nil {
+		col, ok := watermarkColumn(t)
+		if !ok {
+			return Table{}, fmt.Errorf("%w: %s", ErrNoWatermark, t.Name)
+		}
+
+		query += fmt.Sprintf(" WHERE %s >= $1", quote(col))
+		args = append(args, since.UTC())
+	}
+
+	query += " ORDER BY " + strings.Join(order, ", ")
+
+	fw, err := zw.Create(table.File)
+	if err != nil {
+		return Table{}, err
+	}
+
+	hash := sha256.New()
+	enc := json.NewEncoder(io.MultiWriter(fw, hash))
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return Table{}, fmt.Errorf("failed to query %s: %w", t.Name, err)
+	}
+	defer rows.Close()
+
+	values := make([]any, len(cols))
+	dest := make([]any, len(cols))
+
+	for i := range values {
+		dest[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return Table{}, fmt.Errorf("failed to scan %s: %w", t.Name, err)
+		}
+
+		row := make(map[string]any, len(cols))
+		for i, c := range cols {
+			row[c] = normalize(values[i])
+		}
+
+		if err := enc.Encode(row); err != nil {
+			return Table{}, fmt.Errorf("failed to write %s: %w", t.Name, err)
+		}
+
+		table.Count++
+	}
+
+	if err := rows.Err(); err != nil {
+		return Table{}, fmt.Errorf("failed to read %s: %w", t.Name, err)
+	}
+
+	table.Checksum = checksum(hash.Sum(nil))
+
+	return table, nil
+}
+
+// normalize ドライバが返す値をJSONで表現できる形に揃える.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.UTC()
+	default:
+		return v
+	}
+}
+
+// schemaVersion 適用済みの最新のマイグレーションのバージョン.
+func schemaVersion(ctx context.Context, conn *sql.Tx) (string, error) {
+	revs, err := migration.NewRevisions(conn).ReadRevisions(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	if len(revs) == 0 {
+		return "", nil
+	}
+
+	return revs[len(revs)-1].Version, nil
+}
+
+func checksum(sum []byte) string {
+	return "sha256:" + hex.EncodeToString(sum)
+}
+
+func quote(ident string) string {
+	return `"` + strings.ReplaceAll(ident, `"`, `""`) + `"`
+}
+
+func quoteAll(idents []string) string {
+	quoted := make([]string, len(idents))
+	for i, ident := range idents {
+		quoted[i] = quote(ident)
+	}
+
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,3 @@
+package backup
+
+const Overlap = overlap
@@ -0,0 +1,330 @@
+package backup
+
+import (
+	"archive/zip"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+// Archive 復元するアーカイブ.
+type Archive struct {
+	Reader io.ReaderAt
+	Size   int64
+}
+
+type opened struct {
+	zr       *zip.Reader
+	manifest *Manifest
+}
+
+// Restore 全体のアーカイブを空のデータベースに復元し、続けて差分のアーカイブを順に適用する.
+// 全てのアーカイブを1つのトランザクションで登録し、件数とチェックサムがマニフェストと一致した場合のみコミットする.
+// 復元先のスキーマはアーカイブと同じバージョンまでマイグレーション済みである必要がある.
+// 最後に適用したアーカイブのマニフェストを返す.
+func Restore(ctx context.Context, db *sql.DB, full Archive, incrementals ...Archive) (*Manifest, error) {
+	chain, err := openChain(full, incrementals)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := restore(ctx, tx, chain); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			log.GetLogCtx(ctx).Warn("failed to rollback transaction", log.ErrorField(rerr))
+		}
+
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return chain[len(chain)-1].manifest, nil
+}
+
+// ReadManifest アーカイブのマニフェストを読み込む.
+func ReadManifest(zr *zip.Reader) (*Manifest, error) {
+	f, err := zr.Open(ManifestFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest: %w", err)
+	}
+	defer f.Close()
+
+	var manifest Manifest
+
+	if err := json.NewDecoder(f).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	switch manifest.Version {
+	case 1:
+		// 差分バックアップを導入する前の形式は全体のアーカイブのみ
+		manifest.Kind = KindFull
+	case FormatVersion:
+	default:
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedFormat, manifest.Version)
+	}
+
+	return &manifest, nil
+}
+
+// openChain アーカイブを開き、差分が直前のアーカイブに連なっていることを確認する.
+func openChain(full Archive, incrementals []Archive) ([]opened, error) {
+	archives := append([]Archive{full}, incrementals...)
+
+	chain := make([]opened, len(archives))
+
+	for i, archive := range archives {
+		zr, err := zip.NewReader(archive.Reader, archive.Size)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open archive: %w", err)
+		}
+
+		manifest, err := ReadManifest(zr)
+		if err != nil {
+			return nil, err
+		}
+
+		chain[i] = opened{zr: zr, manifest: manifest}
+
+		if i == 0 {
+			if manifest.Kind != KindFull {
+				return nil, fmt.Errorf("%w: first archive must be full, got %s", ErrBrokenChain, manifest.Kind)
+			}
+
+			continue
+		}
+
+		prev := chain[i-1].manifest
+
+		if manifest.Kind != KindIncremental || manifest.Parent == "" || manifest.Parent != prev.ID {
+			return nil, fmt.Errorf("%w: %s does not follow %s", ErrBrokenChain, manifest.ID, prev.ID)
+		}
+
+		if manifest.SchemaVersion != prev.SchemaVersion {
+			return nil, fmt.Errorf("%w: %s has %q, %s has %q", ErrSchemaMismatch,
+				manifest.ID, manifest.SchemaVersion, prev.ID, prev.SchemaVersion)
+		}
+	}
+
+	return chain, nil
+}
+
+func restore(ctx context.Context, tx *sql.Tx, chain []opened) error {
+	version, err := schemaVersion(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	full := chain[0]
+
+	if version != full.manifest.SchemaVersion {
+		return fmt.Errorf("%w: archive %q, database %q", ErrSchemaMismatch, full.manifest.SchemaVersion, version)
+	}
+
+	for _, t := range full.manifest.Tables {
+		var exists int
+
+		err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT 1 FROM %s LIMIT 1", quote(t.Name))).Scan(&exists)
+		if err == nil {
+			return fmt.Errorf("%w: %s", ErrNotEmpty, t.Name)
+		}
+
+		if !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to check %s: %w", t.Name, err)
+		}
+	}
+
+	for _, t := range full.manifest.Tables {
+		if err := restoreTable(ctx, tx, full.zr, t, false); err != nil {
+			return err
+		}
+
+		var stored int64
+
+		if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s", quote(t.Name))).Scan(&stored); err != nil {
+			return fmt.Errorf("failed to count %s: %w", t.Name, err)
+		}
+
+		if stored != t.Count {
+			return fmt.Errorf("%w: %s has %d rows, manifest %d", ErrVerification, t.Name, stored, t.Count)
+		}
+
+		log.GetLogCtx(ctx).Info("restored table", zap.String("table", t.Name), zap.Int64("count", t.Count))
+	}
+
+	for _, incr := range chain[1:] {
+		if err := apply(ctx, tx, incr); err != nil {
+			return err
+		}
+
+		log.GetLogCtx(ctx).Info("applied incremental", zap.String("id", incr.manifest.ID))
+	}
+
+	return nil
+}
+
+// apply 差分のアーカイブを適用する.
+// 削除された行を先に削除してから、更新された行を上書きで登録する.
+// 削除した後に同じ値で作り直された行が一意制約に違反しないようにするため.
+func apply(ctx context.Context, tx *sql.Tx, incr opened) error {
+	known := make(map[string]bool, len(incr.manifest.Tables))
+	for _, t := range incr.manifest.Tables {
+		known[t.Name] = true
+	}
+
+	if tomb := incr.manifest.Tombstones; tomb != nil {
+		if err := readRows(incr.zr, *tomb, func(row map[string]any) error {
+			name, _ := row["table_name"].(string)
+			if !known[name] {
+				return fmt.Errorf("%w: %s", ErrUnknownTable, name)
+			}
+
+			query := fmt.Sprintf("DELETE FROM %s WHERE %s = $1", quote(name), quote("id"))
+
+			if _, err := tx.ExecContext(ctx, query, row["row_id"]); err != nil {
+				return fmt.Errorf("failed to delete from %s: %w", name, err)
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range incr.manifest.Tables {
+		if err := restoreTable(ctx, tx, incr.zr, t, true); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreTable テーブルの行をまとめて登録する. upsertの場合は同じ主キーの行を上書きする.
+func restoreTable(ctx context.Context, tx *sql.Tx, zr *zip.Reader, t Table, upsert bool) error {
+	batch := make([]any, 0, batchSize*len(t.Columns))
+
+	if err := readRows(zr, t, func(row map[string]any) error {
+		for _, c := range t.Columns {
+			batch = append(batch, row[c])
+		}
+
+		if len(batch) < cap(batch) {
+			return nil
+		}
+
+		defer func() { batch = batch[:0] }()
+
+		return insert(ctx, tx, t, batch, upsert)
+	}); err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		return insert(ctx, tx, t, batch, upsert)
+	}
+
+	return nil
+}
+
+// readRows アーカイブのファイルを1行ずつ読み込み、件数とチェックサムがマニフェストと一致するか検証する.
+func readRows(zr *zip.Reader, t Table, fn func(row map[string]any) error) error {
+	f, err := zr.Open(t.File)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", t.File, err)
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	tee := io.TeeReader(f, hash)
+
+	dec := json.NewDecoder(tee)
+	dec.UseNumber()
+
+	var count int64
+
+	for dec.More() {
+		var row map[string]any
+
+		if err := dec.Decode(&row); err != nil {
+			return fmt.Errorf("failed to decode %s: %w", t.File, err)
+		}
+
+		if err := fn(row); err != nil {
+			return err
+		}
+
+		count++
+	}
+
+	// デコーダが読み残した末尾もチェックサムに含める
+	if _, err := io.Copy(io.Discard, tee); err != nil {
+		return fmt.Errorf("failed to read %s: %w", t.File, err)
+	}
+
+	if count != t.Count {
+		return fmt.Errorf("%w: %s has %d rows, manifest %d", ErrVerification, t.File, count, t.Count)
+	}
+
+	if sum := checksum(hash.Sum(nil)); sum != t.Checksum {
+		return fmt.Errorf("%w: %s checksum %s, manifest %s", ErrVerification, t.File, sum, t.Checksum)
+	}
+
+	return nil
+}
+
+// insert 複数行をまとめて登録する.
+func insert(ctx context.Context, tx *sql.Tx, t Table, values []any, upsert bool) error {
+	rows := len(values) / len(t.Columns)
+
+	placeholders := make([]string, rows)
+
+	for i := 0; i < rows; i++ {
+		params := make([]string, len(t.Columns))
+		for j := range t.Columns {
+			params[j] = fmt.Sprintf("$%d", i*len(t.Columns)+j+1)
+		}
+
+		placeholders[i] = "(" + strings.Join(params, ", ") + ")"
+	}
+
+	query := fmt.Sprintf(
+		"INSERT INTO %s (%s) VALUES %s",
+		quote(t.Name),
+		quoteAll(t.Columns),
+		strings.Join(placeholders, ", "),
+	)
+
+	if upsert {
+		sets := make([]string, 0, len(t.Columns))
+
+		for _, c := range t.Columns {
+			if c != "id" {
+				sets = append(sets, fmt.Sprintf("%s = excluded.%s", quote(c), quote(c)))
+			}
+		}
+
+		query += fmt.Sprintf(" ON CONFLICT (%s) DO UPDATE SET %s", quote("id"), strings.Join(sets, ", "))
+	}
+
+	if _, err := tx.ExecContext(ctx, query, values...); err != nil {
+		return fmt.Errorf("failed to insert into %s: %w", t.Name, err)
+	}
+
+	return nil
+}
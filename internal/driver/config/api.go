@@ -8,21 +8,36 @@ import (
 )
 
 type APIConfig struct {
-	Port                string
-	APIKey              string
-	JWTSecret           string
-	CookieDomain        string
-	AppCoreURL          string
-	NewRelicAppName     string
-	NewRelicLicense     string
-	CORSAllowOrigins    string
-	CORSDebugEnable     string
-	RedisURL            string
-	FirebaseSecret      string
-	FirebaseAPIEndpoint string
-	FirebaseAPIKey      string
-	ResendAPIKey        string
-	ResendSender        string
+	Port                    string
+	MetricsPort             string
+	APIKey                  string
+	JWTSecret               string
+	SigningKeyEncryptionKey string
+	CookieDomain            string
+	AppCoreURL              string
+	NewRelicAppName         string
+	NewRelicLicense         string
+	CORSAllowOrigins        string
+	CORSDebugEnable         string
+	RedisURL                string
+	CacheBackend            string
+	CacheCodec              string
+	DSN                     string
+	FirebaseSecret          string
+	FirebaseAPIEndpoint     string
+	FirebaseAPIKey          string
+	ResendAPIKey            string
+	ResendSender            string
+	EmailVerificationURL    string
+	EmailVerificationPolicy string
+	AuthProvider            string
+	AuthFallbackEnable      string
+	OIDCProviders           string
+	OIDCCallbackBaseURL     string
+	OIDCRedirectURL         string
+	RateLimitPolicies       string
+	TrustedProxyHops        string
+	TraceExporter           string
 }
 
 func NewAPI() APIConfig {
@@ -32,22 +47,44 @@ func NewAPI() APIConfig {
 		port = "8080"
 	}
 
+	// メトリクスは公開するポートとは別の内部向けのポートで提供する
+	metricsPort := os.Getenv("METRICS_PORT")
+
+	if _, err := strconv.Atoi(metricsPort); err != nil {
+		metricsPort = "9090"
+	}
+
 	conf := APIConfig{
-		Port:                port,
-		APIKey:              os.Getenv("API_KEY"),
-		JWTSecret:           os.Getenv("JWT_SECRET"),
-		CookieDomain:        os.Getenv("COOKIE_DOMAIN"),
-		AppCoreURL:          os.Getenv("APP_CORE_URL"),
-		NewRelicAppName:     os.Getenv("NEWRELIC_APP_NAME"),
-		NewRelicLicense:     os.Getenv("NEWRELIC_LICENSE"),
-		CORSAllowOrigins:    os.Getenv("CORS_ALLOW_ORIGINS"),
-		CORSDebugEnable:     os.Getenv("CORS_DEBUG_ENABLE"),
-		RedisURL:            os.Getenv("REDIS_URL"),
-		FirebaseSecret:      os.Getenv("FIREBASE_SECRET"),
-		FirebaseAPIEndpoint: os.Getenv("FIREBASE_API_ENDPOINT"),
-		FirebaseAPIKey:      os.Getenv("FIREBASE_API_KEY"),
-		ResendAPIKey:        os.Getenv("RESEND_API_KEY"),
-		ResendSender:        os.Getenv("RESEND_SENDER"),
+		Port:                    port,
+		MetricsPort:             metricsPort,
+		APIKey:                  os.Getenv("API_KEY"),
+		JWTSecret:               os.Getenv("JWT_SECRET"),
+		SigningKeyEncryptionKey: os.Getenv("SIGNING_KEY_ENCRYPTION_KEY"),
+		CookieDomain:            os.Getenv("COOKIE_DOMAIN"),
+		AppCoreURL:              os.Getenv("APP_CORE_URL"),
+		NewRelicAppName:         os.Getenv("NEWRELIC_APP_NAME"),
+		NewRelicLicense:         os.Getenv("NEWRELIC_LICENSE"),
+		CORSAllowOrigins:        os.Getenv("CORS_ALLOW_ORIGINS"),
+		CORSDebugEnable:         os.Getenv("CORS_DEBUG_ENABLE"),
+		RedisURL:                os.Getenv("REDIS_URL"),
+		CacheBackend:            os.Getenv("CACHE_BACKEND"),
+		CacheCodec:              os.Getenv("CACHE_CODEC"),
+		DSN:                     os.Getenv("DATABASE_URL"),
+		FirebaseSecret:          os.Getenv("FIREBASE_SECRET"),
+		FirebaseAPIEndpoint:     os.Getenv("FIREBASE_API_ENDPOINT"),
+		FirebaseAPIKey:          os.Getenv("FIREBASE_API_KEY"),
+		ResendAPIKey:            os.Getenv("RESEND_API_KEY"),
+		ResendSender:            os.Getenv("RESEND_SENDER"),
+		EmailVerificationURL:    os.Getenv("EMAIL_VERIFICATION_URL"),
+		EmailVerificationPolicy: os.Getenv("EMAIL_VERIFICATION_POLICY"),
+		AuthProvider:            os.Getenv("AUTH_PROVIDER"),
+		AuthFallbackEnable:      os.Getenv("AUTH_FALLBACK_ENABLE"),
+		OIDCProviders:           os.Getenv("OIDC_PROVIDERS"),
+		OIDCCallbackBaseURL:     os.Getenv("OIDC_CALLBACK_BASE_URL"),
+		OIDCRedirectURL:         os.Getenv("OIDC_REDIRECT_URL"),
+		RateLimitPolicies:       os.Getenv("RATE_LIMIT_POLICIES"),
+		TrustedProxyHops:        os.Getenv("TRUSTED_PROXY_HOPS"),
+		TraceExporter:           os.Getenv("TRACE_EXPORTER"),
 	}
 
 	log.Log().Sugar().Infof("config: %+v", conf)
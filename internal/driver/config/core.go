@@ -3,16 +3,21 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/morning-night-guild/platform-app/pkg/log"
 )
 
 type CoreConfig struct {
-	Port            string
-	DSN             string
-	APIKey          string
-	NewRelicAppName string
-	NewRelicLicense string
+	Port              string
+	MetricsPort       string
+	DSN               string
+	ReplicaDSNs       []string
+	APIKey            string
+	NewRelicAppName   string
+	NewRelicLicense   string
+	RateLimitPolicies string
+	TraceExporter     string
 }
 
 func NewCore() CoreConfig {
@@ -22,15 +27,39 @@ func NewCore() CoreConfig {
 		port = "8080"
 	}
 
+	// メトリクスは公開するポートとは別の内部向けのポートで提供する
+	metricsPort := os.Getenv("METRICS_PORT")
+
+	if _, err := strconv.Atoi(metricsPort); err != nil {
+		metricsPort = "9090"
+	}
+
 	conf := CoreConfig{
-		Port:            port,
-		DSN:             os.Getenv("DATABASE_URL"),
-		APIKey:          os.Getenv("API_KEY"),
-		NewRelicAppName: os.Getenv("NEWRELIC_APP_NAME"),
-		NewRelicLicense: os.Getenv("NEWRELIC_LICENSE"),
+		Port:              port,
+		MetricsPort:       metricsPort,
+		DSN:               os.Getenv("DATABASE_URL"),
+		ReplicaDSNs:       splitDSNs(os.Getenv("DATABASE_REPLICA_URLS")),
+		APIKey:            os.Getenv("API_KEY"),
+		NewRelicAppName:   os.Getenv("NEWRELIC_APP_NAME"),
+		NewRelicLicense:   os.Getenv("NEWRELIC_LICENSE"),
+		RateLimitPolicies: os.Getenv("RATE_LIMIT_POLICIES"),
+		TraceExporter:     os.Getenv("TRACE_EXPORTER"),
 	}
 
 	log.Log().Sugar().Infof("config: %+v", conf)
 
 	return conf
 }
+
+// splitDSNs カンマ区切りのDSNを分割する. 指定がない場合はnilを返す.
+func splitDSNs(value string) []string {
+	var dsns []string
+
+	for _, dsn := range strings.Split(value, ",") {
+		if dsn = strings.TrimSpace(dsn); dsn != "" {
+			dsns = append(dsns, dsn)
+		}
+	}
+
+	return dsns
+}
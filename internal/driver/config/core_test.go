@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/morning-night-guild/platform-app/internal/driver/config"
@@ -17,52 +18,95 @@ func TestNewCore(t *testing.T) {
 		{
 			name: "configを作成できる",
 			args: config.CoreConfig{
-				Port:            "8080",
-				DSN:             "dsn",
-				APIKey:          "api_key",
-				NewRelicAppName: "new_relic_app_name",
-				NewRelicLicense: "new_relic_license",
+				Port:              "8080",
+				MetricsPort:       "9090",
+				DSN:               "dsn",
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
 			},
 			want: config.CoreConfig{
-				Port:            "8080",
-				DSN:             "dsn",
-				APIKey:          "api_key",
-				NewRelicAppName: "new_relic_app_name",
-				NewRelicLicense: "new_relic_license",
+				Port:              "8080",
+				MetricsPort:       "9090",
+				DSN:               "dsn",
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
+			},
+		},
+		{
+			name: "カンマ区切りで複数のレプリカを指定できる",
+			args: config.CoreConfig{
+				Port:              "8080",
+				MetricsPort:       "9090",
+				DSN:               "dsn",
+				ReplicaDSNs:       []string{"replica1", " replica2 ", ""},
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
+			},
+			want: config.CoreConfig{
+				Port:              "8080",
+				MetricsPort:       "9090",
+				DSN:               "dsn",
+				ReplicaDSNs:       []string{"replica1", "replica2"},
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
 			},
 		},
 		{
 			name: "PORTの指定がなくてもconfigを作成できる",
 			args: config.CoreConfig{
-				Port:            "",
-				DSN:             "dsn",
-				APIKey:          "api_key",
-				NewRelicAppName: "new_relic_app_name",
-				NewRelicLicense: "new_relic_license",
+				Port:              "",
+				MetricsPort:       "",
+				DSN:               "dsn",
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
 			},
 			want: config.CoreConfig{
-				Port:            "8080",
-				DSN:             "dsn",
-				APIKey:          "api_key",
-				NewRelicAppName: "new_relic_app_name",
-				NewRelicLicense: "new_relic_license",
+				Port:              "8080",
+				MetricsPort:       "9090",
+				DSN:               "dsn",
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
 			},
 		},
 		{
 			name: "PORTに数値に変換できない文字列が指定されてもconfigを作成できる",
 			args: config.CoreConfig{
-				Port:            "port",
-				DSN:             "dsn",
-				APIKey:          "api_key",
-				NewRelicAppName: "new_relic_app_name",
-				NewRelicLicense: "new_relic_license",
+				Port:              "port",
+				MetricsPort:       "metrics",
+				DSN:               "dsn",
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
 			},
 			want: config.CoreConfig{
-				Port:            "8080",
-				DSN:             "dsn",
-				APIKey:          "api_key",
-				NewRelicAppName: "new_relic_app_name",
-				NewRelicLicense: "new_relic_license",
+				Port:              "8080",
+				MetricsPort:       "9090",
+				DSN:               "dsn",
+				APIKey:            "api_key",
+				NewRelicAppName:   "new_relic_app_name",
+				NewRelicLicense:   "new_relic_license",
+				RateLimitPolicies: `{"default":{"rate":60,"period":"1m"}}`,
+				TraceExporter:     "stdout",
 			},
 		},
 	}
@@ -72,10 +116,14 @@ func TestNewCore(t *testing.T) {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			t.Setenv("PORT", tt.args.Port)
+			t.Setenv("METRICS_PORT", tt.args.MetricsPort)
 			t.Setenv("DATABASE_URL", tt.args.DSN)
+			t.Setenv("DATABASE_REPLICA_URLS", strings.Join(tt.args.ReplicaDSNs, ","))
 			t.Setenv("API_KEY", tt.args.APIKey)
 			t.Setenv("NEWRELIC_APP_NAME", tt.args.NewRelicAppName)
 			t.Setenv("NEWRELIC_LICENSE", tt.args.NewRelicLicense)
+			t.Setenv("RATE_LIMIT_POLICIES", tt.args.RateLimitPolicies)
+			t.Setenv("TRACE_EXPORTER", tt.args.TraceExporter)
 			if got := config.NewCore(); !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("NewCore() = %v, want %v", got, tt.want)
 			}
@@ -3,6 +3,8 @@ package connect
 import (
 	"net/http"
 
+	"github.com/bufbuild/connect-go"
+	otelconnect "github.com/bufbuild/connect-opentelemetry-go"
 	"github.com/morning-night-guild/platform-app/internal/adapter/external"
 	"github.com/morning-night-guild/platform-app/pkg/connect/article/v1/articlev1connect"
 	"github.com/morning-night-guild/platform-app/pkg/connect/health/v1/healthv1connect"
@@ -17,11 +19,16 @@ var (
 
 type Connect struct {
 	client *http.Client
+	opts   []connect.ClientOption
 }
 
 func New() *Connect {
 	return &Connect{
 		client: http.DefaultClient,
+		// クライアントスパンを作成し、traceparentヘッダーでコアサーバーへ伝搬する
+		opts: []connect.ClientOption{
+			connect.WithInterceptors(otelconnect.NewInterceptor(otelconnect.WithoutMetrics())),
+		},
 	}
 }
 
@@ -29,6 +36,7 @@ func (cn *Connect) Article(url string) (*external.Article, error) {
 	return external.NewArticle(articlev1connect.NewArticleServiceClient(
 		cn.client,
 		url,
+		cn.opts...,
 	)), nil
 }
 
@@ -36,6 +44,7 @@ func (cn *Connect) Health(url string) (*external.Health, error) {
 	return external.NewHealth(healthv1connect.NewHealthServiceClient(
 		cn.client,
 		url,
+		cn.opts...,
 	)), nil
 }
 
@@ -43,5 +52,6 @@ func (cn *Connect) User(url string) (*external.User, error) {
 	return external.NewUser(userv1connect.NewUserServiceClient(
 		cn.client,
 		url,
+		cn.opts...,
 	)), nil
 }
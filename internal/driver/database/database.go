@@ -0,0 +1,84 @@
+// Package database はDSNのスキームに応じてRDBのファクトリを選択する.
+package database
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/internal/driver/postgres"
+	"github.com/morning-night-guild/platform-app/internal/driver/sqlite"
+)
+
+// ErrUnsupportedScheme DSNのスキームに対応するデータベースがない.
+var ErrUnsupportedScheme = errors.New("unsupported database scheme")
+
+// schemes DSNのスキームごとの接続先の種類.
+var schemes = map[string]string{
+	"postgres":   dialect.Postgres,
+	"postgresql": dialect.Postgres,
+	"sqlite":     dialect.SQLite,
+	"sqlite3":    dialect.SQLite,
+	"file":       dialect.SQLite,
+}
+
+// factories 接続先の種類ごとのファクトリ.
+var factories = map[string]gateway.RDBFactory{
+	dialect.Postgres: postgres.New(),
+	dialect.SQLite:   sqlite.New(),
+}
+
+var _ gateway.RDBFactory = (*Database)(nil)
+
+type Database struct{}
+
+func New() *Database {
+	return &Database{}
+}
+
+// Of DSNの接続先の種類に対応するファクトリで接続する.
+func (d Database) Of(dsn string) (*gateway.RDB, error) {
+	dlc, err := Dialect(dsn)
+	if err != nil {
+		return &gateway.RDB{}, err
+	}
+
+	return factories[dlc].Of(dsn)
+}
+
+// Dialect DSNから接続先の種類を判定する.
+// libpqのkey=value形式(例: host=localhost dbname=app)などURLのスキームを持たないDSNはPostgreSQLとみなす.
+func Dialect(dsn string) (string, error) {
+	scheme, _, found := strings.Cut(dsn, ":")
+	if !found || !isScheme(scheme) {
+		return dialect.Postgres, nil
+	}
+
+	dlc, ok := schemes[strings.ToLower(scheme)]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedScheme, scheme)
+	}
+
+	return dlc, nil
+}
+
+// isScheme URLのスキームとして妥当かどうか.
+// key=value形式のパスワードなどに含まれる":"をスキームの区切りと誤認しないよう、RFC 3986の構文で判定する.
+func isScheme(value string) bool {
+	if value == "" {
+		return false
+	}
+
+	for i, r := range value {
+		switch {
+		case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+		case i > 0 && ('0' <= r && r <= '9' || r == '+' || r == '-' || r == '.'):
+		default:
+			return false
+		}
+	}
+
+	return true
+}
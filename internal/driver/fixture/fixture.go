@@ -0,0 +1,131 @@
+// Package fixture はYAMLで定義した行や乱数から生成した行をデータベースに登録する.
+// シードコマンドと、結合テスト・E2Eテストのヘルパーで共有する.
+package fixture
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrInvalid フィクスチャの定義が正しくない.
+var ErrInvalid = errors.New("invalid fixture")
+
+// Fixture 登録する行.
+type Fixture struct {
+	Users        []User        `yaml:"users"`
+	Articles     []Article     `yaml:"articles"`
+	UserArticles []UserArticle `yaml:"userArticles"`
+}
+
+// User ユーザー. プロフィールを省略した場合は未設定になる.
+type User struct {
+	ID          uuid.UUID `yaml:"id"`
+	DisplayName string    `yaml:"displayName"`
+	AvatarURL   string    `yaml:"avatarUrl"`
+	Bio         string    `yaml:"bio"`
+	Locale      string    `yaml:"locale"`
+	Timezone    string    `yaml:"timezone"`
+}
+
+// Article 記事. 作成日時を省略した場合は登録時の日時になる.
+// 共有したユーザーを指定する場合は、そのユーザーも登録する必要がある.
+type Article struct {
+	ID          uuid.UUID  `yaml:"id"`
+	URL         string     `yaml:"url"`
+	Title       string     `yaml:"title"`
+	Description string     `yaml:"description"`
+	Thumbnail   string     `yaml:"thumbnail"`
+	Tags        []string   `yaml:"tags"`
+	CreatedAt   time.Time  `yaml:"createdAt"`
+	DeletedAt   *time.Time `yaml:"deletedAt"`
+	SharedBy    *uuid.UUID `yaml:"sharedBy"`
+}
+
+// UserArticle ユーザーと記事の紐付け.
+type UserArticle struct {
+	UserID    uuid.UUID `yaml:"userId"`
+	ArticleID uuid.UUID `yaml:"articleId"`
+}
+
+// Parse YAMLを読み込む. 定義にないキーはタイプミスを見逃さないようエラーにする.
+func Parse(r io.Reader) (*Fixture, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+
+	var f Fixture
+
+	if err := dec.Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalid, err.Error())
+	}
+
+	if err := f.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// ReadFiles 複数のYAMLファイルを読み込み、1つのフィクスチャにまとめる.
+func ReadFiles(paths ...string) (*Fixture, error) {
+	merged := &Fixture{}
+
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		f, err := Parse(file)
+
+		file.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		merged.Merge(f)
+	}
+
+	return merged, nil
+}
+
+// Merge 別のフィクスチャの行を追加する.
+func (f *Fixture) Merge(other *Fixture) {
+	f.Users = append(f.Users, other.Users...)
+	f.Articles = append(f.Articles, other.Articles...)
+	f.UserArticles = append(f.UserArticles, other.UserArticles...)
+}
+
+// Validate IDや必須の値が指定されているかを確認する.
+// 紐付けの参照先は他のファイルで定義される場合があるため、データベースの外部キー制約に委ねる.
+func (f *Fixture) Validate() error {
+	for i, u := range f.Users {
+		if u.ID == uuid.Nil {
+			return fmt.Errorf("%w: users[%d].id is required", ErrInvalid, i)
+		}
+	}
+
+	for i, a := range f.Articles {
+		if a.ID == uuid.Nil {
+			return fmt.Errorf("%w: articles[%d].id is required", ErrInvalid, i)
+		}
+
+		if a.URL == "" || a.Title == "" {
+			return fmt.Errorf("%w: articles[%d].url and title are required", ErrInvalid, i)
+		}
+	}
+
+	for i, ua := range f.UserArticles {
+		if ua.UserID == uuid.Nil || ua.ArticleID == uuid.Nil {
+			return fmt.Errorf("%w: userArticles[%d].userId and articleId are required", ErrInvalid, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,200 @@
+package fixture_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/morning-night-guild/platform-app/internal/driver/fixture"
+	entarticle "github.com/morning-night-guild/platform-app/pkg/ent/article"
+	"github.com/morning-night-guild/platform-app/pkg/ent/enttest"
+	entschema "github.com/morning-night-guild/platform-app/pkg/ent/schema"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr error
+	}{
+		{
+			name: "ユーザー、記事、紐付けを読み込める",
+			yaml: `
+users:
+  - id: 6f1d2c3e-1a4b-4c5d-8e9f-0a1b2c3d4e5f
+articles:
+  - id: 0b7e6a52-3f4d-4a1e-9c8b-7d6e5f4a3b2c
+    url: https://example.com
+    title: title
+userArticles:
+  - userId: 6f1d2c3e-1a4b-4c5d-8e9f-0a1b2c3d4e5f
+    articleId: 0b7e6a52-3f4d-4a1e-9c8b-7d6e5f4a3b2c
+`,
+		},
+		{
+			name: "空のファイルを読み込める",
+			yaml: "",
+		},
+		{
+			name: "定義にないキーはエラーになる",
+			yaml: `
+articles:
+  - id: 0b7e6a52-3f4d-4a1e-9c8b-7d6e5f4a3b2c
+    url: https://example.com
+    titel: title
+`,
+			wantErr: fixture.ErrInvalid,
+		},
+		{
+			name: "IDのない記事はエラーになる",
+			yaml: `
+articles:
+  - url: https://example.com
+    title: title
+`,
+			wantErr: fixture.ErrInvalid,
+		},
+		{
+			name: "UUIDとして解釈できないIDはエラーになる",
+			yaml: `
+users:
+  - id: user
+`,
+			wantErr: fixture.ErrInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := fixture.Parse(strings.NewReader(tt.yaml)); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("同じシードからは同じフィクスチャを生成する", func(t *testing.T) {
+		t.Parallel()
+
+		got := fixture.Generate(1, 5, 30)
+
+		if want := fixture.Generate(1, 5, 30); !reflect.DeepEqual(got, want) {
+			t.Error("Generate() is not deterministic")
+		}
+
+		if other := fixture.Generate(2, 5, 30); reflect.DeepEqual(got, other) {
+			t.Error("Generate() with different seed is same")
+		}
+
+		if len(got.Users) != 5 || len(got.Articles) != 30 {
+			t.Fatalf("Generate() users = %d, articles = %d, want 5, 30", len(got.Users), len(got.Articles))
+		}
+
+		if err := got.Validate(); err != nil {
+			t.Errorf("Validate() error = %v", err)
+		}
+	})
+
+	t.Run("記事がない場合は紐付けを生成しない", func(t *testing.T) {
+		t.Parallel()
+
+		if got := fixture.Generate(1, 3, 0); len(got.UserArticles) != 0 {
+			t.Errorf("Generate() user articles = %d, want 0", len(got.UserArticles))
+		}
+	})
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("YAMLと生成したフィクスチャを繰り返し登録して削除できる", func(t *testing.T) {
+		t.Parallel()
+
+		client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString()))
+
+		defer client.Close()
+
+		ctx := context.Background()
+
+		f, err := fixture.ReadFiles("testdata/fixture.yaml")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// 1回のINSERTに収まらない件数を生成する
+		f.Merge(fixture.Generate(1, 10, 1500))
+
+		for i := 0; i < 2; i++ {
+			if err := fixture.Load(ctx, client, f); err != nil {
+				t.Fatalf("Load() error = %v", err)
+			}
+		}
+
+		tags := 0
+		for _, a := range f.Articles {
+			tags += len(a.Tags)
+		}
+
+		// ゴミ箱に移動した記事は通常の検索では取得されない
+		counts := map[string][2]int{
+			"users":         {client.User.Query().CountX(ctx), len(f.Users)},
+			"articles":      {client.Article.Query().CountX(entschema.SkipSoftDelete(ctx)), len(f.Articles)},
+			"visible":       {client.Article.Query().CountX(ctx), len(f.Articles) - 1},
+			"article_tags":  {client.ArticleTag.Query().CountX(ctx), tags},
+			"user_articles": {client.UserArticle.Query().CountX(ctx), len(f.UserArticles)},
+			"shared":        {client.Article.Query().Where(entarticle.SharedByNotNil()).CountX(ctx), 1},
+		}
+
+		for name, c := range counts {
+			if c[0] != c[1] {
+				t.Errorf("Load() %s = %d, want %d", name, c[0], c[1])
+			}
+		}
+
+		if err := fixture.Unload(ctx, client, f); err != nil {
+			t.Fatalf("Unload() error = %v", err)
+		}
+
+		if n := client.ArticleTag.Query().CountX(ctx) + client.User.Query().CountX(ctx); n != 0 {
+			t.Errorf("Unload() left %d rows", n)
+		}
+	})
+
+	t.Run("参照先のない紐付けを含む場合は何も登録しない", func(t *testing.T) {
+		t.Parallel()
+
+		client := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", uuid.NewString()))
+
+		defer client.Close()
+
+		ctx := context.Background()
+
+		f := fixture.Generate(1, 1, 1)
+
+		f.UserArticles = append(f.UserArticles, fixture.UserArticle{
+			UserID:    f.Users[0].ID,
+			ArticleID: uuid.New(),
+		})
+
+		if err := fixture.Load(ctx, client, f); err == nil {
+			t.Fatal("Load() error = nil")
+		}
+
+		if n := client.User.Query().CountX(ctx); n != 0 {
+			t.Errorf("users = %d, want 0", n)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package fixture
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// topics 記事のタイトルと説明に用いる技術.
+	topics = []string{
+		"Go", "TypeScript", "React", "Next.js", "PostgreSQL", "Redis", "Kubernetes", "Docker",
+		"Terraform", "GitHub Actions", "gRPC", "OpenTelemetry", "Firebase", "Rust", "GraphQL", "AWS",
+	}
+	// titles 記事のタイトルのテンプレート.
+	titles = []string{
+		"%sで始めるクリーンアーキテクチャ",
+		"%sのパフォーマンスを改善した話",
+		"今さら聞けない%sの基本",
+		"%sを本番運用して分かったこと",
+		"%sのテストを書きやすくする工夫",
+		"%s入門: 最初の一歩",
+		"%sで作る社内ツール",
+		"%sのアップデートまとめ",
+	}
+	// descriptions 記事の説明のテンプレート.
+	descriptions = []string{
+		"%sを使ったプロジェクトで得られた知見をまとめました。",
+		"%sの導入手順と、つまずきやすいポイントを紹介します。",
+		"%sについて、実際のコードを交えながら解説します。",
+	}
+	// sites 記事のURLのドメインとパス.
+	sites = []string{
+		"https://zenn.dev/example/articles/",
+		"https://qiita.com/example/items/",
+		"https://dev.to/example/",
+		"https://medium.com/@example/",
+		"https://blog.example.com/posts/",
+	}
+	// tags 記事に付与するタグ.
+	tags = []string{
+		"backend", "frontend", "database", "infrastructure", "testing", "security", "performance", "beginner",
+	}
+	// epoch 生成する記事の作成日時の起点.
+	epoch = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+const (
+	// period 生成する記事の作成日時の範囲.
+	period = 365 * 24 * time.Hour
+	// maxTags 記事に付与するタグの最大数.
+	maxTags = 3
+	// maxShares ユーザーが共有する記事の最大数.
+	maxShares = 20
+)
+
+// Generate 乱数のシードからユーザー、記事、ユーザーと記事の紐付けを生成する.
+// 同じシードと件数からは常に同じフィクスチャを生成する.
+func Generate(seed int64, users int, articles int) *Fixture {
+	rnd := rand.New(rand.NewSource(seed)) //nolint:gosec
+
+	f := &Fixture{
+		Users:    make([]User, users),
+		Articles: make([]Article, articles),
+	}
+
+	for i := range f.Users {
+		f.Users[i] = User{
+			ID:          newID(rnd),
+			DisplayName: fmt.Sprintf("ユーザー%d", i+1),
+		}
+	}
+
+	for i := range f.Articles {
+		id := newID(rnd)
+
+		topic := topics[rnd.Intn(len(topics))]
+
+		f.Articles[i] = Article{
+			ID:          id,
+			URL:         sites[rnd.Intn(len(sites))] + strings.ReplaceAll(id.String(), "-", ""),
+			Title:       fmt.Sprintf(titles[rnd.Intn(len(titles))], topic),
+			Description: fmt.Sprintf(descriptions[rnd.Intn(len(descriptions))], topic),
+			Thumbnail:   fmt.Sprintf("https://picsum.photos/seed/%s/640/360", id.String()[:8]),
+			Tags:        pickTags(rnd),
+			CreatedAt:   epoch.Add(time.Duration(rnd.Int63n(int64(period)))).Truncate(time.Second),
+		}
+	}
+
+	if articles == 0 {
+		return f
+	}
+
+	for _, u := range f.Users {
+		n := rnd.Intn(min(articles, maxShares) + 1)
+
+		for _, j := range rnd.Perm(articles)[:n] {
+			f.UserArticles = append(f.UserArticles, UserArticle{
+				UserID:    u.ID,
+				ArticleID: f.Articles[j].ID,
+			})
+		}
+	}
+
+	return f
+}
+
+// newID 乱数からUUIDを生成する.
+func newID(rnd *rand.Rand) uuid.UUID {
+	id, err := uuid.NewRandomFromReader(rnd)
+	if err != nil {
+		// math/randの読み込みは失敗しない
+		panic(err)
+	}
+
+	return id
+}
+
+// pickTags 重複しないタグを1つ以上選ぶ.
+func pickTags(rnd *rand.Rand) []string {
+	n := rnd.Intn(maxTags) + 1
+
+	picked := make([]string, n)
+
+	for i, j := range rnd.Perm(len(tags))[:n] {
+		picked[i] = tags[j]
+	}
+
+	return picked
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
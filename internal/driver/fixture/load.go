@@ -0,0 +1,159 @@
+package fixture
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	entarticle "github.com/morning-night-guild/platform-app/pkg/ent/article"
+	entuser "github.com/morning-night-guild/platform-app/pkg/ent/user"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+// batchSize 1回のINSERTで登録する行数. PostgreSQLのパラメータ数の上限を超えないようにする.
+const batchSize = 1000
+
+// Load フィクスチャを1つのトランザクションで登録する.
+// 既に登録済みの行は変更せずに読み飛ばすため、同じフィクスチャを繰り返し登録できる.
+func Load(ctx context.Context, client *ent.Client, f *Fixture) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := load(ctx, tx.Client(), f, time.Now().UTC()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			log.GetLogCtx(ctx).Warn("failed to rollback transaction", log.ErrorField(rerr))
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// Unload フィクスチャで登録したユーザーと記事を削除する. タグや紐付けはカスケードで削除される.
+func Unload(ctx context.Context, client *ent.Client, f *Fixture) error {
+	aids := make([]uuid.UUID, len(f.Articles))
+	for i, a := range f.Articles {
+		aids[i] = a.ID
+	}
+
+	uids := make([]uuid.UUID, len(f.Users))
+	for i, u := range f.Users {
+		uids[i] = u.ID
+	}
+
+	if _, err := client.Article.Delete().Where(entarticle.IDIn(aids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete articles: %w", err)
+	}
+
+	if _, err := client.User.Delete().Where(entuser.IDIn(uids...)).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to delete users: %w", err)
+	}
+
+	return nil
+}
+
+func load(ctx context.Context, client *ent.Client, f *Fixture, now time.Time) error {
+	if err := batch(f.Users, func(users []User) error {
+		bulk := make([]*ent.UserCreate, len(users))
+		for i, u := range users {
+			bulk[i] = client.User.Create().
+				SetID(u.ID).
+				SetDisplayName(u.DisplayName).
+				SetAvatarURL(u.AvatarURL).
+				SetBio(u.Bio).
+				SetLocale(u.Locale).
+				SetTimezone(u.Timezone).
+				SetCreatedAt(now).
+				SetUpdatedAt(now)
+		}
+
+		return client.User.CreateBulk(bulk...).OnConflict().DoNothing().Exec(ctx)
+	}); err != nil {
+		return fmt.Errorf("failed to insert users: %w", err)
+	}
+
+	if err := batch(f.Articles, func(articles []Article) error {
+		bulk := make([]*ent.ArticleCreate, len(articles))
+		for i, a := range articles {
+			created := a.CreatedAt
+			if created.IsZero() {
+				created = now
+			}
+
+			bulk[i] = client.Article.Create().
+				SetID(a.ID).
+				SetURL(a.URL).
+				SetTitle(a.Title).
+				SetDescription(a.Description).
+				SetThumbnail(a.Thumbnail).
+				SetCreatedAt(created).
+				SetUpdatedAt(created).
+				SetNillableDeletedAt(a.DeletedAt).
+				SetNillableSharedBy(a.SharedBy)
+		}
+
+		return client.Article.CreateBulk(bulk...).OnConflict().DoNothing().Exec(ctx)
+	}); err != nil {
+		return fmt.Errorf("failed to insert articles: %w", err)
+	}
+
+	tags := make([]*ent.ArticleTagCreate, 0, len(f.Articles))
+
+	for _, a := range f.Articles {
+		for _, tag := range a.Tags {
+			tags = append(tags, client.ArticleTag.Create().
+				SetArticleID(a.ID).
+				SetTag(tag).
+				SetCreatedAt(now).
+				SetUpdatedAt(now))
+		}
+	}
+
+	if err := batch(tags, func(bulk []*ent.ArticleTagCreate) error {
+		return client.ArticleTag.CreateBulk(bulk...).OnConflict().DoNothing().Exec(ctx)
+	}); err != nil {
+		return fmt.Errorf("failed to insert tags: %w", err)
+	}
+
+	if err := batch(f.UserArticles, func(relations []UserArticle) error {
+		bulk := make([]*ent.UserArticleCreate, len(relations))
+		for i, ua := range relations {
+			bulk[i] = client.UserArticle.Create().
+				SetUserID(ua.UserID).
+				SetArticleID(ua.ArticleID).
+				SetCreatedAt(now).
+				SetUpdatedAt(now)
+		}
+
+		return client.UserArticle.CreateBulk(bulk...).OnConflict().DoNothing().Exec(ctx)
+	}); err != nil {
+		return fmt.Errorf("failed to insert user articles: %w", err)
+	}
+
+	return nil
+}
+
+// batch batchSizeごとに分割してfnを呼び出す.
+func batch[T any](items []T, fn func([]T) error) error {
+	for start := 0; start < len(items); start += batchSize {
+		end := start + batchSize
+		if end > len(items) {
+			end = len(items)
+		}
+
+		if err := fn(items[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,10 +5,12 @@ import (
 
 	"github.com/bufbuild/connect-go"
 	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	otelconnect "github.com/bufbuild/connect-opentelemetry-go"
 	"github.com/go-chi/chi/v5"
 	"github.com/morning-night-guild/platform-app/internal/adapter/controller"
 	"github.com/morning-night-guild/platform-app/internal/driver/middleware"
 	"github.com/morning-night-guild/platform-app/internal/driver/newrelic"
+	"github.com/morning-night-guild/platform-app/internal/driver/probe"
 	"github.com/morning-night-guild/platform-app/internal/driver/router"
 	"github.com/morning-night-guild/platform-app/pkg/connect/article/v1/articlev1connect"
 	"github.com/morning-night-guild/platform-app/pkg/connect/health/v1/healthv1connect"
@@ -20,12 +22,21 @@ import (
 
 func NewConnect(
 	interceptor connect.UnaryInterceptorFunc,
+	rateLimit connect.UnaryInterceptorFunc,
 	nr *newrelic.NewRelic,
 	article *controller.Article,
 	user *controller.User,
 	health *controller.Health,
+	checker *probe.Checker,
 ) http.Handler {
-	ic := connect.WithInterceptors(interceptor)
+	// 先に指定したインターセプターが外側となるため、アクセスログにレート制限の結果も含まれる
+	// また、伝搬されたスパンを最も外側で復元し、アクセスログのトレースIDをスパンと揃える
+	// 呼び出し元はAPIサーバーのみであるため、伝搬されたスパンを親として信頼する
+	ic := connect.WithInterceptors(
+		otelconnect.NewInterceptor(otelconnect.WithTrustRemote(), otelconnect.WithoutMetrics()),
+		interceptor,
+		rateLimit,
+	)
 
 	routes := []router.Route{
 		router.NewRoute(articlev1connect.NewArticleServiceHandler(article, ic)),
@@ -41,20 +52,37 @@ func NewConnect(
 
 	mux := router.New(routes...).Mux()
 
-	mux.Handle(grpchealth.NewHandler(grpchealth.NewStaticChecker()))
+	// gRPCのヘルスチェックはサービスごとに依存先の状態を返す
+	// RDBは接続先の種類によって確認名が異なるため、登録されている方のみが確認される
+	mux.Handle(grpchealth.NewHandler(checker.NewGRPC(map[string][]string{
+		articlev1connect.ArticleServiceName: {probe.NamePostgres, probe.NameSQLite},
+		userv1connect.UserServiceName:       {probe.NamePostgres, probe.NameSQLite},
+		healthv1connect.HealthServiceName:   {},
+	})))
+
+	mux.Handle(livezURL, checker.Livez())
+
+	mux.Handle(readyzURL, checker.Readyz())
 
 	return h2c.NewHandler(mux, &http2.Server{})
 }
 
 const (
-	baseURL   = "/api"
-	healthURL = "/health"
+	baseURL    = "/api"
+	healthURL  = "/health"
+	jwksURL    = "/.well-known/jwks.json"
+	metricsURL = "/metrics"
+	livezURL   = "/livez"
+	readyzURL  = "/readyz"
 )
 
 func NewOpenAPI(
 	si openapi.ServerInterface,
 	cors openapi.MiddlewareFunc,
 	middleware *middleware.Middleware,
+	rateLimit *middleware.RateLimit,
+	idempotency *middleware.Idempotency,
+	checker *probe.Checker,
 ) http.Handler {
 	router := chi.NewRouter()
 
@@ -62,9 +90,29 @@ func NewOpenAPI(
 
 	router.Get(healthURL, func(w http.ResponseWriter, r *http.Request) {})
 
+	// プローブはベースURLの外に公開し、アクセスログやレート制限の対象外とする
+	router.Method(http.MethodGet, livezURL, checker.Livez())
+
+	router.Method(http.MethodGet, readyzURL, checker.Readyz())
+
+	// JWKSは検証側が慣例の位置から取得できるよう、ベースURLの外にも公開する
+	router.Get(jwksURL, si.WellKnownJWKS)
+
 	return openapi.HandlerWithOptions(si, openapi.ChiServerOptions{
-		BaseURL:     baseURL,
-		BaseRouter:  router,
-		Middlewares: []openapi.MiddlewareFunc{middleware.Handle},
+		BaseURL:    baseURL,
+		BaseRouter: router,
+		// 後に指定したミドルウェアが外側となるため、レート制限はログやトレースIDを設定した後に行い、
+		// 冪等キーによる再送もレート制限の対象とする
+		Middlewares: []openapi.MiddlewareFunc{idempotency.Handle, rateLimit.Handle, middleware.Handle},
 	})
 }
+
+// NewMetrics 内部向けのポートでメトリクスを提供するハンドラー.
+// メトリクスにはルートごとのリクエスト数などが含まれるため、公開するポートでは提供しない.
+func NewMetrics(metrics http.Handler) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.Handle(metricsURL, metrics)
+
+	return mux
+}
@@ -7,14 +7,20 @@ import (
 
 	"github.com/bufbuild/connect-go"
 	"github.com/morning-night-guild/platform-app/internal/adapter/external"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
 	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
 	"github.com/morning-night-guild/platform-app/pkg/log"
 	"github.com/morning-night-guild/platform-app/pkg/trace"
 	"go.uber.org/zap"
 )
 
+// Recorder リクエストの結果を記録するメトリクス.
+type Recorder interface {
+	Observe(procedure string, code string, elapsed time.Duration)
+}
+
 // New.
-func New() connect.UnaryInterceptorFunc {
+func New(recorder Recorder) connect.UnaryInterceptorFunc {
 	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
 		return connect.UnaryFunc(func(
 			ctx context.Context,
@@ -34,6 +40,14 @@ func New() connect.UnaryInterceptorFunc {
 
 			ctx = user.SetUIDCtx(ctx, userID)
 
+			// リクエスト内で更新した後の読み取りをレプリカではなくライターで行う
+			ctx = gateway.SetReadYourWritesCtx(ctx)
+
+			// 呼び出し元がレプリカの遅延を許容できない場合は読み取りもライターで行う
+			if req.Header().Get(external.HeaderReadFrom) == external.ReadFromPrimary {
+				ctx = gateway.SetReadFromWriterCtx(ctx)
+			}
+
 			logger := log.GetLogCtx(ctx)
 
 			res, err := next(ctx, req)
@@ -52,6 +66,8 @@ func New() connect.UnaryInterceptorFunc {
 				return connect.CodeOf(connectErr).String()
 			}
 
+			recorder.Observe(req.Spec().Procedure, code(err), time.Since(now))
+
 			logger.Info(
 				"access-log",
 				zap.String("uid", uid),
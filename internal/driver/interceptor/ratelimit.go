@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/morning-night-guild/platform-app/internal/adapter/external"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+// NewRateLimit プロシージャとリクエスト元ごとにレート制限を行う.
+// リクエスト元はユーザーIDのヘッダーがある場合はユーザーID、それ以外は接続元のアドレスで識別する.
+func NewRateLimit(
+	limiter cache.Limiter,
+	policies ratelimit.Policies,
+) connect.UnaryInterceptorFunc {
+	interceptor := func(next connect.UnaryFunc) connect.UnaryFunc {
+		return connect.UnaryFunc(func(
+			ctx context.Context,
+			req connect.AnyRequest,
+		) (connect.AnyResponse, error) {
+			procedure := req.Spec().Procedure
+
+			limit := policies.Of(procedure)
+
+			if limit.Unlimited() {
+				return next(ctx, req)
+			}
+
+			res, err := limiter.Allow(ctx, fmt.Sprintf("%s:%s", procedure, principalOf(req)), limit)
+			if err != nil {
+				log.GetLogCtx(ctx).Warn("failed to check rate limit", log.ErrorField(err))
+
+				return next(ctx, req)
+			}
+
+			if !res.Allowed {
+				log.GetLogCtx(ctx).Warn(fmt.Sprintf("rate limit exceeded: %s", procedure))
+
+				cerr := connect.NewError(connect.CodeResourceExhausted, fmt.Errorf("rate limit exceeded: %s", procedure))
+
+				cerr.Meta().Set("Retry-After", strconv.Itoa(int(math.Ceil(res.RetryAfter.Seconds()))))
+
+				return nil, cerr
+			}
+
+			return next(ctx, req)
+		})
+	}
+
+	return connect.UnaryInterceptorFunc(interceptor)
+}
+
+func principalOf(req connect.AnyRequest) string {
+	if uid, err := user.NewID(req.Header().Get(external.HeaderUID)); err == nil {
+		return fmt.Sprintf("user:%s", uid.String())
+	}
+
+	host, _, err := net.SplitHostPort(req.Peer().Addr)
+	if err != nil {
+		host = req.Peer().Addr
+	}
+
+	return fmt.Sprintf("peer:%s", host)
+}
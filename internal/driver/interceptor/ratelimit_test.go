@@ -0,0 +1,115 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	"github.com/morning-night-guild/platform-app/internal/adapter/external"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/driver/interceptor"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
+	healthv1 "github.com/morning-night-guild/platform-app/pkg/connect/health/v1"
+	"github.com/morning-night-guild/platform-app/pkg/connect/health/v1/healthv1connect"
+)
+
+type health struct {
+	healthv1connect.UnimplementedHealthServiceHandler
+}
+
+func (health) Check(
+	context.Context,
+	*connect.Request[healthv1.CheckRequest],
+) (*connect.Response[healthv1.CheckResponse], error) {
+	return connect.NewResponse(&healthv1.CheckResponse{}), nil
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	newClient := func(t *testing.T, policies ratelimit.Policies) healthv1connect.HealthServiceClient {
+		t.Helper()
+		rl := interceptor.NewRateLimit(
+			&kvs.MemoryLimiter{Prefix: "rate_limit", Store: kvs.NewStore()},
+			policies,
+		)
+		mux := http.NewServeMux()
+		mux.Handle(healthv1connect.NewHealthServiceHandler(health{}, connect.WithInterceptors(rl)))
+		srv := httptest.NewServer(mux)
+		t.Cleanup(srv.Close)
+		return healthv1connect.NewHealthServiceClient(srv.Client(), srv.URL)
+	}
+
+	check := func(client healthv1connect.HealthServiceClient, uid string) error {
+		req := connect.NewRequest(&healthv1.CheckRequest{})
+		if uid != "" {
+			req.Header().Set(external.HeaderUID, uid)
+		}
+		_, err := client.Check(context.Background(), req)
+		return err
+	}
+
+	limited := ratelimit.Policies{
+		Default: cache.Limit{Rate: 1, Period: time.Hour, Burst: 2},
+		Routes:  map[string]cache.Limit{},
+	}
+
+	t.Run("上限を超えるとResourceExhaustedとなる", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t, limited)
+		for i := 0; i < 2; i++ {
+			if err := check(client, ""); err != nil {
+				t.Fatalf("#%d error = %v", i, err)
+			}
+		}
+		err := check(client, "")
+		cerr := new(connect.Error)
+		if !errors.As(err, &cerr) || cerr.Code() != connect.CodeResourceExhausted {
+			t.Fatalf("error = %v, want %v", err, connect.CodeResourceExhausted)
+		}
+		if cerr.Meta().Get("Retry-After") == "" {
+			t.Errorf("Retry-After is empty: %v", cerr.Meta())
+		}
+	})
+
+	t.Run("ユーザーごとに上限を管理する", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t, limited)
+		uid := user.GenerateID().String()
+		for i := 0; i < 2; i++ {
+			if err := check(client, uid); err != nil {
+				t.Fatalf("#%d error = %v", i, err)
+			}
+		}
+		if err := check(client, uid); connect.CodeOf(err) != connect.CodeResourceExhausted {
+			t.Errorf("error = %v, want %v", err, connect.CodeResourceExhausted)
+		}
+		if err := check(client, user.GenerateID().String()); err != nil {
+			t.Errorf("other user error = %v", err)
+		}
+		if err := check(client, ""); err != nil {
+			t.Errorf("peer error = %v", err)
+		}
+	})
+
+	t.Run("上限のないプロシージャは制限しない", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(t, ratelimit.Policies{
+			Default: limited.Default,
+			Routes: map[string]cache.Limit{
+				healthv1connect.HealthServiceCheckProcedure: {},
+			},
+		})
+		for i := 0; i < 5; i++ {
+			if err := check(client, ""); err != nil {
+				t.Errorf("#%d error = %v", i, err)
+			}
+		}
+	})
+}
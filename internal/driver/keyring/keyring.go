@@ -0,0 +1,251 @@
+package keyring
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/adapter/handler"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+var _ handler.Keyring = (*Keyring)(nil)
+
+const (
+	// Key 署名鍵の集合を保存するキー.
+	Key = "current"
+	// DefaultInterval 署名鍵の集合を再読み込みする間隔.
+	DefaultInterval = time.Minute
+	// ActivationDelay 新しい鍵を有効化するまでの猶予.
+	// 再読み込みの間隔より長くすることで、未知のkidによる検証失敗を防ぐ.
+	ActivationDelay = 2 * DefaultInterval
+	// EncryptionKeySize 秘密鍵の暗号化に用いる鍵の長さ(AES-256).
+	EncryptionKeySize = 32
+)
+
+// Keyring KVSに保存された署名鍵の集合を定期的に読み込んで提供する.
+// 鍵の発行とローテーションはrotateコマンドが行い、APIは読み込みのみ行う.
+// 秘密鍵はKVSに平文で保存しないよう、AES-GCMで暗号化して保存する.
+type Keyring struct {
+	cache cache.Cache[auth.KeySet]
+	aead  cipher.AEAD
+	mu    sync.RWMutex
+	keys  auth.KeySet
+}
+
+// New Keyringを作成する.
+// encryptionKeyは秘密鍵の暗号化に用いる32バイトの鍵をbase64で表現したもの.
+func New(
+	cache cache.Cache[auth.KeySet],
+	encryptionKey string,
+) (*Keyring, error) {
+	key, err := base64.StdEncoding.DecodeString(encryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode encryption key: %w", err)
+	}
+
+	if len(key) != EncryptionKeySize {
+		msg := fmt.Sprintf("encryption key must be %d bytes: %d", EncryptionKeySize, len(key))
+
+		return nil, errors.NewValidationError(msg)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gcm: %w", err)
+	}
+
+	return &Keyring{
+		cache: cache,
+		aead:  aead,
+	}, nil
+}
+
+func (kr *Keyring) KeySet() auth.KeySet {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	return kr.keys
+}
+
+// Load 署名鍵の集合を読み込む.
+// 未発行の場合は空の集合とし、共通鍵による署名を継続する.
+func (kr *Keyring) Load(ctx context.Context) error {
+	keys, err := kr.get(ctx)
+	if err != nil && !errors.AsNotFoundError(err) {
+		return err
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	kr.keys = keys
+
+	return nil
+}
+
+// Run 署名鍵の集合を定期的に再読み込みする.
+// 読み込みに失敗した場合は直前の集合を使い続ける.
+func (kr *Keyring) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			keys, err := kr.get(ctx)
+			if err != nil {
+				log.GetLogCtx(ctx).Warn("failed to reload key set", log.ErrorField(err))
+
+				continue
+			}
+
+			kr.mu.Lock()
+			kr.keys = keys
+			kr.mu.Unlock()
+		}
+	}
+}
+
+// Rotate 新しい署名鍵を発行して保存する.
+// 全てのインスタンスが再読み込みするまで新しい鍵は有効化しない.
+// 初回も同様で、有効化されるまでは共通鍵による署名を継続する.
+// 退役した鍵は保持期間の間だけ検証用に残す.
+func (kr *Keyring) Rotate(
+	ctx context.Context,
+	alg auth.SigningAlgorithm,
+	retention time.Duration,
+) (auth.KeySet, error) {
+	if err := kr.Load(ctx); err != nil {
+		return auth.KeySet{}, err
+	}
+
+	keys, err := kr.KeySet().Rotate(alg, time.Now(), ActivationDelay, retention)
+	if err != nil {
+		return auth.KeySet{}, err
+	}
+
+	sealed, err := kr.seal(keys)
+	if err != nil {
+		return auth.KeySet{}, err
+	}
+
+	if err := kr.cache.Set(ctx, Key, sealed, 0); err != nil {
+		return auth.KeySet{}, err
+	}
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	kr.keys = keys
+
+	return keys, nil
+}
+
+// get 署名鍵の集合を読み込み、秘密鍵を復号して解析する.
+func (kr *Keyring) get(ctx context.Context) (auth.KeySet, error) {
+	sealed, err := kr.cache.Get(ctx, Key)
+	if err != nil {
+		return auth.KeySet{}, err
+	}
+
+	keys, err := kr.open(sealed)
+	if err != nil {
+		return auth.KeySet{}, err
+	}
+
+	return keys.Parse()
+}
+
+// seal 秘密鍵を暗号化した署名鍵の集合を作成する.
+// 鍵の取り違えを防ぐため、kidを追加データとして認証する.
+func (kr *Keyring) seal(keys auth.KeySet) (auth.KeySet, error) {
+	sealed := make([]auth.SigningKey, 0, len(keys.Keys))
+
+	for _, key := range keys.Keys {
+		nonce := make([]byte, kr.aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return auth.KeySet{}, fmt.Errorf("failed to generate nonce: %w", err)
+		}
+
+		sealed = append(sealed, auth.SigningKey{
+			KID:         key.KID,
+			Algorithm:   key.Algorithm,
+			PrivateKey:  kr.aead.Seal(nonce, nonce, key.PrivateKey, []byte(key.KID)),
+			CreatedAt:   key.CreatedAt,
+			ActivatedAt: key.ActivatedAt,
+			RetiredAt:   key.RetiredAt,
+		})
+	}
+
+	return auth.KeySet{
+		Keys:       sealed,
+		MigratedAt: keys.MigratedAt,
+	}, nil
+}
+
+// open 暗号化された秘密鍵を復号した署名鍵の集合を作成する.
+func (kr *Keyring) open(sealed auth.KeySet) (auth.KeySet, error) {
+	keys := make([]auth.SigningKey, 0, len(sealed.Keys))
+
+	for _, key := range sealed.Keys {
+		size := kr.aead.NonceSize()
+		if len(key.PrivateKey) < size {
+			return auth.KeySet{}, errors.NewValidationError(fmt.Sprintf("private key is not encrypted. kid: %s", key.KID))
+		}
+
+		der, err := kr.aead.Open(nil, key.PrivateKey[:size], key.PrivateKey[size:], []byte(key.KID))
+		if err != nil {
+			return auth.KeySet{}, fmt.Errorf("failed to decrypt private key. kid: %s: %w", key.KID, err)
+		}
+
+		keys = append(keys, auth.SigningKey{
+			KID:         key.KID,
+			Algorithm:   key.Algorithm,
+			PrivateKey:  der,
+			CreatedAt:   key.CreatedAt,
+			ActivatedAt: key.ActivatedAt,
+			RetiredAt:   key.RetiredAt,
+		})
+	}
+
+	return auth.KeySet{
+		Keys:       keys,
+		MigratedAt: sealed.MigratedAt,
+	}, nil
+}
+
+// Static 固定の署名鍵の集合を提供する.
+// 鍵を読み込まない構成で用い、空の集合であれば共通鍵による署名を継続する.
+type Static struct {
+	keys auth.KeySet
+}
+
+var _ handler.Keyring = Static{}
+
+func NewStatic(
+	keys auth.KeySet,
+) Static {
+	return Static{
+		keys: keys,
+	}
+}
+
+func (st Static) KeySet() auth.KeySet {
+	return st.keys
+}
@@ -0,0 +1,249 @@
+package keyring_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/driver/keyring"
+)
+
+var (
+	encryptionKey = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("k"), keyring.EncryptionKeySize))
+	otherKey      = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte("o"), keyring.EncryptionKeySize))
+)
+
+// sealedKeySet 暗号化してKVSに保存された状態の署名鍵の集合を作成する.
+func sealedKeySet(t *testing.T) auth.KeySet {
+	t.Helper()
+
+	var saved auth.KeySet
+
+	kr, err := keyring.New(&cache.CacheMock[auth.KeySet]{
+		T:         t,
+		GetAssert: func(t *testing.T, key string) { t.Helper() },
+		GetErr:    errors.NewNotFoundError("not found"),
+		SetAssert: func(t *testing.T, key string, value auth.KeySet, ttl time.Duration) {
+			t.Helper()
+			saved = value
+		},
+	}, encryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := kr.Rotate(context.Background(), auth.SigningAlgorithmEdDSA, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	return saved
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		encryptionKey string
+		wantErr       bool
+	}{
+		{
+			name:          "32バイトの暗号化鍵でKeyringを作成できる",
+			encryptionKey: encryptionKey,
+			wantErr:       false,
+		},
+		{
+			name:          "暗号化鍵が未設定の場合はKeyringを作成できない",
+			encryptionKey: "",
+			wantErr:       true,
+		},
+		{
+			name:          "暗号化鍵の長さが不正な場合はKeyringを作成できない",
+			encryptionKey: base64.StdEncoding.EncodeToString([]byte("short")),
+			wantErr:       true,
+		},
+		{
+			name:          "暗号化鍵がbase64でない場合はKeyringを作成できない",
+			encryptionKey: "!!!",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := keyring.New(&cache.CacheMock[auth.KeySet]{T: t}, tt.encryptionKey); (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKeyringLoad(t *testing.T) {
+	t.Parallel()
+
+	key, err := auth.GenerateSigningKey(auth.SigningAlgorithmEdDSA, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed := sealedKeySet(t)
+
+	tests := []struct {
+		name          string
+		cache         func(*testing.T) cache.Cache[auth.KeySet]
+		encryptionKey string
+		wantLen       int
+		wantErr       bool
+	}{
+		{
+			name: "暗号化された署名鍵の集合を読み込める",
+			cache: func(t *testing.T) cache.Cache[auth.KeySet] {
+				t.Helper()
+				return &cache.CacheMock[auth.KeySet]{
+					T:     t,
+					Value: sealed,
+					GetAssert: func(t *testing.T, key string) {
+						t.Helper()
+						if key != keyring.Key {
+							t.Errorf("key = %v, want %v", key, keyring.Key)
+						}
+					},
+				}
+			},
+			encryptionKey: encryptionKey,
+			wantLen:       1,
+			wantErr:       false,
+		},
+		{
+			name: "暗号化鍵が異なる場合は読み込めない",
+			cache: func(t *testing.T) cache.Cache[auth.KeySet] {
+				t.Helper()
+				return &cache.CacheMock[auth.KeySet]{
+					T:         t,
+					Value:     sealed,
+					GetAssert: func(t *testing.T, key string) { t.Helper() },
+				}
+			},
+			encryptionKey: otherKey,
+			wantLen:       0,
+			wantErr:       true,
+		},
+		{
+			name: "平文で保存された署名鍵の集合は読み込めない",
+			cache: func(t *testing.T) cache.Cache[auth.KeySet] {
+				t.Helper()
+				return &cache.CacheMock[auth.KeySet]{
+					T:         t,
+					Value:     auth.NewKeySet(key),
+					GetAssert: func(t *testing.T, key string) { t.Helper() },
+				}
+			},
+			encryptionKey: encryptionKey,
+			wantLen:       0,
+			wantErr:       true,
+		},
+		{
+			name: "署名鍵が未発行の場合は空の集合となる",
+			cache: func(t *testing.T) cache.Cache[auth.KeySet] {
+				t.Helper()
+				return &cache.CacheMock[auth.KeySet]{
+					T:         t,
+					GetAssert: func(t *testing.T, key string) { t.Helper() },
+					GetErr:    errors.NewNotFoundError("not found"),
+				}
+			},
+			encryptionKey: encryptionKey,
+			wantLen:       0,
+			wantErr:       false,
+		},
+		{
+			name: "読み込みに失敗した場合はエラーとなる",
+			cache: func(t *testing.T) cache.Cache[auth.KeySet] {
+				t.Helper()
+				return &cache.CacheMock[auth.KeySet]{
+					T:         t,
+					GetAssert: func(t *testing.T, key string) { t.Helper() },
+					GetErr:    fmt.Errorf("error"),
+				}
+			},
+			encryptionKey: encryptionKey,
+			wantLen:       0,
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			kr, err := keyring.New(tt.cache(t), tt.encryptionKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := kr.Load(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("Keyring.Load() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got := len(kr.KeySet().Keys); got != tt.wantLen {
+				t.Errorf("Keyring.KeySet() len = %v, want %v", got, tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestKeyringRotate(t *testing.T) {
+	t.Parallel()
+
+	sealed := sealedKeySet(t)
+
+	var saved auth.KeySet
+
+	kr, err := keyring.New(&cache.CacheMock[auth.KeySet]{
+		T:         t,
+		Value:     sealed,
+		GetAssert: func(t *testing.T, key string) { t.Helper() },
+		SetAssert: func(t *testing.T, key string, value auth.KeySet, ttl time.Duration) {
+			t.Helper()
+			if key != keyring.Key {
+				t.Errorf("key = %v, want %v", key, keyring.Key)
+			}
+			if ttl != 0 {
+				t.Errorf("ttl = %v, want 0", ttl)
+			}
+			saved = value
+		},
+	}, encryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := kr.Rotate(context.Background(), auth.SigningAlgorithmRS256, time.Hour)
+	if err != nil {
+		t.Fatalf("Keyring.Rotate() error = %v", err)
+	}
+
+	if len(saved.Keys) != 2 || len(got.Keys) != 2 {
+		t.Fatalf("Keyring.Rotate() len = %v, want 2", len(saved.Keys))
+	}
+
+	// 秘密鍵は暗号化して保存する
+	for i := range saved.Keys {
+		if bytes.Equal(saved.Keys[i].PrivateKey, got.Keys[i].PrivateKey) {
+			t.Errorf("Keyring.Rotate() saved plaintext private key. kid: %v", saved.Keys[i].KID)
+		}
+	}
+
+	// 新しい鍵は再読み込みの猶予が過ぎるまで有効化されない
+	next := got.Keys[len(got.Keys)-1]
+	if current, _ := kr.KeySet().Current(); current.KID == next.KID {
+		t.Errorf("Keyring.KeySet().Current() = %v, want not %v", current.KID, next.KID)
+	}
+}
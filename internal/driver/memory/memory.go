@@ -0,0 +1,63 @@
+package memory
+
+import (
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+)
+
+var _ kvs.MemoryFactory[any] = (*Memory[any])(nil)
+
+type Memory[T any] struct {
+	codec kvs.Codec
+}
+
+func New[T any]() *Memory[T] {
+	return &Memory[T]{}
+}
+
+// WithCodec 値のエンコード方式を指定する.
+func (mem *Memory[T]) WithCodec(codec kvs.Codec) *Memory[T] {
+	mem.codec = codec
+
+	return mem
+}
+
+func (mem *Memory[T]) Memory(
+	prefix string,
+	store *kvs.Store,
+) (*kvs.Memory[T], error) {
+	return &kvs.Memory[T]{
+		Prefix: prefix,
+		Store:  store,
+		Codec:  mem.codec,
+	}, nil
+}
+
+func NewIndex(
+	prefix string,
+	store *kvs.Store,
+) (*kvs.MemoryIndex, error) {
+	return &kvs.MemoryIndex{
+		Prefix: prefix,
+		Store:  store,
+	}, nil
+}
+
+func NewLimiter(
+	prefix string,
+	store *kvs.Store,
+) (*kvs.MemoryLimiter, error) {
+	return &kvs.MemoryLimiter{
+		Prefix: prefix,
+		Store:  store,
+	}, nil
+}
+
+func NewLock(
+	prefix string,
+	store *kvs.Store,
+) (*kvs.MemoryLock, error) {
+	return &kvs.MemoryLock{
+		Prefix: prefix,
+		Store:  store,
+	}, nil
+}
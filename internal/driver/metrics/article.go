@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ repository.Article = (*Article)(nil)
+
+// Article 記事の保存に合わせて共有された記事を数えるリポジトリ.
+type Article struct {
+	repository.Article
+	shared prometheus.Counter
+}
+
+func NewArticle(reg prometheus.Registerer, repo repository.Article) (*Article, error) {
+	shared := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "articles_shared_total",
+		Help: "Number of articles shared successfully.",
+	})
+
+	if err := reg.Register(shared); err != nil {
+		return nil, fmt.Errorf("failed to register article metrics: %w", err)
+	}
+
+	return &Article{
+		Article: repo,
+		shared:  shared,
+	}, nil
+}
+
+// Save 記事の保存は共有時のみ行われるため、保存に成功した件数を共有数とする.
+func (mtr *Article) Save(ctx context.Context, item model.Article) error {
+	if err := mtr.Article.Save(ctx, item); err != nil {
+		return err
+	}
+
+	mtr.shared.Inc()
+
+	return nil
+}
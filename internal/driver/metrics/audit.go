@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ repository.Audit = (*Audit)(nil)
+
+// Audit 監査ログの記録に合わせて認証イベントを数えるリポジトリ.
+// サインインとその失敗は監査ログのイベント種別と結果から集計する.
+type Audit struct {
+	repository.Audit
+	events *prometheus.CounterVec
+}
+
+func NewAudit(reg prometheus.Registerer, repo repository.Audit) (*Audit, error) {
+	events := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "auth_events_total",
+		Help: "Number of authentication events partitioned by event type and outcome.",
+	}, []string{"event_type", "outcome"})
+
+	if err := reg.Register(events); err != nil {
+		return nil, fmt.Errorf("failed to register audit metrics: %w", err)
+	}
+
+	return &Audit{
+		Audit:  repo,
+		events: events,
+	}, nil
+}
+
+// Save 監査ログの保存に失敗してもイベント自体は発生しているため、保存の前に数える.
+func (mtr *Audit) Save(ctx context.Context, item model.AuditLog) error {
+	mtr.events.WithLabelValues(item.EventType.String(), item.Outcome.String()).Inc()
+
+	return mtr.Audit.Save(ctx, item)
+}
@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"fmt"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var _ cache.Metrics = (*Cache)(nil)
+
+// Cache Prometheusによるキャッシュのメトリクス.
+type Cache struct {
+	requests *prometheus.CounterVec
+}
+
+func NewCache(reg prometheus.Registerer) (*Cache, error) {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "cache_requests_total",
+		Help: "Number of read-through cache lookups partitioned by cache name and result.",
+	}, []string{"cache", "result"})
+
+	if err := reg.Register(requests); err != nil {
+		return nil, fmt.Errorf("failed to register cache metrics: %w", err)
+	}
+
+	return &Cache{
+		requests: requests,
+	}, nil
+}
+
+func (mtr *Cache) Hit(name string) {
+	mtr.requests.WithLabelValues(name, "hit").Inc()
+}
+
+func (mtr *Cache) Miss(name string) {
+	mtr.requests.WithLabelValues(name, "miss").Inc()
+}
@@ -0,0 +1,47 @@
+package metrics
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// HTTP PrometheusによるHTTPリクエストのメトリクス.
+type HTTP struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewHTTP(reg prometheus.Registerer) (*HTTP, error) {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Number of HTTP requests partitioned by route, method and status code.",
+	}, []string{"route", "method", "code"})
+
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Latency of HTTP requests partitioned by route and method.",
+		Buckets: buckets,
+	}, []string{"route", "method"})
+
+	for _, c := range []prometheus.Collector{requests, duration} {
+		if err := reg.Register(c); err != nil {
+			return nil, fmt.Errorf("failed to register http metrics: %w", err)
+		}
+	}
+
+	return &HTTP{
+		requests: requests,
+		duration: duration,
+	}, nil
+}
+
+// Observe リクエストの結果を記録する.
+// routeにはパスではなくルーティングのパターンを渡し、ラベルの種類が増え続けないようにする.
+func (mtr *HTTP) Observe(route string, method string, status int, elapsed time.Duration) {
+	mtr.requests.WithLabelValues(route, method, strconv.Itoa(status)).Inc()
+
+	mtr.duration.WithLabelValues(route, method).Observe(elapsed.Seconds())
+}
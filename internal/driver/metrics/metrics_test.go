@@ -0,0 +1,236 @@
+package metrics_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang/mock/gomock"
+	// postgres driver.
+	_ "github.com/lib/pq"
+	"github.com/morning-night-guild/platform-app/internal/domain/model"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/domain/repository"
+	"github.com/morning-night-guild/platform-app/internal/driver/metrics"
+	"github.com/morning-night-guild/platform-app/internal/driver/middleware"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+)
+
+func TestHTTP(t *testing.T) {
+	t.Parallel()
+
+	reg := metrics.NewRegistry()
+
+	mtr, err := metrics.NewHTTP(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router := chi.NewRouter()
+	router.With(middleware.New(mtr, 0).Handle).Get("/articles/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if chi.URLParam(r, "id") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	router.Method(http.MethodGet, "/metrics", metrics.Handler(reg))
+
+	for _, path := range []string{"/articles/1", "/articles/2", "/articles/missing"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("User-Agent", "test")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	want := `
+# HELP http_requests_total Number of HTTP requests partitioned by route, method and status code.
+# TYPE http_requests_total counter
+http_requests_total{code="200",method="GET",route="/articles/{id}"} 2
+http_requests_total{code="404",method="GET",route="/articles/{id}"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "http_requests_total"); err != nil {
+		t.Error(err)
+	}
+
+	if got := testutil.CollectAndCount(reg, "http_request_duration_seconds"); got != 1 {
+		t.Errorf("http_request_duration_seconds series = %d, want 1", got)
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if !strings.Contains(rec.Body.String(), "go_goroutines") {
+		t.Errorf("metrics endpoint does not expose runtime metrics")
+	}
+}
+
+func TestRPC(t *testing.T) {
+	t.Parallel()
+
+	reg := metrics.NewRegistry()
+
+	mtr, err := metrics.NewRPC(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mtr.Observe("/article.v1.ArticleService/List", "ok", 0)
+	mtr.Observe("/article.v1.ArticleService/List", "invalid_argument", 0)
+
+	want := `
+# HELP rpc_requests_total Number of RPC requests partitioned by procedure and connect code.
+# TYPE rpc_requests_total counter
+rpc_requests_total{code="invalid_argument",procedure="/article.v1.ArticleService/List"} 1
+rpc_requests_total{code="ok",procedure="/article.v1.ArticleService/List"} 1
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "rpc_requests_total"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAudit(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		item model.AuditLog
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "サインインの成功を数えられる",
+			args: args{
+				item: model.AuditLog{EventType: audit.EventSignIn, Outcome: audit.OutcomeSuccess},
+			},
+			want: `auth_events_total{event_type="signin",outcome="success"} 1`,
+		},
+		{
+			name: "監査ログの保存に失敗してもサインインの失敗を数えられる",
+			err:  errors.New("failed to save"),
+			args: args{
+				item: model.AuditLog{EventType: audit.EventSignIn, Outcome: audit.OutcomeFailure},
+			},
+			want:    `auth_events_total{event_type="signin",outcome="failure"} 1`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := repository.NewMockAudit(ctrl)
+			repo.EXPECT().Save(gomock.Any(), tt.args.item).Return(tt.err)
+
+			reg := metrics.NewRegistry()
+
+			mtr, err := metrics.NewAudit(reg, repo)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := mtr.Save(context.Background(), tt.args.item); (err != nil) != tt.wantErr {
+				t.Errorf("Save() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			want := "# HELP auth_events_total Number of authentication events partitioned by event type and outcome.\n" +
+				"# TYPE auth_events_total counter\n" + tt.want + "\n"
+			if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "auth_events_total"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestArticle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		errs []error
+		want string
+	}{
+		{
+			name: "保存に成功した記事を共有数として数えられる",
+			errs: []error{nil, nil},
+			want: "2",
+		},
+		{
+			name: "保存に失敗した記事は数えない",
+			errs: []error{nil, errors.New("failed to save")},
+			want: "1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			repo := repository.NewMockArticle(ctrl)
+
+			reg := metrics.NewRegistry()
+
+			mtr, err := metrics.NewArticle(reg, repo)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, err := range tt.errs {
+				repo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(err)
+				_ = mtr.Save(context.Background(), model.Article{})
+			}
+
+			want := "# HELP articles_shared_total Number of articles shared successfully.\n" +
+				"# TYPE articles_shared_total counter\n" + "articles_shared_total " + tt.want + "\n"
+			if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "articles_shared_total"); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestRegisterPool(t *testing.T) {
+	t.Parallel()
+
+	reg := metrics.NewRegistry()
+
+	client := redis.NewUniversalClient(&redis.UniversalOptions{Addrs: []string{"localhost:0"}})
+	defer client.Close()
+
+	if err := metrics.RegisterRedis(reg, client); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("postgres", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if err := metrics.RegisterDB(reg, db, "postgres"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"redis_pool_total_connections", "go_sql_open_connections"} {
+		if got := testutil.CollectAndCount(reg, name); got != 1 {
+			t.Errorf("%s series = %d, want 1", name, got)
+		}
+	}
+
+	if err := metrics.RegisterRedis(reg, client); err == nil {
+		t.Errorf("RegisterRedis() registered twice without error")
+	}
+}
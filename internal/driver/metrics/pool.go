@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/redis/go-redis/v9"
+)
+
+// RegisterDB database/sqlのコネクションプールの統計を登録する.
+func RegisterDB(reg prometheus.Registerer, db *sql.DB, name string) error {
+	if err := reg.Register(collectors.NewDBStatsCollector(db, name)); err != nil {
+		return fmt.Errorf("failed to register db metrics: %w", err)
+	}
+
+	return nil
+}
+
+// RegisterRedis Redisのコネクションプールの統計を登録する.
+func RegisterRedis(reg prometheus.Registerer, client redis.UniversalClient) error {
+	if err := reg.Register(newRedisCollector(client)); err != nil {
+		return fmt.Errorf("failed to register redis metrics: %w", err)
+	}
+
+	return nil
+}
+
+var _ prometheus.Collector = (*redisCollector)(nil)
+
+// redisCollector 収集の都度PoolStatsを取得するコレクター.
+type redisCollector struct {
+	client     redis.UniversalClient
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	timeouts   *prometheus.Desc
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+}
+
+func newRedisCollector(client redis.UniversalClient) *redisCollector {
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc("redis_pool_"+name, help, nil, nil)
+	}
+
+	return &redisCollector{
+		client:     client,
+		hits:       desc("hits_total", "Number of times a free connection was found in the pool."),
+		misses:     desc("misses_total", "Number of times a free connection was not found in the pool."),
+		timeouts:   desc("timeouts_total", "Number of times a wait timeout occurred."),
+		totalConns: desc("total_connections", "Number of total connections in the pool."),
+		idleConns:  desc("idle_connections", "Number of idle connections in the pool."),
+		staleConns: desc("stale_connections_total", "Number of stale connections removed from the pool."),
+	}
+}
+
+func (rc *redisCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- rc.hits
+	ch <- rc.misses
+	ch <- rc.timeouts
+	ch <- rc.totalConns
+	ch <- rc.idleConns
+	ch <- rc.staleConns
+}
+
+func (rc *redisCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := rc.client.PoolStats()
+
+	ch <- prometheus.MustNewConstMetric(rc.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(rc.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(rc.timeouts, prometheus.CounterValue, float64(stats.Timeouts))
+	ch <- prometheus.MustNewConstMetric(rc.totalConns, prometheus.GaugeValue, float64(stats.TotalConns))
+	ch <- prometheus.MustNewConstMetric(rc.idleConns, prometheus.GaugeValue, float64(stats.IdleConns))
+	ch <- prometheus.MustNewConstMetric(rc.staleConns, prometheus.CounterValue, float64(stats.StaleConns))
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// RPC PrometheusによるConnectのリクエストのメトリクス.
+type RPC struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewRPC(reg prometheus.Registerer) (*RPC, error) {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "rpc_requests_total",
+		Help: "Number of RPC requests partitioned by procedure and connect code.",
+	}, []string{"procedure", "code"})
+
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "rpc_request_duration_seconds",
+		Help:    "Latency of RPC requests partitioned by procedure.",
+		Buckets: buckets,
+	}, []string{"procedure"})
+
+	for _, c := range []prometheus.Collector{requests, duration} {
+		if err := reg.Register(c); err != nil {
+			return nil, fmt.Errorf("failed to register rpc metrics: %w", err)
+		}
+	}
+
+	return &RPC{
+		requests: requests,
+		duration: duration,
+	}, nil
+}
+
+// Observe リクエストの結果を記録する.
+func (mtr *RPC) Observe(procedure string, code string, elapsed time.Duration) {
+	mtr.requests.WithLabelValues(procedure, code).Inc()
+
+	mtr.duration.WithLabelValues(procedure).Observe(elapsed.Seconds())
+}
@@ -0,0 +1,278 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+const (
+	headerIdempotencyKey      = "Idempotency-Key"
+	headerIdempotencyReplayed = "Idempotent-Replayed"
+	// IdempotencyTTL 記録したレスポンスを保持する期間.
+	IdempotencyTTL = 24 * time.Hour
+	// idempotencyLockTTL 同じ冪等キーのリクエストを処理中とみなす期間.
+	idempotencyLockTTL = time.Minute
+	// idempotencyPersistTimeout レスポンスの記録とロックの解放にかける時間の上限.
+	idempotencyPersistTimeout = 5 * time.Second
+	// maxIdempotencyKeyLength 冪等キーの最大長.
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotencyRecord 冪等キーに対応するリクエストの指紋と記録したレスポンス.
+type IdempotencyRecord struct {
+	Fingerprint string      `json:"fingerprint"`
+	StatusCode  int         `json:"statusCode"`
+	Header      http.Header `json:"header"`
+	Body        []byte      `json:"body"`
+}
+
+// Idempotency Idempotency-Keyヘッダーによりリクエストを冪等にするミドルウェア.
+// 同じキーのリクエストには記録したレスポンスを返し、異なるリクエストボディで再利用された場合は422とする.
+// 処理中の同じキーのリクエストは409とする.
+type Idempotency struct {
+	records  cache.Cache[IdempotencyRecord]
+	lock     cache.Lock
+	routes   map[string]struct{}
+	key      string
+	identify Identify
+	hops     int
+}
+
+// NewIdempotency.
+// routesには"METHOD パターン"(例: POST /api/v1/articles)の形式で冪等にするルートを指定する.
+func NewIdempotency(
+	records cache.Cache[IdempotencyRecord],
+	lock cache.Lock,
+	routes []string,
+	key string,
+	identify Identify,
+	hops int,
+) *Idempotency {
+	rts := make(map[string]struct{}, len(routes))
+
+	for _, route := range routes {
+		rts[route] = struct{}{}
+	}
+
+	return &Idempotency{
+		records:  records,
+		lock:     lock,
+		routes:   rts,
+		key:      key,
+		identify: identify,
+		hops:     hops,
+	}
+}
+
+// Handle.
+func (idm *Idempotency) Handle(next http.Handler) http.Handler { //nolint:funlen
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		route := routeOf(r)
+
+		idk := r.Header.Get(headerIdempotencyKey)
+
+		if _, ok := idm.routes[route]; !ok || idk == "" {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if len(idk) > maxIdempotencyKeyLength {
+			log.GetLogCtx(ctx).Warn("idempotency key is too long")
+
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			log.GetLogCtx(ctx).Warn("failed to read request body", log.ErrorField(err))
+
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		fingerprint := fingerprintOf(r, body)
+
+		// 異なるクライアントが同じキーを用いても衝突しないよう、リクエスト元とルートで区別する
+		// 冪等キーは任意の文字列のため末尾に置き、リクエスト元ごとに記録がまとまるよう先頭をリクエスト元とする
+		key := fmt.Sprintf("%s:%s:%s", principalOf(r, idm.key, idm.identify, idm.hops), route, idk)
+
+		if idm.replay(w, r, key, fingerprint) {
+			return
+		}
+
+		// 有効期限の経過後に他のリクエストが取得したロックを解放しないよう、リクエストごとのトークンで所有者を区別する
+		token := uuid.NewString()
+
+		ok, err := idm.lock.TryLock(ctx, key, token, idempotencyLockTTL)
+		if err != nil {
+			// 保存先の障害でサービスを止めないよう、ロックを取得できない場合は冪等性を保証せずに処理する
+			log.GetLogCtx(ctx).Warn("failed to lock idempotency key", log.ErrorField(err))
+
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		if !ok {
+			log.GetLogCtx(ctx).Warn("idempotency key is in use")
+
+			w.WriteHeader(http.StatusConflict)
+
+			return
+		}
+
+		defer func() {
+			uctx, cancel := persistContext()
+			defer cancel()
+
+			if err := idm.lock.Unlock(uctx, key, token); err != nil {
+				log.GetLogCtx(ctx).Warn("failed to unlock idempotency key", log.ErrorField(err))
+			}
+		}()
+
+		// ロックの取得までに先行するリクエストが完了している場合がある
+		if idm.replay(w, r, key, fingerprint) {
+			return
+		}
+
+		rec := newRecorder(w)
+
+		next.ServeHTTP(rec, r)
+
+		if !rec.wrote {
+			rec.record.Header = recordedHeader(rec.Header())
+		}
+
+		// サーバーエラーは再試行で成功する可能性があるため記録しない
+		if rec.record.StatusCode >= http.StatusInternalServerError {
+			return
+		}
+
+		rec.record.Fingerprint = fingerprint
+
+		pctx, cancel := persistContext()
+		defer cancel()
+
+		if err := idm.records.Set(pctx, key, rec.record, IdempotencyTTL); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to save idempotency record", log.ErrorField(err))
+		}
+	})
+}
+
+// persistContext レスポンスの記録とロックの解放に用いるコンテキストを作成する.
+// クライアントが切断しても完了させるためリクエストのコンテキストから切り離し、
+// ハンドラーの処理時間に左右されないよう処理の直前に作成する.
+func persistContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), idempotencyPersistTimeout)
+}
+
+// replay 記録したレスポンスがある場合は返す.
+func (idm *Idempotency) replay(w http.ResponseWriter, r *http.Request, key string, fingerprint string) bool {
+	record, err := idm.records.Get(r.Context(), key)
+	if err != nil {
+		return false
+	}
+
+	if record.Fingerprint != fingerprint {
+		log.GetLogCtx(r.Context()).Warn("idempotency key is reused with a different request")
+
+		w.WriteHeader(http.StatusUnprocessableEntity)
+
+		return true
+	}
+
+	for name, values := range record.Header {
+		w.Header()[name] = values
+	}
+
+	w.Header().Set(headerIdempotencyReplayed, "true")
+
+	w.WriteHeader(record.StatusCode)
+
+	if _, err := w.Write(record.Body); err != nil {
+		log.GetLogCtx(r.Context()).Warn("failed to write replayed response", log.ErrorField(err))
+	}
+
+	return true
+}
+
+func fingerprintOf(r *http.Request, body []byte) string {
+	hash := sha256.New()
+
+	hash.Write([]byte(r.Method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(r.URL.RequestURI()))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// recorder レスポンスを書き込みつつ記録する.
+type recorder struct {
+	http.ResponseWriter
+	record IdempotencyRecord
+	wrote  bool
+}
+
+func newRecorder(w http.ResponseWriter) *recorder {
+	return &recorder{
+		ResponseWriter: w,
+		record: IdempotencyRecord{
+			StatusCode: http.StatusOK,
+		},
+	}
+}
+
+func (rec *recorder) WriteHeader(code int) {
+	if !rec.wrote {
+		rec.wrote = true
+		rec.record.StatusCode = code
+		rec.record.Header = recordedHeader(rec.Header())
+	}
+
+	rec.ResponseWriter.WriteHeader(code)
+}
+
+func (rec *recorder) Write(b []byte) (int, error) {
+	if !rec.wrote {
+		rec.WriteHeader(http.StatusOK)
+	}
+
+	rec.record.Body = append(rec.record.Body, b...)
+
+	return rec.ResponseWriter.Write(b)
+}
+
+// recordedHeader 再送時に返すヘッダーを取得する.
+// レート制限のヘッダーは再送時点の値を返すため記録しない.
+func recordedHeader(header http.Header) http.Header {
+	res := header.Clone()
+
+	for name := range res {
+		if strings.HasPrefix(name, "Ratelimit-") || name == headerRetryAfter {
+			delete(res, name)
+		}
+	}
+
+	return res
+}
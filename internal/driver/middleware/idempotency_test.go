@@ -0,0 +1,320 @@
+package middleware_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/driver/middleware"
+)
+
+func TestIdempotency(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	type server struct {
+		router http.Handler
+		calls  *int32
+	}
+
+	newServer := func(status int) server {
+		store := kvs.NewStore()
+		idm := middleware.NewIdempotency(
+			&kvs.Memory[middleware.IdempotencyRecord]{Prefix: "idempotency", Store: store},
+			&kvs.MemoryLock{Prefix: "idempotency_lock", Store: store},
+			[]string{"POST /articles"},
+			"key",
+			nil,
+			0,
+		)
+		var calls int32
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&calls, 1)
+			body, _ := io.ReadAll(r.Body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"call":` + string(rune('0'+n)) + `,"body":` + string(body) + `}`))
+		}
+		router := chi.NewRouter()
+		router.With(idm.Handle).Post("/articles", handler)
+		router.With(idm.Handle).Post("/other", handler)
+		return server{router: router, calls: &calls}
+	}
+
+	post := func(srv server, path string, key string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		rec := httptest.NewRecorder()
+		srv.router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("同じキーの再送には記録したレスポンスを返す", func(t *testing.T) {
+		t.Parallel()
+		srv := newServer(http.StatusOK)
+		first := post(srv, "/articles", "k1", `{"url":"a"}`)
+		second := post(srv, "/articles", "k1", `{"url":"a"}`)
+		if atomic.LoadInt32(srv.calls) != 1 {
+			t.Errorf("calls = %d, want 1", atomic.LoadInt32(srv.calls))
+		}
+		if second.Code != first.Code || second.Body.String() != first.Body.String() {
+			t.Errorf("replayed = %d %s, want %d %s", second.Code, second.Body, first.Code, first.Body)
+		}
+		if second.Header().Get("Content-Type") != "application/json" || second.Header().Get("Idempotent-Replayed") != "true" {
+			t.Errorf("replayed header = %v", second.Header())
+		}
+	})
+
+	t.Run("同じキーを異なるリクエストボディで再利用すると422となる", func(t *testing.T) {
+		t.Parallel()
+		srv := newServer(http.StatusOK)
+		post(srv, "/articles", "k1", `{"url":"a"}`)
+		if rec := post(srv, "/articles", "k1", `{"url":"b"}`); rec.Code != http.StatusUnprocessableEntity {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusUnprocessableEntity)
+		}
+	})
+
+	t.Run("キーが異なる場合はそれぞれ処理する", func(t *testing.T) {
+		t.Parallel()
+		srv := newServer(http.StatusOK)
+		post(srv, "/articles", "k1", `{"url":"a"}`)
+		post(srv, "/articles", "k2", `{"url":"a"}`)
+		if atomic.LoadInt32(srv.calls) != 2 {
+			t.Errorf("calls = %d, want 2", atomic.LoadInt32(srv.calls))
+		}
+	})
+
+	t.Run("キーの指定がない場合や対象外のルートは記録しない", func(t *testing.T) {
+		t.Parallel()
+		srv := newServer(http.StatusOK)
+		post(srv, "/articles", "", `{"url":"a"}`)
+		post(srv, "/articles", "", `{"url":"a"}`)
+		post(srv, "/other", "k1", `{"url":"a"}`)
+		post(srv, "/other", "k1", `{"url":"a"}`)
+		if atomic.LoadInt32(srv.calls) != 4 {
+			t.Errorf("calls = %d, want 4", atomic.LoadInt32(srv.calls))
+		}
+	})
+
+	t.Run("サーバーエラーは記録せず再送で再び処理する", func(t *testing.T) {
+		t.Parallel()
+		srv := newServer(http.StatusInternalServerError)
+		post(srv, "/articles", "k1", `{"url":"a"}`)
+		post(srv, "/articles", "k1", `{"url":"a"}`)
+		if atomic.LoadInt32(srv.calls) != 2 {
+			t.Errorf("calls = %d, want 2", atomic.LoadInt32(srv.calls))
+		}
+	})
+
+	t.Run("長すぎるキーは400となる", func(t *testing.T) {
+		t.Parallel()
+		srv := newServer(http.StatusOK)
+		if rec := post(srv, "/articles", strings.Repeat("k", 256), `{}`); rec.Code != http.StatusBadRequest {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusBadRequest)
+		}
+	})
+}
+
+func TestIdempotencyInFlight(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		path string
+		key  string
+	}
+
+	tests := []struct {
+		name   string
+		first  request
+		second request
+		want   int
+	}{
+		{
+			name:   "処理中の同じキーのリクエストは409となる",
+			first:  request{path: "/articles", key: "k1"},
+			second: request{path: "/articles", key: "k1"},
+			want:   http.StatusConflict,
+		},
+		{
+			name:   "処理中でもキーが異なるリクエストは処理する",
+			first:  request{path: "/articles", key: "k1"},
+			second: request{path: "/articles", key: "k2"},
+			want:   http.StatusOK,
+		},
+		{
+			name:   "処理中でもキーの指定がないリクエストは処理する",
+			first:  request{path: "/articles", key: "k1"},
+			second: request{path: "/articles", key: ""},
+			want:   http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			store := kvs.NewStore()
+			idm := middleware.NewIdempotency(
+				&kvs.Memory[middleware.IdempotencyRecord]{Prefix: "idempotency", Store: store},
+				&kvs.MemoryLock{Prefix: "idempotency_lock", Store: store},
+				[]string{"POST /articles"},
+				"key",
+				nil,
+				0,
+			)
+			// 1件目のリクエストを処理中のまま止め、その間に2件目のリクエストを送る
+			entered := make(chan struct{})
+			release := make(chan struct{})
+			var calls int32
+			router := chi.NewRouter()
+			router.With(idm.Handle).Post("/articles", func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					close(entered)
+					<-release
+				}
+				w.WriteHeader(http.StatusOK)
+			})
+			post := func(req request) int {
+				r := httptest.NewRequest(http.MethodPost, req.path, strings.NewReader(`{}`))
+				if req.key != "" {
+					r.Header.Set("Idempotency-Key", req.key)
+				}
+				rec := httptest.NewRecorder()
+				router.ServeHTTP(rec, r)
+				return rec.Code
+			}
+			done := make(chan int)
+			go func() {
+				done <- post(tt.first)
+			}()
+			<-entered
+			if got := post(tt.second); got != tt.want {
+				t.Errorf("status = %d, want %d", got, tt.want)
+			}
+			close(release)
+			if got := <-done; got != http.StatusOK {
+				t.Errorf("first status = %d, want %d", got, http.StatusOK)
+			}
+		})
+	}
+}
+
+// detachedRecords リクエストのコンテキストで記録するとエラーとなる保存先.
+type detachedRecords struct {
+	cache.Cache[middleware.IdempotencyRecord]
+}
+
+func (rcs detachedRecords) Set(ctx context.Context, key string, value middleware.IdempotencyRecord, ttl time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return rcs.Cache.Set(ctx, key, value, ttl)
+}
+
+// detachedLock リクエストのコンテキストで解放するとエラーとなるロック.
+type detachedLock struct {
+	cache.Lock
+	unlocked int32
+}
+
+func (lck *detachedLock) Unlock(ctx context.Context, key string, token string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	atomic.AddInt32(&lck.unlocked, 1)
+
+	return lck.Lock.Unlock(ctx, key, token)
+}
+
+func TestIdempotencyClientDisconnect(t *testing.T) {
+	t.Parallel()
+
+	store := kvs.NewStore()
+	lock := &detachedLock{Lock: &kvs.MemoryLock{Prefix: "idempotency_lock", Store: store}}
+	idm := middleware.NewIdempotency(
+		detachedRecords{Cache: &kvs.Memory[middleware.IdempotencyRecord]{Prefix: "idempotency", Store: store}},
+		lock,
+		[]string{"POST /articles"},
+		"key",
+		nil,
+		0,
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	handler := idm.Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		// レスポンスを返す前にクライアントが切断した状況を再現する
+		cancel()
+		w.WriteHeader(http.StatusCreated)
+	}))
+	post := func(ctx context.Context) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/articles", strings.NewReader(`{}`)).WithContext(ctx)
+		r.Header.Set("Idempotency-Key", "k1")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, r)
+		return rec
+	}
+	post(ctx)
+	if atomic.LoadInt32(&lock.unlocked) != 1 {
+		t.Errorf("unlocked = %d, want 1", atomic.LoadInt32(&lock.unlocked))
+	}
+	rec := post(context.Background())
+	if rec.Code != http.StatusCreated || rec.Header().Get("Idempotent-Replayed") != "true" {
+		t.Errorf("status = %d, header = %v, want replayed %d", rec.Code, rec.Header(), http.StatusCreated)
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("calls = %d, want 1", atomic.LoadInt32(&calls))
+	}
+}
+
+// keyRecords 記録したキーを保持する保存先.
+type keyRecords struct {
+	cache.Cache[middleware.IdempotencyRecord]
+	keys chan string
+}
+
+func (rcs keyRecords) Set(ctx context.Context, key string, value middleware.IdempotencyRecord, ttl time.Duration) error {
+	rcs.keys <- key
+
+	return rcs.Cache.Set(ctx, key, value, ttl)
+}
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	store := kvs.NewStore()
+	records := keyRecords{
+		Cache: &kvs.Memory[middleware.IdempotencyRecord]{Prefix: "idempotency", Store: store},
+		keys:  make(chan string, 1),
+	}
+	idm := middleware.NewIdempotency(
+		records,
+		&kvs.MemoryLock{Prefix: "idempotency_lock", Store: store},
+		[]string{"POST /articles"},
+		"key",
+		nil,
+		0,
+	)
+	handler := idm.Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	r := httptest.NewRequest(http.MethodPost, "/articles", strings.NewReader(`{}`))
+	r.RemoteAddr = "192.0.2.1:1234"
+	r.Header.Set("Idempotency-Key", "k1")
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	// リクエスト元を先頭とし、任意の文字列である冪等キーを末尾とする
+	if got, want := <-records.keys, "ip:192.0.2.1:POST /articles:k1"; got != want {
+		t.Errorf("key = %s, want %s", got, want)
+	}
+}
@@ -1,21 +1,45 @@
 package middleware
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/go-chi/chi/v5"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
 	"github.com/morning-night-guild/platform-app/pkg/log"
 	"github.com/morning-night-guild/platform-app/pkg/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
+const tracerName = "github.com/morning-night-guild/platform-app/internal/driver/middleware"
+
+// Recorder リクエストの結果を記録するメトリクス.
+type Recorder interface {
+	Observe(route string, method string, status int, elapsed time.Duration)
+}
+
 // Middleware.
-type Middleware struct{}
+type Middleware struct {
+	recorder Recorder
+	hops     int
+}
 
 // New.
-func New() *Middleware {
-	return &Middleware{}
+// hopsはAPIサーバーの前段にある信頼できるプロキシの段数で、監査ログに記録するクライアントのアドレスの取得に用いる.
+func New(recorder Recorder, hops int) *Middleware {
+	return &Middleware{
+		recorder: recorder,
+		hops:     hops,
+	}
 }
 
 // Handle.
@@ -23,16 +47,39 @@ func (middle *Middleware) Handle(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		now := time.Now()
 
-		ctx := trace.WithTIDCtx(r.Context())
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+		ctx, span := otel.Tracer(tracerName).Start(
+			ctx,
+			fmt.Sprintf("%s %s", r.Method, patternOf(r)),
+			oteltrace.WithSpanKind(oteltrace.SpanKindServer),
+			oteltrace.WithAttributes(
+				semconv.HTTPMethod(r.Method),
+				semconv.HTTPRoute(patternOf(r)),
+			),
+		)
+		defer span.End()
+
+		ctx = trace.WithTIDCtx(ctx)
 
 		ctx = log.SetLogCtx(ctx, trace.GetTIDCtx(ctx))
 
+		ctx = audit.SetClientCtx(ctx, clientOf(r, middle.hops))
+
 		logger := log.GetLogCtx(ctx)
 
 		rw := newResponseWriter(w)
 
 		next.ServeHTTP(rw, r.WithContext(ctx))
 
+		span.SetAttributes(semconv.HTTPStatusCode(rw.StatusCode))
+
+		if rw.StatusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rw.StatusCode))
+		}
+
+		middle.recorder.Observe(patternOf(r), r.Method, rw.StatusCode, time.Since(now))
+
 		logger.Info(
 			"access-log",
 			zap.String("method", r.Method),
@@ -47,6 +94,26 @@ func (middle *Middleware) Handle(next http.Handler) http.Handler {
 	})
 }
 
+// patternOf メトリクスのラベルに用いるルーティングのパターンを取得する.
+// パスをそのまま用いるとラベルの種類が増え続けるため、ルーティングされていない場合は固定値とする.
+func patternOf(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		return rctx.RoutePattern()
+	}
+
+	return "unmatched"
+}
+
+// clientOf 監査ログに記録するリクエスト元の情報を取得する.
+// IPアドレスはレート制限と同様に、信頼できるプロキシが付与したX-Forwarded-Forから取得する.
+func clientOf(r *http.Request, hops int) audit.Client {
+	return audit.Client{
+		IP:           ratelimit.ClientIP(r.RemoteAddr, r.Header.Values(headerForwardedFor), hops),
+		ForwardedFor: strings.Join(r.Header.Values(headerForwardedFor), ", "),
+		UserAgent:    r.UserAgent(),
+	}
+}
+
 type responseWriter struct {
 	http.ResponseWriter
 	StatusCode int
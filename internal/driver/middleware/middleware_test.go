@@ -0,0 +1,123 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/audit"
+	"github.com/morning-night-guild/platform-app/internal/driver/middleware"
+	"github.com/morning-night-guild/platform-app/pkg/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type recorder struct {
+	routes []string
+}
+
+func (rec *recorder) Observe(route string, _ string, _ int, _ time.Duration) {
+	rec.routes = append(rec.routes, route)
+}
+
+// グローバルなトレーサーを登録するため並列に実行しない.
+func TestMiddlewareTrace(t *testing.T) { //nolint:paralleltest
+	spans := tracetest.NewSpanRecorder()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	rec := &recorder{}
+
+	var tid string
+
+	router := chi.NewRouter()
+	router.With(middleware.New(rec, 0).Handle).Get("/articles/{id}", func(w http.ResponseWriter, r *http.Request) {
+		tid = trace.GetTIDCtx(r.Context())
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/articles/1", nil)
+	req.Header.Set("User-Agent", "test")
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	if tid != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("tid = %s, want trace id of traceparent", tid)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("spans = %d, want 1", len(ended))
+	}
+
+	if got := ended[0].Name(); got != "GET /articles/{id}" {
+		t.Errorf("span name = %s, want GET /articles/{id}", got)
+	}
+
+	if got := ended[0].Parent().SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("parent span id = %s, want 00f067aa0ba902b7", got)
+	}
+
+	if len(rec.routes) != 1 || rec.routes[0] != "/articles/{id}" {
+		t.Errorf("routes = %v, want [/articles/{id}]", rec.routes)
+	}
+}
+
+func TestMiddlewareClient(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		hops         int
+		forwardedFor []string
+		wantIP       string
+	}{
+		{
+			name:         "プロキシを経由しない場合は接続元のアドレスを記録する",
+			hops:         0,
+			forwardedFor: []string{"203.0.113.1"},
+			wantIP:       "192.0.2.1",
+		},
+		{
+			name:         "信頼できるプロキシが付与したX-Forwarded-Forのアドレスを記録する",
+			hops:         1,
+			forwardedFor: []string{"198.51.100.1, 203.0.113.1"},
+			wantIP:       "203.0.113.1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got audit.Client
+
+			hdl := middleware.New(&recorder{}, tt.hops).Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = audit.GetClientCtx(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			req.Header.Set("User-Agent", "test")
+			for _, value := range tt.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+
+			hdl.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got.IP != tt.wantIP {
+				t.Errorf("Client.IP = %v, want %v", got.IP, tt.wantIP)
+			}
+			if got.UserAgent != "test" {
+				t.Errorf("Client.UserAgent = %v, want %v", got.UserAgent, "test")
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+)
+
+const (
+	headerAPIKey             = "Api-Key"
+	headerForwardedFor       = "X-Forwarded-For"
+	headerRateLimitLimit     = "RateLimit-Limit"
+	headerRateLimitRemaining = "RateLimit-Remaining"
+	headerRateLimitReset     = "RateLimit-Reset"
+	headerRetryAfter         = "Retry-After"
+)
+
+// Identify 認証済みのリクエストからユーザーIDを取得する.
+type Identify func(ctx context.Context, r *http.Request) (user.ID, error)
+
+// RateLimit ルートとリクエスト元ごとにレート制限を行うミドルウェア.
+type RateLimit struct {
+	limiter  cache.Limiter
+	policies ratelimit.Policies
+	key      string
+	identify Identify
+	hops     int
+}
+
+// NewRateLimit.
+// hopsはAPIサーバーの前段にある信頼できるプロキシの段数で、X-Forwarded-Forからクライアントのアドレスを取得するために用いる.
+func NewRateLimit(
+	limiter cache.Limiter,
+	policies ratelimit.Policies,
+	key string,
+	identify Identify,
+	hops int,
+) *RateLimit {
+	return &RateLimit{
+		limiter:  limiter,
+		policies: policies,
+		key:      key,
+		identify: identify,
+		hops:     hops,
+	}
+}
+
+// Handle.
+func (rl *RateLimit) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		route := routeOf(r)
+
+		limit := rl.policies.Of(route)
+
+		if limit.Unlimited() {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		res, err := rl.limiter.Allow(ctx, fmt.Sprintf("%s:%s", route, principalOf(r, rl.key, rl.identify, rl.hops)), limit)
+		if err != nil {
+			// レート制限の保存先の障害でサービスを止めないよう、判定できない場合は許可する
+			log.GetLogCtx(ctx).Warn("failed to check rate limit", log.ErrorField(err))
+
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		w.Header().Set(headerRateLimitLimit, strconv.Itoa(res.Limit))
+		w.Header().Set(headerRateLimitRemaining, strconv.Itoa(res.Remaining))
+		w.Header().Set(headerRateLimitReset, seconds(res.ResetAfter))
+
+		if !res.Allowed {
+			log.GetLogCtx(ctx).Warn(fmt.Sprintf("rate limit exceeded: %s", route))
+
+			w.Header().Set(headerRetryAfter, seconds(res.RetryAfter))
+
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// principalOf リクエスト元を識別する.
+// 認証済みの場合はユーザーID、APIキーを持つ内部向けのリクエストはAPIキー、それ以外はIPアドレスで識別する.
+// ロードバランサの背後では接続元がすべてロードバランサとなるため、IPアドレスは信頼できるプロキシが付与したX-Forwarded-Forから取得する.
+func principalOf(r *http.Request, key string, identify Identify, hops int) string {
+	if key != "" && r.Header.Get(headerAPIKey) == key {
+		return "key"
+	}
+
+	// 未認証のリクエストで検証の警告ログを出力しないよう、トークンがある場合のみ検証する
+	if _, err := r.Cookie(auth.AuthTokenKey); err == nil && identify != nil {
+		if uid, err := identify(r.Context(), r); err == nil {
+			return fmt.Sprintf("user:%s", uid.String())
+		}
+	}
+
+	return fmt.Sprintf("ip:%s", ratelimit.ClientIP(r.RemoteAddr, r.Header.Values(headerForwardedFor), hops))
+}
+
+// routeOf レート制限の上限を決定するルートを取得する.
+func routeOf(r *http.Request) string {
+	pattern := r.URL.Path
+
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+		pattern = rctx.RoutePattern()
+	}
+
+	return fmt.Sprintf("%s %s", r.Method, pattern)
+}
+
+func seconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
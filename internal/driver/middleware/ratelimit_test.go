@@ -0,0 +1,147 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/user"
+	"github.com/morning-night-guild/platform-app/internal/driver/middleware"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	uid := user.GenerateID()
+
+	identify := func(_ context.Context, r *http.Request) (user.ID, error) {
+		return uid, nil
+	}
+
+	policies := ratelimit.Policies{
+		Default: cache.Limit{Rate: 1, Period: time.Hour, Burst: 2},
+		Routes: map[string]cache.Limit{
+			"GET /health": {},
+		},
+	}
+
+	newRouter := func() http.Handler {
+		rl := middleware.NewRateLimit(
+			&kvs.MemoryLimiter{Prefix: "rate_limit", Store: kvs.NewStore()},
+			policies,
+			"key",
+			identify,
+			1,
+		)
+		router := chi.NewRouter()
+		ok := func(w http.ResponseWriter, r *http.Request) {}
+		router.With(rl.Handle).Get("/articles/{id}", ok)
+		router.With(rl.Handle).Get("/health", ok)
+		return router
+	}
+
+	serve := func(router http.Handler, path string, opts ...func(*http.Request)) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		for _, opt := range opts {
+			opt(req)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("上限を超えると429となる", func(t *testing.T) {
+		t.Parallel()
+		router := newRouter()
+		for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+			rec := serve(router, "/articles/1")
+			if rec.Code != want {
+				t.Errorf("#%d status = %d, want %d", i, rec.Code, want)
+			}
+		}
+	})
+
+	t.Run("レート制限のヘッダーが付与される", func(t *testing.T) {
+		t.Parallel()
+		router := newRouter()
+		rec := serve(router, "/articles/1")
+		if rec.Header().Get("RateLimit-Limit") != "2" || rec.Header().Get("RateLimit-Remaining") != "1" {
+			t.Errorf("headers = %v", rec.Header())
+		}
+		serve(router, "/articles/1")
+		rec = serve(router, "/articles/1")
+		if rec.Header().Get("Retry-After") == "" || rec.Header().Get("RateLimit-Remaining") != "0" {
+			t.Errorf("headers = %v", rec.Header())
+		}
+	})
+
+	t.Run("ルートのパターンごとに上限を共有する", func(t *testing.T) {
+		t.Parallel()
+		router := newRouter()
+		serve(router, "/articles/1")
+		serve(router, "/articles/2")
+		if rec := serve(router, "/articles/3"); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+		}
+	})
+
+	t.Run("上限のないルートは制限しない", func(t *testing.T) {
+		t.Parallel()
+		router := newRouter()
+		for i := 0; i < 5; i++ {
+			if rec := serve(router, "/health"); rec.Code != http.StatusOK {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+			}
+		}
+	})
+
+	t.Run("リクエスト元ごとに上限を管理する", func(t *testing.T) {
+		t.Parallel()
+		router := newRouter()
+		serve(router, "/articles/1")
+		serve(router, "/articles/1")
+		principals := map[string]func(*http.Request){
+			"ユーザー": func(r *http.Request) {
+				r.AddCookie(&http.Cookie{Name: auth.AuthTokenKey, Value: "token"})
+			},
+			"APIキー": func(r *http.Request) {
+				r.Header.Set("Api-Key", "key")
+			},
+			"別のIPアドレス": func(r *http.Request) {
+				r.RemoteAddr = "192.0.2.2:1234"
+			},
+		}
+		for name, opt := range principals {
+			if rec := serve(router, "/articles/1", opt); rec.Code != http.StatusOK {
+				t.Errorf("%s status = %d, want %d", name, rec.Code, http.StatusOK)
+			}
+		}
+	})
+
+	t.Run("ロードバランサが付与したX-Forwarded-Forのアドレスごとに上限を管理する", func(t *testing.T) {
+		t.Parallel()
+		router := newRouter()
+		forwarded := func(value string) func(*http.Request) {
+			return func(r *http.Request) {
+				r.RemoteAddr = "10.0.0.1:1234"
+				r.Header.Set("X-Forwarded-For", value)
+			}
+		}
+		serve(router, "/articles/1", forwarded("198.51.100.1"))
+		serve(router, "/articles/1", forwarded("198.51.100.1"))
+		if rec := serve(router, "/articles/1", forwarded("198.51.100.2")); rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+		// クライアントが先頭に付与した値は偽装できるため識別に用いない
+		if rec := serve(router, "/articles/1", forwarded("203.0.113.1, 198.51.100.1")); rec.Code != http.StatusTooManyRequests {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+		}
+	})
+}
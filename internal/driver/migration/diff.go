@@ -0,0 +1,40 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	entmigrate "github.com/morning-night-guild/platform-app/pkg/ent/migrate"
+)
+
+// Diff 既存のマイグレーションファイルを適用した状態とentのスキーマとの差分からマイグレーションファイルを生成する.
+// devDBには空の検証用データベースを指定する. 差分がない場合はファイルを生成せずfalseを返す.
+func Diff(ctx context.Context, devDB *sql.DB, dir *Dir, name string) (bool, error) {
+	atlas, err := schema.NewMigrate(
+		entsql.OpenDB(dialect.Postgres, devDB),
+		schema.WithDir(dir),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithErrNoPlan(true),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	if err := atlas.NamedDiff(ctx, name, entmigrate.Tables...); err != nil {
+		if errors.Is(err, migrate.ErrNoPlan) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,86 @@
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+)
+
+var _ migrate.Dir = (*Dir)(nil)
+
+// ErrDownNotFound 巻き戻し用のdownファイルが存在しない.
+var ErrDownNotFound = errors.New("down migration not found")
+
+// Dir golang-migrate形式(`<version>_<name>.up.sql`/`<version>_<name>.down.sql`)のマイグレーションディレクトリ.
+// 適用対象はupファイルのみだが、チェックサムはdownファイルも含めて計算する.
+type Dir struct {
+	*sqltool.GolangMigrateDir
+}
+
+// NewDir マイグレーションディレクトリを作成する.
+// 埋め込みのファイルを読み取り専用で扱う場合も、ローカルのディレクトリに書き込む場合も同じ型で扱う.
+func NewDir(fsys fs.FS) *Dir {
+	return &Dir{
+		GolangMigrateDir: &sqltool.GolangMigrateDir{FS: fsys},
+	}
+}
+
+// OpenDir ローカルのマイグレーションディレクトリを開く.
+func OpenDir(path string) (*Dir, error) {
+	dir, err := migrate.NewLocalDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewDir(dir), nil
+}
+
+// Checksum downファイルを含む全てのSQLファイルからチェックサムを計算する.
+func (d *Dir) Checksum() (migrate.HashFile, error) {
+	if dir, ok := d.FS.(migrate.Dir); ok {
+		return dir.Checksum()
+	}
+
+	names, err := fs.Glob(d.FS, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(names)
+
+	files := make([]migrate.File, len(names))
+
+	for i, name := range names {
+		b, err := fs.ReadFile(d.FS, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		files[i] = migrate.NewLocalFile(name, b)
+	}
+
+	return migrate.NewHashFile(files)
+}
+
+// Down 指定したバージョンのdownファイルを取得する.
+func (d *Dir) Down(version string) (migrate.File, error) {
+	names, err := fs.Glob(d.FS, version+"_*.down.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) != 1 {
+		return nil, fmt.Errorf("%w: %s", ErrDownNotFound, version)
+	}
+
+	b, err := fs.ReadFile(d.FS, names[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", names[0], err)
+	}
+
+	return migrate.NewLocalFile(names[0], b), nil
+}
@@ -0,0 +1,378 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/postgres"
+	"ariga.io/atlas/sql/schema"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+const (
+	// lockName 同時に実行されたマイグレーションを直列化するアドバイザリロックの名前.
+	lockName = "platform-app/migrate"
+	// lockTimeout アドバイザリロックの取得を待つ時間.
+	lockTimeout = time.Minute
+	// operator リビジョンに記録する実行者.
+	operator = "platform-app"
+)
+
+var (
+	// ErrInvalidCount 巻き戻す件数が不正.
+	ErrInvalidCount = errors.New("invalid count")
+	// ErrBaselineRevert ベースラインより前には戻せない.
+	ErrBaselineRevert = errors.New("cannot revert baseline revision")
+	// ErrBaselineNotFound ベースラインに指定したバージョンのファイルが存在しない.
+	ErrBaselineNotFound = errors.New("baseline version not found")
+	// ErrAlreadyMigrated 適用済みのリビジョンが存在するためベースラインを設定できない.
+	ErrAlreadyMigrated = errors.New("revisions already exist")
+	// ErrLockUnsupported ドライバがアドバイザリロックに対応していない.
+	ErrLockUnsupported = errors.New("driver does not support advisory lock")
+)
+
+// State マイグレーションファイルの適用状態.
+type State string
+
+const (
+	// StateApplied 適用済み.
+	StateApplied State = "applied"
+	// StateBaseline ベースラインとして適用済みとみなしている.
+	StateBaseline State = "baseline"
+	// StatePending 未適用.
+	StatePending State = "pending"
+	// StateModified 適用後にファイルが変更されている.
+	StateModified State = "modified"
+	// StateMissing 適用済みだがファイルが存在しない.
+	StateMissing State = "missing"
+)
+
+// Status マイグレーションファイルごとの適用状態.
+type Status struct {
+	Version     string
+	Description string
+	State       State
+	ExecutedAt  time.Time
+}
+
+// Migrator バージョン付きのマイグレーションを適用・巻き戻す.
+// 各ファイルは個別のトランザクションで適用し、アドバイザリロックで同時実行を防ぐ.
+type Migrator struct {
+	db  *sql.DB
+	dir *Dir
+}
+
+// New マイグレーションの実行を作成する.
+func New(db *sql.DB, dir *Dir) *Migrator {
+	return &Migrator{
+		db:  db,
+		dir: dir,
+	}
+}
+
+// Up 未適用のマイグレーションをn件適用する. nが0以下の場合は全て適用する.
+// 適用した件数を返す.
+func (m *Migrator) Up(ctx context.Context, n int) (int, error) {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	ex, err := m.executor(ctx, m.db)
+	if err != nil {
+		return 0, err
+	}
+
+	pending, err := ex.Pending(ctx)
+	if errors.Is(err, migrate.ErrNoPendingFiles) {
+		return 0, nil
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if n > 0 && n < len(pending) {
+		pending = pending[:n]
+	}
+
+	for i, file := range pending {
+		if err := m.tx(ctx, func(tx *sql.Tx) error {
+			ex, err := m.executor(ctx, tx)
+			if err != nil {
+				return err
+			}
+
+			return ex.Execute(ctx, file)
+		}); err != nil {
+			return i, err
+		}
+
+		log.GetLogCtx(ctx).Info("migrated", zap.String("version", file.Version()), zap.String("description", file.Desc()))
+	}
+
+	return len(pending), nil
+}
+
+// Down 適用済みのマイグレーションを新しい順にn件巻き戻す.
+// 巻き戻した件数を返す.
+func (m *Migrator) Down(ctx context.Context, n int) (int, error) {
+	if n <= 0 {
+		return 0, fmt.Errorf("%w: %d", ErrInvalidCount, n)
+	}
+
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	if err := migrate.Validate(m.dir); err != nil {
+		return 0, fmt.Errorf("failed to validate migration directory: %w", err)
+	}
+
+	revs, err := m.revisions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+
+	for i := len(revs) - 1; i >= 0 && count < n; i-- {
+		rev := revs[i]
+
+		if rev.Type == migrate.RevisionTypeBaseline {
+			return count, fmt.Errorf("%w: %s", ErrBaselineRevert, rev.Version)
+		}
+
+		file, err := m.dir.Down(rev.Version)
+		if err != nil {
+			return count, err
+		}
+
+		stmts, err := file.Stmts()
+		if err != nil {
+			return count, fmt.Errorf("failed to read statements from %s: %w", file.Name(), err)
+		}
+
+		if err := m.tx(ctx, func(tx *sql.Tx) error {
+			for _, stmt := range stmts {
+				if _, err := tx.ExecContext(ctx, stmt); err != nil {
+					return fmt.Errorf("failed to execute %q from %s: %w", stmt, file.Name(), err)
+				}
+			}
+
+			return NewRevisions(tx).DeleteRevision(ctx, rev.Version)
+		}); err != nil {
+			return count, err
+		}
+
+		log.GetLogCtx(ctx).Info("reverted", zap.String("version", rev.Version), zap.String("description", rev.Description))
+
+		count++
+	}
+
+	return count, nil
+}
+
+// Status マイグレーションファイルと適用済みリビジョンを突き合わせた状態を返す.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := migrate.Validate(m.dir); err != nil {
+		return nil, fmt.Errorf("failed to validate migration directory: %w", err)
+	}
+
+	revs, err := m.revisions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := m.dir.Files()
+	if err != nil {
+		return nil, err
+	}
+
+	sum, err := m.dir.Checksum()
+	if err != nil {
+		return nil, err
+	}
+
+	return status(files, sum, revs), nil
+}
+
+// Baseline 既存のデータベースを指定したバージョンまで適用済みとして記録する.
+// Schema.Createで作成済みのデータベースをバージョン管理に移行する際に使う.
+func (m *Migrator) Baseline(ctx context.Context, version string) error {
+	unlock, err := m.lock(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := migrate.Validate(m.dir); err != nil {
+		return fmt.Errorf("failed to validate migration directory: %w", err)
+	}
+
+	revs, err := m.revisions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(revs) > 0 {
+		return fmt.Errorf("%w: latest %s", ErrAlreadyMigrated, revs[len(revs)-1].Version)
+	}
+
+	files, err := m.dir.Files()
+	if err != nil {
+		return err
+	}
+
+	idx := migrate.FilesLastIndex(files, func(f migrate.File) bool {
+		return f.Version() == version
+	})
+	if idx == -1 {
+		return fmt.Errorf("%w: %s", ErrBaselineNotFound, version)
+	}
+
+	return NewRevisions(m.db).WriteRevision(ctx, &migrate.Revision{
+		Version:         files[idx].Version(),
+		Description:     files[idx].Desc(),
+		Type:            migrate.RevisionTypeBaseline,
+		ExecutedAt:      time.Now(),
+		OperatorVersion: operator,
+	})
+}
+
+// lock アドバイザリロックを取得する. 解放に失敗した場合はセッションの終了時に解放されるためログのみ残す.
+func (m *Migrator) lock(ctx context.Context) (func(), error) {
+	drv, err := postgres.Open(m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	locker, ok := drv.(schema.Locker)
+	if !ok {
+		return nil, ErrLockUnsupported
+	}
+
+	unlock, err := locker.Lock(ctx, lockName, lockTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire migration lock: %w", err)
+	}
+
+	return func() {
+		if err := unlock(); err != nil {
+			log.GetLogCtx(ctx).Warn("failed to release migration lock", log.ErrorField(err))
+		}
+	}, nil
+}
+
+func (m *Migrator) revisions(ctx context.Context) ([]*migrate.Revision, error) {
+	rrw := NewRevisions(m.db)
+
+	if err := rrw.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	return rrw.ReadRevisions(ctx)
+}
+
+func (m *Migrator) executor(ctx context.Context, conn schema.ExecQuerier) (*migrate.Executor, error) {
+	rrw := NewRevisions(conn)
+
+	if err := rrw.Init(ctx); err != nil {
+		return nil, err
+	}
+
+	drv, err := postgres.Open(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewExecutor(drv, m.dir, rrw, migrate.WithOperatorVersion(operator))
+}
+
+func (m *Migrator) tx(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	if err := fn(tx); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			log.GetLogCtx(ctx).Warn("failed to rollback transaction", log.ErrorField(rerr))
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// status ファイルとリビジョンを突き合わせる.
+func status(files []migrate.File, sum migrate.HashFile, revs []*migrate.Revision) []Status {
+	applied := make(map[string]*migrate.Revision, len(revs))
+
+	for _, rev := range revs {
+		applied[rev.Version] = rev
+	}
+
+	var baseline string
+
+	for _, rev := range revs {
+		if rev.Type == migrate.RevisionTypeBaseline {
+			baseline = rev.Version
+		}
+	}
+
+	result := make([]Status, 0, len(files)+len(revs))
+
+	for _, file := range files {
+		st := Status{
+			Version:     file.Version(),
+			Description: file.Desc(),
+			State:       StatePending,
+		}
+
+		rev, ok := applied[file.Version()]
+
+		switch {
+		case ok && rev.Type == migrate.RevisionTypeBaseline:
+			st.State = StateBaseline
+			st.ExecutedAt = rev.ExecutedAt
+		case ok:
+			st.State = StateApplied
+			st.ExecutedAt = rev.ExecutedAt
+
+			if hash, err := sum.SumByName(file.Name()); err != nil || hash != rev.Hash {
+				st.State = StateModified
+			}
+		case file.Version() < baseline:
+			st.State = StateBaseline
+		}
+
+		delete(applied, file.Version())
+
+		result = append(result, st)
+	}
+
+	for _, rev := range revs {
+		if _, ok := applied[rev.Version]; !ok {
+			continue
+		}
+
+		result = append(result, Status{
+			Version:     rev.Version,
+			Description: rev.Description,
+			State:       StateMissing,
+			ExecutedAt:  rev.ExecutedAt,
+		})
+	}
+
+	return result
+}
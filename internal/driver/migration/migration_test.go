@@ -0,0 +1,270 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/morning-night-guild/platform-app/pkg/ent/migrate/migrations"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	t.Parallel()
+
+	dir := NewDir(migrations.FS)
+
+	if err := migrate.Validate(dir); err != nil {
+		t.Fatalf("Validate() error = %v, atlas.sum may be outdated", err)
+	}
+
+	files, err := dir.Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) == 0 {
+		t.Fatal("Files() = 0, want at least 1")
+	}
+
+	for _, file := range files {
+		if _, err := file.Stmts(); err != nil {
+			t.Errorf("Stmts(%s) error = %v", file.Name(), err)
+		}
+
+		down, err := dir.Down(file.Version())
+		if err != nil {
+			t.Errorf("Down(%s) error = %v", file.Version(), err)
+
+			continue
+		}
+
+		if _, err := down.Stmts(); err != nil {
+			t.Errorf("Stmts(%s) error = %v", down.Name(), err)
+		}
+	}
+}
+
+func TestDirChecksum(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"1_init.up.sql":   {Data: []byte("CREATE TABLE a (id int);\n")},
+		"1_init.down.sql": {Data: []byte("DROP TABLE a;\n")},
+	}
+
+	dir := NewDir(fsys)
+
+	sum, err := dir.Checksum()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := sum.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fsys["atlas.sum"] = &fstest.MapFile{Data: b}
+
+	if err := migrate.Validate(dir); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	files, err := dir.Files()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 || files[0].Desc() != "init" {
+		t.Fatalf("Files() = %v, want only up file", files)
+	}
+
+	// downファイルの変更もチェックサムの不一致として検出する
+	fsys["1_init.down.sql"] = &fstest.MapFile{Data: []byte("DROP TABLE b;\n")}
+
+	if err := migrate.Validate(dir); !errors.Is(err, migrate.ErrChecksumMismatch) {
+		t.Fatalf("Validate() error = %v, want %v", err, migrate.ErrChecksumMismatch)
+	}
+
+	if _, err := dir.Down("2"); !errors.Is(err, ErrDownNotFound) {
+		t.Fatalf("Down() error = %v, want %v", err, ErrDownNotFound)
+	}
+}
+
+func TestRevisions(t *testing.T) {
+	t.Parallel()
+
+	db, err := sql.Open("sqlite3", "file:revisions?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+
+	rrw := NewRevisions(db)
+
+	if err := rrw.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 2回目の初期化もエラーにならない
+	if err := rrw.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := rrw.ReadRevision(ctx, "1"); !errors.Is(err, migrate.ErrRevisionNotExist) {
+		t.Fatalf("ReadRevision() error = %v, want %v", err, migrate.ErrRevisionNotExist)
+	}
+
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rev := &migrate.Revision{
+		Version:         "2",
+		Description:     "second",
+		Type:            migrate.RevisionTypeExecute,
+		Applied:         1,
+		Total:           2,
+		ExecutedAt:      now,
+		ExecutionTime:   time.Second,
+		Hash:            "h1:hash",
+		PartialHashes:   []string{"h1:a"},
+		OperatorVersion: "test",
+	}
+
+	if err := rrw.WriteRevision(ctx, rev); err != nil {
+		t.Fatal(err)
+	}
+
+	rev.Applied = 2
+	rev.PartialHashes = append(rev.PartialHashes, "h1:b")
+
+	if err := rrw.WriteRevision(ctx, rev); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := rrw.WriteRevision(ctx, &migrate.Revision{
+		Version:    "1",
+		Type:       migrate.RevisionTypeBaseline,
+		ExecutedAt: now,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := rrw.ReadRevision(ctx, "2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(got, rev) {
+		t.Errorf("ReadRevision() = %+v, want %+v", got, rev)
+	}
+
+	revs, err := rrw.ReadRevisions(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(revs) != 2 || revs[0].Version != "1" || revs[1].Version != "2" {
+		t.Fatalf("ReadRevisions() = %+v, want versions [1 2]", revs)
+	}
+
+	if err := rrw.DeleteRevision(ctx, "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := rrw.ReadRevision(ctx, "2"); !errors.Is(err, migrate.ErrRevisionNotExist) {
+		t.Fatalf("ReadRevision() error = %v, want %v", err, migrate.ErrRevisionNotExist)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	executed := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	files := []migrate.File{
+		migrate.NewLocalFile("1_a.up.sql", []byte("SELECT 1;")),
+		migrate.NewLocalFile("2_b.up.sql", []byte("SELECT 2;")),
+		migrate.NewLocalFile("3_c.up.sql", []byte("SELECT 3;")),
+		migrate.NewLocalFile("4_d.up.sql", []byte("SELECT 4;")),
+		migrate.NewLocalFile("5_e.up.sql", []byte("SELECT 5;")),
+	}
+
+	sum, err := migrate.NewHashFile(files)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hash := func(name string) string {
+		h, err := sum.SumByName(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return h
+	}
+
+	type args struct {
+		revs []*migrate.Revision
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []State
+	}{
+		{
+			name: "未適用のファイルはpendingになる",
+			args: args{
+				revs: []*migrate.Revision{},
+			},
+			want: []State{StatePending, StatePending, StatePending, StatePending, StatePending},
+		},
+		{
+			name: "ベースライン以前のファイルはbaselineになる",
+			args: args{
+				revs: []*migrate.Revision{
+					{Version: "2", Type: migrate.RevisionTypeBaseline, ExecutedAt: executed},
+					{Version: "3", Type: migrate.RevisionTypeExecute, Hash: hash("3_c.up.sql"), ExecutedAt: executed},
+				},
+			},
+			want: []State{StateBaseline, StateBaseline, StateApplied, StatePending, StatePending},
+		},
+		{
+			name: "適用後にファイルが変更されたらmodifiedになり、ファイルがないリビジョンはmissingになる",
+			args: args{
+				revs: []*migrate.Revision{
+					{Version: "1", Type: migrate.RevisionTypeExecute, Hash: hash("1_a.up.sql"), ExecutedAt: executed},
+					{Version: "2", Type: migrate.RevisionTypeExecute, Hash: "h1:changed", ExecutedAt: executed},
+					{Version: "9", Type: migrate.RevisionTypeExecute, Hash: "h1:removed", ExecutedAt: executed},
+				},
+			},
+			want: []State{StateApplied, StateModified, StatePending, StatePending, StatePending, StateMissing},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sts := status(files, sum, tt.args.revs)
+
+			got := make([]State, len(sts))
+			for i, st := range sts {
+				got[i] = st.State
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("status() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
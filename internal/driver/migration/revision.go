@@ -0,0 +1,197 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/schema"
+)
+
+// RevisionTable 適用済みのマイグレーションを記録するテーブル名.
+const RevisionTable = "schema_revisions"
+
+const revisionColumns = "version, description, type, applied, total, executed_at, execution_time, " +
+	"error, error_stmt, hash, partial_hashes, operator_version"
+
+var _ migrate.RevisionReadWriter = (*Revisions)(nil)
+
+// Revisions 適用済みのマイグレーションをテーブルに読み書きする.
+// トランザクション内で実行する場合は*sql.Txを渡す.
+type Revisions struct {
+	conn schema.ExecQuerier
+}
+
+// NewRevisions リビジョンの読み書きを作成する.
+func NewRevisions(conn schema.ExecQuerier) *Revisions {
+	return &Revisions{
+		conn: conn,
+	}
+}
+
+// Init リビジョンテーブルが存在しなければ作成する.
+func (r *Revisions) Init(ctx context.Context) error {
+	query := `CREATE TABLE IF NOT EXISTS ` + RevisionTable + ` (
+	version varchar NOT NULL PRIMARY KEY,
+	description varchar NOT NULL,
+	type bigint NOT NULL,
+	applied bigint NOT NULL DEFAULT 0,
+	total bigint NOT NULL DEFAULT 0,
+	executed_at timestamp NOT NULL,
+	execution_time bigint NOT NULL,
+	error text NOT NULL DEFAULT '',
+	error_stmt text NOT NULL DEFAULT '',
+	hash varchar NOT NULL DEFAULT '',
+	partial_hashes text NOT NULL DEFAULT '[]',
+	operator_version varchar NOT NULL DEFAULT ''
+)`
+
+	if _, err := r.conn.ExecContext(ctx, query); err != nil {
+		return fmt.Errorf("failed to create revision table: %w", err)
+	}
+
+	return nil
+}
+
+// Ident リビジョンテーブルを識別する. 未適用のデータベースが空か判定する際に除外される.
+func (r *Revisions) Ident() *migrate.TableIdent {
+	return &migrate.TableIdent{
+		Name:   RevisionTable,
+		Schema: "public",
+	}
+}
+
+// ReadRevisions 全てのリビジョンをバージョン順に取得する.
+func (r *Revisions) ReadRevisions(ctx context.Context) ([]*migrate.Revision, error) {
+	rows, err := r.conn.QueryContext(ctx, `SELECT `+revisionColumns+` FROM `+RevisionTable+` ORDER BY version`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revisions: %w", err)
+	}
+	defer rows.Close()
+
+	revs := make([]*migrate.Revision, 0)
+
+	for rows.Next() {
+		rev, err := scanRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		revs = append(revs, rev)
+	}
+
+	return revs, rows.Err()
+}
+
+// ReadRevision 指定したバージョンのリビジョンを取得する.
+// 存在しない場合はmigrate.ErrRevisionNotExistを返す.
+func (r *Revisions) ReadRevision(ctx context.Context, version string) (*migrate.Revision, error) {
+	rows, err := r.conn.QueryContext(ctx, `SELECT `+revisionColumns+` FROM `+RevisionTable+` WHERE version = $1`, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revision: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+
+		return nil, migrate.ErrRevisionNotExist
+	}
+
+	return scanRevision(rows)
+}
+
+// WriteRevision リビジョンを保存する. 同じバージョンが存在する場合は上書きする.
+func (r *Revisions) WriteRevision(ctx context.Context, rev *migrate.Revision) error {
+	hashes, err := json.Marshal(rev.PartialHashes)
+	if err != nil {
+		return fmt.Errorf("failed to marshal partial hashes: %w", err)
+	}
+
+	query := `INSERT INTO ` + RevisionTable + ` (` + revisionColumns + `)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (version) DO UPDATE SET
+	description = excluded.description,
+	type = excluded.type,
+	applied = excluded.applied,
+	total = excluded.total,
+	executed_at = excluded.executed_at,
+	execution_time = excluded.execution_time,
+	error = excluded.error,
+	error_stmt = excluded.error_stmt,
+	hash = excluded.hash,
+	partial_hashes = excluded.partial_hashes,
+	operator_version = excluded.operator_version`
+
+	if _, err := r.conn.ExecContext(
+		ctx,
+		query,
+		rev.Version,
+		rev.Description,
+		int64(rev.Type),
+		rev.Applied,
+		rev.Total,
+		rev.ExecutedAt.UTC(),
+		int64(rev.ExecutionTime),
+		rev.Error,
+		rev.ErrorStmt,
+		rev.Hash,
+		string(hashes),
+		rev.OperatorVersion,
+	); err != nil {
+		return fmt.Errorf("failed to write revision: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRevision 指定したバージョンのリビジョンを削除する.
+func (r *Revisions) DeleteRevision(ctx context.Context, version string) error {
+	if _, err := r.conn.ExecContext(ctx, `DELETE FROM `+RevisionTable+` WHERE version = $1`, version); err != nil {
+		return fmt.Errorf("failed to delete revision: %w", err)
+	}
+
+	return nil
+}
+
+func scanRevision(rows *sql.Rows) (*migrate.Revision, error) {
+	var (
+		rev      migrate.Revision
+		typ      int64
+		elapsed  int64
+		hashes   string
+		executed time.Time
+	)
+
+	if err := rows.Scan(
+		&rev.Version,
+		&rev.Description,
+		&typ,
+		&rev.Applied,
+		&rev.Total,
+		&executed,
+		&elapsed,
+		&rev.Error,
+		&rev.ErrorStmt,
+		&rev.Hash,
+		&hashes,
+		&rev.OperatorVersion,
+	); err != nil {
+		return nil, fmt.Errorf("failed to scan revision: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(hashes), &rev.PartialHashes); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal partial hashes of %s: %w", rev.Version, err)
+	}
+
+	rev.Type = migrate.RevisionType(typ)
+	rev.ExecutionTime = time.Duration(elapsed)
+	rev.ExecutedAt = executed
+
+	return &rev, nil
+}
@@ -0,0 +1,202 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MicahParks/keyfunc/v2"
+	"github.com/morning-night-guild/platform-app/internal/adapter/external"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/auth"
+	"github.com/morning-night-guild/platform-app/internal/domain/model/errors"
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"golang.org/x/oauth2"
+)
+
+const (
+	githubAuthURL  = "https://github.com/login/oauth/authorize"
+	githubTokenURL = "https://github.com/login/oauth/access_token" //nolint:gosec
+	githubAPIURL   = "https://api.github.com"
+	jwksRefresh    = time.Hour
+)
+
+// ProviderConfig 環境変数OIDC_PROVIDERSに設定する認証プロバイダの設定.
+type ProviderConfig struct {
+	Name         string   `json:"name"`
+	Kind         string   `json:"kind"`
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"clientId"`
+	ClientSecret string   `json:"clientSecret"`
+	Scopes       []string `json:"scopes"`
+}
+
+type OIDC struct{}
+
+func New() *OIDC {
+	return &OIDC{}
+}
+
+// Of 認証プロバイダの設定(JSON配列)からOIDCクライアントを作成する.
+// OpenID ConnectプロバイダはissuerのディスカバリからエンドポイントとJWKSを取得する.
+func (oi *OIDC) Of( //nolint:ireturn
+	providers string,
+	callbackBaseURL string,
+) (rpc.OIDC, error) {
+	var cfgs []ProviderConfig
+
+	if providers != "" {
+		if err := json.Unmarshal([]byte(providers), &cfgs); err != nil {
+			return nil, errors.NewValidationError("invalid oidc providers", err)
+		}
+	}
+
+	prvs := make(map[auth.OIDCProvider]external.OIDCProvider, len(cfgs))
+
+	for _, cfg := range cfgs {
+		name, err := auth.NewOIDCProvider(cfg.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		prv, err := oi.provider(cfg, fmt.Sprintf("%s/%s/callback", strings.TrimSuffix(callbackBaseURL, "/"), name))
+		if err != nil {
+			return nil, err
+		}
+
+		prvs[name] = prv
+	}
+
+	return external.NewOIDC(prvs, http.DefaultClient), nil
+}
+
+func (oi *OIDC) provider(cfg ProviderConfig, redirectURL string) (external.OIDCProvider, error) {
+	switch external.OIDCKind(cfg.Kind) {
+	case external.OIDCKindGitHub:
+		scopes := cfg.Scopes
+		if len(scopes) == 0 {
+			scopes = []string{"read:user", "user:email"}
+		}
+
+		return external.OIDCProvider{
+			Kind: external.OIDCKindGitHub,
+			Config: oauth2.Config{
+				ClientID:     cfg.ClientID,
+				ClientSecret: cfg.ClientSecret,
+				Endpoint: oauth2.Endpoint{
+					AuthURL:  githubAuthURL,
+					TokenURL: githubTokenURL,
+				},
+				RedirectURL: redirectURL,
+				Scopes:      scopes,
+			},
+			APIURL: githubAPIURL,
+		}, nil
+	case external.OIDCKindOIDC, "":
+		doc, err := discover(cfg.Issuer)
+		if err != nil {
+			return external.OIDCProvider{}, err
+		}
+
+		jwks, err := keyfunc.Get(doc.JWKSURI, keyfunc.Options{
+			RefreshInterval:   jwksRefresh,
+			RefreshUnknownKID: true,
+			RefreshErrorHandler: func(err error) {
+				log.Log().Warn("failed to refresh jwks", log.ErrorField(err))
+			},
+		})
+		if err != nil {
+			return external.OIDCProvider{}, fmt.Errorf("failed to get jwks: %w", err)
+		}
+
+		scopes := cfg.Scopes
+		if len(scopes) == 0 {
+			scopes = []string{"openid", "email"}
+		}
+
+		return external.OIDCProvider{
+			Kind: external.OIDCKindOIDC,
+			Config: oauth2.Config{
+				ClientID:     cfg.ClientID,
+				ClientSecret: cfg.ClientSecret,
+				Endpoint: oauth2.Endpoint{
+					AuthURL:  doc.AuthorizationEndpoint,
+					TokenURL: doc.TokenEndpoint,
+				},
+				RedirectURL: redirectURL,
+				Scopes:      scopes,
+			},
+			Issuer:     doc.Issuer,
+			Keyfunc:    jwks.Keyfunc,
+			Algorithms: signingAlgorithms(doc.Algorithms),
+		}, nil
+	default:
+		return external.OIDCProvider{}, errors.NewValidationError(fmt.Sprintf("unknown oidc kind: %s", cfg.Kind))
+	}
+}
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"` //nolint:tagliatelle
+	TokenEndpoint         string `json:"token_endpoint"`         //nolint:tagliatelle
+	JWKSURI               string `json:"jwks_uri"`               //nolint:tagliatelle
+	// Algorithms IDトークンの署名に用いられるアルゴリズム.
+	Algorithms []string `json:"id_token_signing_alg_values_supported"` //nolint:tagliatelle
+}
+
+// discover OpenID Connect Discoveryでプロバイダのメタデータを取得する.
+func discover(issuer string) (discovery, error) {
+	if issuer == "" {
+		return discovery{}, errors.NewValidationError("oidc issuer is empty")
+	}
+
+	url := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	if err != nil {
+		return discovery{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return discovery{}, fmt.Errorf("failed to get %s: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return discovery{}, fmt.Errorf("failed to get %s: status code is %d", url, res.StatusCode)
+	}
+
+	var doc discovery
+
+	if err := json.NewDecoder(res.Body).Decode(&doc); err != nil {
+		return discovery{}, fmt.Errorf("failed to decode discovery document: %w", err)
+	}
+
+	return doc, nil
+}
+
+// defaultSigningAlgorithm ディスカバリで署名アルゴリズムが示されない場合のIDトークンの署名アルゴリズム.
+const defaultSigningAlgorithm = "RS256"
+
+// signingAlgorithms IDトークンの検証で受け付ける署名アルゴリズム.
+// JWKSの公開鍵で検証できる非対称鍵のアルゴリズムのみとし、noneや共通鍵のアルゴリズムは受け付けない.
+func signingAlgorithms(supported []string) []string {
+	algs := make([]string, 0, len(supported))
+
+	for _, alg := range supported {
+		switch {
+		case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"), strings.HasPrefix(alg, "ES"), alg == "EdDSA":
+			algs = append(algs, alg)
+		}
+	}
+
+	if len(algs) == 0 {
+		return []string{defaultSigningAlgorithm}
+	}
+
+	return algs
+}
@@ -2,12 +2,16 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 
 	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	// postgres driver.
 	_ "github.com/lib/pq"
 	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
 	"github.com/morning-night-guild/platform-app/pkg/ent"
+	// 既定値やインターセプターを登録する.
+	_ "github.com/morning-night-guild/platform-app/pkg/ent/runtime"
 )
 
 var _ gateway.RDBFactory = (*Postgres)(nil)
@@ -19,16 +23,23 @@ func New() *Postgres {
 }
 
 func (c Postgres) Of(dsn string) (*gateway.RDB, error) {
-	client, err := ent.Open(dialect.Postgres, dsn)
+	db, err := sql.Open(dialect.Postgres, dsn)
 	if err != nil {
 		return &gateway.RDB{}, err
 	}
 
+	client := ent.NewClient(ent.Driver(newTraceDriver(entsql.OpenDB(dialect.Postgres, db))))
+
 	if _, err := client.QueryContext(context.Background(), "SELECT 1"); err != nil {
+		// 呼び出し元は失敗時に接続を閉じないため、ここで閉じる
+		db.Close()
+
 		return &gateway.RDB{}, err
 	}
 
 	return &gateway.RDB{
-		Client: client,
+		Client:  client,
+		DB:      db,
+		Dialect: dialect.Postgres,
 	}, err
 }
@@ -0,0 +1,136 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/morning-night-guild/platform-app/internal/driver/postgres"
+
+var _ dialect.Driver = (*traceDriver)(nil)
+
+// traceDriver entのクエリごとに子スパンを作成するドライバー.
+// 生成されたクライアントはExecContextなどをドライバーの型アサーションで呼び出すため、それらも委譲する.
+type traceDriver struct {
+	*entsql.Driver
+}
+
+func newTraceDriver(drv *entsql.Driver) *traceDriver {
+	return &traceDriver{
+		Driver: drv,
+	}
+}
+
+func (drv *traceDriver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := start(ctx, "Exec", query)
+
+	err := drv.Driver.Exec(ctx, query, args, v)
+
+	end(span, err)
+
+	return err
+}
+
+func (drv *traceDriver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := start(ctx, "Query", query)
+
+	err := drv.Driver.Query(ctx, query, args, v)
+
+	end(span, err)
+
+	return err
+}
+
+func (drv *traceDriver) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, span := start(ctx, "Exec", query)
+
+	res, err := drv.Driver.ExecContext(ctx, query, args...)
+
+	end(span, err)
+
+	return res, err
+}
+
+func (drv *traceDriver) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	ctx, span := start(ctx, "Query", query)
+
+	rows, err := drv.Driver.QueryContext(ctx, query, args...)
+
+	end(span, err)
+
+	return rows, err
+}
+
+func (drv *traceDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return drv.BeginTx(ctx, nil)
+}
+
+func (drv *traceDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	ctx, span := start(ctx, "Begin", "BEGIN")
+
+	tx, err := drv.Driver.BeginTx(ctx, opts)
+
+	end(span, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &traceTx{
+		Tx: tx,
+	}, nil
+}
+
+// traceTx トランザクション内のクエリごとに子スパンを作成する.
+type traceTx struct {
+	dialect.Tx
+}
+
+func (tx *traceTx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := start(ctx, "Exec", query)
+
+	err := tx.Tx.Exec(ctx, query, args, v)
+
+	end(span, err)
+
+	return err
+}
+
+func (tx *traceTx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := start(ctx, "Query", query)
+
+	err := tx.Tx.Query(ctx, query, args, v)
+
+	end(span, err)
+
+	return err
+}
+
+func start(ctx context.Context, operation string, query string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(
+		ctx,
+		fmt.Sprintf("postgres.%s", operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBStatement(query),
+		),
+	)
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
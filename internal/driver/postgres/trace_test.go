@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// グローバルなトレーサーを登録するため並列に実行しない.
+func TestTraceDriver(t *testing.T) { //nolint:paralleltest
+	spans := tracetest.NewSpanRecorder()
+
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)))
+
+	drv, err := entsql.Open(dialect.SQLite, "file:trace?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	td := newTraceDriver(drv)
+	defer td.Close()
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "parent")
+
+	if err := td.Exec(ctx, "CREATE TABLE t (id INTEGER)", []any{}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := td.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Exec(ctx, "INSERT INTO t (id) VALUES (?)", []any{1}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := td.QueryContext(ctx, "SELECT missing FROM t"); err == nil {
+		t.Fatal("QueryContext() error = nil, want error")
+	}
+
+	parent.End()
+
+	want := []string{"postgres.Exec", "postgres.Begin", "postgres.Exec", "postgres.Query"}
+
+	ended := spans.Ended()
+	if len(ended) != len(want)+1 {
+		t.Fatalf("spans = %d, want %d", len(ended), len(want)+1)
+	}
+
+	for i, name := range want {
+		if ended[i].Name() != name {
+			t.Errorf("#%d span name = %s, want %s", i, ended[i].Name(), name)
+		}
+
+		if ended[i].Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("#%d span is not a child of the request span", i)
+		}
+	}
+
+	if got := ended[3].Status().Code.String(); got != "Error" {
+		t.Errorf("status = %s, want Error", got)
+	}
+}
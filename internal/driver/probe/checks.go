@@ -0,0 +1,96 @@
+package probe
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/rpc"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// NamePostgres Postgresの確認名.
+	NamePostgres = "postgres"
+	// NameSQLite SQLiteの確認名.
+	NameSQLite = "sqlite3"
+	// NameRedis Redisの確認名.
+	NameRedis = "redis"
+	// NameCore コアサーバーの確認名.
+	NameCore = "core"
+	// NameFirebase Firebaseの確認名.
+	NameFirebase = "firebase"
+)
+
+const (
+	rdbTimeout      = 2 * time.Second
+	redisTimeout    = time.Second
+	coreTimeout     = 3 * time.Second
+	firebaseTimeout = 3 * time.Second
+)
+
+// Postgres コネクションプールからPingする.
+func Postgres(db *sql.DB) Check {
+	return RDB(NamePostgres, db)
+}
+
+// RDB 接続先のデータベースの種類を確認名としてコネクションプールからPingする.
+// 確認名はentのdialectと同じ値のため、NamePostgresまたはNameSQLiteになる.
+func RDB(name string, db *sql.DB) Check {
+	return Check{
+		Name:    name,
+		Timeout: rdbTimeout,
+		Probe:   db.PingContext,
+	}
+}
+
+// Redis PINGコマンドを送信する.
+func Redis(client redis.UniversalClient) Check {
+	return Check{
+		Name:    NameRedis,
+		Timeout: redisTimeout,
+		Probe: func(ctx context.Context) error {
+			return client.Ping(ctx).Err()
+		},
+	}
+}
+
+// Core コアサーバーのヘルスチェックを呼び出す.
+func Core(health rpc.Health) Check {
+	return Check{
+		Name:    NameCore,
+		Timeout: coreTimeout,
+		Probe:   health.Check,
+	}
+}
+
+// Firebase エンドポイントへの疎通を確認する.
+// 移行中のフォールバックとしてのみ用いる場合はoptionalとし、失敗しても準備完了とみなす.
+func Firebase(endpoint string, optional bool) Check {
+	return Check{
+		Name:     NameFirebase,
+		Timeout:  firebaseTimeout,
+		Optional: optional,
+		Probe: func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+			if err != nil {
+				return err
+			}
+
+			res, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return err
+			}
+			defer res.Body.Close()
+
+			// 疎通のみを確認するため、4xxは利用可能とみなす
+			if res.StatusCode >= http.StatusInternalServerError {
+				return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package probe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+)
+
+var _ grpchealth.Checker = (*GRPC)(nil)
+
+// GRPC サービスごとに依存先を確認するgRPCヘルスチェック.
+type GRPC struct {
+	checker *Checker
+	// services サービス名と依存先の名前の対応.
+	services map[string][]string
+}
+
+// NewGRPC サービスごとの依存先を指定してgRPCヘルスチェックを作成する.
+// サービス名を空とした問い合わせは全ての依存先を確認する.
+func (chk *Checker) NewGRPC(services map[string][]string) *GRPC {
+	return &GRPC{
+		checker:  chk,
+		services: services,
+	}
+}
+
+func (g *GRPC) Check(ctx context.Context, req *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	var report Report
+
+	if req.Service == "" {
+		report = g.checker.Check(ctx)
+	} else {
+		names, ok := g.services[req.Service]
+		if !ok {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("unknown service %s", req.Service))
+		}
+
+		report = g.checker.CheckNames(ctx, names...)
+	}
+
+	status := grpchealth.StatusServing
+	if report.Status == StatusDown {
+		status = grpchealth.StatusNotServing
+	}
+
+	return &grpchealth.CheckResponse{
+		Status: status,
+	}, nil
+}
@@ -0,0 +1,216 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/pkg/log"
+	"go.uber.org/zap"
+)
+
+// Status 依存先の状態.
+type Status string
+
+const (
+	// StatusUp 依存先が利用可能.
+	StatusUp Status = "up"
+	// StatusDown 依存先が利用不可.
+	StatusDown Status = "down"
+)
+
+// Check 依存先の確認方法.
+type Check struct {
+	Name    string
+	Timeout time.Duration
+	// Optional 失敗してもサーバー全体は準備完了とみなす.
+	Optional bool
+	Probe    func(context.Context) error
+}
+
+// cacheTTL 確認結果を再利用する期間.
+// 認証なしで問い合わせられるため、問い合わせのたびに依存先へ接続しないよう短い期間は結果を使い回す.
+const cacheTTL = 5 * time.Second
+
+// Result 依存先の確認結果.
+// 応答には接続先などの内部情報を含めないよう、エラーの内容は返さずにログに出力する.
+type Result struct {
+	Name      string `json:"name"`
+	Status    Status `json:"status"`
+	Optional  bool   `json:"optional,omitempty"`
+	LatencyMS int64  `json:"latencyMs"`
+	Error     string `json:"-"`
+}
+
+// Report 依存先の確認結果の一覧.
+type Report struct {
+	Status Status   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// Checker 依存先の状態を確認する.
+type Checker struct {
+	checks    []Check
+	mu        sync.Mutex
+	cached    Report
+	expiresAt time.Time
+}
+
+func New(checks ...Check) *Checker {
+	return &Checker{
+		checks: checks,
+	}
+}
+
+// Check 全ての依存先を並行に確認する.
+// 確認結果はcacheTTLの間は再利用し、同時に問い合わせられた場合も依存先へは1度だけ接続する.
+func (chk *Checker) Check(ctx context.Context) Report {
+	chk.mu.Lock()
+	defer chk.mu.Unlock()
+
+	if time.Now().Before(chk.expiresAt) {
+		return chk.cached
+	}
+
+	report := chk.check(ctx, chk.checks)
+
+	for _, result := range report.Checks {
+		if result.Status == StatusDown {
+			log.GetLogCtx(ctx).Warn(
+				"dependency is down",
+				zap.String("name", result.Name),
+				zap.String("error", result.Error),
+				zap.Int64("latency(ms)", result.LatencyMS),
+			)
+		}
+	}
+
+	// 問い合わせ元の切断による失敗は依存先の状態ではないため再利用しない
+	if ctx.Err() == nil {
+		chk.cached = report
+		chk.expiresAt = time.Now().Add(cacheTTL)
+	}
+
+	return report
+}
+
+// CheckNames 指定した依存先のみの確認結果を返す.
+// 問い合わせのたびに依存先へ接続しないよう、Checkの確認結果から抽出する.
+func (chk *Checker) CheckNames(ctx context.Context, names ...string) Report {
+	if len(names) == 0 {
+		return summarize([]Result{})
+	}
+
+	report := chk.Check(ctx)
+
+	results := make([]Result, 0, len(names))
+
+	for _, r := range report.Checks {
+		for _, name := range names {
+			if r.Name == name {
+				results = append(results, r)
+			}
+		}
+	}
+
+	return summarize(results)
+}
+
+func (chk *Checker) check(ctx context.Context, checks []Check) Report {
+	results := make([]Result, len(checks))
+
+	var wg sync.WaitGroup
+
+	for i, c := range checks {
+		wg.Add(1)
+
+		go func(i int, c Check) {
+			defer wg.Done()
+
+			results[i] = run(ctx, c)
+		}(i, c)
+	}
+
+	wg.Wait()
+
+	return summarize(results)
+}
+
+// summarize 確認結果の一覧から全体の状態を決定する.
+func summarize(results []Result) Report {
+	status := StatusUp
+
+	for _, r := range results {
+		if r.Status == StatusDown && !r.Optional {
+			status = StatusDown
+		}
+	}
+
+	return Report{
+		Status: status,
+		Checks: results,
+	}
+}
+
+func run(ctx context.Context, c Check) Result {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+
+		defer cancel()
+	}
+
+	now := time.Now()
+
+	err := c.Probe(ctx)
+
+	result := Result{
+		Name:      c.Name,
+		Status:    StatusUp,
+		Optional:  c.Optional,
+		LatencyMS: time.Since(now).Milliseconds(),
+	}
+
+	if err != nil {
+		result.Status = StatusDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// Livez プロセスが応答可能であることのみを返すハンドラー.
+// 依存先の障害で再起動されないよう、依存先は確認しない.
+func (chk *Checker) Livez() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		write(w, http.StatusOK, Report{
+			Status: StatusUp,
+			Checks: []Result{},
+		})
+	})
+}
+
+// Readyz 依存先を確認し、いずれかが利用不可の場合は503を返すハンドラー.
+func (chk *Checker) Readyz() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := chk.Check(r.Context())
+
+		code := http.StatusOK
+		if report.Status == StatusDown {
+			code = http.StatusServiceUnavailable
+		}
+
+		write(w, code, report)
+	})
+}
+
+func write(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+
+	_ = json.NewEncoder(w).Encode(report)
+}
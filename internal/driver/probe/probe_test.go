@@ -0,0 +1,255 @@
+package probe_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bufbuild/connect-go"
+	grpchealth "github.com/bufbuild/connect-grpchealth-go"
+	"github.com/morning-night-guild/platform-app/internal/driver/probe"
+)
+
+func up(name string) probe.Check {
+	return probe.Check{
+		Name:  name,
+		Probe: func(context.Context) error { return nil },
+	}
+}
+
+func down(name string, optional bool) probe.Check {
+	return probe.Check{
+		Name:     name,
+		Optional: optional,
+		Probe:    func(context.Context) error { return errors.New("connection refused") },
+	}
+}
+
+func TestCheckerCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		checks []probe.Check
+		want   probe.Status
+		states []probe.Status
+	}{
+		{
+			name:   "全ての依存先が利用可能な場合はupとなる",
+			checks: []probe.Check{up("postgres"), up("redis")},
+			want:   probe.StatusUp,
+			states: []probe.Status{probe.StatusUp, probe.StatusUp},
+		},
+		{
+			name:   "依存先が利用不可の場合はdownとなる",
+			checks: []probe.Check{up("postgres"), down("redis", false)},
+			want:   probe.StatusDown,
+			states: []probe.Status{probe.StatusUp, probe.StatusDown},
+		},
+		{
+			name:   "任意の依存先が利用不可でもupとなる",
+			checks: []probe.Check{up("postgres"), down("firebase", true)},
+			want:   probe.StatusUp,
+			states: []probe.Status{probe.StatusUp, probe.StatusDown},
+		},
+		{
+			name: "タイムアウトした依存先はdownとなる",
+			checks: []probe.Check{{
+				Name:    "core",
+				Timeout: 10 * time.Millisecond,
+				Probe: func(ctx context.Context) error {
+					<-ctx.Done()
+
+					return ctx.Err()
+				},
+			}},
+			want:   probe.StatusDown,
+			states: []probe.Status{probe.StatusDown},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := probe.New(tt.checks...).Check(context.Background())
+			if got.Status != tt.want {
+				t.Errorf("Check() status = %v, want %v", got.Status, tt.want)
+			}
+			for i, state := range tt.states {
+				if got.Checks[i].Name != tt.checks[i].Name || got.Checks[i].Status != state {
+					t.Errorf("Check() checks[%d] = %+v, want %s %s", i, got.Checks[i], tt.checks[i].Name, state)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckerHandler(t *testing.T) {
+	t.Parallel()
+
+	checker := probe.New(up("postgres"), down("redis", false))
+
+	t.Run("livezは依存先に関わらず200となる", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		checker.Livez().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/livez", nil))
+		if rec.Code != http.StatusOK {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+		}
+	})
+
+	t.Run("readyzは依存先が利用不可の場合に503と確認結果を返す", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		checker.Readyz().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if rec.Code != http.StatusServiceUnavailable {
+			t.Errorf("status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+		}
+		var report probe.Report
+		if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+			t.Fatal(err)
+		}
+		if len(report.Checks) != 2 || report.Checks[1].Name != "redis" || report.Checks[1].Status != probe.StatusDown {
+			t.Errorf("report = %+v", report)
+		}
+	})
+
+	t.Run("readyzはエラーの内容を返さない", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		checker.Readyz().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if body := rec.Body.String(); strings.Contains(body, "connection refused") {
+			t.Errorf("body = %s", body)
+		}
+	})
+
+	t.Run("readyzは依存先ごとの所要時間を返す", func(t *testing.T) {
+		t.Parallel()
+		rec := httptest.NewRecorder()
+		checker.Readyz().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+		if body := rec.Body.String(); !strings.Contains(body, `"latencyMs":`) {
+			t.Errorf("body = %s", body)
+		}
+	})
+}
+
+func TestCheckerCache(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		ctx   func() context.Context
+		names []string
+		calls int32
+	}{
+		{
+			name:  "確認結果を一定期間は再利用する",
+			ctx:   context.Background,
+			calls: 1,
+		},
+		{
+			name: "問い合わせ元が切断した場合の確認結果は再利用しない",
+			ctx: func() context.Context {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx
+			},
+			calls: 3,
+		},
+		{
+			name:  "依存先を指定した確認でも確認結果を再利用する",
+			ctx:   context.Background,
+			names: []string{"postgres"},
+			calls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var calls int32
+			checker := probe.New(probe.Check{
+				Name: "postgres",
+				Probe: func(context.Context) error {
+					atomic.AddInt32(&calls, 1)
+					return nil
+				},
+			})
+			for i := 0; i < 3; i++ {
+				if tt.names != nil {
+					checker.CheckNames(tt.ctx(), tt.names...)
+				} else {
+					checker.Check(tt.ctx())
+				}
+			}
+			if got := atomic.LoadInt32(&calls); got != tt.calls {
+				t.Errorf("calls = %d, want %d", got, tt.calls)
+			}
+		})
+	}
+}
+
+func TestGRPCCheck(t *testing.T) {
+	t.Parallel()
+
+	checker := probe.New(down("postgres", false)).NewGRPC(map[string][]string{
+		"article.v1.ArticleService": {"postgres"},
+		"health.v1.HealthService":   {},
+	})
+
+	tests := []struct {
+		name     string
+		service  string
+		want     grpchealth.Status
+		wantCode connect.Code
+	}{
+		{
+			name:    "依存先が利用不可のサービスはNOT_SERVINGとなる",
+			service: "article.v1.ArticleService",
+			want:    grpchealth.StatusNotServing,
+		},
+		{
+			name:    "依存先のないサービスはSERVINGとなる",
+			service: "health.v1.HealthService",
+			want:    grpchealth.StatusServing,
+		},
+		{
+			name:    "サービスを指定しない場合は全ての依存先を確認する",
+			service: "",
+			want:    grpchealth.StatusNotServing,
+		},
+		{
+			name:     "未知のサービスはNotFoundとなる",
+			service:  "unknown.v1.UnknownService",
+			wantCode: connect.CodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := checker.Check(context.Background(), &grpchealth.CheckRequest{Service: tt.service})
+			if tt.wantCode != 0 {
+				if connect.CodeOf(err) != tt.wantCode {
+					t.Errorf("Check() error = %v, want %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Status != tt.want {
+				t.Errorf("Check() status = %v, want %v", got.Status, tt.want)
+			}
+		})
+	}
+}
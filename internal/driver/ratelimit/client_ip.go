@@ -0,0 +1,52 @@
+package ratelimit
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ParseTrustedProxyHops 信頼するプロキシの段数を解析する.
+// 指定がない場合はプロキシを経由しないものとして0を返す.
+func ParseTrustedProxyHops(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	hops, err := strconv.Atoi(value)
+	if err != nil || hops < 0 {
+		return 0, fmt.Errorf("invalid trusted proxy hops: %s", value)
+	}
+
+	return hops, nil
+}
+
+// ClientIP リクエスト元のIPアドレスを取得する.
+// プロキシは接続元のアドレスをX-Forwarded-Forの末尾に追加するため、末尾からhops番目の値をクライアントのアドレスとする.
+// それより前の値はクライアントが偽装できるため参照しない.
+// hopsが0の場合やX-Forwarded-Forの値が段数に満たない場合は接続元のアドレスを用いる.
+func ClientIP(remoteAddr string, forwardedFor []string, hops int) string {
+	if hops > 0 {
+		addrs := make([]string, 0, len(forwardedFor))
+
+		for _, value := range forwardedFor {
+			for _, addr := range strings.Split(value, ",") {
+				addrs = append(addrs, strings.TrimSpace(addr))
+			}
+		}
+
+		if len(addrs) >= hops {
+			if ip := net.ParseIP(addrs[len(addrs)-hops]); ip != nil {
+				return ip.String()
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+
+	return host
+}
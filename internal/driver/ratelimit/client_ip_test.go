@@ -0,0 +1,130 @@
+package ratelimit_test
+
+import (
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
+)
+
+func TestParseTrustedProxyHops(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{
+			name:    "指定がない場合は0となる",
+			value:   "",
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name:    "段数を解析できる",
+			value:   "2",
+			want:    2,
+			wantErr: false,
+		},
+		{
+			name:    "負の値は解析できない",
+			value:   "-1",
+			want:    0,
+			wantErr: true,
+		},
+		{
+			name:    "数値以外は解析できない",
+			value:   "one",
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ratelimit.ParseTrustedProxyHops(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseTrustedProxyHops() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseTrustedProxyHops() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		remoteAddr   string
+		forwardedFor []string
+		hops         int
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "プロキシを経由しない場合は接続元のアドレスとなる",
+			args: args{
+				remoteAddr:   "192.0.2.1:1234",
+				forwardedFor: []string{"198.51.100.1"},
+				hops:         0,
+			},
+			want: "192.0.2.1",
+		},
+		{
+			name: "ロードバランサが追加した末尾の値をクライアントのアドレスとする",
+			args: args{
+				remoteAddr:   "10.0.0.1:1234",
+				forwardedFor: []string{"203.0.113.1, 198.51.100.1"},
+				hops:         1,
+			},
+			want: "198.51.100.1",
+		},
+		{
+			name: "複数のプロキシを経由する場合は末尾から段数番目の値とする",
+			args: args{
+				remoteAddr:   "10.0.0.1:1234",
+				forwardedFor: []string{"203.0.113.1", "198.51.100.1, 10.0.0.2"},
+				hops:         2,
+			},
+			want: "198.51.100.1",
+		},
+		{
+			name: "値が段数に満たない場合は接続元のアドレスとなる",
+			args: args{
+				remoteAddr:   "10.0.0.1:1234",
+				forwardedFor: []string{"198.51.100.1"},
+				hops:         2,
+			},
+			want: "10.0.0.1",
+		},
+		{
+			name: "IPアドレスでない値は用いない",
+			args: args{
+				remoteAddr:   "10.0.0.1:1234",
+				forwardedFor: []string{"unknown"},
+				hops:         1,
+			},
+			want: "10.0.0.1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ratelimit.ClientIP(tt.args.remoteAddr, tt.args.forwardedFor, tt.args.hops); got != tt.want {
+				t.Errorf("ClientIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+)
+
+// Policies ルートごとのレート制限の上限.
+// ルートはAPIサーバーでは"METHOD パターン"(例: POST /api/v1/auth/signin)、コアサーバーではプロシージャ名とする.
+type Policies struct {
+	Default cache.Limit
+	Routes  map[string]cache.Limit
+}
+
+// Of ルートに適用する上限を取得する.
+func (pls Policies) Of(route string) cache.Limit {
+	if limit, ok := pls.Routes[route]; ok {
+		return limit
+	}
+
+	return pls.Default
+}
+
+type limit struct {
+	Rate   int    `json:"rate"`
+	Period string `json:"period"`
+	Burst  int    `json:"burst"`
+}
+
+func (lmt limit) toLimit() (cache.Limit, error) {
+	period, err := time.ParseDuration(lmt.Period)
+	if err != nil {
+		return cache.Limit{}, fmt.Errorf("invalid rate limit period: %w", err)
+	}
+
+	burst := lmt.Burst
+	if burst <= 0 {
+		burst = lmt.Rate
+	}
+
+	return cache.Limit{
+		Rate:   lmt.Rate,
+		Period: period,
+		Burst:  burst,
+	}, nil
+}
+
+type policies struct {
+	Default *limit           `json:"default"`
+	Routes  map[string]limit `json:"routes"`
+}
+
+// Parse JSONで指定された上限を解析し、既定の上限に上書きする.
+// 例: {"default":{"rate":600,"period":"1m","burst":100},"routes":{"POST /api/v1/auth/signin":{"rate":10,"period":"1m"}}}
+// rateを0とするとそのルートには上限を設けない.
+func Parse(value string, base Policies) (Policies, error) {
+	res := Policies{
+		Default: base.Default,
+		Routes:  make(map[string]cache.Limit, len(base.Routes)),
+	}
+
+	for route, limit := range base.Routes {
+		res.Routes[route] = limit
+	}
+
+	if value == "" {
+		return res, nil
+	}
+
+	var pls policies
+
+	if err := json.Unmarshal([]byte(value), &pls); err != nil {
+		return Policies{}, fmt.Errorf("failed to unmarshal rate limit policies: %w", err)
+	}
+
+	if pls.Default != nil {
+		def, err := pls.Default.toLimit()
+		if err != nil {
+			return Policies{}, err
+		}
+
+		res.Default = def
+	}
+
+	for route, lmt := range pls.Routes {
+		limit, err := lmt.toLimit()
+		if err != nil {
+			return Policies{}, fmt.Errorf("%s: %w", route, err)
+		}
+
+		res.Routes[route] = limit
+	}
+
+	return res, nil
+}
+
+// DefaultAPIPolicies APIサーバーの既定の上限.
+// 認証に関わるルートは総当たりを防ぐため厳しく制限する.
+func DefaultAPIPolicies() Policies {
+	strict := cache.Limit{Rate: 10, Period: time.Minute, Burst: 10}
+
+	return Policies{
+		Default: cache.Limit{Rate: 600, Period: time.Minute, Burst: 100},
+		Routes: map[string]cache.Limit{
+			"POST /api/v1/auth/signin":       strict,
+			"POST /api/v1/auth/signup":       strict,
+			"POST /api/v1/auth/join":         strict,
+			"POST /api/v1/auth/invite":       strict,
+			"PUT /api/v1/auth/password":      strict,
+			"PUT /api/v1/auth/email":         strict,
+			"POST /api/v1/auth/email/verify": strict,
+		},
+	}
+}
+
+// DefaultCorePolicies コアサーバーの既定の上限.
+// コアサーバーはAPIサーバーからのみ呼び出されるため、APIサーバーより緩く制限する.
+func DefaultCorePolicies() Policies {
+	return Policies{
+		Default: cache.Limit{Rate: 6000, Period: time.Minute, Burst: 1000},
+		Routes:  map[string]cache.Limit{},
+	}
+}
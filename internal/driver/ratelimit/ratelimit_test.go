@@ -0,0 +1,86 @@
+package ratelimit_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/morning-night-guild/platform-app/internal/domain/cache"
+	"github.com/morning-night-guild/platform-app/internal/driver/ratelimit"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	base := ratelimit.Policies{
+		Default: cache.Limit{Rate: 100, Period: time.Minute, Burst: 10},
+		Routes: map[string]cache.Limit{
+			"POST /api/v1/auth/signin": {Rate: 10, Period: time.Minute, Burst: 10},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		want    ratelimit.Policies
+		wantErr bool
+	}{
+		{
+			name:    "指定がない場合は既定の上限となる",
+			value:   "",
+			want:    base,
+			wantErr: false,
+		},
+		{
+			name:  "既定の上限とルートの上限を上書きできる",
+			value: `{"default":{"rate":60,"period":"1s","burst":5},"routes":{"GET /api/v1/articles":{"rate":0,"period":"1m"}}}`,
+			want: ratelimit.Policies{
+				Default: cache.Limit{Rate: 60, Period: time.Second, Burst: 5},
+				Routes: map[string]cache.Limit{
+					"POST /api/v1/auth/signin": {Rate: 10, Period: time.Minute, Burst: 10},
+					"GET /api/v1/articles":     {Rate: 0, Period: time.Minute, Burst: 0},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:  "バースト数の指定がない場合はrateと同じとなる",
+			value: `{"routes":{"POST /api/v1/auth/signin":{"rate":3,"period":"1m"}}}`,
+			want: ratelimit.Policies{
+				Default: base.Default,
+				Routes: map[string]cache.Limit{
+					"POST /api/v1/auth/signin": {Rate: 3, Period: time.Minute, Burst: 3},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "JSONでない場合はエラーとなる",
+			value:   "rate=10",
+			want:    ratelimit.Policies{},
+			wantErr: true,
+		},
+		{
+			name:    "periodが不正な場合はエラーとなる",
+			value:   `{"default":{"rate":60,"period":"minute"}}`,
+			want:    ratelimit.Policies{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ratelimit.Parse(tt.value, base)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
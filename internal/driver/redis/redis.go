@@ -2,51 +2,208 @@ package redis
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/morning-night-guild/platform-app/internal/adapter/kvs"
-	"github.com/morning-night-guild/platform-app/internal/driver/env"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
 var _ kvs.Factory[any] = (*Redis[any])(nil)
 
-type Redis[T any] struct{}
+type Redis[T any] struct {
+	codec kvs.Codec
+}
 
 func New[T any]() *Redis[T] {
 	return &Redis[T]{}
 }
 
+// WithCodec 値のエンコード方式を指定する.
+func (rds *Redis[T]) WithCodec(codec kvs.Codec) *Redis[T] {
+	rds.codec = codec
+
+	return rds
+}
+
 func (rds *Redis[T]) KVS(
 	prefix string,
-	client *redis.Client,
+	client redis.UniversalClient,
 ) (*kvs.KVS[T], error) {
 	return &kvs.KVS[T]{
 		Prefix: prefix,
 		Client: client,
+		Codec:  rds.codec,
 	}, nil
 }
 
-func NewRedis(url string) (*redis.Client, error) {
-	var opt *redis.Options
+func NewIndex(
+	prefix string,
+	client redis.UniversalClient,
+) (*kvs.Index, error) {
+	return &kvs.Index{
+		Prefix: prefix,
+		Client: client,
+	}, nil
+}
+
+func NewLimiter(
+	prefix string,
+	client redis.UniversalClient,
+) (*kvs.Limiter, error) {
+	return &kvs.Limiter{
+		Prefix: prefix,
+		Client: client,
+	}, nil
+}
+
+func NewLock(
+	prefix string,
+	client redis.UniversalClient,
+) (*kvs.Lock, error) {
+	return &kvs.Lock{
+		Prefix: prefix,
+		Client: client,
+	}, nil
+}
+
+// Mode Redisの構成.
+type Mode int
+
+const (
+	// ModeSingle 単一ノード.
+	ModeSingle Mode = iota
+	// ModeSentinel Sentinelによるフェイルオーバー構成.
+	ModeSentinel
+	// ModeCluster Redis Cluster.
+	ModeCluster
+)
+
+// Options 接続先のURLから解析したRedisの接続設定.
+type Options struct {
+	Mode      Mode
+	Universal redis.UniversalOptions
+}
+
+// ParseURL 接続先のURLを解析する.
+// 以下の形式に対応する.
+//   - host:port
+//   - redis://[user:password@]host:port[/db]
+//   - redis-sentinel://[user:password@]host:port[,host:port...][/db]?master=name[&sentinel_password=password]
+//   - redis-cluster://[user:password@]host:port[,host:port...]
+//
+// redis-sentinel, redis-clusterはスキームをrediss-sentinel, rediss-clusterとするとTLSで接続する.
+func ParseURL(value string) (Options, error) {
+	if !strings.Contains(value, "://") {
+		return Options{
+			Mode: ModeSingle,
+			Universal: redis.UniversalOptions{
+				Addrs: []string{value},
+			},
+		}, nil
+	}
+
+	scheme, _, _ := strings.Cut(value, "://")
+
+	switch scheme {
+	case "redis", "rediss":
+		opt, err := redis.ParseURL(value)
+		if err != nil {
+			return Options{}, fmt.Errorf("failed to parse redis url: %w", err)
+		}
+
+		return Options{
+			Mode: ModeSingle,
+			Universal: redis.UniversalOptions{
+				Addrs:     []string{opt.Addr},
+				Username:  opt.Username,
+				Password:  opt.Password,
+				DB:        opt.DB,
+				TLSConfig: opt.TLSConfig,
+			},
+		}, nil
+	case "redis-sentinel", "rediss-sentinel":
+		return parseNodesURL(value, ModeSentinel)
+	case "redis-cluster", "rediss-cluster":
+		return parseNodesURL(value, ModeCluster)
+	default:
+		return Options{}, fmt.Errorf("unknown redis url scheme: %s", scheme)
+	}
+}
+
+// parseNodesURL 複数のノードを指定するURLを解析する.
+func parseNodesURL(value string, mode Mode) (Options, error) {
+	u, err := url.Parse(value)
+	if err != nil {
+		return Options{}, fmt.Errorf("failed to parse redis url: %w", err)
+	}
 
-	if env.Get().IsProd() {
-		var err error
+	opt := redis.UniversalOptions{
+		Addrs: strings.Split(u.Host, ","),
+	}
+
+	if u.User != nil {
+		opt.Username = u.User.Username()
+		opt.Password, _ = u.User.Password()
+	}
 
-		opt, err = redis.ParseURL(url)
+	if strings.HasPrefix(u.Scheme, "rediss") {
+		opt.TLSConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	if db := strings.Trim(u.Path, "/"); db != "" {
+		if mode == ModeCluster {
+			return Options{}, fmt.Errorf("redis cluster does not support db: %s", db)
+		}
 
+		opt.DB, err = strconv.Atoi(db)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse redis url: %w", err)
+			return Options{}, fmt.Errorf("invalid redis db: %w", err)
 		}
-	} else {
-		opt = &redis.Options{
-			Addr:     url,
-			Password: "",
-			DB:       0,
+	}
+
+	if mode == ModeSentinel {
+		opt.MasterName = u.Query().Get("master")
+		if opt.MasterName == "" {
+			return Options{}, fmt.Errorf("redis sentinel url requires master: %s", u.Redacted())
 		}
+
+		opt.SentinelPassword = u.Query().Get("sentinel_password")
+	}
+
+	return Options{
+		Mode:      mode,
+		Universal: opt,
+	}, nil
+}
+
+func NewRedis(value string) (redis.UniversalClient, error) {
+	opts, err := ParseURL(value)
+	if err != nil {
+		return nil, err
 	}
 
-	client := redis.NewClient(opt)
+	var client redis.UniversalClient
+
+	switch opts.Mode {
+	case ModeSentinel:
+		client = redis.NewFailoverClient(opts.Universal.Failover())
+	case ModeCluster:
+		client = redis.NewClusterClient(opts.Universal.Cluster())
+	case ModeSingle:
+		client = redis.NewClient(opts.Universal.Simple())
+	}
+
+	// コマンドごとに子スパンを作成する. トレーサーが登録されていない場合は何もしない
+	if err := redisotel.InstrumentTracing(client); err != nil {
+		return nil, err
+	}
 
 	if err := client.Ping(context.Background()).Err(); err != nil {
 		return nil, err
@@ -42,15 +42,25 @@ func NewServer(
 
 // Run.
 func (srv *Server) Run() {
-	log.Log().Sugar().Infof("server running on %s", srv.Addr)
+	Run(srv)
+}
 
-	go func() {
-		if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
-			log.Log().Sugar().Errorf("server closed with error: %s", err.Error())
+// Run 複数のサーバーを起動し、終了のシグナルを受け取るとすべて停止する.
+// 公開するサーバーとメトリクスなどの内部向けのサーバーを同じプロセスで提供するために用いる.
+func Run(servers ...*Server) {
+	for _, srv := range servers {
+		srv := srv
 
-			os.Exit(1)
-		}
-	}()
+		log.Log().Sugar().Infof("server running on %s", srv.Addr)
+
+		go func() {
+			if err := srv.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				log.Log().Sugar().Errorf("server closed with error: %s", err.Error())
+
+				os.Exit(1)
+			}
+		}()
+	}
 
 	quit := make(chan os.Signal, 1)
 
@@ -62,8 +72,10 @@ func (srv *Server) Run() {
 
 	defer cancel()
 
-	if err := srv.Shutdown(ctx); err != nil {
-		log.Log().Sugar().Infof("failed to gracefully shutdown:", err)
+	for _, srv := range servers {
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Log().Sugar().Infof("failed to gracefully shutdown:", err)
+		}
 	}
 
 	log.Log().Info("server shutdown")
@@ -0,0 +1,112 @@
+// Package sqlite はSQLiteをRDBとして用いるファクトリ.
+// 外部のデータベースなしにコアサーバーを単一のバイナリで動かすローカル利用やテストを想定する.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	// sqlite driver.
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/morning-night-guild/platform-app/internal/adapter/gateway"
+	"github.com/morning-night-guild/platform-app/pkg/ent"
+	// 既定値やインターセプターを登録する.
+	_ "github.com/morning-night-guild/platform-app/pkg/ent/runtime"
+)
+
+// memory インメモリのデータベースを指定するパス.
+const memory = ":memory:"
+
+// defaultParams 指定がない場合に付与する接続パラメータ.
+// 外部キー制約はSQLiteの既定では無効のため、カスケード削除のために有効にする.
+var defaultParams = map[string]string{
+	"_fk":           "1",
+	"_busy_timeout": "5000",
+}
+
+// ErrInvalidDSN SQLiteのDSNとして解釈できない.
+var ErrInvalidDSN = errors.New("invalid sqlite dsn")
+
+var _ gateway.RDBFactory = (*SQLite)(nil)
+
+type SQLite struct{}
+
+func New() *SQLite {
+	return &SQLite{}
+}
+
+// Of sqlite://path、sqlite3://path、file:pathの形式のDSNで接続する.
+// バージョン管理されたマイグレーションはPostgreSQL向けのため、entのスキーマから直接テーブルを作成する.
+func (s SQLite) Of(dsn string) (*gateway.RDB, error) {
+	source, err := DataSourceName(dsn)
+	if err != nil {
+		return &gateway.RDB{}, err
+	}
+
+	db, err := sql.Open(dialect.SQLite, source)
+	if err != nil {
+		return &gateway.RDB{}, err
+	}
+
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.SQLite, db)))
+
+	if err := client.Schema.Create(context.Background()); err != nil {
+		// 呼び出し元は失敗時に接続を閉じないため、ここで閉じる
+		db.Close()
+
+		return &gateway.RDB{}, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return &gateway.RDB{
+		Client:  client,
+		DB:      db,
+		Dialect: dialect.SQLite,
+	}, nil
+}
+
+// DataSourceName DSNをgo-sqlite3のデータソース名に変換する.
+// sqlite://:memory:はコネクション間で共有するインメモリのデータベースになる.
+func DataSourceName(dsn string) (string, error) {
+	var path, query string
+
+	switch {
+	case strings.HasPrefix(dsn, "sqlite://"):
+		path, query, _ = strings.Cut(strings.TrimPrefix(dsn, "sqlite://"), "?")
+	case strings.HasPrefix(dsn, "sqlite3://"):
+		path, query, _ = strings.Cut(strings.TrimPrefix(dsn, "sqlite3://"), "?")
+	case strings.HasPrefix(dsn, "file:"):
+		path, query, _ = strings.Cut(strings.TrimPrefix(dsn, "file:"), "?")
+	default:
+		return "", fmt.Errorf("%w: unsupported scheme %s", ErrInvalidDSN, dsn)
+	}
+
+	if path == "" {
+		return "", fmt.Errorf("%w: no path %s", ErrInvalidDSN, dsn)
+	}
+
+	params, err := url.ParseQuery(query)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidDSN, err.Error())
+	}
+
+	if path == memory {
+		path = "memory"
+
+		params.Set("mode", "memory")
+		params.Set("cache", "shared")
+	}
+
+	for key, value := range defaultParams {
+		if !params.Has(key) {
+			params.Set(key, value)
+		}
+	}
+
+	return fmt.Sprintf("file:%s?%s", path, params.Encode()), nil
+}
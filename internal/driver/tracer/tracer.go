@@ -0,0 +1,91 @@
+package tracer
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+const (
+	// ExporterNone スパンを送信しない.
+	ExporterNone = "none"
+	// ExporterStdout スパンを標準出力に書き出す. テストやローカルでの確認に用いる.
+	ExporterStdout = "stdout"
+	// ExporterOTLP スパンをOTLP(gRPC)で送信する.
+	// 送信先はOTEL_EXPORTER_OTLP_ENDPOINTなどの標準の環境変数で指定する.
+	ExporterOTLP = "otlp"
+)
+
+// Tracer OpenTelemetryのトレーサー.
+type Tracer struct {
+	provider *sdktrace.TracerProvider
+}
+
+// New 設定に応じたエクスポーターでトレーサーを作成し、グローバルに登録する.
+// エクスポーターを指定しない場合もW3C Trace Contextの伝搬は行い、
+// 上流から伝搬されたトレースIDをログに用いる.
+func New(ctx context.Context, exporter string, service string) (*Tracer, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	var exp sdktrace.SpanExporter
+
+	switch exporter {
+	case "", ExporterNone:
+		return &Tracer{}, nil
+	case ExporterStdout:
+		e, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+		if err != nil {
+			return nil, fmt.Errorf("failed to create stdout exporter: %w", err)
+		}
+
+		exp = e
+	case ExporterOTLP:
+		e, err := otlptracegrpc.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+		}
+
+		exp = e
+	default:
+		return nil, fmt.Errorf("unknown trace exporter: %s", exporter)
+	}
+
+	return Of(exp, service), nil
+}
+
+// Of エクスポーターを指定してトレーサーを作成し、グローバルに登録する.
+func Of(exporter sdktrace.SpanExporter, service string) *Tracer {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(service),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return &Tracer{
+		provider: provider,
+	}
+}
+
+// Shutdown 未送信のスパンを送信して終了する.
+func (tr *Tracer) Shutdown(ctx context.Context) error {
+	if tr.provider == nil {
+		return nil
+	}
+
+	return tr.provider.Shutdown(ctx)
+}
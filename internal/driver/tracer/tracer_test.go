@@ -0,0 +1,109 @@
+package tracer_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/morning-night-guild/platform-app/internal/driver/tracer"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		exporter string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{
+			name: "エクスポーターを指定しない場合はトレーサーを作成できる",
+			args: args{
+				exporter: "",
+			},
+			wantErr: false,
+		},
+		{
+			name: "noneを指定した場合はトレーサーを作成できる",
+			args: args{
+				exporter: tracer.ExporterNone,
+			},
+			wantErr: false,
+		},
+		{
+			name: "stdoutを指定した場合はトレーサーを作成できる",
+			args: args{
+				exporter: tracer.ExporterStdout,
+			},
+			wantErr: false,
+		},
+		{
+			name: "未知のエクスポーターを指定した場合はエラーとなる",
+			args: args{
+				exporter: "jaeger",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tracer.New(context.Background(), tt.args.exporter, "test")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("New() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if err := got.Shutdown(context.Background()); err != nil {
+				t.Errorf("Shutdown() error = %v", err)
+			}
+		})
+	}
+}
+
+// グローバルなトレーサーを登録するため並列に実行しない.
+func TestOf(t *testing.T) { //nolint:paralleltest
+	exp := &exporter{}
+
+	tr := tracer.Of(exp, "test")
+
+	_, span := otel.Tracer("test").Start(context.Background(), "span")
+	span.End()
+
+	if err := tr.Shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exp.spans
+	if len(spans) != 1 {
+		t.Fatalf("spans = %d, want 1", len(spans))
+	}
+
+	if got, _ := spans[0].Resource().Set().Value("service.name"); got.AsString() != "test" {
+		t.Errorf("service.name = %v, want test", got.AsString())
+	}
+}
+
+// exporter 終了後もスパンを保持するエクスポーター.
+type exporter struct {
+	spans []sdktrace.ReadOnlySpan
+}
+
+func (exp *exporter) ExportSpans(_ context.Context, spans []sdktrace.ReadOnlySpan) error {
+	exp.spans = append(exp.spans, spans...)
+
+	return nil
+}
+
+func (exp *exporter) Shutdown(context.Context) error {
+	return nil
+}
@@ -42,6 +42,11 @@ const (
 	ArticleServiceListByUserProcedure = "/article.v1.ArticleService/ListByUser"
 	// ArticleServiceDeleteProcedure is the fully-qualified name of the ArticleService's Delete RPC.
 	ArticleServiceDeleteProcedure = "/article.v1.ArticleService/Delete"
+	// ArticleServiceListTrashProcedure is the fully-qualified name of the ArticleService's ListTrash
+	// RPC.
+	ArticleServiceListTrashProcedure = "/article.v1.ArticleService/ListTrash"
+	// ArticleServiceRestoreProcedure is the fully-qualified name of the ArticleService's Restore RPC.
+	ArticleServiceRestoreProcedure = "/article.v1.ArticleService/Restore"
 	// ArticleServiceAddToUserProcedure is the fully-qualified name of the ArticleService's AddToUser
 	// RPC.
 	ArticleServiceAddToUserProcedure = "/article.v1.ArticleService/AddToUser"
@@ -58,8 +63,12 @@ type ArticleServiceClient interface {
 	List(context.Context, *connect_go.Request[v1.ListRequest]) (*connect_go.Response[v1.ListResponse], error)
 	// 一覧取得(ユーザーごと)
 	ListByUser(context.Context, *connect_go.Request[v1.ListByUserRequest]) (*connect_go.Response[v1.ListByUserResponse], error)
-	// 削除
+	// 削除(ゴミ箱への移動)
 	Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error)
+	// ゴミ箱一覧
+	ListTrash(context.Context, *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error)
+	// ゴミ箱からの復元
+	Restore(context.Context, *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error)
 	// 追加
 	AddToUser(context.Context, *connect_go.Request[v1.AddToUserRequest]) (*connect_go.Response[v1.AddToUserResponse], error)
 	// 削除
@@ -96,6 +105,16 @@ func NewArticleServiceClient(httpClient connect_go.HTTPClient, baseURL string, o
 			baseURL+ArticleServiceDeleteProcedure,
 			opts...,
 		),
+		listTrash: connect_go.NewClient[v1.ListTrashRequest, v1.ListTrashResponse](
+			httpClient,
+			baseURL+ArticleServiceListTrashProcedure,
+			opts...,
+		),
+		restore: connect_go.NewClient[v1.RestoreRequest, v1.RestoreResponse](
+			httpClient,
+			baseURL+ArticleServiceRestoreProcedure,
+			opts...,
+		),
 		addToUser: connect_go.NewClient[v1.AddToUserRequest, v1.AddToUserResponse](
 			httpClient,
 			baseURL+ArticleServiceAddToUserProcedure,
@@ -115,6 +134,8 @@ type articleServiceClient struct {
 	list           *connect_go.Client[v1.ListRequest, v1.ListResponse]
 	listByUser     *connect_go.Client[v1.ListByUserRequest, v1.ListByUserResponse]
 	delete         *connect_go.Client[v1.DeleteRequest, v1.DeleteResponse]
+	listTrash      *connect_go.Client[v1.ListTrashRequest, v1.ListTrashResponse]
+	restore        *connect_go.Client[v1.RestoreRequest, v1.RestoreResponse]
 	addToUser      *connect_go.Client[v1.AddToUserRequest, v1.AddToUserResponse]
 	removeFromUser *connect_go.Client[v1.RemoveFromUserRequest, v1.RemoveFromUserResponse]
 }
@@ -139,6 +160,16 @@ func (c *articleServiceClient) Delete(ctx context.Context, req *connect_go.Reque
 	return c.delete.CallUnary(ctx, req)
 }
 
+// ListTrash calls article.v1.ArticleService.ListTrash.
+func (c *articleServiceClient) ListTrash(ctx context.Context, req *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error) {
+	return c.listTrash.CallUnary(ctx, req)
+}
+
+// Restore calls article.v1.ArticleService.Restore.
+func (c *articleServiceClient) Restore(ctx context.Context, req *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error) {
+	return c.restore.CallUnary(ctx, req)
+}
+
 // AddToUser calls article.v1.ArticleService.AddToUser.
 func (c *articleServiceClient) AddToUser(ctx context.Context, req *connect_go.Request[v1.AddToUserRequest]) (*connect_go.Response[v1.AddToUserResponse], error) {
 	return c.addToUser.CallUnary(ctx, req)
@@ -157,8 +188,12 @@ type ArticleServiceHandler interface {
 	List(context.Context, *connect_go.Request[v1.ListRequest]) (*connect_go.Response[v1.ListResponse], error)
 	// 一覧取得(ユーザーごと)
 	ListByUser(context.Context, *connect_go.Request[v1.ListByUserRequest]) (*connect_go.Response[v1.ListByUserResponse], error)
-	// 削除
+	// 削除(ゴミ箱への移動)
 	Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error)
+	// ゴミ箱一覧
+	ListTrash(context.Context, *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error)
+	// ゴミ箱からの復元
+	Restore(context.Context, *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error)
 	// 追加
 	AddToUser(context.Context, *connect_go.Request[v1.AddToUserRequest]) (*connect_go.Response[v1.AddToUserResponse], error)
 	// 削除
@@ -191,6 +226,16 @@ func NewArticleServiceHandler(svc ArticleServiceHandler, opts ...connect_go.Hand
 		svc.Delete,
 		opts...,
 	)
+	articleServiceListTrashHandler := connect_go.NewUnaryHandler(
+		ArticleServiceListTrashProcedure,
+		svc.ListTrash,
+		opts...,
+	)
+	articleServiceRestoreHandler := connect_go.NewUnaryHandler(
+		ArticleServiceRestoreProcedure,
+		svc.Restore,
+		opts...,
+	)
 	articleServiceAddToUserHandler := connect_go.NewUnaryHandler(
 		ArticleServiceAddToUserProcedure,
 		svc.AddToUser,
@@ -211,6 +256,10 @@ func NewArticleServiceHandler(svc ArticleServiceHandler, opts ...connect_go.Hand
 			articleServiceListByUserHandler.ServeHTTP(w, r)
 		case ArticleServiceDeleteProcedure:
 			articleServiceDeleteHandler.ServeHTTP(w, r)
+		case ArticleServiceListTrashProcedure:
+			articleServiceListTrashHandler.ServeHTTP(w, r)
+		case ArticleServiceRestoreProcedure:
+			articleServiceRestoreHandler.ServeHTTP(w, r)
 		case ArticleServiceAddToUserProcedure:
 			articleServiceAddToUserHandler.ServeHTTP(w, r)
 		case ArticleServiceRemoveFromUserProcedure:
@@ -240,6 +289,14 @@ func (UnimplementedArticleServiceHandler) Delete(context.Context, *connect_go.Re
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("article.v1.ArticleService.Delete is not implemented"))
 }
 
+func (UnimplementedArticleServiceHandler) ListTrash(context.Context, *connect_go.Request[v1.ListTrashRequest]) (*connect_go.Response[v1.ListTrashResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("article.v1.ArticleService.ListTrash is not implemented"))
+}
+
+func (UnimplementedArticleServiceHandler) Restore(context.Context, *connect_go.Request[v1.RestoreRequest]) (*connect_go.Response[v1.RestoreResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("article.v1.ArticleService.Restore is not implemented"))
+}
+
 func (UnimplementedArticleServiceHandler) AddToUser(context.Context, *connect_go.Request[v1.AddToUserRequest]) (*connect_go.Response[v1.AddToUserResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("article.v1.ArticleService.AddToUser is not implemented"))
 }
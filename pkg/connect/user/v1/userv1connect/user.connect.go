@@ -35,16 +35,24 @@ const (
 const (
 	// UserServiceCreateProcedure is the fully-qualified name of the UserService's Create RPC.
 	UserServiceCreateProcedure = "/user.v1.UserService/Create"
+	// UserServiceGetProcedure is the fully-qualified name of the UserService's Get RPC.
+	UserServiceGetProcedure = "/user.v1.UserService/Get"
 	// UserServiceUpdateProcedure is the fully-qualified name of the UserService's Update RPC.
 	UserServiceUpdateProcedure = "/user.v1.UserService/Update"
+	// UserServiceDeleteProcedure is the fully-qualified name of the UserService's Delete RPC.
+	UserServiceDeleteProcedure = "/user.v1.UserService/Delete"
 )
 
 // UserServiceClient is a client for the user.v1.UserService service.
 type UserServiceClient interface {
 	// 作成
 	Create(context.Context, *connect_go.Request[v1.CreateRequest]) (*connect_go.Response[v1.CreateResponse], error)
+	// 取得
+	Get(context.Context, *connect_go.Request[v1.GetRequest]) (*connect_go.Response[v1.GetResponse], error)
 	// 更新
 	Update(context.Context, *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error)
+	// 削除(猶予期間を過ぎた後に完全に削除する)
+	Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the user.v1.UserService service. By default, it uses
@@ -62,18 +70,30 @@ func NewUserServiceClient(httpClient connect_go.HTTPClient, baseURL string, opts
 			baseURL+UserServiceCreateProcedure,
 			opts...,
 		),
+		get: connect_go.NewClient[v1.GetRequest, v1.GetResponse](
+			httpClient,
+			baseURL+UserServiceGetProcedure,
+			opts...,
+		),
 		update: connect_go.NewClient[v1.UpdateRequest, v1.UpdateResponse](
 			httpClient,
 			baseURL+UserServiceUpdateProcedure,
 			opts...,
 		),
+		delete: connect_go.NewClient[v1.DeleteRequest, v1.DeleteResponse](
+			httpClient,
+			baseURL+UserServiceDeleteProcedure,
+			opts...,
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
 	create *connect_go.Client[v1.CreateRequest, v1.CreateResponse]
+	get    *connect_go.Client[v1.GetRequest, v1.GetResponse]
 	update *connect_go.Client[v1.UpdateRequest, v1.UpdateResponse]
+	delete *connect_go.Client[v1.DeleteRequest, v1.DeleteResponse]
 }
 
 // Create calls user.v1.UserService.Create.
@@ -81,17 +101,31 @@ func (c *userServiceClient) Create(ctx context.Context, req *connect_go.Request[
 	return c.create.CallUnary(ctx, req)
 }
 
+// Get calls user.v1.UserService.Get.
+func (c *userServiceClient) Get(ctx context.Context, req *connect_go.Request[v1.GetRequest]) (*connect_go.Response[v1.GetResponse], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
 // Update calls user.v1.UserService.Update.
 func (c *userServiceClient) Update(ctx context.Context, req *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error) {
 	return c.update.CallUnary(ctx, req)
 }
 
+// Delete calls user.v1.UserService.Delete.
+func (c *userServiceClient) Delete(ctx context.Context, req *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the user.v1.UserService service.
 type UserServiceHandler interface {
 	// 作成
 	Create(context.Context, *connect_go.Request[v1.CreateRequest]) (*connect_go.Response[v1.CreateResponse], error)
+	// 取得
+	Get(context.Context, *connect_go.Request[v1.GetRequest]) (*connect_go.Response[v1.GetResponse], error)
 	// 更新
 	Update(context.Context, *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error)
+	// 削除(猶予期間を過ぎた後に完全に削除する)
+	Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -105,17 +139,31 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect_go.HandlerOpt
 		svc.Create,
 		opts...,
 	)
+	userServiceGetHandler := connect_go.NewUnaryHandler(
+		UserServiceGetProcedure,
+		svc.Get,
+		opts...,
+	)
 	userServiceUpdateHandler := connect_go.NewUnaryHandler(
 		UserServiceUpdateProcedure,
 		svc.Update,
 		opts...,
 	)
+	userServiceDeleteHandler := connect_go.NewUnaryHandler(
+		UserServiceDeleteProcedure,
+		svc.Delete,
+		opts...,
+	)
 	return "/user.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceCreateProcedure:
 			userServiceCreateHandler.ServeHTTP(w, r)
+		case UserServiceGetProcedure:
+			userServiceGetHandler.ServeHTTP(w, r)
 		case UserServiceUpdateProcedure:
 			userServiceUpdateHandler.ServeHTTP(w, r)
+		case UserServiceDeleteProcedure:
+			userServiceDeleteHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -129,6 +177,14 @@ func (UnimplementedUserServiceHandler) Create(context.Context, *connect_go.Reque
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("user.v1.UserService.Create is not implemented"))
 }
 
+func (UnimplementedUserServiceHandler) Get(context.Context, *connect_go.Request[v1.GetRequest]) (*connect_go.Response[v1.GetResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("user.v1.UserService.Get is not implemented"))
+}
+
 func (UnimplementedUserServiceHandler) Update(context.Context, *connect_go.Request[v1.UpdateRequest]) (*connect_go.Response[v1.UpdateResponse], error) {
 	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("user.v1.UserService.Update is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) Delete(context.Context, *connect_go.Request[v1.DeleteRequest]) (*connect_go.Response[v1.DeleteResponse], error) {
+	return nil, connect_go.NewError(connect_go.CodeUnimplemented, errors.New("user.v1.UserService.Delete is not implemented"))
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/pkg/ent/article"
+	"github.com/morning-night-guild/platform-app/pkg/ent/user"
 )
 
 // Article is the model entity for the Article schema.
@@ -30,6 +31,10 @@ type Article struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// SharedBy holds the value of the "shared_by" field.
+	SharedBy *uuid.UUID `json:"shared_by,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArticleQuery when eager-loading is set.
 	Edges        ArticleEdges `json:"edges"`
@@ -42,9 +47,11 @@ type ArticleEdges struct {
 	Tags []*ArticleTag `json:"tags,omitempty"`
 	// UserArticles holds the value of the user_articles edge.
 	UserArticles []*UserArticle `json:"user_articles,omitempty"`
+	// Sharer holds the value of the sharer edge.
+	Sharer *User `json:"sharer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TagsOrErr returns the Tags value or an error if the edge
@@ -65,14 +72,29 @@ func (e ArticleEdges) UserArticlesOrErr() ([]*UserArticle, error) {
 	return nil, &NotLoadedError{edge: "user_articles"}
 }
 
+// SharerOrErr returns the Sharer value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleEdges) SharerOrErr() (*User, error) {
+	if e.loadedTypes[2] {
+		if e.Sharer == nil {
+			// Edge was loaded but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.Sharer, nil
+	}
+	return nil, &NotLoadedError{edge: "sharer"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Article) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case article.FieldSharedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case article.FieldTitle, article.FieldURL, article.FieldDescription, article.FieldThumbnail:
 			values[i] = new(sql.NullString)
-		case article.FieldCreatedAt, article.FieldUpdatedAt:
+		case article.FieldCreatedAt, article.FieldUpdatedAt, article.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case article.FieldID:
 			values[i] = new(uuid.UUID)
@@ -133,6 +155,20 @@ func (a *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.UpdatedAt = value.Time
 			}
+		case article.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				a.DeletedAt = new(time.Time)
+				*a.DeletedAt = value.Time
+			}
+		case article.FieldSharedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field shared_by", values[i])
+			} else if value.Valid {
+				a.SharedBy = new(uuid.UUID)
+				*a.SharedBy = *value.S.(*uuid.UUID)
+			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -156,6 +192,11 @@ func (a *Article) QueryUserArticles() *UserArticleQuery {
 	return NewArticleClient(a.config).QueryUserArticles(a)
 }
 
+// QuerySharer queries the "sharer" edge of the Article entity.
+func (a *Article) QuerySharer() *UserQuery {
+	return NewArticleClient(a.config).QuerySharer(a)
+}
+
 // Update returns a builder for updating this Article.
 // Note that you need to call Article.Unwrap() before calling this method if this Article
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -196,6 +237,16 @@ func (a *Article) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(a.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := a.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := a.SharedBy; v != nil {
+		builder.WriteString("shared_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
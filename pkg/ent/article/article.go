@@ -5,6 +5,7 @@ package article
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -27,10 +28,16 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldSharedBy holds the string denoting the shared_by field in the database.
+	FieldSharedBy = "shared_by"
 	// EdgeTags holds the string denoting the tags edge name in mutations.
 	EdgeTags = "tags"
 	// EdgeUserArticles holds the string denoting the user_articles edge name in mutations.
 	EdgeUserArticles = "user_articles"
+	// EdgeSharer holds the string denoting the sharer edge name in mutations.
+	EdgeSharer = "sharer"
 	// Table holds the table name of the article in the database.
 	Table = "articles"
 	// TagsTable is the table that holds the tags relation/edge.
@@ -47,6 +54,13 @@ const (
 	UserArticlesInverseTable = "user_articles"
 	// UserArticlesColumn is the table column denoting the user_articles relation/edge.
 	UserArticlesColumn = "article_id"
+	// SharerTable is the table that holds the sharer relation/edge.
+	SharerTable = "articles"
+	// SharerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	SharerInverseTable = "users"
+	// SharerColumn is the table column denoting the sharer relation/edge.
+	SharerColumn = "shared_by"
 )
 
 // Columns holds all SQL columns for article fields.
@@ -58,6 +72,8 @@ var Columns = []string{
 	FieldThumbnail,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldSharedBy,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -70,7 +86,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/morning-night-guild/platform-app/pkg/ent/runtime"
 var (
+	Interceptors [1]ent.Interceptor
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -119,6 +141,16 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// BySharedBy orders the results by the shared_by field.
+func BySharedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSharedBy, opts...).ToFunc()
+}
+
 // ByTagsCount orders the results by tags count.
 func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -146,6 +178,13 @@ func ByUserArticles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserArticlesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySharerField orders the results by sharer field.
+func BySharerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharerStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newTagsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -160,3 +199,10 @@ func newUserArticlesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UserArticlesTable, UserArticlesColumn),
 	)
 }
+func newSharerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SharerTable, SharerColumn),
+	)
+}
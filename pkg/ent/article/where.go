@@ -86,6 +86,16 @@ func UpdatedAt(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// SharedBy applies equality check predicate on the "shared_by" field. It's identical to SharedByEQ.
+func SharedBy(v uuid.UUID) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSharedBy, v))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldTitle, v))
@@ -426,6 +436,86 @@ func UpdatedAtLTE(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// SharedByEQ applies the EQ predicate on the "shared_by" field.
+func SharedByEQ(v uuid.UUID) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSharedBy, v))
+}
+
+// SharedByNEQ applies the NEQ predicate on the "shared_by" field.
+func SharedByNEQ(v uuid.UUID) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldSharedBy, v))
+}
+
+// SharedByIn applies the In predicate on the "shared_by" field.
+func SharedByIn(vs ...uuid.UUID) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldSharedBy, vs...))
+}
+
+// SharedByNotIn applies the NotIn predicate on the "shared_by" field.
+func SharedByNotIn(vs ...uuid.UUID) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldSharedBy, vs...))
+}
+
+// SharedByIsNil applies the IsNil predicate on the "shared_by" field.
+func SharedByIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldSharedBy))
+}
+
+// SharedByNotNil applies the NotNil predicate on the "shared_by" field.
+func SharedByNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldSharedBy))
+}
+
 // HasTags applies the HasEdge predicate on the "tags" edge.
 func HasTags() predicate.Article {
 	return predicate.Article(func(s *sql.Selector) {
@@ -472,6 +562,29 @@ func HasUserArticlesWith(preds ...predicate.UserArticle) predicate.Article {
 	})
 }
 
+// HasSharer applies the HasEdge predicate on the "sharer" edge.
+func HasSharer() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SharerTable, SharerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSharerWith applies the HasEdge predicate on the "sharer" edge with a given conditions (other predicates).
+func HasSharerWith(preds ...predicate.User) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newSharerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Article) predicate.Article {
 	return predicate.Article(func(s *sql.Selector) {
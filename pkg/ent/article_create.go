@@ -15,6 +15,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/morning-night-guild/platform-app/pkg/ent/article"
 	"github.com/morning-night-guild/platform-app/pkg/ent/articletag"
+	"github.com/morning-night-guild/platform-app/pkg/ent/user"
 	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
 )
 
@@ -78,6 +79,34 @@ func (ac *ArticleCreate) SetNillableUpdatedAt(t *time.Time) *ArticleCreate {
 	return ac
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (ac *ArticleCreate) SetDeletedAt(t time.Time) *ArticleCreate {
+	ac.mutation.SetDeletedAt(t)
+	return ac
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableDeletedAt(t *time.Time) *ArticleCreate {
+	if t != nil {
+		ac.SetDeletedAt(*t)
+	}
+	return ac
+}
+
+// SetSharedBy sets the "shared_by" field.
+func (ac *ArticleCreate) SetSharedBy(u uuid.UUID) *ArticleCreate {
+	ac.mutation.SetSharedBy(u)
+	return ac
+}
+
+// SetNillableSharedBy sets the "shared_by" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableSharedBy(u *uuid.UUID) *ArticleCreate {
+	if u != nil {
+		ac.SetSharedBy(*u)
+	}
+	return ac
+}
+
 // SetID sets the "id" field.
 func (ac *ArticleCreate) SetID(u uuid.UUID) *ArticleCreate {
 	ac.mutation.SetID(u)
@@ -122,6 +151,25 @@ func (ac *ArticleCreate) AddUserArticles(u ...*UserArticle) *ArticleCreate {
 	return ac.AddUserArticleIDs(ids...)
 }
 
+// SetSharerID sets the "sharer" edge to the User entity by ID.
+func (ac *ArticleCreate) SetSharerID(id uuid.UUID) *ArticleCreate {
+	ac.mutation.SetSharerID(id)
+	return ac
+}
+
+// SetNillableSharerID sets the "sharer" edge to the User entity by ID if the given value is not nil.
+func (ac *ArticleCreate) SetNillableSharerID(id *uuid.UUID) *ArticleCreate {
+	if id != nil {
+		ac = ac.SetSharerID(*id)
+	}
+	return ac
+}
+
+// SetSharer sets the "sharer" edge to the User entity.
+func (ac *ArticleCreate) SetSharer(u *User) *ArticleCreate {
+	return ac.SetSharerID(u.ID)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (ac *ArticleCreate) Mutation() *ArticleMutation {
 	return ac.mutation
@@ -185,12 +233,6 @@ func (ac *ArticleCreate) check() error {
 	if _, ok := ac.mutation.Thumbnail(); !ok {
 		return &ValidationError{Name: "thumbnail", err: errors.New(`ent: missing required field "Article.thumbnail"`)}
 	}
-	if _, ok := ac.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Article.created_at"`)}
-	}
-	if _, ok := ac.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Article.updated_at"`)}
-	}
 	return nil
 }
 
@@ -251,6 +293,10 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_spec.SetField(article.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := ac.mutation.DeletedAt(); ok {
+		_spec.SetField(article.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := ac.mutation.TagsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -283,6 +329,23 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := ac.mutation.SharerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.SharerTable,
+			Columns: []string{article.SharerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SharedBy = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -407,6 +470,42 @@ func (u *ArticleUpsert) UpdateUpdatedAt() *ArticleUpsert {
 	return u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ArticleUpsert) SetDeletedAt(v time.Time) *ArticleUpsert {
+	u.Set(article.FieldDeletedAt, v)
+	return u
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateDeletedAt() *ArticleUpsert {
+	u.SetExcluded(article.FieldDeletedAt)
+	return u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ArticleUpsert) ClearDeletedAt() *ArticleUpsert {
+	u.SetNull(article.FieldDeletedAt)
+	return u
+}
+
+// SetSharedBy sets the "shared_by" field.
+func (u *ArticleUpsert) SetSharedBy(v uuid.UUID) *ArticleUpsert {
+	u.Set(article.FieldSharedBy, v)
+	return u
+}
+
+// UpdateSharedBy sets the "shared_by" field to the value that was provided on create.
+func (u *ArticleUpsert) UpdateSharedBy() *ArticleUpsert {
+	u.SetExcluded(article.FieldSharedBy)
+	return u
+}
+
+// ClearSharedBy clears the value of the "shared_by" field.
+func (u *ArticleUpsert) ClearSharedBy() *ArticleUpsert {
+	u.SetNull(article.FieldSharedBy)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -539,6 +638,48 @@ func (u *ArticleUpsertOne) UpdateUpdatedAt() *ArticleUpsertOne {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ArticleUpsertOne) SetDeletedAt(v time.Time) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateDeletedAt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ArticleUpsertOne) ClearDeletedAt() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetSharedBy sets the "shared_by" field.
+func (u *ArticleUpsertOne) SetSharedBy(v uuid.UUID) *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetSharedBy(v)
+	})
+}
+
+// UpdateSharedBy sets the "shared_by" field to the value that was provided on create.
+func (u *ArticleUpsertOne) UpdateSharedBy() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateSharedBy()
+	})
+}
+
+// ClearSharedBy clears the value of the "shared_by" field.
+func (u *ArticleUpsertOne) ClearSharedBy() *ArticleUpsertOne {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearSharedBy()
+	})
+}
+
 // Exec executes the query.
 func (u *ArticleUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -834,6 +975,48 @@ func (u *ArticleUpsertBulk) UpdateUpdatedAt() *ArticleUpsertBulk {
 	})
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (u *ArticleUpsertBulk) SetDeletedAt(v time.Time) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetDeletedAt(v)
+	})
+}
+
+// UpdateDeletedAt sets the "deleted_at" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateDeletedAt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateDeletedAt()
+	})
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (u *ArticleUpsertBulk) ClearDeletedAt() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearDeletedAt()
+	})
+}
+
+// SetSharedBy sets the "shared_by" field.
+func (u *ArticleUpsertBulk) SetSharedBy(v uuid.UUID) *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.SetSharedBy(v)
+	})
+}
+
+// UpdateSharedBy sets the "shared_by" field to the value that was provided on create.
+func (u *ArticleUpsertBulk) UpdateSharedBy() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.UpdateSharedBy()
+	})
+}
+
+// ClearSharedBy clears the value of the "shared_by" field.
+func (u *ArticleUpsertBulk) ClearSharedBy() *ArticleUpsertBulk {
+	return u.Update(func(s *ArticleUpsert) {
+		s.ClearSharedBy()
+	})
+}
+
 // Exec executes the query.
 func (u *ArticleUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -16,6 +16,7 @@ import (
 	"github.com/morning-night-guild/platform-app/pkg/ent/article"
 	"github.com/morning-night-guild/platform-app/pkg/ent/articletag"
 	"github.com/morning-night-guild/platform-app/pkg/ent/predicate"
+	"github.com/morning-night-guild/platform-app/pkg/ent/user"
 	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
 )
 
@@ -28,6 +29,7 @@ type ArticleQuery struct {
 	predicates       []predicate.Article
 	withTags         *ArticleTagQuery
 	withUserArticles *UserArticleQuery
+	withSharer       *UserQuery
 	modifiers        []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -109,6 +111,28 @@ func (aq *ArticleQuery) QueryUserArticles() *UserArticleQuery {
 	return query
 }
 
+// QuerySharer chains the current query on the "sharer" edge.
+func (aq *ArticleQuery) QuerySharer() *UserQuery {
+	query := (&UserClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, article.SharerTable, article.SharerColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Article entity from the query.
 // Returns a *NotFoundError when no Article was found.
 func (aq *ArticleQuery) First(ctx context.Context) (*Article, error) {
@@ -303,6 +327,7 @@ func (aq *ArticleQuery) Clone() *ArticleQuery {
 		predicates:       append([]predicate.Article{}, aq.predicates...),
 		withTags:         aq.withTags.Clone(),
 		withUserArticles: aq.withUserArticles.Clone(),
+		withSharer:       aq.withSharer.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
@@ -331,6 +356,17 @@ func (aq *ArticleQuery) WithUserArticles(opts ...func(*UserArticleQuery)) *Artic
 	return aq
 }
 
+// WithSharer tells the query-builder to eager-load the nodes that are connected to
+// the "sharer" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithSharer(opts ...func(*UserQuery)) *ArticleQuery {
+	query := (&UserClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withSharer = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +445,10 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 	var (
 		nodes       = []*Article{}
 		_spec       = aq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			aq.withTags != nil,
 			aq.withUserArticles != nil,
+			aq.withSharer != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -449,6 +486,12 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 			return nil, err
 		}
 	}
+	if query := aq.withSharer; query != nil {
+		if err := aq.loadSharer(ctx, query, nodes, nil,
+			func(n *Article, e *User) { n.Edges.Sharer = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -512,6 +555,38 @@ func (aq *ArticleQuery) loadUserArticles(ctx context.Context, query *UserArticle
 	}
 	return nil
 }
+func (aq *ArticleQuery) loadSharer(ctx context.Context, query *UserQuery, nodes []*Article, init func(*Article), assign func(*Article, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Article)
+	for i := range nodes {
+		if nodes[i].SharedBy == nil {
+			continue
+		}
+		fk := *nodes[i].SharedBy
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "shared_by" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (aq *ArticleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -541,6 +616,9 @@ func (aq *ArticleQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if aq.withSharer != nil {
+			_spec.Node.AddColumnOnce(article.FieldSharedBy)
+		}
 	}
 	if ps := aq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -15,6 +15,7 @@ import (
 	"github.com/morning-night-guild/platform-app/pkg/ent/article"
 	"github.com/morning-night-guild/platform-app/pkg/ent/articletag"
 	"github.com/morning-night-guild/platform-app/pkg/ent/predicate"
+	"github.com/morning-night-guild/platform-app/pkg/ent/user"
 	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
 )
 
@@ -75,6 +76,46 @@ func (au *ArticleUpdate) SetUpdatedAt(t time.Time) *ArticleUpdate {
 	return au
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (au *ArticleUpdate) SetDeletedAt(t time.Time) *ArticleUpdate {
+	au.mutation.SetDeletedAt(t)
+	return au
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableDeletedAt(t *time.Time) *ArticleUpdate {
+	if t != nil {
+		au.SetDeletedAt(*t)
+	}
+	return au
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (au *ArticleUpdate) ClearDeletedAt() *ArticleUpdate {
+	au.mutation.ClearDeletedAt()
+	return au
+}
+
+// SetSharedBy sets the "shared_by" field.
+func (au *ArticleUpdate) SetSharedBy(u uuid.UUID) *ArticleUpdate {
+	au.mutation.SetSharedBy(u)
+	return au
+}
+
+// SetNillableSharedBy sets the "shared_by" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableSharedBy(u *uuid.UUID) *ArticleUpdate {
+	if u != nil {
+		au.SetSharedBy(*u)
+	}
+	return au
+}
+
+// ClearSharedBy clears the value of the "shared_by" field.
+func (au *ArticleUpdate) ClearSharedBy() *ArticleUpdate {
+	au.mutation.ClearSharedBy()
+	return au
+}
+
 // AddTagIDs adds the "tags" edge to the ArticleTag entity by IDs.
 func (au *ArticleUpdate) AddTagIDs(ids ...uuid.UUID) *ArticleUpdate {
 	au.mutation.AddTagIDs(ids...)
@@ -105,6 +146,25 @@ func (au *ArticleUpdate) AddUserArticles(u ...*UserArticle) *ArticleUpdate {
 	return au.AddUserArticleIDs(ids...)
 }
 
+// SetSharerID sets the "sharer" edge to the User entity by ID.
+func (au *ArticleUpdate) SetSharerID(id uuid.UUID) *ArticleUpdate {
+	au.mutation.SetSharerID(id)
+	return au
+}
+
+// SetNillableSharerID sets the "sharer" edge to the User entity by ID if the given value is not nil.
+func (au *ArticleUpdate) SetNillableSharerID(id *uuid.UUID) *ArticleUpdate {
+	if id != nil {
+		au = au.SetSharerID(*id)
+	}
+	return au
+}
+
+// SetSharer sets the "sharer" edge to the User entity.
+func (au *ArticleUpdate) SetSharer(u *User) *ArticleUpdate {
+	return au.SetSharerID(u.ID)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (au *ArticleUpdate) Mutation() *ArticleMutation {
 	return au.mutation
@@ -152,6 +212,12 @@ func (au *ArticleUpdate) RemoveUserArticles(u ...*UserArticle) *ArticleUpdate {
 	return au.RemoveUserArticleIDs(ids...)
 }
 
+// ClearSharer clears the "sharer" edge to the User entity.
+func (au *ArticleUpdate) ClearSharer() *ArticleUpdate {
+	au.mutation.ClearSharer()
+	return au
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (au *ArticleUpdate) Save(ctx context.Context) (int, error) {
 	au.defaults()
@@ -215,6 +281,12 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.UpdatedAt(); ok {
 		_spec.SetField(article.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.DeletedAt(); ok {
+		_spec.SetField(article.FieldDeletedAt, field.TypeTime, value)
+	}
+	if au.mutation.DeletedAtCleared() {
+		_spec.ClearField(article.FieldDeletedAt, field.TypeTime)
+	}
 	if au.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -305,6 +377,35 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.SharerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.SharerTable,
+			Columns: []string{article.SharerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SharerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.SharerTable,
+			Columns: []string{article.SharerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{article.Label}
@@ -369,6 +470,46 @@ func (auo *ArticleUpdateOne) SetUpdatedAt(t time.Time) *ArticleUpdateOne {
 	return auo
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (auo *ArticleUpdateOne) SetDeletedAt(t time.Time) *ArticleUpdateOne {
+	auo.mutation.SetDeletedAt(t)
+	return auo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableDeletedAt(t *time.Time) *ArticleUpdateOne {
+	if t != nil {
+		auo.SetDeletedAt(*t)
+	}
+	return auo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (auo *ArticleUpdateOne) ClearDeletedAt() *ArticleUpdateOne {
+	auo.mutation.ClearDeletedAt()
+	return auo
+}
+
+// SetSharedBy sets the "shared_by" field.
+func (auo *ArticleUpdateOne) SetSharedBy(u uuid.UUID) *ArticleUpdateOne {
+	auo.mutation.SetSharedBy(u)
+	return auo
+}
+
+// SetNillableSharedBy sets the "shared_by" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableSharedBy(u *uuid.UUID) *ArticleUpdateOne {
+	if u != nil {
+		auo.SetSharedBy(*u)
+	}
+	return auo
+}
+
+// ClearSharedBy clears the value of the "shared_by" field.
+func (auo *ArticleUpdateOne) ClearSharedBy() *ArticleUpdateOne {
+	auo.mutation.ClearSharedBy()
+	return auo
+}
+
 // AddTagIDs adds the "tags" edge to the ArticleTag entity by IDs.
 func (auo *ArticleUpdateOne) AddTagIDs(ids ...uuid.UUID) *ArticleUpdateOne {
 	auo.mutation.AddTagIDs(ids...)
@@ -399,6 +540,25 @@ func (auo *ArticleUpdateOne) AddUserArticles(u ...*UserArticle) *ArticleUpdateOn
 	return auo.AddUserArticleIDs(ids...)
 }
 
+// SetSharerID sets the "sharer" edge to the User entity by ID.
+func (auo *ArticleUpdateOne) SetSharerID(id uuid.UUID) *ArticleUpdateOne {
+	auo.mutation.SetSharerID(id)
+	return auo
+}
+
+// SetNillableSharerID sets the "sharer" edge to the User entity by ID if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableSharerID(id *uuid.UUID) *ArticleUpdateOne {
+	if id != nil {
+		auo = auo.SetSharerID(*id)
+	}
+	return auo
+}
+
+// SetSharer sets the "sharer" edge to the User entity.
+func (auo *ArticleUpdateOne) SetSharer(u *User) *ArticleUpdateOne {
+	return auo.SetSharerID(u.ID)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (auo *ArticleUpdateOne) Mutation() *ArticleMutation {
 	return auo.mutation
@@ -446,6 +606,12 @@ func (auo *ArticleUpdateOne) RemoveUserArticles(u ...*UserArticle) *ArticleUpdat
 	return auo.RemoveUserArticleIDs(ids...)
 }
 
+// ClearSharer clears the "sharer" edge to the User entity.
+func (auo *ArticleUpdateOne) ClearSharer() *ArticleUpdateOne {
+	auo.mutation.ClearSharer()
+	return auo
+}
+
 // Where appends a list predicates to the ArticleUpdate builder.
 func (auo *ArticleUpdateOne) Where(ps ...predicate.Article) *ArticleUpdateOne {
 	auo.mutation.Where(ps...)
@@ -539,6 +705,12 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 	if value, ok := auo.mutation.UpdatedAt(); ok {
 		_spec.SetField(article.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.DeletedAt(); ok {
+		_spec.SetField(article.FieldDeletedAt, field.TypeTime, value)
+	}
+	if auo.mutation.DeletedAtCleared() {
+		_spec.ClearField(article.FieldDeletedAt, field.TypeTime)
+	}
 	if auo.mutation.TagsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -629,6 +801,35 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.SharerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.SharerTable,
+			Columns: []string{article.SharerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SharerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.SharerTable,
+			Columns: []string{article.SharerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Article{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -5,6 +5,7 @@ package ent
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -22,6 +23,10 @@ type ArticleTag struct {
 	Tag string `json:"tag,omitempty"`
 	// ArticleID holds the value of the "article_id" field.
 	ArticleID uuid.UUID `json:"article_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArticleTagQuery when eager-loading is set.
 	Edges        ArticleTagEdges `json:"edges"`
@@ -57,6 +62,8 @@ func (*ArticleTag) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case articletag.FieldTag:
 			values[i] = new(sql.NullString)
+		case articletag.FieldCreatedAt, articletag.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		case articletag.FieldID, articletag.FieldArticleID:
 			values[i] = new(uuid.UUID)
 		default:
@@ -92,6 +99,18 @@ func (at *ArticleTag) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				at.ArticleID = *value
 			}
+		case articletag.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		case articletag.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				at.UpdatedAt = value.Time
+			}
 		default:
 			at.selectValues.Set(columns[i], values[i])
 		}
@@ -138,6 +157,12 @@ func (at *ArticleTag) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("article_id=")
 	builder.WriteString(fmt.Sprintf("%v", at.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(at.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -3,6 +3,8 @@
 package articletag
 
 import (
+	"time"
+
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/google/uuid"
@@ -64,6 +66,16 @@ func ArticleID(v uuid.UUID) predicate.ArticleTag {
 	return predicate.ArticleTag(sql.FieldEQ(FieldArticleID, v))
 }
 
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // TagEQ applies the EQ predicate on the "tag" field.
 func TagEQ(v string) predicate.ArticleTag {
 	return predicate.ArticleTag(sql.FieldEQ(FieldTag, v))
@@ -149,6 +161,86 @@ func ArticleIDNotIn(vs ...uuid.UUID) predicate.ArticleTag {
 	return predicate.ArticleTag(sql.FieldNotIn(FieldArticleID, vs...))
 }
 
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ArticleTag {
+	return predicate.ArticleTag(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
 // HasArticle applies the HasEdge predicate on the "article" edge.
 func HasArticle() predicate.ArticleTag {
 	return predicate.ArticleTag(func(s *sql.Selector) {
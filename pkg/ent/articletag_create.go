@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
@@ -36,6 +37,34 @@ func (atc *ArticleTagCreate) SetArticleID(u uuid.UUID) *ArticleTagCreate {
 	return atc
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (atc *ArticleTagCreate) SetCreatedAt(t time.Time) *ArticleTagCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *ArticleTagCreate) SetNillableCreatedAt(t *time.Time) *ArticleTagCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atc *ArticleTagCreate) SetUpdatedAt(t time.Time) *ArticleTagCreate {
+	atc.mutation.SetUpdatedAt(t)
+	return atc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (atc *ArticleTagCreate) SetNillableUpdatedAt(t *time.Time) *ArticleTagCreate {
+	if t != nil {
+		atc.SetUpdatedAt(*t)
+	}
+	return atc
+}
+
 // SetID sets the "id" field.
 func (atc *ArticleTagCreate) SetID(u uuid.UUID) *ArticleTagCreate {
 	atc.mutation.SetID(u)
@@ -90,6 +119,14 @@ func (atc *ArticleTagCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (atc *ArticleTagCreate) defaults() {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		v := articletag.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		v := articletag.DefaultUpdatedAt()
+		atc.mutation.SetUpdatedAt(v)
+	}
 	if _, ok := atc.mutation.ID(); !ok {
 		v := articletag.DefaultID()
 		atc.mutation.SetID(v)
@@ -147,6 +184,14 @@ func (atc *ArticleTagCreate) createSpec() (*ArticleTag, *sqlgraph.CreateSpec) {
 		_spec.SetField(articletag.FieldTag, field.TypeString, value)
 		_node.Tag = value
 	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(articletag.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := atc.mutation.UpdatedAt(); ok {
+		_spec.SetField(articletag.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
 	if nodes := atc.mutation.ArticleIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -240,6 +285,30 @@ func (u *ArticleTagUpsert) UpdateArticleID() *ArticleTagUpsert {
 	return u
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (u *ArticleTagUpsert) SetCreatedAt(v time.Time) *ArticleTagUpsert {
+	u.Set(articletag.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ArticleTagUpsert) UpdateCreatedAt() *ArticleTagUpsert {
+	u.SetExcluded(articletag.FieldCreatedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArticleTagUpsert) SetUpdatedAt(v time.Time) *ArticleTagUpsert {
+	u.Set(articletag.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArticleTagUpsert) UpdateUpdatedAt() *ArticleTagUpsert {
+	u.SetExcluded(articletag.FieldUpdatedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -316,6 +385,34 @@ func (u *ArticleTagUpsertOne) UpdateArticleID() *ArticleTagUpsertOne {
 	})
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (u *ArticleTagUpsertOne) SetCreatedAt(v time.Time) *ArticleTagUpsertOne {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ArticleTagUpsertOne) UpdateCreatedAt() *ArticleTagUpsertOne {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArticleTagUpsertOne) SetUpdatedAt(v time.Time) *ArticleTagUpsertOne {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArticleTagUpsertOne) UpdateUpdatedAt() *ArticleTagUpsertOne {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ArticleTagUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -555,6 +652,34 @@ func (u *ArticleTagUpsertBulk) UpdateArticleID() *ArticleTagUpsertBulk {
 	})
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (u *ArticleTagUpsertBulk) SetCreatedAt(v time.Time) *ArticleTagUpsertBulk {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *ArticleTagUpsertBulk) UpdateCreatedAt() *ArticleTagUpsertBulk {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *ArticleTagUpsertBulk) SetUpdatedAt(v time.Time) *ArticleTagUpsertBulk {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *ArticleTagUpsertBulk) UpdateUpdatedAt() *ArticleTagUpsertBulk {
+	return u.Update(func(s *ArticleTagUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ArticleTagUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -41,6 +42,26 @@ func (atu *ArticleTagUpdate) SetArticleID(u uuid.UUID) *ArticleTagUpdate {
 	return atu
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (atu *ArticleTagUpdate) SetCreatedAt(t time.Time) *ArticleTagUpdate {
+	atu.mutation.SetCreatedAt(t)
+	return atu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atu *ArticleTagUpdate) SetNillableCreatedAt(t *time.Time) *ArticleTagUpdate {
+	if t != nil {
+		atu.SetCreatedAt(*t)
+	}
+	return atu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atu *ArticleTagUpdate) SetUpdatedAt(t time.Time) *ArticleTagUpdate {
+	atu.mutation.SetUpdatedAt(t)
+	return atu
+}
+
 // SetArticle sets the "article" edge to the Article entity.
 func (atu *ArticleTagUpdate) SetArticle(a *Article) *ArticleTagUpdate {
 	return atu.SetArticleID(a.ID)
@@ -59,6 +80,7 @@ func (atu *ArticleTagUpdate) ClearArticle() *ArticleTagUpdate {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (atu *ArticleTagUpdate) Save(ctx context.Context) (int, error) {
+	atu.defaults()
 	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
 }
 
@@ -84,6 +106,14 @@ func (atu *ArticleTagUpdate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (atu *ArticleTagUpdate) defaults() {
+	if _, ok := atu.mutation.UpdatedAt(); !ok {
+		v := articletag.UpdateDefaultUpdatedAt()
+		atu.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (atu *ArticleTagUpdate) check() error {
 	if _, ok := atu.mutation.ArticleID(); atu.mutation.ArticleCleared() && !ok {
@@ -107,6 +137,12 @@ func (atu *ArticleTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := atu.mutation.Tag(); ok {
 		_spec.SetField(articletag.FieldTag, field.TypeString, value)
 	}
+	if value, ok := atu.mutation.CreatedAt(); ok {
+		_spec.SetField(articletag.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := atu.mutation.UpdatedAt(); ok {
+		_spec.SetField(articletag.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if atu.mutation.ArticleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -168,6 +204,26 @@ func (atuo *ArticleTagUpdateOne) SetArticleID(u uuid.UUID) *ArticleTagUpdateOne
 	return atuo
 }
 
+// SetCreatedAt sets the "created_at" field.
+func (atuo *ArticleTagUpdateOne) SetCreatedAt(t time.Time) *ArticleTagUpdateOne {
+	atuo.mutation.SetCreatedAt(t)
+	return atuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atuo *ArticleTagUpdateOne) SetNillableCreatedAt(t *time.Time) *ArticleTagUpdateOne {
+	if t != nil {
+		atuo.SetCreatedAt(*t)
+	}
+	return atuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atuo *ArticleTagUpdateOne) SetUpdatedAt(t time.Time) *ArticleTagUpdateOne {
+	atuo.mutation.SetUpdatedAt(t)
+	return atuo
+}
+
 // SetArticle sets the "article" edge to the Article entity.
 func (atuo *ArticleTagUpdateOne) SetArticle(a *Article) *ArticleTagUpdateOne {
 	return atuo.SetArticleID(a.ID)
@@ -199,6 +255,7 @@ func (atuo *ArticleTagUpdateOne) Select(field string, fields ...string) *Article
 
 // Save executes the query and returns the updated ArticleTag entity.
 func (atuo *ArticleTagUpdateOne) Save(ctx context.Context) (*ArticleTag, error) {
+	atuo.defaults()
 	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
 }
 
@@ -224,6 +281,14 @@ func (atuo *ArticleTagUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (atuo *ArticleTagUpdateOne) defaults() {
+	if _, ok := atuo.mutation.UpdatedAt(); !ok {
+		v := articletag.UpdateDefaultUpdatedAt()
+		atuo.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (atuo *ArticleTagUpdateOne) check() error {
 	if _, ok := atuo.mutation.ArticleID(); atuo.mutation.ArticleCleared() && !ok {
@@ -264,6 +329,12 @@ func (atuo *ArticleTagUpdateOne) sqlSave(ctx context.Context) (_node *ArticleTag
 	if value, ok := atuo.mutation.Tag(); ok {
 		_spec.SetField(articletag.FieldTag, field.TypeString, value)
 	}
+	if value, ok := atuo.mutation.CreatedAt(); ok {
+		_spec.SetField(articletag.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := atuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(articletag.FieldUpdatedAt, field.TypeTime, value)
+	}
 	if atuo.mutation.ArticleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,946 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent/auditlog"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetActorID sets the "actor_id" field.
+func (alc *AuditLogCreate) SetActorID(u uuid.UUID) *AuditLogCreate {
+	alc.mutation.SetActorID(u)
+	return alc
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableActorID(u *uuid.UUID) *AuditLogCreate {
+	if u != nil {
+		alc.SetActorID(*u)
+	}
+	return alc
+}
+
+// SetEmail sets the "email" field.
+func (alc *AuditLogCreate) SetEmail(s string) *AuditLogCreate {
+	alc.mutation.SetEmail(s)
+	return alc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableEmail(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetEmail(*s)
+	}
+	return alc
+}
+
+// SetEventType sets the "event_type" field.
+func (alc *AuditLogCreate) SetEventType(s string) *AuditLogCreate {
+	alc.mutation.SetEventType(s)
+	return alc
+}
+
+// SetOutcome sets the "outcome" field.
+func (alc *AuditLogCreate) SetOutcome(s string) *AuditLogCreate {
+	alc.mutation.SetOutcome(s)
+	return alc
+}
+
+// SetIP sets the "ip" field.
+func (alc *AuditLogCreate) SetIP(s string) *AuditLogCreate {
+	alc.mutation.SetIP(s)
+	return alc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableIP(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetIP(*s)
+	}
+	return alc
+}
+
+// SetForwardedFor sets the "forwarded_for" field.
+func (alc *AuditLogCreate) SetForwardedFor(s string) *AuditLogCreate {
+	alc.mutation.SetForwardedFor(s)
+	return alc
+}
+
+// SetNillableForwardedFor sets the "forwarded_for" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableForwardedFor(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetForwardedFor(*s)
+	}
+	return alc
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alc *AuditLogCreate) SetUserAgent(s string) *AuditLogCreate {
+	alc.mutation.SetUserAgent(s)
+	return alc
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserAgent(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetUserAgent(*s)
+	}
+	return alc
+}
+
+// SetTraceID sets the "trace_id" field.
+func (alc *AuditLogCreate) SetTraceID(s string) *AuditLogCreate {
+	alc.mutation.SetTraceID(s)
+	return alc
+}
+
+// SetNillableTraceID sets the "trace_id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableTraceID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetTraceID(*s)
+	}
+	return alc
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (alc *AuditLogCreate) SetOccurredAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetOccurredAt(t)
+	return alc
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableOccurredAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetOccurredAt(*t)
+	}
+	return alc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (alc *AuditLogCreate) SetUpdatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetUpdatedAt(t)
+	return alc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUpdatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetUpdatedAt(*t)
+	}
+	return alc
+}
+
+// SetID sets the "id" field.
+func (alc *AuditLogCreate) SetID(u uuid.UUID) *AuditLogCreate {
+	alc.mutation.SetID(u)
+	return alc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableID(u *uuid.UUID) *AuditLogCreate {
+	if u != nil {
+		alc.SetID(*u)
+	}
+	return alc
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alc *AuditLogCreate) Mutation() *AuditLogMutation {
+	return alc.mutation
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	alc.defaults()
+	return withHooks(ctx, alc.sqlSave, alc.mutation, alc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alc *AuditLogCreate) Exec(ctx context.Context) error {
+	_, err := alc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alc *AuditLogCreate) ExecX(ctx context.Context) {
+	if err := alc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alc *AuditLogCreate) defaults() {
+	if _, ok := alc.mutation.Email(); !ok {
+		v := auditlog.DefaultEmail
+		alc.mutation.SetEmail(v)
+	}
+	if _, ok := alc.mutation.IP(); !ok {
+		v := auditlog.DefaultIP
+		alc.mutation.SetIP(v)
+	}
+	if _, ok := alc.mutation.ForwardedFor(); !ok {
+		v := auditlog.DefaultForwardedFor
+		alc.mutation.SetForwardedFor(v)
+	}
+	if _, ok := alc.mutation.UserAgent(); !ok {
+		v := auditlog.DefaultUserAgent
+		alc.mutation.SetUserAgent(v)
+	}
+	if _, ok := alc.mutation.TraceID(); !ok {
+		v := auditlog.DefaultTraceID
+		alc.mutation.SetTraceID(v)
+	}
+	if _, ok := alc.mutation.OccurredAt(); !ok {
+		v := auditlog.DefaultOccurredAt()
+		alc.mutation.SetOccurredAt(v)
+	}
+	if _, ok := alc.mutation.UpdatedAt(); !ok {
+		v := auditlog.DefaultUpdatedAt()
+		alc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := alc.mutation.ID(); !ok {
+		v := auditlog.DefaultID()
+		alc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (alc *AuditLogCreate) check() error {
+	if _, ok := alc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "AuditLog.email"`)}
+	}
+	if _, ok := alc.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "AuditLog.event_type"`)}
+	}
+	if _, ok := alc.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "AuditLog.outcome"`)}
+	}
+	if _, ok := alc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "AuditLog.ip"`)}
+	}
+	if _, ok := alc.mutation.ForwardedFor(); !ok {
+		return &ValidationError{Name: "forwarded_for", err: errors.New(`ent: missing required field "AuditLog.forwarded_for"`)}
+	}
+	if _, ok := alc.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AuditLog.user_agent"`)}
+	}
+	if _, ok := alc.mutation.TraceID(); !ok {
+		return &ValidationError{Name: "trace_id", err: errors.New(`ent: missing required field "AuditLog.trace_id"`)}
+	}
+	return nil
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	if err := alc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := alc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	alc.mutation.id = &_node.ID
+	alc.mutation.done = true
+	return _node, nil
+}
+
+func (alc *AuditLogCreate) createSpec() (*AuditLog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditLog{config: alc.config}
+		_spec = sqlgraph.NewCreateSpec(auditlog.Table, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = alc.conflict
+	if id, ok := alc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := alc.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUUID, value)
+		_node.ActorID = &value
+	}
+	if value, ok := alc.mutation.Email(); ok {
+		_spec.SetField(auditlog.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := alc.mutation.EventType(); ok {
+		_spec.SetField(auditlog.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := alc.mutation.Outcome(); ok {
+		_spec.SetField(auditlog.FieldOutcome, field.TypeString, value)
+		_node.Outcome = value
+	}
+	if value, ok := alc.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := alc.mutation.ForwardedFor(); ok {
+		_spec.SetField(auditlog.FieldForwardedFor, field.TypeString, value)
+		_node.ForwardedFor = value
+	}
+	if value, ok := alc.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := alc.mutation.TraceID(); ok {
+		_spec.SetField(auditlog.FieldTraceID, field.TypeString, value)
+		_node.TraceID = value
+	}
+	if value, ok := alc.mutation.OccurredAt(); ok {
+		_spec.SetField(auditlog.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := alc.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.Create().
+//		SetActorID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetActorID(v+v).
+//		}).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertOne {
+	alc.conflict = opts
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alc *AuditLogCreate) OnConflictColumns(columns ...string) *AuditLogUpsertOne {
+	alc.conflict = append(alc.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertOne{
+		create: alc,
+	}
+}
+
+type (
+	// AuditLogUpsertOne is the builder for "upsert"-ing
+	//  one AuditLog node.
+	AuditLogUpsertOne struct {
+		create *AuditLogCreate
+	}
+
+	// AuditLogUpsert is the "OnConflict" setter.
+	AuditLogUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogUpsert) SetActorID(v uuid.UUID) *AuditLogUpsert {
+	u.Set(auditlog.FieldActorID, v)
+	return u
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateActorID() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldActorID)
+	return u
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogUpsert) ClearActorID() *AuditLogUpsert {
+	u.SetNull(auditlog.FieldActorID)
+	return u
+}
+
+// SetEmail sets the "email" field.
+func (u *AuditLogUpsert) SetEmail(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldEmail, v)
+	return u
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateEmail() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldEmail)
+	return u
+}
+
+// SetIP sets the "ip" field.
+func (u *AuditLogUpsert) SetIP(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldIP, v)
+	return u
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateIP() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldIP)
+	return u
+}
+
+// SetForwardedFor sets the "forwarded_for" field.
+func (u *AuditLogUpsert) SetForwardedFor(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldForwardedFor, v)
+	return u
+}
+
+// UpdateForwardedFor sets the "forwarded_for" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateForwardedFor() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldForwardedFor)
+	return u
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogUpsert) SetUserAgent(v string) *AuditLogUpsert {
+	u.Set(auditlog.FieldUserAgent, v)
+	return u
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateUserAgent() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldUserAgent)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogUpsert) SetUpdatedAt(v time.Time) *AuditLogUpsert {
+	u.Set(auditlog.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogUpsert) UpdateUpdatedAt() *AuditLogUpsert {
+	u.SetExcluded(auditlog.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertOne) UpdateNewValues() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditlog.FieldID)
+		}
+		if _, exists := u.create.mutation.EventType(); exists {
+			s.SetIgnore(auditlog.FieldEventType)
+		}
+		if _, exists := u.create.mutation.Outcome(); exists {
+			s.SetIgnore(auditlog.FieldOutcome)
+		}
+		if _, exists := u.create.mutation.TraceID(); exists {
+			s.SetIgnore(auditlog.FieldTraceID)
+		}
+		if _, exists := u.create.mutation.OccurredAt(); exists {
+			s.SetIgnore(auditlog.FieldOccurredAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditLogUpsertOne) Ignore() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertOne) DoNothing() *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreate.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertOne) Update(set func(*AuditLogUpsert)) *AuditLogUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogUpsertOne) SetActorID(v uuid.UUID) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateActorID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogUpsertOne) ClearActorID() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *AuditLogUpsertOne) SetEmail(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateEmail() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *AuditLogUpsertOne) SetIP(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateIP() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// SetForwardedFor sets the "forwarded_for" field.
+func (u *AuditLogUpsertOne) SetForwardedFor(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetForwardedFor(v)
+	})
+}
+
+// UpdateForwardedFor sets the "forwarded_for" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateForwardedFor() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateForwardedFor()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogUpsertOne) SetUserAgent(v string) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateUserAgent() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogUpsertOne) SetUpdatedAt(v time.Time) *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogUpsertOne) UpdateUpdatedAt() *AuditLogUpsertOne {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditLogUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: AuditLogUpsertOne.ID is not supported by MySQL driver. Use AuditLogUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditLogUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditLogCreateBulk is the builder for creating many AuditLog entities in bulk.
+type AuditLogCreateBulk struct {
+	config
+	builders []*AuditLogCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditLog entities in the database.
+func (alcb *AuditLogCreateBulk) Save(ctx context.Context) ([]*AuditLog, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(alcb.builders))
+	nodes := make([]*AuditLog, len(alcb.builders))
+	mutators := make([]Mutator, len(alcb.builders))
+	for i := range alcb.builders {
+		func(i int, root context.Context) {
+			builder := alcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditLogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, alcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = alcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, alcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, alcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) SaveX(ctx context.Context) []*AuditLog {
+	v, err := alcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (alcb *AuditLogCreateBulk) Exec(ctx context.Context) error {
+	_, err := alcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alcb *AuditLogCreateBulk) ExecX(ctx context.Context) {
+	if err := alcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditLog.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditLogUpsert) {
+//			SetActorID(v+v).
+//		}).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditLogUpsertBulk {
+	alcb.conflict = opts
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (alcb *AuditLogCreateBulk) OnConflictColumns(columns ...string) *AuditLogUpsertBulk {
+	alcb.conflict = append(alcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditLogUpsertBulk{
+		create: alcb,
+	}
+}
+
+// AuditLogUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditLog nodes.
+type AuditLogUpsertBulk struct {
+	create *AuditLogCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditlog.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) UpdateNewValues() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditlog.FieldID)
+			}
+			if _, exists := b.mutation.EventType(); exists {
+				s.SetIgnore(auditlog.FieldEventType)
+			}
+			if _, exists := b.mutation.Outcome(); exists {
+				s.SetIgnore(auditlog.FieldOutcome)
+			}
+			if _, exists := b.mutation.TraceID(); exists {
+				s.SetIgnore(auditlog.FieldTraceID)
+			}
+			if _, exists := b.mutation.OccurredAt(); exists {
+				s.SetIgnore(auditlog.FieldOccurredAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditLog.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditLogUpsertBulk) Ignore() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditLogUpsertBulk) DoNothing() *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditLogCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditLogUpsertBulk) Update(set func(*AuditLogUpsert)) *AuditLogUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditLogUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActorID sets the "actor_id" field.
+func (u *AuditLogUpsertBulk) SetActorID(v uuid.UUID) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetActorID(v)
+	})
+}
+
+// UpdateActorID sets the "actor_id" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateActorID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateActorID()
+	})
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (u *AuditLogUpsertBulk) ClearActorID() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.ClearActorID()
+	})
+}
+
+// SetEmail sets the "email" field.
+func (u *AuditLogUpsertBulk) SetEmail(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetEmail(v)
+	})
+}
+
+// UpdateEmail sets the "email" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateEmail() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateEmail()
+	})
+}
+
+// SetIP sets the "ip" field.
+func (u *AuditLogUpsertBulk) SetIP(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetIP(v)
+	})
+}
+
+// UpdateIP sets the "ip" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateIP() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateIP()
+	})
+}
+
+// SetForwardedFor sets the "forwarded_for" field.
+func (u *AuditLogUpsertBulk) SetForwardedFor(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetForwardedFor(v)
+	})
+}
+
+// UpdateForwardedFor sets the "forwarded_for" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateForwardedFor() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateForwardedFor()
+	})
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (u *AuditLogUpsertBulk) SetUserAgent(v string) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUserAgent(v)
+	})
+}
+
+// UpdateUserAgent sets the "user_agent" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateUserAgent() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUserAgent()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *AuditLogUpsertBulk) SetUpdatedAt(v time.Time) *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *AuditLogUpsertBulk) UpdateUpdatedAt() *AuditLogUpsertBulk {
+	return u.Update(func(s *AuditLogUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *AuditLogUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the AuditLogCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuditLogCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditLogUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
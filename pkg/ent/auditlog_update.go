@@ -0,0 +1,401 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent/auditlog"
+	"github.com/morning-night-guild/platform-app/pkg/ent/predicate"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.mutation.Where(ps...)
+	return alu
+}
+
+// SetActorID sets the "actor_id" field.
+func (alu *AuditLogUpdate) SetActorID(u uuid.UUID) *AuditLogUpdate {
+	alu.mutation.SetActorID(u)
+	return alu
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableActorID(u *uuid.UUID) *AuditLogUpdate {
+	if u != nil {
+		alu.SetActorID(*u)
+	}
+	return alu
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (alu *AuditLogUpdate) ClearActorID() *AuditLogUpdate {
+	alu.mutation.ClearActorID()
+	return alu
+}
+
+// SetEmail sets the "email" field.
+func (alu *AuditLogUpdate) SetEmail(s string) *AuditLogUpdate {
+	alu.mutation.SetEmail(s)
+	return alu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableEmail(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetEmail(*s)
+	}
+	return alu
+}
+
+// SetIP sets the "ip" field.
+func (alu *AuditLogUpdate) SetIP(s string) *AuditLogUpdate {
+	alu.mutation.SetIP(s)
+	return alu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableIP(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetIP(*s)
+	}
+	return alu
+}
+
+// SetForwardedFor sets the "forwarded_for" field.
+func (alu *AuditLogUpdate) SetForwardedFor(s string) *AuditLogUpdate {
+	alu.mutation.SetForwardedFor(s)
+	return alu
+}
+
+// SetNillableForwardedFor sets the "forwarded_for" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableForwardedFor(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetForwardedFor(*s)
+	}
+	return alu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (alu *AuditLogUpdate) SetUserAgent(s string) *AuditLogUpdate {
+	alu.mutation.SetUserAgent(s)
+	return alu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserAgent(s *string) *AuditLogUpdate {
+	if s != nil {
+		alu.SetUserAgent(*s)
+	}
+	return alu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (alu *AuditLogUpdate) SetUpdatedAt(t time.Time) *AuditLogUpdate {
+	alu.mutation.SetUpdatedAt(t)
+	return alu
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (alu *AuditLogUpdate) Mutation() *AuditLogMutation {
+	return alu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+	alu.defaults()
+	return withHooks(ctx, alu.sqlSave, alu.mutation, alu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (alu *AuditLogUpdate) defaults() {
+	if _, ok := alu.mutation.UpdatedAt(); !ok {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		alu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	if ps := alu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := alu.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUUID, value)
+	}
+	if alu.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := alu.mutation.Email(); ok {
+		_spec.SetField(auditlog.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.ForwardedFor(); ok {
+		_spec.SetField(auditlog.FieldForwardedFor, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := alu.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	alu.mutation.done = true
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetActorID sets the "actor_id" field.
+func (aluo *AuditLogUpdateOne) SetActorID(u uuid.UUID) *AuditLogUpdateOne {
+	aluo.mutation.SetActorID(u)
+	return aluo
+}
+
+// SetNillableActorID sets the "actor_id" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableActorID(u *uuid.UUID) *AuditLogUpdateOne {
+	if u != nil {
+		aluo.SetActorID(*u)
+	}
+	return aluo
+}
+
+// ClearActorID clears the value of the "actor_id" field.
+func (aluo *AuditLogUpdateOne) ClearActorID() *AuditLogUpdateOne {
+	aluo.mutation.ClearActorID()
+	return aluo
+}
+
+// SetEmail sets the "email" field.
+func (aluo *AuditLogUpdateOne) SetEmail(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetEmail(s)
+	return aluo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableEmail(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetEmail(*s)
+	}
+	return aluo
+}
+
+// SetIP sets the "ip" field.
+func (aluo *AuditLogUpdateOne) SetIP(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetIP(s)
+	return aluo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableIP(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetIP(*s)
+	}
+	return aluo
+}
+
+// SetForwardedFor sets the "forwarded_for" field.
+func (aluo *AuditLogUpdateOne) SetForwardedFor(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetForwardedFor(s)
+	return aluo
+}
+
+// SetNillableForwardedFor sets the "forwarded_for" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableForwardedFor(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetForwardedFor(*s)
+	}
+	return aluo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aluo *AuditLogUpdateOne) SetUserAgent(s string) *AuditLogUpdateOne {
+	aluo.mutation.SetUserAgent(s)
+	return aluo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserAgent(s *string) *AuditLogUpdateOne {
+	if s != nil {
+		aluo.SetUserAgent(*s)
+	}
+	return aluo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aluo *AuditLogUpdateOne) SetUpdatedAt(t time.Time) *AuditLogUpdateOne {
+	aluo.mutation.SetUpdatedAt(t)
+	return aluo
+}
+
+// Mutation returns the AuditLogMutation object of the builder.
+func (aluo *AuditLogUpdateOne) Mutation() *AuditLogMutation {
+	return aluo.mutation
+}
+
+// Where appends a list predicates to the AuditLogUpdate builder.
+func (aluo *AuditLogUpdateOne) Where(ps ...predicate.AuditLog) *AuditLogUpdateOne {
+	aluo.mutation.Where(ps...)
+	return aluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aluo *AuditLogUpdateOne) Select(field string, fields ...string) *AuditLogUpdateOne {
+	aluo.fields = append([]string{field}, fields...)
+	return aluo
+}
+
+// Save executes the query and returns the updated AuditLog entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+	aluo.defaults()
+	return withHooks(ctx, aluo.sqlSave, aluo.mutation, aluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	node, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aluo *AuditLogUpdateOne) defaults() {
+	if _, ok := aluo.mutation.UpdatedAt(); !ok {
+		v := auditlog.UpdateDefaultUpdatedAt()
+		aluo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (_node *AuditLog, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditlog.Table, auditlog.Columns, sqlgraph.NewFieldSpec(auditlog.FieldID, field.TypeUUID))
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuditLog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditlog.FieldID)
+		for _, f := range fields {
+			if !auditlog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != auditlog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aluo.mutation.ActorID(); ok {
+		_spec.SetField(auditlog.FieldActorID, field.TypeUUID, value)
+	}
+	if aluo.mutation.ActorIDCleared() {
+		_spec.ClearField(auditlog.FieldActorID, field.TypeUUID)
+	}
+	if value, ok := aluo.mutation.Email(); ok {
+		_spec.SetField(auditlog.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.IP(); ok {
+		_spec.SetField(auditlog.FieldIP, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.ForwardedFor(); ok {
+		_spec.SetField(auditlog.FieldForwardedFor, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.UserAgent(); ok {
+		_spec.SetField(auditlog.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := aluo.mutation.UpdatedAt(); ok {
+		_spec.SetField(auditlog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &AuditLog{config: aluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aluo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,316 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/morning-night-guild/platform-app/pkg/ent/credential"
+	"github.com/morning-night-guild/platform-app/pkg/ent/predicate"
+)
+
+// CredentialUpdate is the builder for updating Credential entities.
+type CredentialUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cu *CredentialUpdate) Where(ps ...predicate.Credential) *CredentialUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetEmail sets the "email" field.
+func (cu *CredentialUpdate) SetEmail(s string) *CredentialUpdate {
+	cu.mutation.SetEmail(s)
+	return cu
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (cu *CredentialUpdate) SetPasswordHash(s string) *CredentialUpdate {
+	cu.mutation.SetPasswordHash(s)
+	return cu
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (cu *CredentialUpdate) SetEmailVerified(b bool) *CredentialUpdate {
+	cu.mutation.SetEmailVerified(b)
+	return cu
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableEmailVerified(b *bool) *CredentialUpdate {
+	if b != nil {
+		cu.SetEmailVerified(*b)
+	}
+	return cu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cu *CredentialUpdate) SetCreatedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetCreatedAt(t)
+	return cu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cu *CredentialUpdate) SetNillableCreatedAt(t *time.Time) *CredentialUpdate {
+	if t != nil {
+		cu.SetCreatedAt(*t)
+	}
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CredentialUpdate) SetUpdatedAt(t time.Time) *CredentialUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cu *CredentialUpdate) Mutation() *CredentialMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CredentialUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CredentialUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CredentialUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CredentialUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CredentialUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := credential.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cu *CredentialUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeUUID))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Email(); ok {
+		_spec.SetField(credential.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.PasswordHash(); ok {
+		_spec.SetField(credential.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.EmailVerified(); ok {
+		_spec.SetField(credential.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.CreatedAt(); ok {
+		_spec.SetField(credential.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(credential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CredentialUpdateOne is the builder for updating a single Credential entity.
+type CredentialUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CredentialMutation
+}
+
+// SetEmail sets the "email" field.
+func (cuo *CredentialUpdateOne) SetEmail(s string) *CredentialUpdateOne {
+	cuo.mutation.SetEmail(s)
+	return cuo
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (cuo *CredentialUpdateOne) SetPasswordHash(s string) *CredentialUpdateOne {
+	cuo.mutation.SetPasswordHash(s)
+	return cuo
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (cuo *CredentialUpdateOne) SetEmailVerified(b bool) *CredentialUpdateOne {
+	cuo.mutation.SetEmailVerified(b)
+	return cuo
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableEmailVerified(b *bool) *CredentialUpdateOne {
+	if b != nil {
+		cuo.SetEmailVerified(*b)
+	}
+	return cuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cuo *CredentialUpdateOne) SetCreatedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetCreatedAt(t)
+	return cuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cuo *CredentialUpdateOne) SetNillableCreatedAt(t *time.Time) *CredentialUpdateOne {
+	if t != nil {
+		cuo.SetCreatedAt(*t)
+	}
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CredentialUpdateOne) SetUpdatedAt(t time.Time) *CredentialUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// Mutation returns the CredentialMutation object of the builder.
+func (cuo *CredentialUpdateOne) Mutation() *CredentialMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CredentialUpdate builder.
+func (cuo *CredentialUpdateOne) Where(ps ...predicate.Credential) *CredentialUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CredentialUpdateOne) Select(field string, fields ...string) *CredentialUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Credential entity.
+func (cuo *CredentialUpdateOne) Save(ctx context.Context) (*Credential, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) SaveX(ctx context.Context) *Credential {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CredentialUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CredentialUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CredentialUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := credential.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cuo *CredentialUpdateOne) sqlSave(ctx context.Context) (_node *Credential, err error) {
+	_spec := sqlgraph.NewUpdateSpec(credential.Table, credential.Columns, sqlgraph.NewFieldSpec(credential.FieldID, field.TypeUUID))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Credential.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, credential.FieldID)
+		for _, f := range fields {
+			if !credential.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != credential.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Email(); ok {
+		_spec.SetField(credential.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.PasswordHash(); ok {
+		_spec.SetField(credential.FieldPasswordHash, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.EmailVerified(); ok {
+		_spec.SetField(credential.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.CreatedAt(); ok {
+		_spec.SetField(credential.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(credential.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Credential{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{credential.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
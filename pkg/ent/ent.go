@@ -14,6 +14,9 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/morning-night-guild/platform-app/pkg/ent/article"
 	"github.com/morning-night-guild/platform-app/pkg/ent/articletag"
+	"github.com/morning-night-guild/platform-app/pkg/ent/auditlog"
+	"github.com/morning-night-guild/platform-app/pkg/ent/credential"
+	"github.com/morning-night-guild/platform-app/pkg/ent/tombstone"
 	"github.com/morning-night-guild/platform-app/pkg/ent/user"
 	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
 )
@@ -78,6 +81,9 @@ func checkColumn(table, column string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			article.Table:     article.ValidColumn,
 			articletag.Table:  articletag.ValidColumn,
+			auditlog.Table:    auditlog.ValidColumn,
+			credential.Table:  credential.ValidColumn,
+			tombstone.Table:   tombstone.ValidColumn,
 			user.Table:        user.ValidColumn,
 			userarticle.Table: userarticle.ValidColumn,
 		})
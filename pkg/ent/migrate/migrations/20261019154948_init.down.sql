@@ -0,0 +1,34 @@
+-- reverse: create index "userarticle_user_id" to table: "user_articles"
+DROP INDEX "userarticle_user_id";
+-- reverse: create index "userarticle_user_id_article_id" to table: "user_articles"
+DROP INDEX "userarticle_user_id_article_id";
+-- reverse: create "user_articles" table
+DROP TABLE "user_articles";
+-- reverse: create "users" table
+DROP TABLE "users";
+-- reverse: create index "articletag_article_id" to table: "article_tags"
+DROP INDEX "articletag_article_id";
+-- reverse: create index "articletag_tag" to table: "article_tags"
+DROP INDEX "articletag_tag";
+-- reverse: create index "articletag_tag_article_id" to table: "article_tags"
+DROP INDEX "articletag_tag_article_id";
+-- reverse: create "article_tags" table
+DROP TABLE "article_tags";
+-- reverse: create index "credentials_email_key" to table: "credentials"
+DROP INDEX "credentials_email_key";
+-- reverse: create "credentials" table
+DROP TABLE "credentials";
+-- reverse: create index "auditlog_event_type_occurred_at" to table: "audit_logs"
+DROP INDEX "auditlog_event_type_occurred_at";
+-- reverse: create index "auditlog_actor_id_occurred_at" to table: "audit_logs"
+DROP INDEX "auditlog_actor_id_occurred_at";
+-- reverse: create index "auditlog_occurred_at" to table: "audit_logs"
+DROP INDEX "auditlog_occurred_at";
+-- reverse: create "audit_logs" table
+DROP TABLE "audit_logs";
+-- reverse: create index "article_title" to table: "articles"
+DROP INDEX "article_title";
+-- reverse: create index "articles_url_key" to table: "articles"
+DROP INDEX "articles_url_key";
+-- reverse: create "articles" table
+DROP TABLE "articles";
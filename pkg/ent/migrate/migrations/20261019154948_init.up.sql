@@ -0,0 +1,34 @@
+-- create "articles" table
+CREATE TABLE "articles" ("id" uuid NOT NULL, "title" character varying NOT NULL, "url" character varying NOT NULL, "description" character varying NOT NULL, "thumbnail" character varying NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "articles_url_key" to table: "articles"
+CREATE UNIQUE INDEX "articles_url_key" ON "articles" ("url");
+-- create index "article_title" to table: "articles"
+CREATE INDEX "article_title" ON "articles" ("title");
+-- create "audit_logs" table
+CREATE TABLE "audit_logs" ("id" uuid NOT NULL, "actor_id" uuid NULL, "email" character varying NOT NULL DEFAULT '', "event_type" character varying NOT NULL, "outcome" character varying NOT NULL, "ip" character varying NOT NULL DEFAULT '', "forwarded_for" character varying NOT NULL DEFAULT '', "user_agent" character varying NOT NULL DEFAULT '', "trace_id" character varying NOT NULL DEFAULT '', "occurred_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditlog_occurred_at" to table: "audit_logs"
+CREATE INDEX "auditlog_occurred_at" ON "audit_logs" ("occurred_at");
+-- create index "auditlog_actor_id_occurred_at" to table: "audit_logs"
+CREATE INDEX "auditlog_actor_id_occurred_at" ON "audit_logs" ("actor_id", "occurred_at");
+-- create index "auditlog_event_type_occurred_at" to table: "audit_logs"
+CREATE INDEX "auditlog_event_type_occurred_at" ON "audit_logs" ("event_type", "occurred_at");
+-- create "credentials" table
+CREATE TABLE "credentials" ("id" uuid NOT NULL, "email" character varying NOT NULL, "password_hash" character varying NOT NULL, "email_verified" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "credentials_email_key" to table: "credentials"
+CREATE UNIQUE INDEX "credentials_email_key" ON "credentials" ("email");
+-- create "article_tags" table
+CREATE TABLE "article_tags" ("id" uuid NOT NULL, "tag" character varying NOT NULL, "article_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "article_tags_articles_tags" FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE);
+-- create index "articletag_tag_article_id" to table: "article_tags"
+CREATE UNIQUE INDEX "articletag_tag_article_id" ON "article_tags" ("tag", "article_id");
+-- create index "articletag_tag" to table: "article_tags"
+CREATE INDEX "articletag_tag" ON "article_tags" ("tag");
+-- create index "articletag_article_id" to table: "article_tags"
+CREATE INDEX "articletag_article_id" ON "article_tags" ("article_id");
+-- create "users" table
+CREATE TABLE "users" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "user_articles" table
+CREATE TABLE "user_articles" ("id" uuid NOT NULL, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "article_id" uuid NOT NULL, "user_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "user_articles_articles_user_articles" FOREIGN KEY ("article_id") REFERENCES "articles" ("id") ON DELETE CASCADE, CONSTRAINT "user_articles_users_user_articles" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE CASCADE);
+-- create index "userarticle_user_id_article_id" to table: "user_articles"
+CREATE UNIQUE INDEX "userarticle_user_id_article_id" ON "user_articles" ("user_id", "article_id");
+-- create index "userarticle_user_id" to table: "user_articles"
+CREATE INDEX "userarticle_user_id" ON "user_articles" ("user_id");
@@ -0,0 +1,26 @@
+-- reverse: create trigger "user_articles_tombstone" to table: "user_articles"
+DROP TRIGGER "user_articles_tombstone" ON "user_articles";
+-- reverse: create trigger "users_tombstone" to table: "users"
+DROP TRIGGER "users_tombstone" ON "users";
+-- reverse: create trigger "credentials_tombstone" to table: "credentials"
+DROP TRIGGER "credentials_tombstone" ON "credentials";
+-- reverse: create trigger "audit_logs_tombstone" to table: "audit_logs"
+DROP TRIGGER "audit_logs_tombstone" ON "audit_logs";
+-- reverse: create trigger "article_tags_tombstone" to table: "article_tags"
+DROP TRIGGER "article_tags_tombstone" ON "article_tags";
+-- reverse: create trigger "articles_tombstone" to table: "articles"
+DROP TRIGGER "articles_tombstone" ON "articles";
+-- reverse: create function "record_tombstone"
+DROP FUNCTION "record_tombstone"();
+-- reverse: create index "tombstone_deleted_at" to table: "tombstones"
+DROP INDEX "tombstone_deleted_at";
+-- reverse: create "tombstones" table
+DROP TABLE "tombstones";
+-- reverse: create index "userarticle_updated_at" to table: "user_articles"
+DROP INDEX "userarticle_updated_at";
+-- reverse: create index "articletag_updated_at" to table: "article_tags"
+DROP INDEX "articletag_updated_at";
+-- reverse: modify "article_tags" table
+ALTER TABLE "article_tags" DROP COLUMN "updated_at", DROP COLUMN "created_at";
+-- reverse: create index "article_updated_at" to table: "articles"
+DROP INDEX "article_updated_at";
@@ -0,0 +1,35 @@
+-- create index "article_updated_at" to table: "articles"
+CREATE INDEX "article_updated_at" ON "articles" ("updated_at");
+-- modify "article_tags" table
+ALTER TABLE "article_tags" ADD COLUMN "created_at" timestamptz NULL, ADD COLUMN "updated_at" timestamptz NULL;
+-- backfill "article_tags" timestamps from the tagged article
+UPDATE "article_tags" SET "created_at" = "articles"."created_at", "updated_at" = "articles"."updated_at" FROM "articles" WHERE "articles"."id" = "article_tags"."article_id";
+-- modify "article_tags" table
+ALTER TABLE "article_tags" ALTER COLUMN "created_at" SET NOT NULL, ALTER COLUMN "updated_at" SET NOT NULL;
+-- create index "articletag_updated_at" to table: "article_tags"
+CREATE INDEX "articletag_updated_at" ON "article_tags" ("updated_at");
+-- create index "userarticle_updated_at" to table: "user_articles"
+CREATE INDEX "userarticle_updated_at" ON "user_articles" ("updated_at");
+-- create "tombstones" table
+CREATE TABLE "tombstones" ("id" uuid NOT NULL, "table_name" character varying NOT NULL, "row_id" uuid NOT NULL, "deleted_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "tombstone_deleted_at" to table: "tombstones"
+CREATE INDEX "tombstone_deleted_at" ON "tombstones" ("deleted_at");
+-- create function "record_tombstone"
+CREATE FUNCTION "record_tombstone"() RETURNS trigger AS $$
+BEGIN
+  INSERT INTO "tombstones" ("id", "table_name", "row_id", "deleted_at") VALUES (gen_random_uuid(), TG_TABLE_NAME, OLD."id", now());
+  RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+-- create trigger "articles_tombstone" to table: "articles"
+CREATE TRIGGER "articles_tombstone" AFTER DELETE ON "articles" FOR EACH ROW EXECUTE FUNCTION "record_tombstone"();
+-- create trigger "article_tags_tombstone" to table: "article_tags"
+CREATE TRIGGER "article_tags_tombstone" AFTER DELETE ON "article_tags" FOR EACH ROW EXECUTE FUNCTION "record_tombstone"();
+-- create trigger "audit_logs_tombstone" to table: "audit_logs"
+CREATE TRIGGER "audit_logs_tombstone" AFTER DELETE ON "audit_logs" FOR EACH ROW EXECUTE FUNCTION "record_tombstone"();
+-- create trigger "credentials_tombstone" to table: "credentials"
+CREATE TRIGGER "credentials_tombstone" AFTER DELETE ON "credentials" FOR EACH ROW EXECUTE FUNCTION "record_tombstone"();
+-- create trigger "users_tombstone" to table: "users"
+CREATE TRIGGER "users_tombstone" AFTER DELETE ON "users" FOR EACH ROW EXECUTE FUNCTION "record_tombstone"();
+-- create trigger "user_articles_tombstone" to table: "user_articles"
+CREATE TRIGGER "user_articles_tombstone" AFTER DELETE ON "user_articles" FOR EACH ROW EXECUTE FUNCTION "record_tombstone"();
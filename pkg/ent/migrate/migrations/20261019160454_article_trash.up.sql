@@ -0,0 +1,4 @@
+-- modify "articles" table
+ALTER TABLE "articles" ADD COLUMN "deleted_at" timestamptz NULL;
+-- create index "article_deleted_at" to table: "articles"
+CREATE INDEX "article_deleted_at" ON "articles" ("deleted_at");
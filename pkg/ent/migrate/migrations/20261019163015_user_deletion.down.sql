@@ -0,0 +1,4 @@
+-- reverse: create index "user_deleted_at" to table: "users"
+DROP INDEX "user_deleted_at";
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "deleted_at";
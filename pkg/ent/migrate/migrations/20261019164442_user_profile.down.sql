@@ -0,0 +1,6 @@
+-- reverse: create index "article_shared_by" to table: "articles"
+DROP INDEX "article_shared_by";
+-- reverse: modify "articles" table
+ALTER TABLE "articles" DROP CONSTRAINT "articles_users_shared_articles", DROP COLUMN "shared_by";
+-- reverse: modify "users" table
+ALTER TABLE "users" DROP COLUMN "timezone", DROP COLUMN "locale", DROP COLUMN "bio", DROP COLUMN "avatar_url", DROP COLUMN "display_name";
@@ -0,0 +1,6 @@
+-- modify "users" table
+ALTER TABLE "users" ADD COLUMN "display_name" character varying NOT NULL DEFAULT '', ADD COLUMN "avatar_url" character varying NOT NULL DEFAULT '', ADD COLUMN "bio" character varying NOT NULL DEFAULT '', ADD COLUMN "locale" character varying NOT NULL DEFAULT '', ADD COLUMN "timezone" character varying NOT NULL DEFAULT '';
+-- modify "articles" table
+ALTER TABLE "articles" ADD COLUMN "shared_by" uuid NULL, ADD CONSTRAINT "articles_users_shared_articles" FOREIGN KEY ("shared_by") REFERENCES "users" ("id") ON DELETE SET NULL;
+-- create index "article_shared_by" to table: "articles"
+CREATE INDEX "article_shared_by" ON "articles" ("shared_by");
@@ -0,0 +1,14 @@
+-- reverse: modify "user_articles" table
+ALTER TABLE "user_articles" ALTER COLUMN "updated_at" DROP DEFAULT, ALTER COLUMN "created_at" DROP DEFAULT;
+-- reverse: modify "users" table
+ALTER TABLE "users" ALTER COLUMN "updated_at" DROP DEFAULT, ALTER COLUMN "created_at" DROP DEFAULT;
+-- reverse: modify "tombstones" table
+ALTER TABLE "tombstones" ALTER COLUMN "deleted_at" DROP DEFAULT;
+-- reverse: modify "credentials" table
+ALTER TABLE "credentials" ALTER COLUMN "updated_at" DROP DEFAULT, ALTER COLUMN "created_at" DROP DEFAULT;
+-- reverse: modify "audit_logs" table
+ALTER TABLE "audit_logs" ALTER COLUMN "occurred_at" DROP DEFAULT;
+-- reverse: modify "article_tags" table
+ALTER TABLE "article_tags" ALTER COLUMN "updated_at" DROP DEFAULT, ALTER COLUMN "created_at" DROP DEFAULT;
+-- reverse: modify "articles" table
+ALTER TABLE "articles" ALTER COLUMN "updated_at" DROP DEFAULT, ALTER COLUMN "created_at" DROP DEFAULT;
@@ -0,0 +1,14 @@
+-- modify "articles" table
+ALTER TABLE "articles" ALTER COLUMN "created_at" SET DEFAULT (CURRENT_TIMESTAMP), ALTER COLUMN "updated_at" SET DEFAULT (CURRENT_TIMESTAMP);
+-- modify "article_tags" table
+ALTER TABLE "article_tags" ALTER COLUMN "created_at" SET DEFAULT (CURRENT_TIMESTAMP), ALTER COLUMN "updated_at" SET DEFAULT (CURRENT_TIMESTAMP);
+-- modify "audit_logs" table
+ALTER TABLE "audit_logs" ALTER COLUMN "occurred_at" SET DEFAULT (CURRENT_TIMESTAMP);
+-- modify "credentials" table
+ALTER TABLE "credentials" ALTER COLUMN "created_at" SET DEFAULT (CURRENT_TIMESTAMP), ALTER COLUMN "updated_at" SET DEFAULT (CURRENT_TIMESTAMP);
+-- modify "tombstones" table
+ALTER TABLE "tombstones" ALTER COLUMN "deleted_at" SET DEFAULT (CURRENT_TIMESTAMP);
+-- modify "users" table
+ALTER TABLE "users" ALTER COLUMN "created_at" SET DEFAULT (CURRENT_TIMESTAMP), ALTER COLUMN "updated_at" SET DEFAULT (CURRENT_TIMESTAMP);
+-- modify "user_articles" table
+ALTER TABLE "user_articles" ALTER COLUMN "created_at" SET DEFAULT (CURRENT_TIMESTAMP), ALTER COLUMN "updated_at" SET DEFAULT (CURRENT_TIMESTAMP);
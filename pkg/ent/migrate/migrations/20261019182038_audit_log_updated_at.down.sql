@@ -0,0 +1,4 @@
+-- reverse: create index "auditlog_updated_at" to table: "audit_logs"
+DROP INDEX "auditlog_updated_at";
+-- reverse: modify "audit_logs" table
+ALTER TABLE "audit_logs" DROP COLUMN "updated_at";
@@ -0,0 +1,8 @@
+-- modify "audit_logs" table
+ALTER TABLE "audit_logs" ADD COLUMN "updated_at" timestamptz NULL DEFAULT (CURRENT_TIMESTAMP);
+-- backfill "audit_logs" updated_at from occurred_at
+UPDATE "audit_logs" SET "updated_at" = "occurred_at";
+-- modify "audit_logs" table
+ALTER TABLE "audit_logs" ALTER COLUMN "updated_at" SET NOT NULL;
+-- create index "auditlog_updated_at" to table: "audit_logs"
+CREATE INDEX "auditlog_updated_at" ON "audit_logs" ("updated_at");
@@ -0,0 +1,10 @@
+// Package migrations はentのスキーマから生成したバージョン付きのマイグレーションファイルを提供する.
+// ファイルは`make migrate-diff`で生成し、手で編集した場合は`atlas.sum`を再計算する必要がある.
+package migrations
+
+import "embed"
+
+// FS マイグレーションファイルとチェックサムファイル.
+//
+//go:embed *.sql atlas.sum
+var FS embed.FS
@@ -15,26 +15,53 @@ var (
 		{Name: "url", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString},
 		{Name: "thumbnail", Type: field.TypeString},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "shared_by", Type: field.TypeUUID, Nullable: true},
 	}
 	// ArticlesTable holds the schema information for the "articles" table.
 	ArticlesTable = &schema.Table{
 		Name:       "articles",
 		Columns:    ArticlesColumns,
 		PrimaryKey: []*schema.Column{ArticlesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "articles_users_shared_articles",
+				Columns:    []*schema.Column{ArticlesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
 				Name:    "article_title",
 				Unique:  false,
 				Columns: []*schema.Column{ArticlesColumns[1]},
 			},
+			{
+				Name:    "article_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[6]},
+			},
+			{
+				Name:    "article_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[7]},
+			},
+			{
+				Name:    "article_shared_by",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[8]},
+			},
 		},
 	}
 	// ArticleTagsColumns holds the columns for the "article_tags" table.
 	ArticleTagsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "tag", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
 		{Name: "article_id", Type: field.TypeUUID},
 	}
 	// ArticleTagsTable holds the schema information for the "article_tags" table.
@@ -45,7 +72,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "article_tags_articles_tags",
-				Columns:    []*schema.Column{ArticleTagsColumns[2]},
+				Columns:    []*schema.Column{ArticleTagsColumns[4]},
 				RefColumns: []*schema.Column{ArticlesColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -54,7 +81,7 @@ var (
 			{
 				Name:    "articletag_tag_article_id",
 				Unique:  true,
-				Columns: []*schema.Column{ArticleTagsColumns[1], ArticleTagsColumns[2]},
+				Columns: []*schema.Column{ArticleTagsColumns[1], ArticleTagsColumns[4]},
 			},
 			{
 				Name:    "articletag_tag",
@@ -64,27 +91,122 @@ var (
 			{
 				Name:    "articletag_article_id",
 				Unique:  false,
-				Columns: []*schema.Column{ArticleTagsColumns[2]},
+				Columns: []*schema.Column{ArticleTagsColumns[4]},
+			},
+			{
+				Name:    "articletag_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArticleTagsColumns[3]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "outcome", Type: field.TypeString},
+		{Name: "ip", Type: field.TypeString, Default: ""},
+		{Name: "forwarded_for", Type: field.TypeString, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Default: ""},
+		{Name: "trace_id", Type: field.TypeString, Default: ""},
+		{Name: "occurred_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_actor_id_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_event_type_occurred_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[3], AuditLogsColumns[9]},
+			},
+			{
+				Name:    "auditlog_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[10]},
+			},
+		},
+	}
+	// CredentialsColumns holds the columns for the "credentials" table.
+	CredentialsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "password_hash", Type: field.TypeString},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+	}
+	// CredentialsTable holds the schema information for the "credentials" table.
+	CredentialsTable = &schema.Table{
+		Name:       "credentials",
+		Columns:    CredentialsColumns,
+		PrimaryKey: []*schema.Column{CredentialsColumns[0]},
+	}
+	// TombstonesColumns holds the columns for the "tombstones" table.
+	TombstonesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "table_name", Type: field.TypeString},
+		{Name: "row_id", Type: field.TypeUUID},
+		{Name: "deleted_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+	}
+	// TombstonesTable holds the schema information for the "tombstones" table.
+	TombstonesTable = &schema.Table{
+		Name:       "tombstones",
+		Columns:    TombstonesColumns,
+		PrimaryKey: []*schema.Column{TombstonesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tombstone_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{TombstonesColumns[3]},
 			},
 		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "display_name", Type: field.TypeString, Default: ""},
+		{Name: "avatar_url", Type: field.TypeString, Default: ""},
+		{Name: "bio", Type: field.TypeString, Default: ""},
+		{Name: "locale", Type: field.TypeString, Default: ""},
+		{Name: "timezone", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
 		Name:       "users",
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "user_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{UsersColumns[8]},
+			},
+		},
 	}
 	// UserArticlesColumns holds the columns for the "user_articles" table.
 	UserArticlesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "created_at", Type: field.TypeTime},
-		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
+		{Name: "updated_at", Type: field.TypeTime, Default: schema.Expr("CURRENT_TIMESTAMP")},
 		{Name: "article_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
@@ -118,18 +240,27 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{UserArticlesColumns[4]},
 			},
+			{
+				Name:    "userarticle_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{UserArticlesColumns[2]},
+			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ArticlesTable,
 		ArticleTagsTable,
+		AuditLogsTable,
+		CredentialsTable,
+		TombstonesTable,
 		UsersTable,
 		UserArticlesTable,
 	}
 )
 
 func init() {
+	ArticlesTable.ForeignKeys[0].RefTable = UsersTable
 	ArticleTagsTable.ForeignKeys[0].RefTable = ArticlesTable
 	UserArticlesTable.ForeignKeys[0].RefTable = ArticlesTable
 	UserArticlesTable.ForeignKeys[1].RefTable = UsersTable
@@ -12,6 +12,15 @@ type Article func(*sql.Selector)
 // ArticleTag is the predicate function for articletag builders.
 type ArticleTag func(*sql.Selector)
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Credential is the predicate function for credential builders.
+type Credential func(*sql.Selector)
+
+// Tombstone is the predicate function for tombstone builders.
+type Tombstone func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
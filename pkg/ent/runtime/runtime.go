@@ -2,7 +2,173 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/morning-night-guild/platform-app/pkg/ent/runtime.go
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent/article"
+	"github.com/morning-night-guild/platform-app/pkg/ent/articletag"
+	"github.com/morning-night-guild/platform-app/pkg/ent/auditlog"
+	"github.com/morning-night-guild/platform-app/pkg/ent/credential"
+	"github.com/morning-night-guild/platform-app/pkg/ent/schema"
+	"github.com/morning-night-guild/platform-app/pkg/ent/tombstone"
+	"github.com/morning-night-guild/platform-app/pkg/ent/user"
+	"github.com/morning-night-guild/platform-app/pkg/ent/userarticle"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	articleInters := schema.Article{}.Interceptors()
+	article.Interceptors[0] = articleInters[0]
+	articleFields := schema.Article{}.Fields()
+	_ = articleFields
+	// articleDescCreatedAt is the schema descriptor for created_at field.
+	articleDescCreatedAt := articleFields[5].Descriptor()
+	// article.DefaultCreatedAt holds the default value on creation for the created_at field.
+	article.DefaultCreatedAt = articleDescCreatedAt.Default.(func() time.Time)
+	// articleDescUpdatedAt is the schema descriptor for updated_at field.
+	articleDescUpdatedAt := articleFields[6].Descriptor()
+	// article.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	article.DefaultUpdatedAt = articleDescUpdatedAt.Default.(func() time.Time)
+	// article.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	article.UpdateDefaultUpdatedAt = articleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articleDescID is the schema descriptor for id field.
+	articleDescID := articleFields[0].Descriptor()
+	// article.DefaultID holds the default value on creation for the id field.
+	article.DefaultID = articleDescID.Default.(func() uuid.UUID)
+	articletagFields := schema.ArticleTag{}.Fields()
+	_ = articletagFields
+	// articletagDescCreatedAt is the schema descriptor for created_at field.
+	articletagDescCreatedAt := articletagFields[3].Descriptor()
+	// articletag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articletag.DefaultCreatedAt = articletagDescCreatedAt.Default.(func() time.Time)
+	// articletagDescUpdatedAt is the schema descriptor for updated_at field.
+	articletagDescUpdatedAt := articletagFields[4].Descriptor()
+	// articletag.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	articletag.DefaultUpdatedAt = articletagDescUpdatedAt.Default.(func() time.Time)
+	// articletag.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	articletag.UpdateDefaultUpdatedAt = articletagDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articletagDescID is the schema descriptor for id field.
+	articletagDescID := articletagFields[0].Descriptor()
+	// articletag.DefaultID holds the default value on creation for the id field.
+	articletag.DefaultID = articletagDescID.Default.(func() uuid.UUID)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescEmail is the schema descriptor for email field.
+	auditlogDescEmail := auditlogFields[2].Descriptor()
+	// auditlog.DefaultEmail holds the default value on creation for the email field.
+	auditlog.DefaultEmail = auditlogDescEmail.Default.(string)
+	// auditlogDescIP is the schema descriptor for ip field.
+	auditlogDescIP := auditlogFields[5].Descriptor()
+	// auditlog.DefaultIP holds the default value on creation for the ip field.
+	auditlog.DefaultIP = auditlogDescIP.Default.(string)
+	// auditlogDescForwardedFor is the schema descriptor for forwarded_for field.
+	auditlogDescForwardedFor := auditlogFields[6].Descriptor()
+	// auditlog.DefaultForwardedFor holds the default value on creation for the forwarded_for field.
+	auditlog.DefaultForwardedFor = auditlogDescForwardedFor.Default.(string)
+	// auditlogDescUserAgent is the schema descriptor for user_agent field.
+	auditlogDescUserAgent := auditlogFields[7].Descriptor()
+	// auditlog.DefaultUserAgent holds the default value on creation for the user_agent field.
+	auditlog.DefaultUserAgent = auditlogDescUserAgent.Default.(string)
+	// auditlogDescTraceID is the schema descriptor for trace_id field.
+	auditlogDescTraceID := auditlogFields[8].Descriptor()
+	// auditlog.DefaultTraceID holds the default value on creation for the trace_id field.
+	auditlog.DefaultTraceID = auditlogDescTraceID.Default.(string)
+	// auditlogDescOccurredAt is the schema descriptor for occurred_at field.
+	auditlogDescOccurredAt := auditlogFields[9].Descriptor()
+	// auditlog.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	auditlog.DefaultOccurredAt = auditlogDescOccurredAt.Default.(func() time.Time)
+	// auditlogDescUpdatedAt is the schema descriptor for updated_at field.
+	auditlogDescUpdatedAt := auditlogFields[10].Descriptor()
+	// auditlog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	auditlog.DefaultUpdatedAt = auditlogDescUpdatedAt.Default.(func() time.Time)
+	// auditlog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	auditlog.UpdateDefaultUpdatedAt = auditlogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
+	credentialFields := schema.Credential{}.Fields()
+	_ = credentialFields
+	// credentialDescEmailVerified is the schema descriptor for email_verified field.
+	credentialDescEmailVerified := credentialFields[3].Descriptor()
+	// credential.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	credential.DefaultEmailVerified = credentialDescEmailVerified.Default.(bool)
+	// credentialDescCreatedAt is the schema descriptor for created_at field.
+	credentialDescCreatedAt := credentialFields[4].Descriptor()
+	// credential.DefaultCreatedAt holds the default value on creation for the created_at field.
+	credential.DefaultCreatedAt = credentialDescCreatedAt.Default.(func() time.Time)
+	// credentialDescUpdatedAt is the schema descriptor for updated_at field.
+	credentialDescUpdatedAt := credentialFields[5].Descriptor()
+	// credential.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	credential.DefaultUpdatedAt = credentialDescUpdatedAt.Default.(func() time.Time)
+	// credential.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	credential.UpdateDefaultUpdatedAt = credentialDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tombstoneFields := schema.Tombstone{}.Fields()
+	_ = tombstoneFields
+	// tombstoneDescDeletedAt is the schema descriptor for deleted_at field.
+	tombstoneDescDeletedAt := tombstoneFields[3].Descriptor()
+	// tombstone.DefaultDeletedAt holds the default value on creation for the deleted_at field.
+	tombstone.DefaultDeletedAt = tombstoneDescDeletedAt.Default.(func() time.Time)
+	// tombstoneDescID is the schema descriptor for id field.
+	tombstoneDescID := tombstoneFields[0].Descriptor()
+	// tombstone.DefaultID holds the default value on creation for the id field.
+	tombstone.DefaultID = tombstoneDescID.Default.(func() uuid.UUID)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescDisplayName is the schema descriptor for display_name field.
+	userDescDisplayName := userFields[1].Descriptor()
+	// user.DefaultDisplayName holds the default value on creation for the display_name field.
+	user.DefaultDisplayName = userDescDisplayName.Default.(string)
+	// userDescAvatarURL is the schema descriptor for avatar_url field.
+	userDescAvatarURL := userFields[2].Descriptor()
+	// user.DefaultAvatarURL holds the default value on creation for the avatar_url field.
+	user.DefaultAvatarURL = userDescAvatarURL.Default.(string)
+	// userDescBio is the schema descriptor for bio field.
+	userDescBio := userFields[3].Descriptor()
+	// user.DefaultBio holds the default value on creation for the bio field.
+	user.DefaultBio = userDescBio.Default.(string)
+	// userDescLocale is the schema descriptor for locale field.
+	userDescLocale := userFields[4].Descriptor()
+	// user.DefaultLocale holds the default value on creation for the locale field.
+	user.DefaultLocale = userDescLocale.Default.(string)
+	// userDescTimezone is the schema descriptor for timezone field.
+	userDescTimezone := userFields[5].Descriptor()
+	// user.DefaultTimezone holds the default value on creation for the timezone field.
+	user.DefaultTimezone = userDescTimezone.Default.(string)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[6].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[7].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	userarticleFields := schema.UserArticle{}.Fields()
+	_ = userarticleFields
+	// userarticleDescCreatedAt is the schema descriptor for created_at field.
+	userarticleDescCreatedAt := userarticleFields[3].Descriptor()
+	// userarticle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	userarticle.DefaultCreatedAt = userarticleDescCreatedAt.Default.(func() time.Time)
+	// userarticleDescUpdatedAt is the schema descriptor for updated_at field.
+	userarticleDescUpdatedAt := userarticleFields[4].Descriptor()
+	// userarticle.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	userarticle.DefaultUpdatedAt = userarticleDescUpdatedAt.Default.(func() time.Time)
+	// userarticle.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	userarticle.UpdateDefaultUpdatedAt = userarticleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userarticleDescID is the schema descriptor for id field.
+	userarticleDescID := userarticleFields[0].Descriptor()
+	// userarticle.DefaultID holds the default value on creation for the id field.
+	userarticle.DefaultID = userarticleDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.12.3"                                         // Version of ent codegen.
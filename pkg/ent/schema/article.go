@@ -1,14 +1,16 @@
 package schema
 
 import (
-	"time"
+	"context"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent/intercept"
 )
 
 // Article holds the schema definition for the Article entity.
@@ -24,8 +26,12 @@ func (Article) Fields() []ent.Field {
 		field.String("url").Unique(),
 		field.String("description"),
 		field.String("thumbnail"),
-		field.Time("created_at").Default(time.Now().UTC),
-		field.Time("updated_at").Default(time.Now().UTC).UpdateDefault(time.Now().UTC),
+		field.Time("created_at").Default(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("updated_at").Default(nowUTC).UpdateDefault(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("deleted_at").Optional().Nillable(),
+		field.UUID("shared_by", uuid.UUID{}).Optional().Nillable(),
 	}
 }
 
@@ -40,6 +46,10 @@ func (Article) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		edge.From("sharer", User.Type).
+			Ref("shared_articles").
+			Field("shared_by").
+			Unique(),
 	}
 }
 
@@ -47,5 +57,32 @@ func (Article) Edges() []ent.Edge {
 func (Article) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("title"),
+		index.Fields("updated_at"),
+		index.Fields("deleted_at"),
+		index.Fields("shared_by"),
+	}
+}
+
+type skipSoftDeleteKey struct{}
+
+// SkipSoftDelete ゴミ箱に移動した記事も取得するコンテキストを返す.
+func SkipSoftDelete(parent context.Context) context.Context {
+	return context.WithValue(parent, skipSoftDeleteKey{}, true)
+}
+
+// Interceptors of the Article.
+// ゴミ箱に移動した記事(deleted_atが設定された記事)は、SkipSoftDeleteを指定しない限り取得しない.
+// 他のエンティティから辿った場合も同様に除外する.
+func (Article) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if skip, _ := ctx.Value(skipSoftDeleteKey{}).(bool); skip {
+				return nil
+			}
+
+			q.WhereP(sql.FieldIsNull("deleted_at"))
+
+			return nil
+		}),
 	}
 }
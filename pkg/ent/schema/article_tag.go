@@ -2,6 +2,7 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -19,6 +20,10 @@ func (ArticleTag) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.String("tag"),
 		field.UUID("article_id", uuid.UUID{}),
+		field.Time("created_at").Default(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("updated_at").Default(nowUTC).UpdateDefault(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
 	}
 }
 
@@ -40,5 +45,6 @@ func (ArticleTag) Indexes() []ent.Index {
 		index.Fields("tag", "article_id").Unique(),
 		index.Fields("tag"),
 		index.Fields("article_id"),
+		index.Fields("updated_at"),
 	}
 }
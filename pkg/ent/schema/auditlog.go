@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// 追記のみを前提とするため、全てのフィールドを更新不可とする.
+// ただし、ユーザーを完全に削除する際に匿名化するため、個人を特定できるフィールドは更新可能とする.
+// 匿名化を差分バックアップに含めるため、更新日時を記録する.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.UUID("actor_id", uuid.UUID{}).Optional().Nillable(),
+		field.String("email").Default(""),
+		field.String("event_type").Immutable(),
+		field.String("outcome").Immutable(),
+		field.String("ip").Default(""),
+		field.String("forwarded_for").Default(""),
+		field.String("user_agent").Default(""),
+		field.String("trace_id").Default("").Immutable(),
+		field.Time("occurred_at").Default(nowUTC).Immutable().
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("updated_at").Default(nowUTC).UpdateDefault(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("occurred_at"),
+		index.Fields("actor_id", "occurred_at"),
+		index.Fields("event_type", "occurred_at"),
+		index.Fields("updated_at"),
+	}
+}
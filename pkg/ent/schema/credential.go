@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Credential holds the schema definition for the Credential entity.
+type Credential struct {
+	ent.Schema
+}
+
+// Fields of the Credential.
+func (Credential) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}),
+		field.String("email").Unique(),
+		field.String("password_hash").Sensitive(),
+		field.Bool("email_verified").Default(false),
+		field.Time("created_at").Default(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("updated_at").Default(nowUTC).UpdateDefault(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Edges of the Credential.
+func (Credential) Edges() []ent.Edge {
+	return nil
+}
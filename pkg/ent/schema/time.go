@@ -0,0 +1,9 @@
+package schema
+
+import "time"
+
+// nowUTC 現在時刻をUTCで返す.
+// 既定値にtime.Now().UTCを渡すとスキーマを読み込んだ時刻に固定されるため、呼び出しのたびに時刻を取得する.
+func nowUTC() time.Time {
+	return time.Now().UTC()
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Tombstone holds the schema definition for the Tombstone entity.
+// 差分バックアップで削除を再現するため、削除された行をトリガーで記録する.
+// トリガーはマイグレーションファイルで作成する.
+type Tombstone struct {
+	ent.Schema
+}
+
+// Fields of the Tombstone.
+func (Tombstone) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).Default(uuid.New).Immutable(),
+		field.String("table_name").Immutable(),
+		field.UUID("row_id", uuid.UUID{}).Immutable(),
+		field.Time("deleted_at").Default(nowUTC).Immutable().
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+	}
+}
+
+// Edges of the Tombstone.
+func (Tombstone) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Tombstone.
+func (Tombstone) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
+	}
+}
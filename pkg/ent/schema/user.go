@@ -1,12 +1,11 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -19,8 +18,16 @@ type User struct {
 func (User) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
-		field.Time("created_at").Default(time.Now().UTC),
-		field.Time("updated_at").Default(time.Now().UTC).UpdateDefault(time.Now().UTC),
+		field.String("display_name").Default(""),
+		field.String("avatar_url").Default(""),
+		field.String("bio").Default(""),
+		field.String("locale").Default(""),
+		field.String("timezone").Default(""),
+		field.Time("created_at").Default(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("updated_at").Default(nowUTC).UpdateDefault(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("deleted_at").Optional().Nillable(),
 	}
 }
 
@@ -31,5 +38,17 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{
 				OnDelete: entsql.Cascade,
 			}),
+		// ユーザーを完全に削除しても共有した記事は残す
+		edge.To("shared_articles", Article.Type).
+			Annotations(entsql.Annotation{
+				OnDelete: entsql.SetNull,
+			}),
+	}
+}
+
+// Indexes of the User.
+func (User) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deleted_at"),
 	}
 }
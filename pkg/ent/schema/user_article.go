@@ -1,9 +1,8 @@
 package schema
 
 import (
-	"time"
-
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -21,8 +20,10 @@ func (UserArticle) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).Default(uuid.New),
 		field.UUID("article_id", uuid.UUID{}),
 		field.UUID("user_id", uuid.UUID{}),
-		field.Time("created_at").Default(time.Now().UTC),
-		field.Time("updated_at").Default(time.Now().UTC).UpdateDefault(time.Now().UTC),
+		field.Time("created_at").Default(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
+		field.Time("updated_at").Default(nowUTC).UpdateDefault(nowUTC).
+			Annotations(entsql.DefaultExpr("CURRENT_TIMESTAMP")),
 	}
 }
 
@@ -47,5 +48,6 @@ func (UserArticle) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "article_id").Unique(),
 		index.Fields("user_id"),
+		index.Fields("updated_at"),
 	}
 }
@@ -0,0 +1,129 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent/tombstone"
+)
+
+// Tombstone is the model entity for the Tombstone schema.
+type Tombstone struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// TableName holds the value of the "table_name" field.
+	TableName string `json:"table_name,omitempty"`
+	// RowID holds the value of the "row_id" field.
+	RowID uuid.UUID `json:"row_id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt    time.Time `json:"deleted_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Tombstone) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tombstone.FieldTableName:
+			values[i] = new(sql.NullString)
+		case tombstone.FieldDeletedAt:
+			values[i] = new(sql.NullTime)
+		case tombstone.FieldID, tombstone.FieldRowID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Tombstone fields.
+func (t *Tombstone) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tombstone.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				t.ID = *value
+			}
+		case tombstone.FieldTableName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field table_name", values[i])
+			} else if value.Valid {
+				t.TableName = value.String
+			}
+		case tombstone.FieldRowID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field row_id", values[i])
+			} else if value != nil {
+				t.RowID = *value
+			}
+		case tombstone.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				t.DeletedAt = value.Time
+			}
+		default:
+			t.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Tombstone.
+// This includes values selected through modifiers, order, etc.
+func (t *Tombstone) Value(name string) (ent.Value, error) {
+	return t.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Tombstone.
+// Note that you need to call Tombstone.Unwrap() before calling this method if this Tombstone
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Tombstone) Update() *TombstoneUpdateOne {
+	return NewTombstoneClient(t.config).UpdateOne(t)
+}
+
+// Unwrap unwraps the Tombstone entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (t *Tombstone) Unwrap() *Tombstone {
+	_tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Tombstone is not a transactional entity")
+	}
+	t.config.driver = _tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Tombstone) String() string {
+	var builder strings.Builder
+	builder.WriteString("Tombstone(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", t.ID))
+	builder.WriteString("table_name=")
+	builder.WriteString(t.TableName)
+	builder.WriteString(", ")
+	builder.WriteString("row_id=")
+	builder.WriteString(fmt.Sprintf("%v", t.RowID))
+	builder.WriteString(", ")
+	builder.WriteString("deleted_at=")
+	builder.WriteString(t.DeletedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Tombstones is a parsable slice of Tombstone.
+type Tombstones []*Tombstone
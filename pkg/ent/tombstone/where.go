@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tombstone
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLTE(FieldID, id))
+}
+
+// TableName applies equality check predicate on the "table_name" field. It's identical to TableNameEQ.
+func TableName(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldTableName, v))
+}
+
+// RowID applies equality check predicate on the "row_id" field. It's identical to RowIDEQ.
+func RowID(v uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldRowID, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// TableNameEQ applies the EQ predicate on the "table_name" field.
+func TableNameEQ(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldTableName, v))
+}
+
+// TableNameNEQ applies the NEQ predicate on the "table_name" field.
+func TableNameNEQ(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNEQ(FieldTableName, v))
+}
+
+// TableNameIn applies the In predicate on the "table_name" field.
+func TableNameIn(vs ...string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldIn(FieldTableName, vs...))
+}
+
+// TableNameNotIn applies the NotIn predicate on the "table_name" field.
+func TableNameNotIn(vs ...string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNotIn(FieldTableName, vs...))
+}
+
+// TableNameGT applies the GT predicate on the "table_name" field.
+func TableNameGT(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGT(FieldTableName, v))
+}
+
+// TableNameGTE applies the GTE predicate on the "table_name" field.
+func TableNameGTE(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGTE(FieldTableName, v))
+}
+
+// TableNameLT applies the LT predicate on the "table_name" field.
+func TableNameLT(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLT(FieldTableName, v))
+}
+
+// TableNameLTE applies the LTE predicate on the "table_name" field.
+func TableNameLTE(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLTE(FieldTableName, v))
+}
+
+// TableNameContains applies the Contains predicate on the "table_name" field.
+func TableNameContains(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldContains(FieldTableName, v))
+}
+
+// TableNameHasPrefix applies the HasPrefix predicate on the "table_name" field.
+func TableNameHasPrefix(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldHasPrefix(FieldTableName, v))
+}
+
+// TableNameHasSuffix applies the HasSuffix predicate on the "table_name" field.
+func TableNameHasSuffix(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldHasSuffix(FieldTableName, v))
+}
+
+// TableNameEqualFold applies the EqualFold predicate on the "table_name" field.
+func TableNameEqualFold(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEqualFold(FieldTableName, v))
+}
+
+// TableNameContainsFold applies the ContainsFold predicate on the "table_name" field.
+func TableNameContainsFold(v string) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldContainsFold(FieldTableName, v))
+}
+
+// RowIDEQ applies the EQ predicate on the "row_id" field.
+func RowIDEQ(v uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldRowID, v))
+}
+
+// RowIDNEQ applies the NEQ predicate on the "row_id" field.
+func RowIDNEQ(v uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNEQ(FieldRowID, v))
+}
+
+// RowIDIn applies the In predicate on the "row_id" field.
+func RowIDIn(vs ...uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldIn(FieldRowID, vs...))
+}
+
+// RowIDNotIn applies the NotIn predicate on the "row_id" field.
+func RowIDNotIn(vs ...uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNotIn(FieldRowID, vs...))
+}
+
+// RowIDGT applies the GT predicate on the "row_id" field.
+func RowIDGT(v uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGT(FieldRowID, v))
+}
+
+// RowIDGTE applies the GTE predicate on the "row_id" field.
+func RowIDGTE(v uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGTE(FieldRowID, v))
+}
+
+// RowIDLT applies the LT predicate on the "row_id" field.
+func RowIDLT(v uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLT(FieldRowID, v))
+}
+
+// RowIDLTE applies the LTE predicate on the "row_id" field.
+func RowIDLTE(v uuid.UUID) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLTE(FieldRowID, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Tombstone {
+	return predicate.Tombstone(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Tombstone) predicate.Tombstone {
+	return predicate.Tombstone(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Tombstone) predicate.Tombstone {
+	return predicate.Tombstone(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Tombstone) predicate.Tombstone {
+	return predicate.Tombstone(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,515 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/morning-night-guild/platform-app/pkg/ent/tombstone"
+)
+
+// TombstoneCreate is the builder for creating a Tombstone entity.
+type TombstoneCreate struct {
+	config
+	mutation *TombstoneMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetTableName sets the "table_name" field.
+func (tc *TombstoneCreate) SetTableName(s string) *TombstoneCreate {
+	tc.mutation.SetTableName(s)
+	return tc
+}
+
+// SetRowID sets the "row_id" field.
+func (tc *TombstoneCreate) SetRowID(u uuid.UUID) *TombstoneCreate {
+	tc.mutation.SetRowID(u)
+	return tc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (tc *TombstoneCreate) SetDeletedAt(t time.Time) *TombstoneCreate {
+	tc.mutation.SetDeletedAt(t)
+	return tc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (tc *TombstoneCreate) SetNillableDeletedAt(t *time.Time) *TombstoneCreate {
+	if t != nil {
+		tc.SetDeletedAt(*t)
+	}
+	return tc
+}
+
+// SetID sets the "id" field.
+func (tc *TombstoneCreate) SetID(u uuid.UUID) *TombstoneCreate {
+	tc.mutation.SetID(u)
+	return tc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (tc *TombstoneCreate) SetNillableID(u *uuid.UUID) *TombstoneCreate {
+	if u != nil {
+		tc.SetID(*u)
+	}
+	return tc
+}
+
+// Mutation returns the TombstoneMutation object of the builder.
+func (tc *TombstoneCreate) Mutation() *TombstoneMutation {
+	return tc.mutation
+}
+
+// Save creates the Tombstone in the database.
+func (tc *TombstoneCreate) Save(ctx context.Context) (*Tombstone, error) {
+	tc.defaults()
+	return withHooks(ctx, tc.sqlSave, tc.mutation, tc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *TombstoneCreate) SaveX(ctx context.Context) *Tombstone {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tc *TombstoneCreate) Exec(ctx context.Context) error {
+	_, err := tc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tc *TombstoneCreate) ExecX(ctx context.Context) {
+	if err := tc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tc *TombstoneCreate) defaults() {
+	if _, ok := tc.mutation.DeletedAt(); !ok {
+		v := tombstone.DefaultDeletedAt()
+		tc.mutation.SetDeletedAt(v)
+	}
+	if _, ok := tc.mutation.ID(); !ok {
+		v := tombstone.DefaultID()
+		tc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tc *TombstoneCreate) check() error {
+	if _, ok := tc.mutation.TableName(); !ok {
+		return &ValidationError{Name: "table_name", err: errors.New(`ent: missing required field "Tombstone.table_name"`)}
+	}
+	if _, ok := tc.mutation.RowID(); !ok {
+		return &ValidationError{Name: "row_id", err: errors.New(`ent: missing required field "Tombstone.row_id"`)}
+	}
+	return nil
+}
+
+func (tc *TombstoneCreate) sqlSave(ctx context.Context) (*Tombstone, error) {
+	if err := tc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	tc.mutation.id = &_node.ID
+	tc.mutation.done = true
+	return _node, nil
+}
+
+func (tc *TombstoneCreate) createSpec() (*Tombstone, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Tombstone{config: tc.config}
+		_spec = sqlgraph.NewCreateSpec(tombstone.Table, sqlgraph.NewFieldSpec(tombstone.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = tc.conflict
+	if id, ok := tc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := tc.mutation.TableName(); ok {
+		_spec.SetField(tombstone.FieldTableName, field.TypeString, value)
+		_node.TableName = value
+	}
+	if value, ok := tc.mutation.RowID(); ok {
+		_spec.SetField(tombstone.FieldRowID, field.TypeUUID, value)
+		_node.RowID = value
+	}
+	if value, ok := tc.mutation.DeletedAt(); ok {
+		_spec.SetField(tombstone.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Tombstone.Create().
+//		SetTableName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TombstoneUpsert) {
+//			SetTableName(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TombstoneCreate) OnConflict(opts ...sql.ConflictOption) *TombstoneUpsertOne {
+	tc.conflict = opts
+	return &TombstoneUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Tombstone.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TombstoneCreate) OnConflictColumns(columns ...string) *TombstoneUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TombstoneUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TombstoneUpsertOne is the builder for "upsert"-ing
+	//  one Tombstone node.
+	TombstoneUpsertOne struct {
+		create *TombstoneCreate
+	}
+
+	// TombstoneUpsert is the "OnConflict" setter.
+	TombstoneUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Tombstone.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tombstone.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TombstoneUpsertOne) UpdateNewValues() *TombstoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tombstone.FieldID)
+		}
+		if _, exists := u.create.mutation.TableName(); exists {
+			s.SetIgnore(tombstone.FieldTableName)
+		}
+		if _, exists := u.create.mutation.RowID(); exists {
+			s.SetIgnore(tombstone.FieldRowID)
+		}
+		if _, exists := u.create.mutation.DeletedAt(); exists {
+			s.SetIgnore(tombstone.FieldDeletedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Tombstone.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TombstoneUpsertOne) Ignore() *TombstoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TombstoneUpsertOne) DoNothing() *TombstoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TombstoneCreate.OnConflict
+// documentation for more info.
+func (u *TombstoneUpsertOne) Update(set func(*TombstoneUpsert)) *TombstoneUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TombstoneUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TombstoneUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TombstoneCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TombstoneUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TombstoneUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TombstoneUpsertOne.ID is not supported by MySQL driver. Use TombstoneUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TombstoneUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TombstoneCreateBulk is the builder for creating many Tombstone entities in bulk.
+type TombstoneCreateBulk struct {
+	config
+	builders []*TombstoneCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Tombstone entities in the database.
+func (tcb *TombstoneCreateBulk) Save(ctx context.Context) ([]*Tombstone, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(tcb.builders))
+	nodes := make([]*Tombstone, len(tcb.builders))
+	mutators := make([]Mutator, len(tcb.builders))
+	for i := range tcb.builders {
+		func(i int, root context.Context) {
+			builder := tcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TombstoneMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcb *TombstoneCreateBulk) SaveX(ctx context.Context) []*Tombstone {
+	v, err := tcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcb *TombstoneCreateBulk) Exec(ctx context.Context) error {
+	_, err := tcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcb *TombstoneCreateBulk) ExecX(ctx context.Context) {
+	if err := tcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Tombstone.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TombstoneUpsert) {
+//			SetTableName(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TombstoneCreateBulk) OnConflict(opts ...sql.ConflictOption) *TombstoneUpsertBulk {
+	tcb.conflict = opts
+	return &TombstoneUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Tombstone.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TombstoneCreateBulk) OnConflictColumns(columns ...string) *TombstoneUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TombstoneUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TombstoneUpsertBulk is the builder for "upsert"-ing
+// a bulk of Tombstone nodes.
+type TombstoneUpsertBulk struct {
+	create *TombstoneCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Tombstone.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tombstone.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TombstoneUpsertBulk) UpdateNewValues() *TombstoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tombstone.FieldID)
+			}
+			if _, exists := b.mutation.TableName(); exists {
+				s.SetIgnore(tombstone.FieldTableName)
+			}
+			if _, exists := b.mutation.RowID(); exists {
+				s.SetIgnore(tombstone.FieldRowID)
+			}
+			if _, exists := b.mutation.DeletedAt(); exists {
+				s.SetIgnore(tombstone.FieldDeletedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Tombstone.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TombstoneUpsertBulk) Ignore() *TombstoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TombstoneUpsertBulk) DoNothing() *TombstoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TombstoneCreateBulk.OnConflict
+// documentation for more info.
+func (u *TombstoneUpsertBulk) Update(set func(*TombstoneUpsert)) *TombstoneUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TombstoneUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *TombstoneUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TombstoneCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TombstoneCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TombstoneUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -18,6 +18,12 @@ type Tx struct {
 	Article *ArticleClient
 	// ArticleTag is the client for interacting with the ArticleTag builders.
 	ArticleTag *ArticleTagClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Credential is the client for interacting with the Credential builders.
+	Credential *CredentialClient
+	// Tombstone is the client for interacting with the Tombstone builders.
+	Tombstone *TombstoneClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserArticle is the client for interacting with the UserArticle builders.
@@ -155,6 +161,9 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Article = NewArticleClient(tx.config)
 	tx.ArticleTag = NewArticleTagClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Credential = NewCredentialClient(tx.config)
+	tx.Tombstone = NewTombstoneClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserArticle = NewUserArticleClient(tx.config)
 }
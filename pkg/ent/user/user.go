@@ -15,12 +15,26 @@ const (
 	Label = "user"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
+	// FieldDisplayName holds the string denoting the display_name field in the database.
+	FieldDisplayName = "display_name"
+	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
+	FieldAvatarURL = "avatar_url"
+	// FieldBio holds the string denoting the bio field in the database.
+	FieldBio = "bio"
+	// FieldLocale holds the string denoting the locale field in the database.
+	FieldLocale = "locale"
+	// FieldTimezone holds the string denoting the timezone field in the database.
+	FieldTimezone = "timezone"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// EdgeUserArticles holds the string denoting the user_articles edge name in mutations.
 	EdgeUserArticles = "user_articles"
+	// EdgeSharedArticles holds the string denoting the shared_articles edge name in mutations.
+	EdgeSharedArticles = "shared_articles"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// UserArticlesTable is the table that holds the user_articles relation/edge.
@@ -30,13 +44,26 @@ const (
 	UserArticlesInverseTable = "user_articles"
 	// UserArticlesColumn is the table column denoting the user_articles relation/edge.
 	UserArticlesColumn = "user_id"
+	// SharedArticlesTable is the table that holds the shared_articles relation/edge.
+	SharedArticlesTable = "articles"
+	// SharedArticlesInverseTable is the table name for the Article entity.
+	// It exists in this package in order to avoid circular dependency with the "article" package.
+	SharedArticlesInverseTable = "articles"
+	// SharedArticlesColumn is the table column denoting the shared_articles relation/edge.
+	SharedArticlesColumn = "shared_by"
 )
 
 // Columns holds all SQL columns for user fields.
 var Columns = []string{
 	FieldID,
+	FieldDisplayName,
+	FieldAvatarURL,
+	FieldBio,
+	FieldLocale,
+	FieldTimezone,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -50,6 +77,16 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultDisplayName holds the default value on creation for the "display_name" field.
+	DefaultDisplayName string
+	// DefaultAvatarURL holds the default value on creation for the "avatar_url" field.
+	DefaultAvatarURL string
+	// DefaultBio holds the default value on creation for the "bio" field.
+	DefaultBio string
+	// DefaultLocale holds the default value on creation for the "locale" field.
+	DefaultLocale string
+	// DefaultTimezone holds the default value on creation for the "timezone" field.
+	DefaultTimezone string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -68,6 +105,31 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
+// ByDisplayName orders the results by the display_name field.
+func ByDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayName, opts...).ToFunc()
+}
+
+// ByAvatarURL orders the results by the avatar_url field.
+func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
+}
+
+// ByBio orders the results by the bio field.
+func ByBio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBio, opts...).ToFunc()
+}
+
+// ByLocale orders the results by the locale field.
+func ByLocale(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocale, opts...).ToFunc()
+}
+
+// ByTimezone orders the results by the timezone field.
+func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -78,6 +140,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByUserArticlesCount orders the results by user_articles count.
 func ByUserArticlesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -91,6 +158,20 @@ func ByUserArticles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserArticlesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySharedArticlesCount orders the results by shared_articles count.
+func BySharedArticlesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharedArticlesStep(), opts...)
+	}
+}
+
+// BySharedArticles orders the results by shared_articles terms.
+func BySharedArticles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharedArticlesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserArticlesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -98,3 +179,10 @@ func newUserArticlesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, UserArticlesTable, UserArticlesColumn),
 	)
 }
+func newSharedArticlesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharedArticlesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SharedArticlesTable, SharedArticlesColumn),
+	)
+}
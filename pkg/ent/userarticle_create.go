@@ -147,12 +147,6 @@ func (uac *UserArticleCreate) check() error {
 	if _, ok := uac.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserArticle.user_id"`)}
 	}
-	if _, ok := uac.mutation.CreatedAt(); !ok {
-		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserArticle.created_at"`)}
-	}
-	if _, ok := uac.mutation.UpdatedAt(); !ok {
-		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserArticle.updated_at"`)}
-	}
 	if _, ok := uac.mutation.ArticleID(); !ok {
 		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "UserArticle.article"`)}
 	}
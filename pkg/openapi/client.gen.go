@@ -90,6 +90,24 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// WellKnownJWKS request
+	WellKnownJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AdminArticleTrashList request
+	V1AdminArticleTrashList(ctx context.Context, params *V1AdminArticleTrashListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AdminArticleRestore request
+	V1AdminArticleRestore(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AdminAuditList request
+	V1AdminAuditList(ctx context.Context, params *V1AdminAuditListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AdminAuditExport request
+	V1AdminAuditExport(ctx context.Context, params *V1AdminAuditExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AdminUserDelete request
+	V1AdminUserDelete(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V1ArticleList request
 	V1ArticleList(ctx context.Context, params *V1ArticleListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -104,6 +122,19 @@ type ClientInterface interface {
 	// V1ArticleAddOwn request
 	V1ArticleAddOwn(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V1AuthChangeEmail request with any body
+	V1AuthChangeEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V1AuthChangeEmail(ctx context.Context, body V1AuthChangeEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AuthConfirmEmail request
+	V1AuthConfirmEmail(ctx context.Context, params *V1AuthConfirmEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AuthVerifyEmail request with any body
+	V1AuthVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V1AuthVerifyEmailWithFormdataBody(ctx context.Context, body V1AuthVerifyEmailFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V1AuthInvite request with any body
 	V1AuthInviteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -114,6 +145,14 @@ type ClientInterface interface {
 
 	V1AuthJoin(ctx context.Context, body V1AuthJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// V1AuthOIDCAuthorize request with any body
+	V1AuthOIDCAuthorizeWithBody(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V1AuthOIDCAuthorize(ctx context.Context, provider string, body V1AuthOIDCAuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1AuthOIDCCallback request
+	V1AuthOIDCCallback(ctx context.Context, provider string, params *V1AuthOIDCCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// V1AuthChangePassword request with any body
 	V1AuthChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -154,6 +193,89 @@ type ClientInterface interface {
 
 	// V1InternalArticleDelete request
 	V1InternalArticleDelete(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1UserDelete request
+	V1UserDelete(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1UserGet request
+	V1UserGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// V1UserUpdate request with any body
+	V1UserUpdateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	V1UserUpdate(ctx context.Context, body V1UserUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) WellKnownJWKS(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWellKnownJWKSRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AdminArticleTrashList(ctx context.Context, params *V1AdminArticleTrashListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AdminArticleTrashListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AdminArticleRestore(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AdminArticleRestoreRequest(c.Server, articleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AdminAuditList(ctx context.Context, params *V1AdminAuditListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AdminAuditListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AdminAuditExport(ctx context.Context, params *V1AdminAuditExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AdminAuditExportRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AdminUserDelete(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AdminUserDeleteRequest(c.Server, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) V1ArticleList(ctx context.Context, params *V1ArticleListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -216,6 +338,66 @@ func (c *Client) V1ArticleAddOwn(ctx context.Context, articleId openapi_types.UU
 	return c.Client.Do(req)
 }
 
+func (c *Client) V1AuthChangeEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthChangeEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AuthChangeEmail(ctx context.Context, body V1AuthChangeEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthChangeEmailRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AuthConfirmEmail(ctx context.Context, params *V1AuthConfirmEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthConfirmEmailRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AuthVerifyEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthVerifyEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AuthVerifyEmailWithFormdataBody(ctx context.Context, body V1AuthVerifyEmailFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthVerifyEmailRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) V1AuthInviteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewV1AuthInviteRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -264,6 +446,42 @@ func (c *Client) V1AuthJoin(ctx context.Context, body V1AuthJoinJSONRequestBody,
 	return c.Client.Do(req)
 }
 
+func (c *Client) V1AuthOIDCAuthorizeWithBody(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthOIDCAuthorizeRequestWithBody(c.Server, provider, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AuthOIDCAuthorize(ctx context.Context, provider string, body V1AuthOIDCAuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthOIDCAuthorizeRequest(c.Server, provider, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1AuthOIDCCallback(ctx context.Context, provider string, params *V1AuthOIDCCallbackParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1AuthOIDCCallbackRequest(c.Server, provider, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) V1AuthChangePasswordWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewV1AuthChangePasswordRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -444,8 +662,56 @@ func (c *Client) V1InternalArticleDelete(ctx context.Context, articleId openapi_
 	return c.Client.Do(req)
 }
 
-// NewV1ArticleListRequest generates requests for V1ArticleList
-func NewV1ArticleListRequest(server string, params *V1ArticleListParams) (*http.Request, error) {
+func (c *Client) V1UserDelete(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1UserDeleteRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1UserGet(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1UserGetRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1UserUpdateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1UserUpdateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) V1UserUpdate(ctx context.Context, body V1UserUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewV1UserUpdateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewWellKnownJWKSRequest generates requests for WellKnownJWKS
+func NewWellKnownJWKSRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -453,7 +719,7 @@ func NewV1ArticleListRequest(server string, params *V1ArticleListParams) (*http.
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/articles")
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -463,24 +729,35 @@ func NewV1ArticleListRequest(server string, params *V1ArticleListParams) (*http.
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Scope != nil {
+	return req, nil
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope", runtime.ParamLocationQuery, *params.Scope); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// NewV1AdminArticleTrashListRequest generates requests for V1AdminArticleTrashList
+func NewV1AdminArticleTrashListRequest(server string, params *V1AdminArticleTrashListParams) (*http.Request, error) {
+	var err error
 
-		}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/articles/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
 
 		if params.MaxPageSize != nil {
 
@@ -514,22 +791,6 @@ func NewV1ArticleListRequest(server string, params *V1ArticleListParams) (*http.
 
 		}
 
-		if params.Title != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title", runtime.ParamLocationQuery, *params.Title); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -541,19 +802,699 @@ func NewV1ArticleListRequest(server string, params *V1ArticleListParams) (*http.
 	return req, nil
 }
 
-// NewV1ArticleShareRequest calls the generic V1ArticleShare builder with application/json body
+// NewV1AdminArticleRestoreRequest generates requests for V1AdminArticleRestore
+func NewV1AdminArticleRestoreRequest(server string, articleId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "articleId", runtime.ParamLocationPath, articleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/articles/trash/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1AdminAuditListRequest generates requests for V1AdminAuditList
+func NewV1AdminAuditListRequest(server string, params *V1AdminAuditListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/audit")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ActorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actorId", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Email != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventType", runtime.ParamLocationQuery, *params.EventType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Outcome != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "outcome", runtime.ParamLocationQuery, *params.Outcome); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Ip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ip", runtime.ParamLocationQuery, *params.Ip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPageSize", runtime.ParamLocationQuery, *params.MaxPageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1AdminAuditExportRequest generates requests for V1AdminAuditExport
+func NewV1AdminAuditExportRequest(server string, params *V1AdminAuditExportParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/audit/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ActorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actorId", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Email != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email", runtime.ParamLocationQuery, *params.Email); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.EventType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "eventType", runtime.ParamLocationQuery, *params.EventType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Outcome != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "outcome", runtime.ParamLocationQuery, *params.Outcome); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Ip != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ip", runtime.ParamLocationQuery, *params.Ip); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1AdminUserDeleteRequest generates requests for V1AdminUserDelete
+func NewV1AdminUserDeleteRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/admin/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1ArticleListRequest generates requests for V1ArticleList
+func NewV1ArticleListRequest(server string, params *V1ArticleListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/articles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Scope != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope", runtime.ParamLocationQuery, *params.Scope); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "maxPageSize", runtime.ParamLocationQuery, *params.MaxPageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageToken", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Title != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title", runtime.ParamLocationQuery, *params.Title); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1ArticleShareRequest calls the generic V1ArticleShare builder with application/json body
 func NewV1ArticleShareRequest(server string, body V1ArticleShareJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV1ArticleShareRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewV1ArticleShareRequestWithBody generates requests for V1ArticleShare with any type of body
+func NewV1ArticleShareRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/articles")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV1ArticleRemoveOwnRequest generates requests for V1ArticleRemoveOwn
+func NewV1ArticleRemoveOwnRequest(server string, articleId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "articleId", runtime.ParamLocationPath, articleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/articles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1ArticleAddOwnRequest generates requests for V1ArticleAddOwn
+func NewV1ArticleAddOwnRequest(server string, articleId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "articleId", runtime.ParamLocationPath, articleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/articles/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1AuthChangeEmailRequest calls the generic V1AuthChangeEmail builder with application/json body
+func NewV1AuthChangeEmailRequest(server string, body V1AuthChangeEmailJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV1AuthChangeEmailRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewV1AuthChangeEmailRequestWithBody generates requests for V1AuthChangeEmail with any type of body
+func NewV1AuthChangeEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV1AuthConfirmEmailRequest generates requests for V1AuthConfirmEmail
+func NewV1AuthConfirmEmailRequest(server string, params *V1AuthConfirmEmailParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/email/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, params.Code); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1AuthVerifyEmailRequestWithFormdataBody calls the generic V1AuthVerifyEmail builder with application/x-www-form-urlencoded body
+func NewV1AuthVerifyEmailRequestWithFormdataBody(server string, body V1AuthVerifyEmailFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewV1ArticleShareRequestWithBody(server, "application/json", bodyReader)
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewV1AuthVerifyEmailRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
 }
 
-// NewV1ArticleShareRequestWithBody generates requests for V1ArticleShare with any type of body
-func NewV1ArticleShareRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewV1AuthVerifyEmailRequestWithBody generates requests for V1AuthVerifyEmail with any type of body
+func NewV1AuthVerifyEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -561,7 +1502,7 @@ func NewV1ArticleShareRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/articles")
+	operationPath := fmt.Sprintf("/v1/auth/email/verify")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -581,23 +1522,27 @@ func NewV1ArticleShareRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewV1ArticleRemoveOwnRequest generates requests for V1ArticleRemoveOwn
-func NewV1ArticleRemoveOwnRequest(server string, articleId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "articleId", runtime.ParamLocationPath, articleId)
+// NewV1AuthInviteRequest calls the generic V1AuthInvite builder with application/json body
+func NewV1AuthInviteRequest(server string, body V1AuthInviteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV1AuthInviteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewV1AuthInviteRequestWithBody generates requests for V1AuthInvite with any type of body
+func NewV1AuthInviteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/articles/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/auth/invite")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -607,31 +1552,37 @@ func NewV1ArticleRemoveOwnRequest(server string, articleId openapi_types.UUID) (
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewV1ArticleAddOwnRequest generates requests for V1ArticleAddOwn
-func NewV1ArticleAddOwnRequest(server string, articleId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "articleId", runtime.ParamLocationPath, articleId)
+// NewV1AuthJoinRequest calls the generic V1AuthJoin builder with application/json body
+func NewV1AuthJoinRequest(server string, body V1AuthJoinJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV1AuthJoinRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewV1AuthJoinRequestWithBody generates requests for V1AuthJoin with any type of body
+func NewV1AuthJoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/articles/%s", pathParam0)
+	operationPath := fmt.Sprintf("/v1/auth/join")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -641,35 +1592,44 @@ func NewV1ArticleAddOwnRequest(server string, articleId openapi_types.UUID) (*ht
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewV1AuthInviteRequest calls the generic V1AuthInvite builder with application/json body
-func NewV1AuthInviteRequest(server string, body V1AuthInviteJSONRequestBody) (*http.Request, error) {
+// NewV1AuthOIDCAuthorizeRequest calls the generic V1AuthOIDCAuthorize builder with application/json body
+func NewV1AuthOIDCAuthorizeRequest(server string, provider string, body V1AuthOIDCAuthorizeJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewV1AuthInviteRequestWithBody(server, "application/json", bodyReader)
+	return NewV1AuthOIDCAuthorizeRequestWithBody(server, provider, "application/json", bodyReader)
 }
 
-// NewV1AuthInviteRequestWithBody generates requests for V1AuthInvite with any type of body
-func NewV1AuthInviteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewV1AuthOIDCAuthorizeRequestWithBody generates requests for V1AuthOIDCAuthorize with any type of body
+func NewV1AuthOIDCAuthorizeRequestWithBody(server string, provider string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "provider", runtime.ParamLocationPath, provider)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/auth/invite")
+	operationPath := fmt.Sprintf("/v1/auth/oidc/%s/authorize", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -689,27 +1649,23 @@ func NewV1AuthInviteRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewV1AuthJoinRequest calls the generic V1AuthJoin builder with application/json body
-func NewV1AuthJoinRequest(server string, body V1AuthJoinJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewV1AuthOIDCCallbackRequest generates requests for V1AuthOIDCCallback
+func NewV1AuthOIDCCallbackRequest(server string, provider string, params *V1AuthOIDCCallbackParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "provider", runtime.ParamLocationPath, provider)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewV1AuthJoinRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewV1AuthJoinRequestWithBody generates requests for V1AuthJoin with any type of body
-func NewV1AuthJoinRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/auth/join")
+	operationPath := fmt.Sprintf("/v1/auth/oidc/%s/callback", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -719,13 +1675,41 @@ func NewV1AuthJoinRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code", runtime.ParamLocationQuery, params.Code); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, params.State); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
@@ -956,7 +1940,128 @@ func NewV1AuthSignUpRequestWithBody(server string, contentType string, body io.R
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/auth/signup")
+	operationPath := fmt.Sprintf("/v1/auth/signup")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewV1AuthVerifyRequest generates requests for V1AuthVerify
+func NewV1AuthVerifyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/auth/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1HealthAPIRequest generates requests for V1HealthAPI
+func NewV1HealthAPIRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/health/api")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1HealthCoreRequest generates requests for V1HealthCore
+func NewV1HealthCoreRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/health/core")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewV1InternalArticleShareRequest calls the generic V1InternalArticleShare builder with application/json body
+func NewV1InternalArticleShareRequest(server string, body V1InternalArticleShareJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewV1InternalArticleShareRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewV1InternalArticleShareRequestWithBody generates requests for V1InternalArticleShare with any type of body
+func NewV1InternalArticleShareRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/internal/articles")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -976,16 +2081,23 @@ func NewV1AuthSignUpRequestWithBody(server string, contentType string, body io.R
 	return req, nil
 }
 
-// NewV1AuthVerifyRequest generates requests for V1AuthVerify
-func NewV1AuthVerifyRequest(server string) (*http.Request, error) {
+// NewV1InternalArticleDeleteRequest generates requests for V1InternalArticleDelete
+func NewV1InternalArticleDeleteRequest(server string, articleId openapi_types.UUID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "articleId", runtime.ParamLocationPath, articleId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/auth/verify")
+	operationPath := fmt.Sprintf("/v1/internal/articles/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -995,7 +2107,7 @@ func NewV1AuthVerifyRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1003,8 +2115,8 @@ func NewV1AuthVerifyRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewV1HealthAPIRequest generates requests for V1HealthAPI
-func NewV1HealthAPIRequest(server string) (*http.Request, error) {
+// NewV1UserDeleteRequest generates requests for V1UserDelete
+func NewV1UserDeleteRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1012,7 +2124,7 @@ func NewV1HealthAPIRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/health/api")
+	operationPath := fmt.Sprintf("/v1/users/me")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1022,7 +2134,7 @@ func NewV1HealthAPIRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1030,8 +2142,8 @@ func NewV1HealthAPIRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewV1HealthCoreRequest generates requests for V1HealthCore
-func NewV1HealthCoreRequest(server string) (*http.Request, error) {
+// NewV1UserGetRequest generates requests for V1UserGet
+func NewV1UserGetRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1039,7 +2151,7 @@ func NewV1HealthCoreRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/health/core")
+	operationPath := fmt.Sprintf("/v1/users/me")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1057,19 +2169,19 @@ func NewV1HealthCoreRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewV1InternalArticleShareRequest calls the generic V1InternalArticleShare builder with application/json body
-func NewV1InternalArticleShareRequest(server string, body V1InternalArticleShareJSONRequestBody) (*http.Request, error) {
+// NewV1UserUpdateRequest calls the generic V1UserUpdate builder with application/json body
+func NewV1UserUpdateRequest(server string, body V1UserUpdateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewV1InternalArticleShareRequestWithBody(server, "application/json", bodyReader)
+	return NewV1UserUpdateRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewV1InternalArticleShareRequestWithBody generates requests for V1InternalArticleShare with any type of body
-func NewV1InternalArticleShareRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewV1UserUpdateRequestWithBody generates requests for V1UserUpdate with any type of body
+func NewV1UserUpdateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -1077,7 +2189,7 @@ func NewV1InternalArticleShareRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/internal/articles")
+	operationPath := fmt.Sprintf("/v1/users/me")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1087,7 +2199,7 @@ func NewV1InternalArticleShareRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -1097,40 +2209,6 @@ func NewV1InternalArticleShareRequestWithBody(server string, contentType string,
 	return req, nil
 }
 
-// NewV1InternalArticleDeleteRequest generates requests for V1InternalArticleDelete
-func NewV1InternalArticleDeleteRequest(server string, articleId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", true, "articleId", runtime.ParamLocationPath, articleId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/v1/internal/articles/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -1174,6 +2252,24 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// WellKnownJWKS request
+	WellKnownJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WellKnownJWKSResponse, error)
+
+	// V1AdminArticleTrashList request
+	V1AdminArticleTrashListWithResponse(ctx context.Context, params *V1AdminArticleTrashListParams, reqEditors ...RequestEditorFn) (*V1AdminArticleTrashListResponse, error)
+
+	// V1AdminArticleRestore request
+	V1AdminArticleRestoreWithResponse(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1AdminArticleRestoreResponse, error)
+
+	// V1AdminAuditList request
+	V1AdminAuditListWithResponse(ctx context.Context, params *V1AdminAuditListParams, reqEditors ...RequestEditorFn) (*V1AdminAuditListResponse, error)
+
+	// V1AdminAuditExport request
+	V1AdminAuditExportWithResponse(ctx context.Context, params *V1AdminAuditExportParams, reqEditors ...RequestEditorFn) (*V1AdminAuditExportResponse, error)
+
+	// V1AdminUserDelete request
+	V1AdminUserDeleteWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1AdminUserDeleteResponse, error)
+
 	// V1ArticleList request
 	V1ArticleListWithResponse(ctx context.Context, params *V1ArticleListParams, reqEditors ...RequestEditorFn) (*V1ArticleListResponse, error)
 
@@ -1188,6 +2284,19 @@ type ClientWithResponsesInterface interface {
 	// V1ArticleAddOwn request
 	V1ArticleAddOwnWithResponse(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1ArticleAddOwnResponse, error)
 
+	// V1AuthChangeEmail request with any body
+	V1AuthChangeEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthChangeEmailResponse, error)
+
+	V1AuthChangeEmailWithResponse(ctx context.Context, body V1AuthChangeEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AuthChangeEmailResponse, error)
+
+	// V1AuthConfirmEmail request
+	V1AuthConfirmEmailWithResponse(ctx context.Context, params *V1AuthConfirmEmailParams, reqEditors ...RequestEditorFn) (*V1AuthConfirmEmailResponse, error)
+
+	// V1AuthVerifyEmail request with any body
+	V1AuthVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthVerifyEmailResponse, error)
+
+	V1AuthVerifyEmailWithFormdataBodyWithResponse(ctx context.Context, body V1AuthVerifyEmailFormdataRequestBody, reqEditors ...RequestEditorFn) (*V1AuthVerifyEmailResponse, error)
+
 	// V1AuthInvite request with any body
 	V1AuthInviteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthInviteResponse, error)
 
@@ -1198,6 +2307,14 @@ type ClientWithResponsesInterface interface {
 
 	V1AuthJoinWithResponse(ctx context.Context, body V1AuthJoinJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AuthJoinResponse, error)
 
+	// V1AuthOIDCAuthorize request with any body
+	V1AuthOIDCAuthorizeWithBodyWithResponse(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthOIDCAuthorizeResponse, error)
+
+	V1AuthOIDCAuthorizeWithResponse(ctx context.Context, provider string, body V1AuthOIDCAuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AuthOIDCAuthorizeResponse, error)
+
+	// V1AuthOIDCCallback request
+	V1AuthOIDCCallbackWithResponse(ctx context.Context, provider string, params *V1AuthOIDCCallbackParams, reqEditors ...RequestEditorFn) (*V1AuthOIDCCallbackResponse, error)
+
 	// V1AuthChangePassword request with any body
 	V1AuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthChangePasswordResponse, error)
 
@@ -1238,16 +2355,220 @@ type ClientWithResponsesInterface interface {
 
 	// V1InternalArticleDelete request
 	V1InternalArticleDeleteWithResponse(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1InternalArticleDeleteResponse, error)
+
+	// V1UserDelete request
+	V1UserDeleteWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1UserDeleteResponse, error)
+
+	// V1UserGet request
+	V1UserGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1UserGetResponse, error)
+
+	// V1UserUpdate request with any body
+	V1UserUpdateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1UserUpdateResponse, error)
+
+	V1UserUpdateWithResponse(ctx context.Context, body V1UserUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*V1UserUpdateResponse, error)
+}
+
+type WellKnownJWKSResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSResponseSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r WellKnownJWKSResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WellKnownJWKSResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1AdminArticleTrashListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1AdminArticleTrashListResponseSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1AdminArticleTrashListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1AdminArticleTrashListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1AdminArticleRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r V1AdminArticleRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1AdminArticleRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1AdminAuditListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1AdminAuditListResponseSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1AdminAuditListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1AdminAuditListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1AdminAuditExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r V1AdminAuditExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1AdminAuditExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1AdminUserDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1UserDeleteResponseSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1AdminUserDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1AdminUserDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1ArticleListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1ArticleListResponseSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1ArticleListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1ArticleListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1ArticleShareResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r V1ArticleShareResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1ArticleShareResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1ArticleRemoveOwnResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r V1ArticleRemoveOwnResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1ArticleRemoveOwnResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
-type V1ArticleListResponse struct {
+type V1ArticleAddOwnResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *V1ArticleListResponseSchema
 }
 
 // Status returns HTTPResponse.Status
-func (r V1ArticleListResponse) Status() string {
+func (r V1ArticleAddOwnResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1255,20 +2576,20 @@ func (r V1ArticleListResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r V1ArticleListResponse) StatusCode() int {
+func (r V1ArticleAddOwnResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type V1ArticleShareResponse struct {
+type V1AuthChangeEmailResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r V1ArticleShareResponse) Status() string {
+func (r V1AuthChangeEmailResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1276,20 +2597,20 @@ func (r V1ArticleShareResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r V1ArticleShareResponse) StatusCode() int {
+func (r V1AuthChangeEmailResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type V1ArticleRemoveOwnResponse struct {
+type V1AuthConfirmEmailResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r V1ArticleRemoveOwnResponse) Status() string {
+func (r V1AuthConfirmEmailResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1297,20 +2618,20 @@ func (r V1ArticleRemoveOwnResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r V1ArticleRemoveOwnResponse) StatusCode() int {
+func (r V1AuthConfirmEmailResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type V1ArticleAddOwnResponse struct {
+type V1AuthVerifyEmailResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 }
 
 // Status returns HTTPResponse.Status
-func (r V1ArticleAddOwnResponse) Status() string {
+func (r V1AuthVerifyEmailResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -1318,7 +2639,7 @@ func (r V1ArticleAddOwnResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r V1ArticleAddOwnResponse) StatusCode() int {
+func (r V1AuthVerifyEmailResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -1369,6 +2690,49 @@ func (r V1AuthJoinResponse) StatusCode() int {
 	return 0
 }
 
+type V1AuthOIDCAuthorizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1AuthOIDCAuthorizeResponseSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1AuthOIDCAuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1AuthOIDCAuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1AuthOIDCCallbackResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r V1AuthOIDCCallbackResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1AuthOIDCCallbackResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type V1AuthChangePasswordResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1601,6 +2965,126 @@ func (r V1InternalArticleDeleteResponse) StatusCode() int {
 	return 0
 }
 
+type V1UserDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *V1UserDeleteResponseSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1UserDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1UserDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1UserGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1UserGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1UserGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type V1UserUpdateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSchema
+}
+
+// Status returns HTTPResponse.Status
+func (r V1UserUpdateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r V1UserUpdateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// WellKnownJWKSWithResponse request returning *WellKnownJWKSResponse
+func (c *ClientWithResponses) WellKnownJWKSWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*WellKnownJWKSResponse, error) {
+	rsp, err := c.WellKnownJWKS(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWellKnownJWKSResponse(rsp)
+}
+
+// V1AdminArticleTrashListWithResponse request returning *V1AdminArticleTrashListResponse
+func (c *ClientWithResponses) V1AdminArticleTrashListWithResponse(ctx context.Context, params *V1AdminArticleTrashListParams, reqEditors ...RequestEditorFn) (*V1AdminArticleTrashListResponse, error) {
+	rsp, err := c.V1AdminArticleTrashList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AdminArticleTrashListResponse(rsp)
+}
+
+// V1AdminArticleRestoreWithResponse request returning *V1AdminArticleRestoreResponse
+func (c *ClientWithResponses) V1AdminArticleRestoreWithResponse(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1AdminArticleRestoreResponse, error) {
+	rsp, err := c.V1AdminArticleRestore(ctx, articleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AdminArticleRestoreResponse(rsp)
+}
+
+// V1AdminAuditListWithResponse request returning *V1AdminAuditListResponse
+func (c *ClientWithResponses) V1AdminAuditListWithResponse(ctx context.Context, params *V1AdminAuditListParams, reqEditors ...RequestEditorFn) (*V1AdminAuditListResponse, error) {
+	rsp, err := c.V1AdminAuditList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AdminAuditListResponse(rsp)
+}
+
+// V1AdminAuditExportWithResponse request returning *V1AdminAuditExportResponse
+func (c *ClientWithResponses) V1AdminAuditExportWithResponse(ctx context.Context, params *V1AdminAuditExportParams, reqEditors ...RequestEditorFn) (*V1AdminAuditExportResponse, error) {
+	rsp, err := c.V1AdminAuditExport(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AdminAuditExportResponse(rsp)
+}
+
+// V1AdminUserDeleteWithResponse request returning *V1AdminUserDeleteResponse
+func (c *ClientWithResponses) V1AdminUserDeleteWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1AdminUserDeleteResponse, error) {
+	rsp, err := c.V1AdminUserDelete(ctx, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AdminUserDeleteResponse(rsp)
+}
+
 // V1ArticleListWithResponse request returning *V1ArticleListResponse
 func (c *ClientWithResponses) V1ArticleListWithResponse(ctx context.Context, params *V1ArticleListParams, reqEditors ...RequestEditorFn) (*V1ArticleListResponse, error) {
 	rsp, err := c.V1ArticleList(ctx, params, reqEditors...)
@@ -1645,6 +3129,49 @@ func (c *ClientWithResponses) V1ArticleAddOwnWithResponse(ctx context.Context, a
 	return ParseV1ArticleAddOwnResponse(rsp)
 }
 
+// V1AuthChangeEmailWithBodyWithResponse request with arbitrary body returning *V1AuthChangeEmailResponse
+func (c *ClientWithResponses) V1AuthChangeEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthChangeEmailResponse, error) {
+	rsp, err := c.V1AuthChangeEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthChangeEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) V1AuthChangeEmailWithResponse(ctx context.Context, body V1AuthChangeEmailJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AuthChangeEmailResponse, error) {
+	rsp, err := c.V1AuthChangeEmail(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthChangeEmailResponse(rsp)
+}
+
+// V1AuthConfirmEmailWithResponse request returning *V1AuthConfirmEmailResponse
+func (c *ClientWithResponses) V1AuthConfirmEmailWithResponse(ctx context.Context, params *V1AuthConfirmEmailParams, reqEditors ...RequestEditorFn) (*V1AuthConfirmEmailResponse, error) {
+	rsp, err := c.V1AuthConfirmEmail(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthConfirmEmailResponse(rsp)
+}
+
+// V1AuthVerifyEmailWithBodyWithResponse request with arbitrary body returning *V1AuthVerifyEmailResponse
+func (c *ClientWithResponses) V1AuthVerifyEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthVerifyEmailResponse, error) {
+	rsp, err := c.V1AuthVerifyEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthVerifyEmailResponse(rsp)
+}
+
+func (c *ClientWithResponses) V1AuthVerifyEmailWithFormdataBodyWithResponse(ctx context.Context, body V1AuthVerifyEmailFormdataRequestBody, reqEditors ...RequestEditorFn) (*V1AuthVerifyEmailResponse, error) {
+	rsp, err := c.V1AuthVerifyEmailWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthVerifyEmailResponse(rsp)
+}
+
 // V1AuthInviteWithBodyWithResponse request with arbitrary body returning *V1AuthInviteResponse
 func (c *ClientWithResponses) V1AuthInviteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthInviteResponse, error) {
 	rsp, err := c.V1AuthInviteWithBody(ctx, contentType, body, reqEditors...)
@@ -1679,6 +3206,32 @@ func (c *ClientWithResponses) V1AuthJoinWithResponse(ctx context.Context, body V
 	return ParseV1AuthJoinResponse(rsp)
 }
 
+// V1AuthOIDCAuthorizeWithBodyWithResponse request with arbitrary body returning *V1AuthOIDCAuthorizeResponse
+func (c *ClientWithResponses) V1AuthOIDCAuthorizeWithBodyWithResponse(ctx context.Context, provider string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthOIDCAuthorizeResponse, error) {
+	rsp, err := c.V1AuthOIDCAuthorizeWithBody(ctx, provider, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthOIDCAuthorizeResponse(rsp)
+}
+
+func (c *ClientWithResponses) V1AuthOIDCAuthorizeWithResponse(ctx context.Context, provider string, body V1AuthOIDCAuthorizeJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AuthOIDCAuthorizeResponse, error) {
+	rsp, err := c.V1AuthOIDCAuthorize(ctx, provider, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthOIDCAuthorizeResponse(rsp)
+}
+
+// V1AuthOIDCCallbackWithResponse request returning *V1AuthOIDCCallbackResponse
+func (c *ClientWithResponses) V1AuthOIDCCallbackWithResponse(ctx context.Context, provider string, params *V1AuthOIDCCallbackParams, reqEditors ...RequestEditorFn) (*V1AuthOIDCCallbackResponse, error) {
+	rsp, err := c.V1AuthOIDCCallback(ctx, provider, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthOIDCCallbackResponse(rsp)
+}
+
 // V1AuthChangePasswordWithBodyWithResponse request with arbitrary body returning *V1AuthChangePasswordResponse
 func (c *ClientWithResponses) V1AuthChangePasswordWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthChangePasswordResponse, error) {
 	rsp, err := c.V1AuthChangePasswordWithBody(ctx, contentType, body, reqEditors...)
@@ -1737,77 +3290,248 @@ func (c *ClientWithResponses) V1AuthSignOutAllWithResponse(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1AuthSignOutAllResponse(rsp)
+	return ParseV1AuthSignOutAllResponse(rsp)
+}
+
+// V1AuthSignUpWithBodyWithResponse request with arbitrary body returning *V1AuthSignUpResponse
+func (c *ClientWithResponses) V1AuthSignUpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthSignUpResponse, error) {
+	rsp, err := c.V1AuthSignUpWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthSignUpResponse(rsp)
+}
+
+func (c *ClientWithResponses) V1AuthSignUpWithResponse(ctx context.Context, body V1AuthSignUpJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AuthSignUpResponse, error) {
+	rsp, err := c.V1AuthSignUp(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthSignUpResponse(rsp)
+}
+
+// V1AuthVerifyWithResponse request returning *V1AuthVerifyResponse
+func (c *ClientWithResponses) V1AuthVerifyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1AuthVerifyResponse, error) {
+	rsp, err := c.V1AuthVerify(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1AuthVerifyResponse(rsp)
+}
+
+// V1HealthAPIWithResponse request returning *V1HealthAPIResponse
+func (c *ClientWithResponses) V1HealthAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1HealthAPIResponse, error) {
+	rsp, err := c.V1HealthAPI(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1HealthAPIResponse(rsp)
+}
+
+// V1HealthCoreWithResponse request returning *V1HealthCoreResponse
+func (c *ClientWithResponses) V1HealthCoreWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1HealthCoreResponse, error) {
+	rsp, err := c.V1HealthCore(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1HealthCoreResponse(rsp)
+}
+
+// V1InternalArticleShareWithBodyWithResponse request with arbitrary body returning *V1InternalArticleShareResponse
+func (c *ClientWithResponses) V1InternalArticleShareWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1InternalArticleShareResponse, error) {
+	rsp, err := c.V1InternalArticleShareWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1InternalArticleShareResponse(rsp)
+}
+
+func (c *ClientWithResponses) V1InternalArticleShareWithResponse(ctx context.Context, body V1InternalArticleShareJSONRequestBody, reqEditors ...RequestEditorFn) (*V1InternalArticleShareResponse, error) {
+	rsp, err := c.V1InternalArticleShare(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1InternalArticleShareResponse(rsp)
+}
+
+// V1InternalArticleDeleteWithResponse request returning *V1InternalArticleDeleteResponse
+func (c *ClientWithResponses) V1InternalArticleDeleteWithResponse(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1InternalArticleDeleteResponse, error) {
+	rsp, err := c.V1InternalArticleDelete(ctx, articleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1InternalArticleDeleteResponse(rsp)
+}
+
+// V1UserDeleteWithResponse request returning *V1UserDeleteResponse
+func (c *ClientWithResponses) V1UserDeleteWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1UserDeleteResponse, error) {
+	rsp, err := c.V1UserDelete(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1UserDeleteResponse(rsp)
+}
+
+// V1UserGetWithResponse request returning *V1UserGetResponse
+func (c *ClientWithResponses) V1UserGetWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1UserGetResponse, error) {
+	rsp, err := c.V1UserGet(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseV1UserGetResponse(rsp)
 }
 
-// V1AuthSignUpWithBodyWithResponse request with arbitrary body returning *V1AuthSignUpResponse
-func (c *ClientWithResponses) V1AuthSignUpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1AuthSignUpResponse, error) {
-	rsp, err := c.V1AuthSignUpWithBody(ctx, contentType, body, reqEditors...)
+// V1UserUpdateWithBodyWithResponse request with arbitrary body returning *V1UserUpdateResponse
+func (c *ClientWithResponses) V1UserUpdateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1UserUpdateResponse, error) {
+	rsp, err := c.V1UserUpdateWithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1AuthSignUpResponse(rsp)
+	return ParseV1UserUpdateResponse(rsp)
 }
 
-func (c *ClientWithResponses) V1AuthSignUpWithResponse(ctx context.Context, body V1AuthSignUpJSONRequestBody, reqEditors ...RequestEditorFn) (*V1AuthSignUpResponse, error) {
-	rsp, err := c.V1AuthSignUp(ctx, body, reqEditors...)
+func (c *ClientWithResponses) V1UserUpdateWithResponse(ctx context.Context, body V1UserUpdateJSONRequestBody, reqEditors ...RequestEditorFn) (*V1UserUpdateResponse, error) {
+	rsp, err := c.V1UserUpdate(ctx, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1AuthSignUpResponse(rsp)
+	return ParseV1UserUpdateResponse(rsp)
 }
 
-// V1AuthVerifyWithResponse request returning *V1AuthVerifyResponse
-func (c *ClientWithResponses) V1AuthVerifyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1AuthVerifyResponse, error) {
-	rsp, err := c.V1AuthVerify(ctx, reqEditors...)
+// ParseWellKnownJWKSResponse parses an HTTP response from a WellKnownJWKSWithResponse call
+func ParseWellKnownJWKSResponse(rsp *http.Response) (*WellKnownJWKSResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1AuthVerifyResponse(rsp)
+
+	response := &WellKnownJWKSResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSResponseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// V1HealthAPIWithResponse request returning *V1HealthAPIResponse
-func (c *ClientWithResponses) V1HealthAPIWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1HealthAPIResponse, error) {
-	rsp, err := c.V1HealthAPI(ctx, reqEditors...)
+// ParseV1AdminArticleTrashListResponse parses an HTTP response from a V1AdminArticleTrashListWithResponse call
+func ParseV1AdminArticleTrashListResponse(rsp *http.Response) (*V1AdminArticleTrashListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1HealthAPIResponse(rsp)
+
+	response := &V1AdminArticleTrashListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1AdminArticleTrashListResponseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// V1HealthCoreWithResponse request returning *V1HealthCoreResponse
-func (c *ClientWithResponses) V1HealthCoreWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*V1HealthCoreResponse, error) {
-	rsp, err := c.V1HealthCore(ctx, reqEditors...)
+// ParseV1AdminArticleRestoreResponse parses an HTTP response from a V1AdminArticleRestoreWithResponse call
+func ParseV1AdminArticleRestoreResponse(rsp *http.Response) (*V1AdminArticleRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1HealthCoreResponse(rsp)
+
+	response := &V1AdminArticleRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// V1InternalArticleShareWithBodyWithResponse request with arbitrary body returning *V1InternalArticleShareResponse
-func (c *ClientWithResponses) V1InternalArticleShareWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*V1InternalArticleShareResponse, error) {
-	rsp, err := c.V1InternalArticleShareWithBody(ctx, contentType, body, reqEditors...)
+// ParseV1AdminAuditListResponse parses an HTTP response from a V1AdminAuditListWithResponse call
+func ParseV1AdminAuditListResponse(rsp *http.Response) (*V1AdminAuditListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1InternalArticleShareResponse(rsp)
+
+	response := &V1AdminAuditListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1AdminAuditListResponseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) V1InternalArticleShareWithResponse(ctx context.Context, body V1InternalArticleShareJSONRequestBody, reqEditors ...RequestEditorFn) (*V1InternalArticleShareResponse, error) {
-	rsp, err := c.V1InternalArticleShare(ctx, body, reqEditors...)
+// ParseV1AdminAuditExportResponse parses an HTTP response from a V1AdminAuditExportWithResponse call
+func ParseV1AdminAuditExportResponse(rsp *http.Response) (*V1AdminAuditExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1InternalArticleShareResponse(rsp)
+
+	response := &V1AdminAuditExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// V1InternalArticleDeleteWithResponse request returning *V1InternalArticleDeleteResponse
-func (c *ClientWithResponses) V1InternalArticleDeleteWithResponse(ctx context.Context, articleId openapi_types.UUID, reqEditors ...RequestEditorFn) (*V1InternalArticleDeleteResponse, error) {
-	rsp, err := c.V1InternalArticleDelete(ctx, articleId, reqEditors...)
+// ParseV1AdminUserDeleteResponse parses an HTTP response from a V1AdminUserDeleteWithResponse call
+func ParseV1AdminUserDeleteResponse(rsp *http.Response) (*V1AdminUserDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseV1InternalArticleDeleteResponse(rsp)
+
+	response := &V1AdminUserDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1UserDeleteResponseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
 // ParseV1ArticleListResponse parses an HTTP response from a V1ArticleListWithResponse call
@@ -1884,6 +3608,54 @@ func ParseV1ArticleAddOwnResponse(rsp *http.Response) (*V1ArticleAddOwnResponse,
 	return response, nil
 }
 
+// ParseV1AuthChangeEmailResponse parses an HTTP response from a V1AuthChangeEmailWithResponse call
+func ParseV1AuthChangeEmailResponse(rsp *http.Response) (*V1AuthChangeEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1AuthChangeEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseV1AuthConfirmEmailResponse parses an HTTP response from a V1AuthConfirmEmailWithResponse call
+func ParseV1AuthConfirmEmailResponse(rsp *http.Response) (*V1AuthConfirmEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1AuthConfirmEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseV1AuthVerifyEmailResponse parses an HTTP response from a V1AuthVerifyEmailWithResponse call
+func ParseV1AuthVerifyEmailResponse(rsp *http.Response) (*V1AuthVerifyEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1AuthVerifyEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseV1AuthInviteResponse parses an HTTP response from a V1AuthInviteWithResponse call
 func ParseV1AuthInviteResponse(rsp *http.Response) (*V1AuthInviteResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1936,6 +3708,48 @@ func ParseV1AuthJoinResponse(rsp *http.Response) (*V1AuthJoinResponse, error) {
 	return response, nil
 }
 
+// ParseV1AuthOIDCAuthorizeResponse parses an HTTP response from a V1AuthOIDCAuthorizeWithResponse call
+func ParseV1AuthOIDCAuthorizeResponse(rsp *http.Response) (*V1AuthOIDCAuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1AuthOIDCAuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1AuthOIDCAuthorizeResponseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseV1AuthOIDCCallbackResponse parses an HTTP response from a V1AuthOIDCCallbackWithResponse call
+func ParseV1AuthOIDCCallbackResponse(rsp *http.Response) (*V1AuthOIDCCallbackResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1AuthOIDCCallbackResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseV1AuthChangePasswordResponse parses an HTTP response from a V1AuthChangePasswordWithResponse call
 func ParseV1AuthChangePasswordResponse(rsp *http.Response) (*V1AuthChangePasswordResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -2121,3 +3935,81 @@ func ParseV1InternalArticleDeleteResponse(rsp *http.Response) (*V1InternalArticl
 
 	return response, nil
 }
+
+// ParseV1UserDeleteResponse parses an HTTP response from a V1UserDeleteWithResponse call
+func ParseV1UserDeleteResponse(rsp *http.Response) (*V1UserDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1UserDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest V1UserDeleteResponseSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseV1UserGetResponse parses an HTTP response from a V1UserGetWithResponse call
+func ParseV1UserGetResponse(rsp *http.Response) (*V1UserGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1UserGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseV1UserUpdateResponse parses an HTTP response from a V1UserUpdateWithResponse call
+func ParseV1UserUpdateResponse(rsp *http.Response) (*V1UserUpdateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &V1UserUpdateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSchema
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -15,6 +15,24 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// JWKS
+	// (GET /.well-known/jwks.json)
+	WellKnownJWKS(w http.ResponseWriter, r *http.Request)
+	// ゴミ箱一覧
+	// (GET /v1/admin/articles/trash)
+	V1AdminArticleTrashList(w http.ResponseWriter, r *http.Request, params V1AdminArticleTrashListParams)
+	// 記事復元
+	// (POST /v1/admin/articles/trash/{articleId}/restore)
+	V1AdminArticleRestore(w http.ResponseWriter, r *http.Request, articleId openapi_types.UUID)
+	// 監査ログ一覧
+	// (GET /v1/admin/audit)
+	V1AdminAuditList(w http.ResponseWriter, r *http.Request, params V1AdminAuditListParams)
+	// 監査ログエクスポート
+	// (GET /v1/admin/audit/export)
+	V1AdminAuditExport(w http.ResponseWriter, r *http.Request, params V1AdminAuditExportParams)
+	// アカウント削除
+	// (DELETE /v1/admin/users/{userId})
+	V1AdminUserDelete(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
 	// 記事一覧
 	// (GET /v1/articles)
 	V1ArticleList(w http.ResponseWriter, r *http.Request, params V1ArticleListParams)
@@ -27,12 +45,27 @@ type ServerInterface interface {
 	// 記事追加
 	// (POST /v1/articles/{articleId})
 	V1ArticleAddOwn(w http.ResponseWriter, r *http.Request, articleId openapi_types.UUID)
+	// メールアドレス変更
+	// (PUT /v1/auth/email)
+	V1AuthChangeEmail(w http.ResponseWriter, r *http.Request)
+	// メールアドレス検証の確認
+	// (GET /v1/auth/email/verify)
+	V1AuthConfirmEmail(w http.ResponseWriter, r *http.Request, params V1AuthConfirmEmailParams)
+	// メールアドレス検証
+	// (POST /v1/auth/email/verify)
+	V1AuthVerifyEmail(w http.ResponseWriter, r *http.Request)
 	// 招待
 	// (POST /v1/auth/invite)
 	V1AuthInvite(w http.ResponseWriter, r *http.Request)
 	// 参加
 	// (POST /v1/auth/join)
 	V1AuthJoin(w http.ResponseWriter, r *http.Request)
+	// OIDC認可
+	// (POST /v1/auth/oidc/{provider}/authorize)
+	V1AuthOIDCAuthorize(w http.ResponseWriter, r *http.Request, provider string)
+	// OIDCコールバック
+	// (GET /v1/auth/oidc/{provider}/callback)
+	V1AuthOIDCCallback(w http.ResponseWriter, r *http.Request, provider string, params V1AuthOIDCCallbackParams)
 	// パスワード変更
 	// (PUT /v1/auth/password)
 	V1AuthChangePassword(w http.ResponseWriter, r *http.Request)
@@ -66,6 +99,15 @@ type ServerInterface interface {
 	// 記事削除
 	// (DELETE /v1/internal/articles/{articleId})
 	V1InternalArticleDelete(w http.ResponseWriter, r *http.Request, articleId openapi_types.UUID)
+	// アカウント削除
+	// (DELETE /v1/users/me)
+	V1UserDelete(w http.ResponseWriter, r *http.Request)
+	// プロフィール取得
+	// (GET /v1/users/me)
+	V1UserGet(w http.ResponseWriter, r *http.Request)
+	// プロフィール更新
+	// (PATCH /v1/users/me)
+	V1UserUpdate(w http.ResponseWriter, r *http.Request)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -77,6 +119,287 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// WellKnownJWKS operation middleware
+func (siw *ServerInterfaceWrapper) WellKnownJWKS(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WellKnownJWKS(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AdminArticleTrashList operation middleware
+func (siw *ServerInterfaceWrapper) V1AdminArticleTrashList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1AdminArticleTrashListParams
+
+	// ------------- Optional query parameter "maxPageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPageSize", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxPageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageToken", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AdminArticleTrashList(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AdminArticleRestore operation middleware
+func (siw *ServerInterfaceWrapper) V1AdminArticleRestore(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "articleId" -------------
+	var articleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", true, "articleId", runtime.ParamLocationPath, chi.URLParam(r, "articleId"), &articleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "articleId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AdminArticleRestore(w, r, articleId)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AdminAuditList operation middleware
+func (siw *ServerInterfaceWrapper) V1AdminAuditList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1AdminAuditListParams
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actorId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", r.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "email", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "eventType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventType", r.URL.Query(), &params.EventType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "outcome" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "outcome", r.URL.Query(), &params.Outcome)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "outcome", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ip", r.URL.Query(), &params.Ip)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ip", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "maxPageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "maxPageSize", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maxPageSize", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageToken", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageToken", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AdminAuditList(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AdminAuditExport operation middleware
+func (siw *ServerInterfaceWrapper) V1AdminAuditExport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1AdminAuditExportParams
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actorId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", r.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "email", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "eventType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "eventType", r.URL.Query(), &params.EventType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "eventType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "outcome" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "outcome", r.URL.Query(), &params.Outcome)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "outcome", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ip" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ip", r.URL.Query(), &params.Ip)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ip", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", r.URL.Query(), &params.Until)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "until", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AdminAuditExport(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AdminUserDelete operation middleware
+func (siw *ServerInterfaceWrapper) V1AdminUserDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", true, "userId", runtime.ParamLocationPath, chi.URLParam(r, "userId"), &userId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AdminUserDelete(w, r, userId)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // V1ArticleList operation middleware
 func (siw *ServerInterfaceWrapper) V1ArticleList(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -210,6 +533,75 @@ func (siw *ServerInterfaceWrapper) V1ArticleAddOwn(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// V1AuthChangeEmail operation middleware
+func (siw *ServerInterfaceWrapper) V1AuthChangeEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthTokenCookieScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionTokenCookieScopes, []string{})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AuthChangeEmail(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AuthConfirmEmail operation middleware
+func (siw *ServerInterfaceWrapper) V1AuthConfirmEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1AuthConfirmEmailParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AuthConfirmEmail(w, r, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AuthVerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) V1AuthVerifyEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AuthVerifyEmail(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // V1AuthInvite operation middleware
 func (siw *ServerInterfaceWrapper) V1AuthInvite(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -242,6 +634,91 @@ func (siw *ServerInterfaceWrapper) V1AuthJoin(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// V1AuthOIDCAuthorize operation middleware
+func (siw *ServerInterfaceWrapper) V1AuthOIDCAuthorize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithLocation("simple", true, "provider", runtime.ParamLocationPath, chi.URLParam(r, "provider"), &provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AuthOIDCAuthorize(w, r, provider)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1AuthOIDCCallback operation middleware
+func (siw *ServerInterfaceWrapper) V1AuthOIDCCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider string
+
+	err = runtime.BindStyledParameterWithLocation("simple", true, "provider", runtime.ParamLocationPath, chi.URLParam(r, "provider"), &provider)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params V1AuthOIDCCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1AuthOIDCCallback(w, r, provider, params)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // V1AuthChangePassword operation middleware
 func (siw *ServerInterfaceWrapper) V1AuthChangePassword(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -485,6 +962,63 @@ func (siw *ServerInterfaceWrapper) V1InternalArticleDelete(w http.ResponseWriter
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// V1UserDelete operation middleware
+func (siw *ServerInterfaceWrapper) V1UserDelete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthTokenCookieScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionTokenCookieScopes, []string{})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UserDelete(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1UserGet operation middleware
+func (siw *ServerInterfaceWrapper) V1UserGet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthTokenCookieScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionTokenCookieScopes, []string{})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UserGet(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// V1UserUpdate operation middleware
+func (siw *ServerInterfaceWrapper) V1UserUpdate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, AuthTokenCookieScopes, []string{})
+
+	ctx = context.WithValue(ctx, SessionTokenCookieScopes, []string{})
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.V1UserUpdate(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -598,6 +1132,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/.well-known/jwks.json", wrapper.WellKnownJWKS)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/articles/trash", wrapper.V1AdminArticleTrashList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/admin/articles/trash/{articleId}/restore", wrapper.V1AdminArticleRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/audit", wrapper.V1AdminAuditList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/admin/audit/export", wrapper.V1AdminAuditExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/admin/users/{userId}", wrapper.V1AdminUserDelete)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/v1/articles", wrapper.V1ArticleList)
 	})
@@ -610,12 +1162,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/articles/{articleId}", wrapper.V1ArticleAddOwn)
 	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v1/auth/email", wrapper.V1AuthChangeEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/auth/email/verify", wrapper.V1AuthConfirmEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/auth/email/verify", wrapper.V1AuthVerifyEmail)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/auth/invite", wrapper.V1AuthInvite)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/v1/auth/join", wrapper.V1AuthJoin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/auth/oidc/{provider}/authorize", wrapper.V1AuthOIDCAuthorize)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/auth/oidc/{provider}/callback", wrapper.V1AuthOIDCCallback)
+	})
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/v1/auth/password", wrapper.V1AuthChangePassword)
 	})
@@ -649,6 +1216,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/v1/internal/articles/{articleId}", wrapper.V1InternalArticleDelete)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v1/users/me", wrapper.V1UserDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/users/me", wrapper.V1UserGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v1/users/me", wrapper.V1UserUpdate)
+	})
 
 	return r
 }
@@ -4,6 +4,8 @@
 package openapi
 
 import (
+	"time"
+
 	openapi_types "github.com/deepmap/oapi-codegen/pkg/types"
 )
 
@@ -13,6 +15,32 @@ const (
 	SessionTokenCookieScopes = "sessionTokenCookie.Scopes"
 )
 
+// Defines values for AuditEventTypeSchema.
+const (
+	AccountDelete  AuditEventTypeSchema = "account_delete"
+	EmailChange    AuditEventTypeSchema = "email_change"
+	InvitationUse  AuditEventTypeSchema = "invitation_use"
+	PasswordChange AuditEventTypeSchema = "password_change"
+	Signin         AuditEventTypeSchema = "signin"
+	Signout        AuditEventTypeSchema = "signout"
+	SignoutAll     AuditEventTypeSchema = "signout_all"
+)
+
+// Defines values for AuditOutcomeSchema.
+const (
+	Failure AuditOutcomeSchema = "failure"
+	Success AuditOutcomeSchema = "success"
+)
+
+// Defines values for V1UserUpdateRequestSchemaUpdateMask.
+const (
+	AvatarUrl   V1UserUpdateRequestSchemaUpdateMask = "avatarUrl"
+	Bio         V1UserUpdateRequestSchemaUpdateMask = "bio"
+	DisplayName V1UserUpdateRequestSchemaUpdateMask = "displayName"
+	Locale      V1UserUpdateRequestSchemaUpdateMask = "locale"
+	Timezone    V1UserUpdateRequestSchemaUpdateMask = "timezone"
+)
+
 // Defines values for V1ArticleListParamsScope.
 const (
 	All V1ArticleListParamsScope = "all"
@@ -25,7 +53,8 @@ type ArticleSchema struct {
 	Description *string `json:"description,omitempty"`
 
 	// Id id
-	Id *openapi_types.UUID `json:"id,omitempty"`
+	Id       *openapi_types.UUID  `json:"id,omitempty"`
+	SharedBy *ArticleSharerSchema `json:"sharedBy,omitempty"`
 
 	// Tags タグ
 	Tags *[]string `json:"tags,omitempty"`
@@ -40,6 +69,145 @@ type ArticleSchema struct {
 	Url *string `json:"url,omitempty"`
 }
 
+// ArticleSharerSchema defines model for ArticleSharerSchema.
+type ArticleSharerSchema struct {
+	// AvatarUrl アバター画像のURL
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+
+	// DisplayName 表示名
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Id 共有したユーザーのid
+	Id openapi_types.UUID `json:"id"`
+}
+
+// AuditEventTypeSchema イベント種別
+type AuditEventTypeSchema string
+
+// AuditLogSchema defines model for AuditLogSchema.
+type AuditLogSchema struct {
+	// ActorId 操作者のユーザーID、特定できない場合は含まれない
+	ActorId *openapi_types.UUID `json:"actorId,omitempty"`
+
+	// Email メールアドレス
+	Email *string `json:"email,omitempty"`
+
+	// EventType イベント種別
+	EventType AuditEventTypeSchema `json:"eventType"`
+
+	// ForwardedFor X-Forwarded-Forヘッダーの値
+	ForwardedFor *string `json:"forwardedFor,omitempty"`
+
+	// Id id
+	Id openapi_types.UUID `json:"id"`
+
+	// Ip 接続元IPアドレス
+	Ip *string `json:"ip,omitempty"`
+
+	// OccurredAt 発生日時
+	OccurredAt time.Time `json:"occurredAt"`
+
+	// Outcome 結果
+	Outcome AuditOutcomeSchema `json:"outcome"`
+
+	// TraceId トレースID
+	TraceId *string `json:"traceId,omitempty"`
+
+	// UserAgent ユーザーエージェント
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// AuditOutcomeSchema 結果
+type AuditOutcomeSchema string
+
+// JWKSResponseSchema defines model for JWKSResponseSchema.
+type JWKSResponseSchema struct {
+	// Keys 公開鍵の一覧
+	Keys []JWKSchema `json:"keys"`
+}
+
+// JWKSchema defines model for JWKSchema.
+type JWKSchema struct {
+	// Alg 署名アルゴリズム
+	Alg string `json:"alg"`
+
+	// Crv 曲線
+	Crv *string `json:"crv,omitempty"`
+
+	// E RSA公開鍵のexponent
+	E *string `json:"e,omitempty"`
+
+	// Kid 鍵ID
+	Kid string `json:"kid"`
+
+	// Kty 鍵の種別
+	Kty string `json:"kty"`
+
+	// N RSA公開鍵のmodulus
+	N *string `json:"n,omitempty"`
+
+	// Use 用途
+	Use string `json:"use"`
+
+	// X EdDSA公開鍵
+	X *string `json:"x,omitempty"`
+}
+
+// TrashedArticleSchema defines model for TrashedArticleSchema.
+type TrashedArticleSchema struct {
+	Article ArticleSchema `json:"article"`
+
+	// DeletedAt ゴミ箱に移動した日時
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// UserExportSchema defines model for UserExportSchema.
+type UserExportSchema struct {
+	// Articles 共有した記事
+	Articles []ArticleSchema `json:"articles"`
+
+	// ExportedAt エクスポートした日時
+	ExportedAt time.Time  `json:"exportedAt"`
+	User       UserSchema `json:"user"`
+}
+
+// UserSchema defines model for UserSchema.
+type UserSchema struct {
+	// AvatarUrl アバター画像のURL
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+
+	// Bio 自己紹介
+	Bio *string `json:"bio,omitempty"`
+
+	// DisplayName 表示名
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Id id
+	Id openapi_types.UUID `json:"id"`
+
+	// Locale ロケール(BCP 47)
+	Locale *string `json:"locale,omitempty"`
+
+	// Timezone タイムゾーン(IANA)
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// V1AdminArticleTrashListResponseSchema defines model for V1AdminArticleTrashListResponseSchema.
+type V1AdminArticleTrashListResponseSchema struct {
+	Articles *[]TrashedArticleSchema `json:"articles,omitempty"`
+
+	// NextPageToken 次回リクエスト時に指定するページトークン
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
+// V1AdminAuditListResponseSchema defines model for V1AdminAuditListResponseSchema.
+type V1AdminAuditListResponseSchema struct {
+	Logs *[]AuditLogSchema `json:"logs,omitempty"`
+
+	// NextPageToken 次回リクエスト時に指定するページトークン(最後のページでは返さない)
+	NextPageToken *string `json:"nextPageToken,omitempty"`
+}
+
 // V1ArticleListResponseSchema defines model for V1ArticleListResponseSchema.
 type V1ArticleListResponseSchema struct {
 	Articles *[]ArticleSchema `json:"articles,omitempty"`
@@ -61,6 +229,18 @@ type V1ArticleShareRequestSchema struct {
 
 	// Url 記事のURL
 	Url string `json:"url"`
+
+	// UserId 共有したユーザーのid
+	UserId *openapi_types.UUID `json:"userId,omitempty"`
+}
+
+// V1AuthChangeEmailRequestSchema defines model for V1AuthChangeEmailRequestSchema.
+type V1AuthChangeEmailRequestSchema struct {
+	// Email 新しいメールアドレス
+	Email openapi_types.Email `json:"email"`
+
+	// Password パスワード
+	Password string `json:"password"`
 }
 
 // V1AuthChangePasswordRequestSchema defines model for V1AuthChangePasswordRequestSchema.
@@ -99,6 +279,24 @@ type V1AuthJoinRequestSchema struct {
 	Password string `json:"password"`
 }
 
+// V1AuthOIDCAuthorizeRequestSchema defines model for V1AuthOIDCAuthorizeRequestSchema.
+type V1AuthOIDCAuthorizeRequestSchema struct {
+	// ExpiresIn トークン有効期限(秒)
+	ExpiresIn *int `json:"expiresIn,omitempty"`
+
+	// InvitationCode 招待コード(未登録のユーザーが参加する場合のみ)
+	InvitationCode *string `json:"invitationCode,omitempty"`
+
+	// PublicKey 公開鍵
+	PublicKey string `json:"publicKey"`
+}
+
+// V1AuthOIDCAuthorizeResponseSchema defines model for V1AuthOIDCAuthorizeResponseSchema.
+type V1AuthOIDCAuthorizeResponseSchema struct {
+	// Url 認可URL
+	Url string `json:"url"`
+}
+
 // V1AuthSignInRequestSchema defines model for V1AuthSignInRequestSchema.
 type V1AuthSignInRequestSchema struct {
 	// Email メールアドレス
@@ -123,6 +321,12 @@ type V1AuthSignUpRequestSchema struct {
 	Password string `json:"password"`
 }
 
+// V1AuthVerifyEmailRequestSchema defines model for V1AuthVerifyEmailRequestSchema.
+type V1AuthVerifyEmailRequestSchema struct {
+	// Code 検証コード
+	Code string `json:"code"`
+}
+
 // V1AuthVerifyUnauthorizedResponseSchema defines model for V1AuthVerifyUnauthorizedResponseSchema.
 type V1AuthVerifyUnauthorizedResponseSchema struct {
 	// Code リフレッシュコード
@@ -131,6 +335,101 @@ type V1AuthVerifyUnauthorizedResponseSchema struct {
 	Code openapi_types.UUID `json:"code"`
 }
 
+// V1UserDeleteResponseSchema defines model for V1UserDeleteResponseSchema.
+type V1UserDeleteResponseSchema struct {
+	Export UserExportSchema `json:"export"`
+
+	// PurgeAt データを完全に削除する日時
+	PurgeAt time.Time `json:"purgeAt"`
+}
+
+// V1UserUpdateRequestSchema defines model for V1UserUpdateRequestSchema.
+type V1UserUpdateRequestSchema struct {
+	// AvatarUrl アバター画像のURL(https)
+	AvatarUrl *string `json:"avatarUrl,omitempty"`
+
+	// Bio 自己紹介
+	Bio *string `json:"bio,omitempty"`
+
+	// DisplayName 表示名
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// Locale ロケール(BCP 47)
+	Locale *string `json:"locale,omitempty"`
+
+	// Timezone タイムゾーン(IANA)
+	Timezone *string `json:"timezone,omitempty"`
+
+	// UpdateMask 更新する項目
+	UpdateMask *[]V1UserUpdateRequestSchemaUpdateMask `json:"updateMask,omitempty"`
+}
+
+// V1UserUpdateRequestSchemaUpdateMask defines model for V1UserUpdateRequestSchema.UpdateMask.
+type V1UserUpdateRequestSchemaUpdateMask string
+
+// V1AdminArticleTrashListParams defines parameters for V1AdminArticleTrashList.
+type V1AdminArticleTrashListParams struct {
+	// MaxPageSize ページサイズ
+	MaxPageSize *int `form:"maxPageSize,omitempty" json:"maxPageSize,omitempty"`
+
+	// PageToken トークン
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+}
+
+// V1AdminAuditListParams defines parameters for V1AdminAuditList.
+type V1AdminAuditListParams struct {
+	// ActorId 操作者のユーザーID
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Email メールアドレス
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+
+	// EventType イベント種別
+	EventType *AuditEventTypeSchema `form:"eventType,omitempty" json:"eventType,omitempty"`
+
+	// Outcome 結果
+	Outcome *AuditOutcomeSchema `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// Ip 接続元IPアドレス
+	Ip *string `form:"ip,omitempty" json:"ip,omitempty"`
+
+	// Since この日時以降に発生したイベントに絞り込む
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until この日時より前に発生したイベントに絞り込む
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// MaxPageSize ページサイズ
+	MaxPageSize *int `form:"maxPageSize,omitempty" json:"maxPageSize,omitempty"`
+
+	// PageToken トークン
+	PageToken *string `form:"pageToken,omitempty" json:"pageToken,omitempty"`
+}
+
+// V1AdminAuditExportParams defines parameters for V1AdminAuditExport.
+type V1AdminAuditExportParams struct {
+	// ActorId 操作者のユーザーID
+	ActorId *openapi_types.UUID `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// Email メールアドレス
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+
+	// EventType イベント種別
+	EventType *AuditEventTypeSchema `form:"eventType,omitempty" json:"eventType,omitempty"`
+
+	// Outcome 結果
+	Outcome *AuditOutcomeSchema `form:"outcome,omitempty" json:"outcome,omitempty"`
+
+	// Ip 接続元IPアドレス
+	Ip *string `form:"ip,omitempty" json:"ip,omitempty"`
+
+	// Since この日時以降に発生したイベントに絞り込む
+	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
+
+	// Until この日時より前に発生したイベントに絞り込む
+	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+}
+
 // V1ArticleListParams defines parameters for V1ArticleList.
 type V1ArticleListParams struct {
 	// Scope 取得範囲
@@ -149,6 +448,21 @@ type V1ArticleListParams struct {
 // V1ArticleListParamsScope defines parameters for V1ArticleList.
 type V1ArticleListParamsScope string
 
+// V1AuthConfirmEmailParams defines parameters for V1AuthConfirmEmail.
+type V1AuthConfirmEmailParams struct {
+	// Code 検証コード
+	Code string `form:"code" json:"code"`
+}
+
+// V1AuthOIDCCallbackParams defines parameters for V1AuthOIDCCallback.
+type V1AuthOIDCCallbackParams struct {
+	// Code 認可コード
+	Code string `form:"code" json:"code"`
+
+	// State state
+	State string `form:"state" json:"state"`
+}
+
 // V1AuthRefreshParams defines parameters for V1AuthRefresh.
 type V1AuthRefreshParams struct {
 	// Code 署名付きコード
@@ -162,12 +476,21 @@ type V1AuthRefreshParams struct {
 // V1ArticleShareJSONRequestBody defines body for V1ArticleShare for application/json ContentType.
 type V1ArticleShareJSONRequestBody = V1ArticleShareRequestSchema
 
+// V1AuthChangeEmailJSONRequestBody defines body for V1AuthChangeEmail for application/json ContentType.
+type V1AuthChangeEmailJSONRequestBody = V1AuthChangeEmailRequestSchema
+
+// V1AuthVerifyEmailFormdataRequestBody defines body for V1AuthVerifyEmail for application/x-www-form-urlencoded ContentType.
+type V1AuthVerifyEmailFormdataRequestBody = V1AuthVerifyEmailRequestSchema
+
 // V1AuthInviteJSONRequestBody defines body for V1AuthInvite for application/json ContentType.
 type V1AuthInviteJSONRequestBody = V1AuthInviteRequestSchema
 
 // V1AuthJoinJSONRequestBody defines body for V1AuthJoin for application/json ContentType.
 type V1AuthJoinJSONRequestBody = V1AuthJoinRequestSchema
 
+// V1AuthOIDCAuthorizeJSONRequestBody defines body for V1AuthOIDCAuthorize for application/json ContentType.
+type V1AuthOIDCAuthorizeJSONRequestBody = V1AuthOIDCAuthorizeRequestSchema
+
 // V1AuthChangePasswordJSONRequestBody defines body for V1AuthChangePassword for application/json ContentType.
 type V1AuthChangePasswordJSONRequestBody = V1AuthChangePasswordRequestSchema
 
@@ -179,3 +502,6 @@ type V1AuthSignUpJSONRequestBody = V1AuthSignUpRequestSchema
 
 // V1InternalArticleShareJSONRequestBody defines body for V1InternalArticleShare for application/json ContentType.
 type V1InternalArticleShareJSONRequestBody = V1ArticleShareRequestSchema
+
+// V1UserUpdateJSONRequestBody defines body for V1UserUpdate for application/json ContentType.
+type V1UserUpdateJSONRequestBody = V1UserUpdateRequestSchema
@@ -2,15 +2,29 @@ package trace
 
 import (
 	"context"
+
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type key struct{}
 
+// WithTIDCtx トレースIDを設定する.
+// スパンが存在する場合はスパンのトレースIDを用い、ログとスパンを関連付ける.
 func WithTIDCtx(ctx context.Context) context.Context {
+	if tid, ok := spanTID(ctx); ok {
+		return context.WithValue(ctx, key{}, tid)
+	}
+
 	return context.WithValue(ctx, key{}, Generate())
 }
 
+// SetTIDCtx 伝搬されたトレースIDを設定する.
+// スパンが存在する場合はスパンのトレースIDを優先する.
 func SetTIDCtx(ctx context.Context, tid string) context.Context {
+	if v, ok := spanTID(ctx); ok {
+		tid = v
+	}
+
 	return context.WithValue(ctx, key{}, tid)
 }
 
@@ -24,3 +38,12 @@ func GetTIDCtx(ctx context.Context) string {
 
 	return tid
 }
+
+func spanTID(ctx context.Context) (string, bool) {
+	sc := oteltrace.SpanContextFromContext(ctx)
+	if !sc.HasTraceID() {
+		return "", false
+	}
+
+	return sc.TraceID().String(), true
+}